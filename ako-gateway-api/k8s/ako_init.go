@@ -33,9 +33,11 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
 	akogatewayapinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/nodes"
+	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
 	akogatewayapistatus "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/status"
 	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/k8s"
@@ -88,7 +90,7 @@ func (c *GatewayController) InitController(informers k8s.K8sinformers, registere
 	//HTTPRoutes can be attached to multiple gateways
 	//This will make HTTPRoute updates affect multiple graphs
 	numWorkers := uint32(1)
-	ingestionQueueParams := utils.WorkerQueue{NumWorkers: numWorkers, WorkqueueName: utils.ObjectIngestionLayer}
+	ingestionQueueParams := utils.WorkerQueue{NumWorkers: numWorkers, WorkqueueName: utils.ObjectIngestionLayer, TrackEnqueueTime: true}
 
 	numGraphWorkers := uint32(8)
 
@@ -144,6 +146,7 @@ func (c *GatewayController) InitController(informers k8s.K8sinformers, registere
 
 	graphQueue.SyncFunc = SyncFromNodesLayer
 	graphQueue.Run(stopCh, graphWG)
+	go k8s.MonitorControllerSync(stopCh)
 
 	c.SetupEventHandlers(informers)
 	c.SetupGatewayApiEventHandlers(numWorkers)
@@ -280,6 +283,17 @@ func (c *GatewayController) FullSyncK8s(sync bool) error {
 		akogatewayapinodes.DequeueIngestion(key, true)
 	}
 
+	// ReferenceGrant Section
+	// The ReferenceGrant index is populated before the Gateways and Routes are validated.
+	referenceGrantObjs, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().ReferenceGrantInformer.Lister().ReferenceGrants(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
+	if err != nil {
+		utils.AviLog.Errorf("Unable to retrieve the referencegrants during full sync: %s", err)
+		return err
+	}
+	for _, referenceGrantObj := range referenceGrantObjs {
+		akogatewayapiobjects.GatewayApiLister().UpdateReferenceGrant(referenceGrantObj.Namespace, referenceGrantObj.Name, ReferenceGrantToStore(referenceGrantObj))
+	}
+
 	// Gateway Section
 	var filteredGateways []*gatewayv1.Gateway
 	gatewayObjs, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GatewayInformer.Lister().Gateways(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
@@ -340,6 +354,132 @@ func (c *GatewayController) FullSyncK8s(sync bool) error {
 		akogatewayapinodes.DequeueIngestion(key, true)
 	}
 
+	// GRPCRoute Section
+	var filteredGRPCRoutes []*gatewayv1.GRPCRoute
+	grpcRouteObjs, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GRPCRouteInformer.Lister().GRPCRoutes(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
+	if err != nil {
+		utils.AviLog.Errorf("Unable to retrieve the grpcroutes during full sync: %s", err)
+		return err
+	}
+
+	for _, grpcRouteObj := range grpcRouteObjs {
+		key := lib.GRPCRoute + "/" + utils.ObjKey(grpcRouteObj)
+		meta, err := meta.Accessor(grpcRouteObj)
+		if err == nil {
+			resVer := meta.GetResourceVersion()
+			objects.SharedResourceVerInstanceLister().Save(key, resVer)
+		}
+		if IsGRPCRouteConfigValid(key, grpcRouteObj) {
+			filteredGRPCRoutes = append(filteredGRPCRoutes, grpcRouteObj)
+		}
+	}
+	sort.Slice(filteredGRPCRoutes, func(i, j int) bool {
+		if filteredGRPCRoutes[i].GetCreationTimestamp().Unix() == filteredGRPCRoutes[j].GetCreationTimestamp().Unix() {
+			return filteredGRPCRoutes[i].Namespace+"/"+filteredGRPCRoutes[i].Name < filteredGRPCRoutes[j].Namespace+"/"+filteredGRPCRoutes[j].Name
+		}
+		return filteredGRPCRoutes[i].GetCreationTimestamp().Unix() < filteredGRPCRoutes[j].GetCreationTimestamp().Unix()
+	})
+	for _, filteredGRPCRoute := range filteredGRPCRoutes {
+		key := lib.GRPCRoute + "/" + utils.ObjKey(filteredGRPCRoute)
+		akogatewayapinodes.DequeueIngestion(key, true)
+	}
+
+	// TLSRoute Section
+	if akogatewayapilib.AKOControlConfig().GatewayApiInformers().TLSRouteInformer != nil {
+		var filteredTLSRoutes []*gatewayv1alpha2.TLSRoute
+		tlsRouteObjs, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().TLSRouteInformer.Lister().TLSRoutes(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Errorf("Unable to retrieve the tlsroutes during full sync: %s", err)
+			return err
+		}
+
+		for _, tlsRouteObj := range tlsRouteObjs {
+			key := lib.TLSRoute + "/" + utils.ObjKey(tlsRouteObj)
+			meta, err := meta.Accessor(tlsRouteObj)
+			if err == nil {
+				resVer := meta.GetResourceVersion()
+				objects.SharedResourceVerInstanceLister().Save(key, resVer)
+			}
+			if IsTLSRouteConfigValid(key, tlsRouteObj) {
+				filteredTLSRoutes = append(filteredTLSRoutes, tlsRouteObj)
+			}
+		}
+		sort.Slice(filteredTLSRoutes, func(i, j int) bool {
+			if filteredTLSRoutes[i].GetCreationTimestamp().Unix() == filteredTLSRoutes[j].GetCreationTimestamp().Unix() {
+				return filteredTLSRoutes[i].Namespace+"/"+filteredTLSRoutes[i].Name < filteredTLSRoutes[j].Namespace+"/"+filteredTLSRoutes[j].Name
+			}
+			return filteredTLSRoutes[i].GetCreationTimestamp().Unix() < filteredTLSRoutes[j].GetCreationTimestamp().Unix()
+		})
+		for _, filteredTLSRoute := range filteredTLSRoutes {
+			key := lib.TLSRoute + "/" + utils.ObjKey(filteredTLSRoute)
+			akogatewayapinodes.DequeueIngestion(key, true)
+		}
+	}
+
+	// TCPRoute Section
+	if akogatewayapilib.AKOControlConfig().GatewayApiInformers().TCPRouteInformer != nil {
+		var filteredTCPRoutes []*gatewayv1alpha2.TCPRoute
+		tcpRouteObjs, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().TCPRouteInformer.Lister().TCPRoutes(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Errorf("Unable to retrieve the tcproutes during full sync: %s", err)
+			return err
+		}
+
+		for _, tcpRouteObj := range tcpRouteObjs {
+			key := lib.TCPRoute + "/" + utils.ObjKey(tcpRouteObj)
+			meta, err := meta.Accessor(tcpRouteObj)
+			if err == nil {
+				resVer := meta.GetResourceVersion()
+				objects.SharedResourceVerInstanceLister().Save(key, resVer)
+			}
+			if IsTCPRouteConfigValid(key, tcpRouteObj) {
+				filteredTCPRoutes = append(filteredTCPRoutes, tcpRouteObj)
+			}
+		}
+		sort.Slice(filteredTCPRoutes, func(i, j int) bool {
+			if filteredTCPRoutes[i].GetCreationTimestamp().Unix() == filteredTCPRoutes[j].GetCreationTimestamp().Unix() {
+				return filteredTCPRoutes[i].Namespace+"/"+filteredTCPRoutes[i].Name < filteredTCPRoutes[j].Namespace+"/"+filteredTCPRoutes[j].Name
+			}
+			return filteredTCPRoutes[i].GetCreationTimestamp().Unix() < filteredTCPRoutes[j].GetCreationTimestamp().Unix()
+		})
+		for _, filteredTCPRoute := range filteredTCPRoutes {
+			key := lib.TCPRoute + "/" + utils.ObjKey(filteredTCPRoute)
+			akogatewayapinodes.DequeueIngestion(key, true)
+		}
+	}
+
+	// UDPRoute Section
+	if akogatewayapilib.AKOControlConfig().GatewayApiInformers().UDPRouteInformer != nil {
+		var filteredUDPRoutes []*gatewayv1alpha2.UDPRoute
+		udpRouteObjs, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().UDPRouteInformer.Lister().UDPRoutes(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Errorf("Unable to retrieve the udproutes during full sync: %s", err)
+			return err
+		}
+
+		for _, udpRouteObj := range udpRouteObjs {
+			key := lib.UDPRoute + "/" + utils.ObjKey(udpRouteObj)
+			meta, err := meta.Accessor(udpRouteObj)
+			if err == nil {
+				resVer := meta.GetResourceVersion()
+				objects.SharedResourceVerInstanceLister().Save(key, resVer)
+			}
+			if IsUDPRouteConfigValid(key, udpRouteObj) {
+				filteredUDPRoutes = append(filteredUDPRoutes, udpRouteObj)
+			}
+		}
+		sort.Slice(filteredUDPRoutes, func(i, j int) bool {
+			if filteredUDPRoutes[i].GetCreationTimestamp().Unix() == filteredUDPRoutes[j].GetCreationTimestamp().Unix() {
+				return filteredUDPRoutes[i].Namespace+"/"+filteredUDPRoutes[i].Name < filteredUDPRoutes[j].Namespace+"/"+filteredUDPRoutes[j].Name
+			}
+			return filteredUDPRoutes[i].GetCreationTimestamp().Unix() < filteredUDPRoutes[j].GetCreationTimestamp().Unix()
+		})
+		for _, filteredUDPRoute := range filteredUDPRoutes {
+			key := lib.UDPRoute + "/" + utils.ObjKey(filteredUDPRoute)
+			akogatewayapinodes.DequeueIngestion(key, true)
+		}
+	}
+
 	// Service Section
 	svcObjs, err := utils.GetInformers().ServiceInformer.Lister().Services(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
 	if err != nil {
@@ -415,6 +555,10 @@ func (c *GatewayController) publishAllParentVSKeysToRestLayer() {
 }
 
 func (c *GatewayController) FullSync() {
+	if lib.IsControllerSyncPaused() {
+		utils.AviLog.Infof("Sync with the Avi Controller is paused, skipping full sync")
+		return
+	}
 	aviRestClientPool := avicache.SharedAVIClients(lib.GetTenant())
 	aviObjCache := avicache.SharedAviObjCache()
 
@@ -451,6 +595,11 @@ func SyncFromNodesLayer(key interface{}, wg *sync.WaitGroup) error {
 		utils.AviLog.Warnf("Unexpected object type: expected string, got %T", key)
 		return nil
 	}
+	if lib.BufferKeyIfControllerSyncPaused(keyStr) {
+		utils.AviLog.Infof("key: %s, msg: sync with the Avi Controller is paused, buffering the key", keyStr)
+		lib.DecrementQueueCounter(utils.GraphLayer)
+		return nil
+	}
 	cache := avicache.SharedAviObjCache()
 	restlayer := rest.NewRestOperations(cache)
 	restlayer.DequeueNodes(keyStr)
@@ -725,6 +874,7 @@ func syncGatewayAPIObjectStatuses() {
 
 	var gatewayStatusOptions []status.StatusOptions
 	var httpRouteStatusOptions []status.StatusOptions
+	var grpcRouteStatusOptions []status.StatusOptions
 
 	for _, vsKey := range vsKeys {
 		if vsKey.Name == lib.DummyVSForStaleData {
@@ -792,6 +942,21 @@ func syncGatewayAPIObjectStatuses() {
 				continue
 			}
 
+			if httpRouteServiceMetadata.RouteType == lib.GRPCRoute {
+				grpcRouteStatusOptions = append(grpcRouteStatusOptions,
+					status.StatusOptions{
+						ObjType: lib.GRPCRoute,
+						Op:      lib.UpdateStatus,
+						Key:     lib.SyncStatusKey,
+						Options: &status.UpdateOptions{
+							Key:                lib.SyncStatusKey,
+							ServiceMetadata:    httpRouteServiceMetadata,
+							VirtualServiceUUID: vsCacheObj.Uuid,
+							Tenant:             vsCacheObj.Tenant,
+						},
+					})
+				continue
+			}
 			httpRouteStatusOptions = append(httpRouteStatusOptions,
 				status.StatusOptions{
 					ObjType: lib.HTTPRoute,
@@ -809,6 +974,7 @@ func syncGatewayAPIObjectStatuses() {
 
 	akogatewayapistatus.BulkUpdate(lib.SyncStatusKey, lib.Gateway, gatewayStatusOptions)
 	akogatewayapistatus.BulkUpdate(lib.SyncStatusKey, lib.HTTPRoute, httpRouteStatusOptions)
+	akogatewayapistatus.BulkUpdate(lib.SyncStatusKey, lib.GRPCRoute, grpcRouteStatusOptions)
 	utils.AviLog.Infof("Gateway API status syncing completed")
 	lib.AKOControlConfig().PodEventf(corev1.EventTypeNormal, lib.StatusSync, "Gateway API status syncing completed")
 }
@@ -17,16 +17,22 @@ package k8s
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/runtime"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 	gatewayexternalversions "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
 
@@ -44,6 +50,12 @@ import (
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses;gatewayclasses/status,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways;gateways/status,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;httproutes/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=grpcroutes;grpcroutes/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes;tlsroutes/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes;tcproutes/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=udproutes;udproutes/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies;backendtlspolicies/status,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=ako.vmware.com,resources=applicationprofiles;applicationprofiles/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ako.vmware.com,resources=healthmonitors;healthmonitors/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ako.vmware.com,resources=routebackendextensions;routebackendextensions/status,verbs=get;list;watch
@@ -74,11 +86,38 @@ func SharedGatewayController() *GatewayController {
 
 func (c *GatewayController) InitGatewayAPIInformers(cs gatewayclientset.Interface) {
 	gatewayFactory := gatewayexternalversions.NewSharedInformerFactory(cs, time.Second*30)
-	akogatewayapilib.AKOControlConfig().SetGatewayApiInformers(&akogatewayapilib.GatewayAPIInformers{
+	gatewayApiInformers := &akogatewayapilib.GatewayAPIInformers{
 		GatewayInformer:      gatewayFactory.Gateway().V1().Gateways(),
 		GatewayClassInformer: gatewayFactory.Gateway().V1().GatewayClasses(),
 		HTTPRouteInformer:    gatewayFactory.Gateway().V1().HTTPRoutes(),
-	})
+		GRPCRouteInformer:    gatewayFactory.Gateway().V1().GRPCRoutes(),
+
+		ReferenceGrantInformer: gatewayFactory.Gateway().V1beta1().ReferenceGrants(),
+	}
+	// TLSRoute, TCPRoute and UDPRoute are part of the experimental channel, watch them only when the CRD is installed.
+	if akogatewayapilib.IsGatewayAPIResourceServed(cs, gatewayv1alpha2.GroupVersion.String(), akogatewayapilib.TLSRouteResource) {
+		gatewayApiInformers.TLSRouteInformer = gatewayFactory.Gateway().V1alpha2().TLSRoutes()
+	} else {
+		utils.AviLog.Infof("TLSRoute CRD is not present in the cluster, TLSRoutes will not be processed")
+	}
+	if akogatewayapilib.IsGatewayAPIResourceServed(cs, gatewayv1alpha2.GroupVersion.String(), akogatewayapilib.TCPRouteResource) {
+		gatewayApiInformers.TCPRouteInformer = gatewayFactory.Gateway().V1alpha2().TCPRoutes()
+	} else {
+		utils.AviLog.Infof("TCPRoute CRD is not present in the cluster, TCPRoutes will not be processed")
+	}
+	if akogatewayapilib.IsGatewayAPIResourceServed(cs, gatewayv1alpha2.GroupVersion.String(), akogatewayapilib.UDPRouteResource) {
+		gatewayApiInformers.UDPRouteInformer = gatewayFactory.Gateway().V1alpha2().UDPRoutes()
+	} else {
+		utils.AviLog.Infof("UDPRoute CRD is not present in the cluster, UDPRoutes will not be processed")
+	}
+	if akogatewayapilib.IsGatewayAPIResourceServed(cs, gatewayv1alpha3.GroupVersion.String(), akogatewayapilib.BackendTLSPolicyResource) {
+		gatewayApiInformers.BackendTLSPolicyInformer = gatewayFactory.Gateway().V1alpha3().BackendTLSPolicies()
+		kubeInformerFactory := kubeinformers.NewSharedInformerFactory(utils.GetInformers().ClientSet, utils.InformerDefaultResync)
+		gatewayApiInformers.ConfigMapInformer = kubeInformerFactory.Core().V1().ConfigMaps()
+	} else {
+		utils.AviLog.Infof("BackendTLSPolicy CRD is not present in the cluster, BackendTLSPolicies will not be processed")
+	}
+	akogatewayapilib.AKOControlConfig().SetGatewayApiInformers(gatewayApiInformers)
 }
 
 func NewInfraSettingCRDInformer() {
@@ -114,6 +153,28 @@ func (c *GatewayController) Start(stopCh <-chan struct{}) {
 	informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().GatewayInformer.Informer().HasSynced)
 	go akogatewayapilib.AKOControlConfig().GatewayApiInformers().HTTPRouteInformer.Informer().Run(stopCh)
 	informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().HTTPRouteInformer.Informer().HasSynced)
+	go akogatewayapilib.AKOControlConfig().GatewayApiInformers().GRPCRouteInformer.Informer().Run(stopCh)
+	informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().GRPCRouteInformer.Informer().HasSynced)
+	go akogatewayapilib.AKOControlConfig().GatewayApiInformers().ReferenceGrantInformer.Informer().Run(stopCh)
+	informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().ReferenceGrantInformer.Informer().HasSynced)
+	if akogatewayapilib.AKOControlConfig().GatewayApiInformers().TLSRouteInformer != nil {
+		go akogatewayapilib.AKOControlConfig().GatewayApiInformers().TLSRouteInformer.Informer().Run(stopCh)
+		informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().TLSRouteInformer.Informer().HasSynced)
+	}
+	if akogatewayapilib.AKOControlConfig().GatewayApiInformers().TCPRouteInformer != nil {
+		go akogatewayapilib.AKOControlConfig().GatewayApiInformers().TCPRouteInformer.Informer().Run(stopCh)
+		informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().TCPRouteInformer.Informer().HasSynced)
+	}
+	if akogatewayapilib.AKOControlConfig().GatewayApiInformers().UDPRouteInformer != nil {
+		go akogatewayapilib.AKOControlConfig().GatewayApiInformers().UDPRouteInformer.Informer().Run(stopCh)
+		informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().UDPRouteInformer.Informer().HasSynced)
+	}
+	if akogatewayapilib.AKOControlConfig().GatewayApiInformers().BackendTLSPolicyInformer != nil {
+		go akogatewayapilib.AKOControlConfig().GatewayApiInformers().BackendTLSPolicyInformer.Informer().Run(stopCh)
+		informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().BackendTLSPolicyInformer.Informer().HasSynced)
+		go akogatewayapilib.AKOControlConfig().GatewayApiInformers().ConfigMapInformer.Informer().Run(stopCh)
+		informersList = append(informersList, akogatewayapilib.AKOControlConfig().GatewayApiInformers().ConfigMapInformer.Informer().HasSynced)
+	}
 
 	if akogatewayapilib.AKOControlConfig().AviInfraSettingEnabled() {
 		go akogatewayapilib.AKOControlConfig().AviInfraSettingInformer().Informer().Run(stopCh)
@@ -407,6 +468,7 @@ func (c *GatewayController) SetupEventHandlers(k8sinfo k8s.K8sinformers) {
 			bkt := utils.Bkt(namespace, numWorkers)
 			ValidateGatewayListenerWithSecret(key, namespace, name, false)
 			c.workqueue[bkt].AddRateLimited(key)
+			addBackendTLSPolicyCACertRefServicesToIngestionQueue(key, utils.Secret, namespace, name, numWorkers, c)
 			utils.AviLog.Debugf("key: %s, msg: ADD", key)
 		},
 		DeleteFunc: func(obj interface{}) {
@@ -426,6 +488,7 @@ func (c *GatewayController) SetupEventHandlers(k8sinfo k8s.K8sinformers) {
 					return
 				}
 			}
+			lib.DeleteSecretCertificateStates(secret.Namespace, secret.Name)
 			if checkAviSecretUpdateAndShutdown(secret) {
 				namespace, name, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(secret))
 				key := utils.Secret + "/" + utils.ObjKey(secret)
@@ -436,6 +499,7 @@ func (c *GatewayController) SetupEventHandlers(k8sinfo k8s.K8sinformers) {
 				bkt := utils.Bkt(namespace, numWorkers)
 				ValidateGatewayListenerWithSecret(key, namespace, name, true)
 				c.workqueue[bkt].AddRateLimited(key)
+				addBackendTLSPolicyCACertRefServicesToIngestionQueue(key, utils.Secret, namespace, name, numWorkers, c)
 				utils.AviLog.Debugf("key: %s, msg: DELETE", key)
 			}
 		},
@@ -457,6 +521,7 @@ func (c *GatewayController) SetupEventHandlers(k8sinfo k8s.K8sinformers) {
 					bkt := utils.Bkt(namespace, numWorkers)
 					ValidateGatewayListenerWithSecret(key, namespace, name, false)
 					c.workqueue[bkt].AddRateLimited(key)
+					addBackendTLSPolicyCACertRefServicesToIngestionQueue(key, utils.Secret, namespace, name, numWorkers, c)
 					utils.AviLog.Debugf("key: %s, msg: UPDATE", key)
 				}
 			}
@@ -526,6 +591,7 @@ func (c *GatewayController) SetupGatewayApiEventHandlers(numWorkers uint32) {
 			}
 			key := lib.Gateway + "/" + utils.ObjKey(gw)
 			objects.SharedResourceVerInstanceLister().Delete(key)
+			lib.DeleteCertificateStates(lib.Gateway, gw.Namespace, gw.Name)
 			namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(gw))
 			bkt := utils.Bkt(namespace, numWorkers)
 			c.workqueue[bkt].AddRateLimited(key)
@@ -688,6 +754,468 @@ func (c *GatewayController) SetupGatewayApiEventHandlers(numWorkers uint32) {
 		},
 	}
 	informer.HTTPRouteInformer.Informer().AddEventHandler(httpRouteEventHandler)
+
+	grpcRouteEventHandler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if c.DisableSync {
+				return
+			}
+			grpcRoute := obj.(*gatewayv1.GRPCRoute)
+			key := lib.GRPCRoute + "/" + utils.ObjKey(grpcRoute)
+			ok, resVer := objects.SharedResourceVerInstanceLister().Get(key)
+			if ok && resVer.(string) == grpcRoute.ResourceVersion {
+				utils.AviLog.Debugf("key: %s, msg: same resource version returning", key)
+				return
+			}
+			if !IsGRPCRouteConfigValid(key, grpcRoute) {
+				return
+			}
+			namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(grpcRoute))
+			bkt := utils.Bkt(namespace, numWorkers)
+			c.workqueue[bkt].AddRateLimited(key)
+			utils.AviLog.Debugf("key: %s, msg: ADD", key)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if c.DisableSync {
+				return
+			}
+			grpcRoute, ok := obj.(*gatewayv1.GRPCRoute)
+			if !ok {
+				// grpcRoute was deleted but its final state is unrecorded.
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					utils.AviLog.Errorf("couldn't get object from tombstone %#v", obj)
+					return
+				}
+				grpcRoute, ok = tombstone.Obj.(*gatewayv1.GRPCRoute)
+				if !ok {
+					utils.AviLog.Errorf("Tombstone contained object that is not a GRPCRoute: %#v", obj)
+					return
+				}
+			}
+			key := lib.GRPCRoute + "/" + utils.ObjKey(grpcRoute)
+			objects.SharedResourceVerInstanceLister().Delete(key)
+			namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(grpcRoute))
+			bkt := utils.Bkt(namespace, numWorkers)
+			c.workqueue[bkt].AddRateLimited(key)
+			akogatewayapiobjects.GatewayApiLister().DeleteRouteToRouteStatusMapping(key)
+			utils.AviLog.Debugf("key: %s, msg: DELETE", key)
+		},
+		UpdateFunc: func(old, obj interface{}) {
+			if c.DisableSync {
+				return
+			}
+			oldGRPCRoute := old.(*gatewayv1.GRPCRoute)
+			newGRPCRoute := obj.(*gatewayv1.GRPCRoute)
+			if IsGRPCRouteUpdated(oldGRPCRoute, newGRPCRoute) {
+				key := lib.GRPCRoute + "/" + utils.ObjKey(newGRPCRoute)
+				if !IsGRPCRouteConfigValid(key, newGRPCRoute) {
+					return
+				}
+				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(newGRPCRoute))
+				bkt := utils.Bkt(namespace, numWorkers)
+				c.workqueue[bkt].AddRateLimited(key)
+				utils.AviLog.Debugf("key: %s, msg: UPDATE", key)
+			}
+		},
+	}
+	informer.GRPCRouteInformer.Informer().AddEventHandler(grpcRouteEventHandler)
+
+	referenceGrantEventHandler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if c.DisableSync {
+				return
+			}
+			grant := obj.(*gatewayv1beta1.ReferenceGrant)
+			key := lib.ReferenceGrant + "/" + utils.ObjKey(grant)
+			grantStore := ReferenceGrantToStore(grant)
+			akogatewayapiobjects.GatewayApiLister().UpdateReferenceGrant(grant.Namespace, grant.Name, grantStore)
+			utils.AviLog.Debugf("key: %s, msg: ADD", key)
+			addReferenceGrantReferrersToIngestionQueue(key, grant.Namespace, grantStore.From, numWorkers, c)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if c.DisableSync {
+				return
+			}
+			grant, ok := obj.(*gatewayv1beta1.ReferenceGrant)
+			if !ok {
+				// reference grant was deleted but its final state is unrecorded.
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					utils.AviLog.Errorf("couldn't get object from tombstone %#v", obj)
+					return
+				}
+				grant, ok = tombstone.Obj.(*gatewayv1beta1.ReferenceGrant)
+				if !ok {
+					utils.AviLog.Errorf("Tombstone contained object that is not a ReferenceGrant: %#v", obj)
+					return
+				}
+			}
+			key := lib.ReferenceGrant + "/" + utils.ObjKey(grant)
+			_, grantStore := akogatewayapiobjects.GatewayApiLister().GetReferenceGrant(grant.Namespace, grant.Name)
+			akogatewayapiobjects.GatewayApiLister().DeleteReferenceGrant(grant.Namespace, grant.Name)
+			utils.AviLog.Debugf("key: %s, msg: DELETE", key)
+			addReferenceGrantReferrersToIngestionQueue(key, grant.Namespace, grantStore.From, numWorkers, c)
+		},
+		UpdateFunc: func(old, obj interface{}) {
+			if c.DisableSync {
+				return
+			}
+			oldGrant := old.(*gatewayv1beta1.ReferenceGrant)
+			grant := obj.(*gatewayv1beta1.ReferenceGrant)
+			if !IsReferenceGrantUpdated(oldGrant, grant) {
+				return
+			}
+			key := lib.ReferenceGrant + "/" + utils.ObjKey(grant)
+			_, oldGrantStore := akogatewayapiobjects.GatewayApiLister().GetReferenceGrant(grant.Namespace, grant.Name)
+			grantStore := ReferenceGrantToStore(grant)
+			akogatewayapiobjects.GatewayApiLister().UpdateReferenceGrant(grant.Namespace, grant.Name, grantStore)
+			utils.AviLog.Debugf("key: %s, msg: UPDATE", key)
+			// referrers permitted by the old or the new spec are re-evaluated
+			addReferenceGrantReferrersToIngestionQueue(key, grant.Namespace, append(oldGrantStore.From, grantStore.From...), numWorkers, c)
+		},
+	}
+	informer.ReferenceGrantInformer.Informer().AddEventHandler(referenceGrantEventHandler)
+
+	if informer.TLSRouteInformer != nil {
+		tlsRouteEventHandler := cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				tlsRoute := obj.(*gatewayv1alpha2.TLSRoute)
+				key := lib.TLSRoute + "/" + utils.ObjKey(tlsRoute)
+				ok, resVer := objects.SharedResourceVerInstanceLister().Get(key)
+				if ok && resVer.(string) == tlsRoute.ResourceVersion {
+					utils.AviLog.Debugf("key: %s, msg: same resource version returning", key)
+					return
+				}
+				if !IsTLSRouteConfigValid(key, tlsRoute) {
+					return
+				}
+				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(tlsRoute))
+				bkt := utils.Bkt(namespace, numWorkers)
+				c.workqueue[bkt].AddRateLimited(key)
+				utils.AviLog.Debugf("key: %s, msg: ADD", key)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				tlsRoute, ok := obj.(*gatewayv1alpha2.TLSRoute)
+				if !ok {
+					// tlsRoute was deleted but its final state is unrecorded.
+					tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						utils.AviLog.Errorf("couldn't get object from tombstone %#v", obj)
+						return
+					}
+					tlsRoute, ok = tombstone.Obj.(*gatewayv1alpha2.TLSRoute)
+					if !ok {
+						utils.AviLog.Errorf("Tombstone contained object that is not a TLSRoute: %#v", obj)
+						return
+					}
+				}
+				key := lib.TLSRoute + "/" + utils.ObjKey(tlsRoute)
+				objects.SharedResourceVerInstanceLister().Delete(key)
+				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(tlsRoute))
+				bkt := utils.Bkt(namespace, numWorkers)
+				c.workqueue[bkt].AddRateLimited(key)
+				akogatewayapiobjects.GatewayApiLister().DeleteRouteToRouteStatusMapping(key)
+				utils.AviLog.Debugf("key: %s, msg: DELETE", key)
+			},
+			UpdateFunc: func(old, obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				oldTLSRoute := old.(*gatewayv1alpha2.TLSRoute)
+				newTLSRoute := obj.(*gatewayv1alpha2.TLSRoute)
+				if IsTLSRouteUpdated(oldTLSRoute, newTLSRoute) {
+					key := lib.TLSRoute + "/" + utils.ObjKey(newTLSRoute)
+					if !IsTLSRouteConfigValid(key, newTLSRoute) {
+						return
+					}
+					namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(newTLSRoute))
+					bkt := utils.Bkt(namespace, numWorkers)
+					c.workqueue[bkt].AddRateLimited(key)
+					utils.AviLog.Debugf("key: %s, msg: UPDATE", key)
+				}
+			},
+		}
+		informer.TLSRouteInformer.Informer().AddEventHandler(tlsRouteEventHandler)
+	}
+
+	if informer.TCPRouteInformer != nil {
+		tcpRouteEventHandler := cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				tcpRoute := obj.(*gatewayv1alpha2.TCPRoute)
+				key := lib.TCPRoute + "/" + utils.ObjKey(tcpRoute)
+				ok, resVer := objects.SharedResourceVerInstanceLister().Get(key)
+				if ok && resVer.(string) == tcpRoute.ResourceVersion {
+					utils.AviLog.Debugf("key: %s, msg: same resource version returning", key)
+					return
+				}
+				if !IsTCPRouteConfigValid(key, tcpRoute) {
+					return
+				}
+				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(tcpRoute))
+				bkt := utils.Bkt(namespace, numWorkers)
+				c.workqueue[bkt].AddRateLimited(key)
+				utils.AviLog.Debugf("key: %s, msg: ADD", key)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				tcpRoute, ok := obj.(*gatewayv1alpha2.TCPRoute)
+				if !ok {
+					// tcpRoute was deleted but its final state is unrecorded.
+					tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						utils.AviLog.Errorf("couldn't get object from tombstone %#v", obj)
+						return
+					}
+					tcpRoute, ok = tombstone.Obj.(*gatewayv1alpha2.TCPRoute)
+					if !ok {
+						utils.AviLog.Errorf("Tombstone contained object that is not a TCPRoute: %#v", obj)
+						return
+					}
+				}
+				key := lib.TCPRoute + "/" + utils.ObjKey(tcpRoute)
+				objects.SharedResourceVerInstanceLister().Delete(key)
+				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(tcpRoute))
+				bkt := utils.Bkt(namespace, numWorkers)
+				c.workqueue[bkt].AddRateLimited(key)
+				akogatewayapiobjects.GatewayApiLister().DeleteRouteToRouteStatusMapping(key)
+				utils.AviLog.Debugf("key: %s, msg: DELETE", key)
+			},
+			UpdateFunc: func(old, obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				oldTCPRoute := old.(*gatewayv1alpha2.TCPRoute)
+				newTCPRoute := obj.(*gatewayv1alpha2.TCPRoute)
+				if IsTCPRouteUpdated(oldTCPRoute, newTCPRoute) {
+					key := lib.TCPRoute + "/" + utils.ObjKey(newTCPRoute)
+					if !IsTCPRouteConfigValid(key, newTCPRoute) {
+						return
+					}
+					namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(newTCPRoute))
+					bkt := utils.Bkt(namespace, numWorkers)
+					c.workqueue[bkt].AddRateLimited(key)
+					utils.AviLog.Debugf("key: %s, msg: UPDATE", key)
+				}
+			},
+		}
+		informer.TCPRouteInformer.Informer().AddEventHandler(tcpRouteEventHandler)
+	}
+
+	if informer.UDPRouteInformer != nil {
+		udpRouteEventHandler := cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				udpRoute := obj.(*gatewayv1alpha2.UDPRoute)
+				key := lib.UDPRoute + "/" + utils.ObjKey(udpRoute)
+				ok, resVer := objects.SharedResourceVerInstanceLister().Get(key)
+				if ok && resVer.(string) == udpRoute.ResourceVersion {
+					utils.AviLog.Debugf("key: %s, msg: same resource version returning", key)
+					return
+				}
+				if !IsUDPRouteConfigValid(key, udpRoute) {
+					return
+				}
+				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(udpRoute))
+				bkt := utils.Bkt(namespace, numWorkers)
+				c.workqueue[bkt].AddRateLimited(key)
+				utils.AviLog.Debugf("key: %s, msg: ADD", key)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				udpRoute, ok := obj.(*gatewayv1alpha2.UDPRoute)
+				if !ok {
+					// udpRoute was deleted but its final state is unrecorded.
+					tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						utils.AviLog.Errorf("couldn't get object from tombstone %#v", obj)
+						return
+					}
+					udpRoute, ok = tombstone.Obj.(*gatewayv1alpha2.UDPRoute)
+					if !ok {
+						utils.AviLog.Errorf("Tombstone contained object that is not a UDPRoute: %#v", obj)
+						return
+					}
+				}
+				key := lib.UDPRoute + "/" + utils.ObjKey(udpRoute)
+				objects.SharedResourceVerInstanceLister().Delete(key)
+				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(udpRoute))
+				bkt := utils.Bkt(namespace, numWorkers)
+				c.workqueue[bkt].AddRateLimited(key)
+				akogatewayapiobjects.GatewayApiLister().DeleteRouteToRouteStatusMapping(key)
+				utils.AviLog.Debugf("key: %s, msg: DELETE", key)
+			},
+			UpdateFunc: func(old, obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				oldUDPRoute := old.(*gatewayv1alpha2.UDPRoute)
+				newUDPRoute := obj.(*gatewayv1alpha2.UDPRoute)
+				if IsUDPRouteUpdated(oldUDPRoute, newUDPRoute) {
+					key := lib.UDPRoute + "/" + utils.ObjKey(newUDPRoute)
+					if !IsUDPRouteConfigValid(key, newUDPRoute) {
+						return
+					}
+					namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(newUDPRoute))
+					bkt := utils.Bkt(namespace, numWorkers)
+					c.workqueue[bkt].AddRateLimited(key)
+					utils.AviLog.Debugf("key: %s, msg: UPDATE", key)
+				}
+			},
+		}
+		informer.UDPRouteInformer.Informer().AddEventHandler(udpRouteEventHandler)
+	}
+
+	if informer.BackendTLSPolicyInformer != nil {
+		backendTLSPolicyEventHandler := cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				policy := obj.(*gatewayv1alpha3.BackendTLSPolicy)
+				key := lib.BackendTLSPolicy + "/" + utils.ObjKey(policy)
+				ok, resVer := objects.SharedResourceVerInstanceLister().Get(key)
+				if ok && resVer.(string) == policy.ResourceVersion {
+					utils.AviLog.Debugf("key: %s, msg: same resource version returning", key)
+					return
+				}
+				addBackendTLSPolicyServicesToIngestionQueue(key, policy, nil, numWorkers, c)
+				utils.AviLog.Debugf("key: %s, msg: ADD", key)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				policy, ok := obj.(*gatewayv1alpha3.BackendTLSPolicy)
+				if !ok {
+					// policy was deleted but its final state is unrecorded.
+					tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						utils.AviLog.Errorf("couldn't get object from tombstone %#v", obj)
+						return
+					}
+					policy, ok = tombstone.Obj.(*gatewayv1alpha3.BackendTLSPolicy)
+					if !ok {
+						utils.AviLog.Errorf("Tombstone contained object that is not a BackendTLSPolicy: %#v", obj)
+						return
+					}
+				}
+				key := lib.BackendTLSPolicy + "/" + utils.ObjKey(policy)
+				objects.SharedResourceVerInstanceLister().Delete(key)
+				addBackendTLSPolicyServicesToIngestionQueue(key, policy, nil, numWorkers, c)
+				utils.AviLog.Debugf("key: %s, msg: DELETE", key)
+			},
+			UpdateFunc: func(old, obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				oldPolicy := old.(*gatewayv1alpha3.BackendTLSPolicy)
+				policy := obj.(*gatewayv1alpha3.BackendTLSPolicy)
+				if oldPolicy.ResourceVersion == policy.ResourceVersion || reflect.DeepEqual(oldPolicy.Spec, policy.Spec) {
+					return
+				}
+				key := lib.BackendTLSPolicy + "/" + utils.ObjKey(policy)
+				addBackendTLSPolicyServicesToIngestionQueue(key, policy, oldPolicy, numWorkers, c)
+				utils.AviLog.Debugf("key: %s, msg: UPDATE", key)
+			},
+		}
+		informer.BackendTLSPolicyInformer.Informer().AddEventHandler(backendTLSPolicyEventHandler)
+
+		caConfigMapEventHandler := cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				cm := obj.(*corev1.ConfigMap)
+				key := akogatewayapilib.ConfigMapKind + "/" + utils.ObjKey(cm)
+				addBackendTLSPolicyCACertRefServicesToIngestionQueue(key, akogatewayapilib.ConfigMapKind, cm.Namespace, cm.Name, numWorkers, c)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				cm, ok := obj.(*corev1.ConfigMap)
+				if !ok {
+					// configmap was deleted but its final state is unrecorded.
+					tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						utils.AviLog.Errorf("couldn't get object from tombstone %#v", obj)
+						return
+					}
+					cm, ok = tombstone.Obj.(*corev1.ConfigMap)
+					if !ok {
+						utils.AviLog.Errorf("Tombstone contained object that is not a ConfigMap: %#v", obj)
+						return
+					}
+				}
+				key := akogatewayapilib.ConfigMapKind + "/" + utils.ObjKey(cm)
+				addBackendTLSPolicyCACertRefServicesToIngestionQueue(key, akogatewayapilib.ConfigMapKind, cm.Namespace, cm.Name, numWorkers, c)
+			},
+			UpdateFunc: func(old, obj interface{}) {
+				if c.DisableSync {
+					return
+				}
+				oldCM := old.(*corev1.ConfigMap)
+				cm := obj.(*corev1.ConfigMap)
+				if oldCM.ResourceVersion == cm.ResourceVersion || reflect.DeepEqual(oldCM.Data, cm.Data) {
+					return
+				}
+				key := akogatewayapilib.ConfigMapKind + "/" + utils.ObjKey(cm)
+				addBackendTLSPolicyCACertRefServicesToIngestionQueue(key, akogatewayapilib.ConfigMapKind, cm.Namespace, cm.Name, numWorkers, c)
+			},
+		}
+		informer.ConfigMapInformer.Informer().AddEventHandler(caConfigMapEventHandler)
+	}
+}
+
+// addBackendTLSPolicyCACertRefServicesToIngestionQueue re-evaluates the Services targeted by the BackendTLSPolicies
+// with the ConfigMap or the Secret as a caCertificateRef, on a change of the CA certificate.
+func addBackendTLSPolicyCACertRefServicesToIngestionQueue(key, kind, namespace, name string, numWorkers uint32, c *GatewayController) {
+	if lib.IsNamespaceBlocked(namespace) {
+		return
+	}
+	for _, policy := range akogatewayapilib.GetBackendTLSPoliciesForCACertRef(kind, namespace, name) {
+		addBackendTLSPolicyServicesToIngestionQueue(key, policy, nil, numWorkers, c)
+	}
+}
+
+// addBackendTLSPolicyServicesToIngestionQueue re-evaluates the Services targeted by the BackendTLSPolicy, and by
+// the older version of the BackendTLSPolicy on an update, which re-evaluates the Routes with the Services as backends.
+func addBackendTLSPolicyServicesToIngestionQueue(key string, policy, oldPolicy *gatewayv1alpha3.BackendTLSPolicy, numWorkers uint32, c *GatewayController) {
+	targetRefs := policy.Spec.TargetRefs
+	if oldPolicy != nil {
+		targetRefs = append(slices.Clone(oldPolicy.Spec.TargetRefs), targetRefs...)
+	}
+	processed := make(map[string]struct{})
+	for _, targetRef := range targetRefs {
+		if targetRef.Group != "" || string(targetRef.Kind) != utils.Service {
+			utils.AviLog.Warnf("key: %s, msg: BackendTLSPolicy targetRef %s of kind %s is not supported", key, targetRef.Name, targetRef.Kind)
+			continue
+		}
+		svcKey := utils.Service + "/" + policy.Namespace + "/" + string(targetRef.Name)
+		if _, ok := processed[svcKey]; ok {
+			continue
+		}
+		processed[svcKey] = struct{}{}
+		bkt := utils.Bkt(policy.Namespace, numWorkers)
+		c.workqueue[bkt].AddRateLimited(svcKey)
+		utils.AviLog.Debugf("key: %s, msg: %s re-evaluated for the BackendTLSPolicy", key, svcKey)
+	}
 }
 
 func (c *GatewayController) SetupAviInfraSettingEventHandler(numWorkers uint32) {
@@ -695,32 +1223,33 @@ func (c *GatewayController) SetupAviInfraSettingEventHandler(numWorkers uint32)
 		utils.AviLog.Infof("Setting up AviInfraSetting CRD event handler")
 		aviInfraEventHandler := cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
+				aviInfra := obj.(*akov1beta1.AviInfraSetting)
+				lib.UpdateFQDNClaims(aviInfra)
 				if c.DisableSync {
 					return
 				}
-				aviInfra := obj.(*akov1beta1.AviInfraSetting)
 				key := lib.AviInfraSetting + "/" + utils.ObjKey(aviInfra)
 				utils.AviLog.Debugf("key: %s, msg: ADD", key)
 
 				addGatewayMappedToInfrasettingToIngestionQueue(numWorkers, c, utils.ObjKey(aviInfra))
+				addFQDNClaimedRoutesToIngestionQueue(numWorkers, c, nil, aviInfra)
 			},
 			UpdateFunc: func(old, new interface{}) {
+				oldObj := old.(*akov1beta1.AviInfraSetting)
+				aviInfra := new.(*akov1beta1.AviInfraSetting)
+				lib.UpdateFQDNClaims(aviInfra)
 				if c.DisableSync {
 					return
 				}
-				oldObj := old.(*akov1beta1.AviInfraSetting)
-				aviInfra := new.(*akov1beta1.AviInfraSetting)
 				if isAviInfraUpdated(oldObj, aviInfra) {
 					key := lib.AviInfraSetting + "/" + utils.ObjKey(aviInfra)
 					utils.AviLog.Debugf("key: %s, msg: UPDATE", key)
 
 					addGatewayMappedToInfrasettingToIngestionQueue(numWorkers, c, utils.ObjKey(aviInfra))
+					addFQDNClaimedRoutesToIngestionQueue(numWorkers, c, oldObj, aviInfra)
 				}
 			},
 			DeleteFunc: func(obj interface{}) {
-				if c.DisableSync {
-					return
-				}
 				aviInfra, ok := obj.(*akov1beta1.AviInfraSetting)
 				if !ok {
 					tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
@@ -734,9 +1263,14 @@ func (c *GatewayController) SetupAviInfraSettingEventHandler(numWorkers uint32)
 						return
 					}
 				}
+				lib.DeleteFQDNClaims(aviInfra.Name)
+				if c.DisableSync {
+					return
+				}
 				key := lib.AviInfraSetting + "/" + utils.ObjKey(aviInfra)
 				utils.AviLog.Debugf("key: %s, msg: DELETE", key)
 				addGatewayMappedToInfrasettingToIngestionQueue(numWorkers, c, utils.ObjKey(aviInfra))
+				addFQDNClaimedRoutesToIngestionQueue(numWorkers, c, aviInfra, nil)
 			},
 		}
 		akogatewayapilib.AKOControlConfig().AviInfraSettingInformer().Informer().AddEventHandler(aviInfraEventHandler)
@@ -752,6 +1286,12 @@ func IsGatewayUpdated(oldGateway, newGateway *gatewayv1.Gateway) bool {
 	return oldHash != newHash || !reflect.DeepEqual(oldGateway.Annotations, newGateway.Annotations)
 }
 
+func IsReferenceGrantUpdated(oldGrant, newGrant *gatewayv1beta1.ReferenceGrant) bool {
+	oldHash := utils.Hash(utils.Stringify(oldGrant.Spec))
+	newHash := utils.Hash(utils.Stringify(newGrant.Spec))
+	return oldHash != newHash
+}
+
 func IsHTTPRouteUpdated(oldHTTPRoute, newHTTPRoute *gatewayv1.HTTPRoute) bool {
 	if newHTTPRoute.GetDeletionTimestamp() != nil {
 		return true
@@ -761,6 +1301,42 @@ func IsHTTPRouteUpdated(oldHTTPRoute, newHTTPRoute *gatewayv1.HTTPRoute) bool {
 	return oldHash != newHash
 }
 
+func IsGRPCRouteUpdated(oldGRPCRoute, newGRPCRoute *gatewayv1.GRPCRoute) bool {
+	if newGRPCRoute.GetDeletionTimestamp() != nil {
+		return true
+	}
+	oldHash := utils.Hash(utils.Stringify(oldGRPCRoute.Spec))
+	newHash := utils.Hash(utils.Stringify(newGRPCRoute.Spec))
+	return oldHash != newHash
+}
+
+func IsTLSRouteUpdated(oldTLSRoute, newTLSRoute *gatewayv1alpha2.TLSRoute) bool {
+	if newTLSRoute.GetDeletionTimestamp() != nil {
+		return true
+	}
+	oldHash := utils.Hash(utils.Stringify(oldTLSRoute.Spec))
+	newHash := utils.Hash(utils.Stringify(newTLSRoute.Spec))
+	return oldHash != newHash
+}
+
+func IsTCPRouteUpdated(oldTCPRoute, newTCPRoute *gatewayv1alpha2.TCPRoute) bool {
+	if newTCPRoute.GetDeletionTimestamp() != nil {
+		return true
+	}
+	oldHash := utils.Hash(utils.Stringify(oldTCPRoute.Spec))
+	newHash := utils.Hash(utils.Stringify(newTCPRoute.Spec))
+	return oldHash != newHash
+}
+
+func IsUDPRouteUpdated(oldUDPRoute, newUDPRoute *gatewayv1alpha2.UDPRoute) bool {
+	if newUDPRoute.GetDeletionTimestamp() != nil {
+		return true
+	}
+	oldHash := utils.Hash(utils.Stringify(oldUDPRoute.Spec))
+	newHash := utils.Hash(utils.Stringify(newUDPRoute.Spec))
+	return oldHash != newHash
+}
+
 func isAviInfraUpdated(oldAviInfra, newAviInfra *akov1beta1.AviInfraSetting) bool {
 	oldSpecHash := utils.Hash(utils.Stringify(oldAviInfra.Spec) + oldAviInfra.Status.Status)
 	newSpecHash := utils.Hash(utils.Stringify(newAviInfra.Spec) + newAviInfra.Status.Status)
@@ -831,3 +1407,226 @@ func addGatewayMappedToInfrasettingToIngestionQueue(numWorkers uint32, c *Gatewa
 		}
 	}
 }
+
+// addFQDNClaimedRoutesToIngestionQueue re-evaluates the Routes with hostnames matching the FQDN claims of the
+// AviInfraSetting before and after the change, so that the Routes are accepted or rejected as per the current claims.
+func addFQDNClaimedRoutesToIngestionQueue(numWorkers uint32, c *GatewayController, oldInfraSetting, newInfraSetting *akov1beta1.AviInfraSetting) {
+	if !lib.FQDNClaimsUpdated(oldInfraSetting, newInfraSetting) {
+		return
+	}
+	var domains []string
+	for _, infraSetting := range []*akov1beta1.AviInfraSetting{oldInfraSetting, newInfraSetting} {
+		if infraSetting == nil {
+			continue
+		}
+		for _, claim := range infraSetting.Spec.FQDNClaims {
+			domains = append(domains, claim.Domains...)
+		}
+	}
+	enqueue := func(kind string, route metav1.Object, hostnames []gatewayv1.Hostname) {
+		for _, host := range hostnames {
+			if slices.ContainsFunc(domains, func(domain string) bool { return lib.IsFQDNClaimedByDomain(domain, string(host)) }) {
+				key := kind + "/" + route.GetNamespace() + "/" + route.GetName()
+				bkt := utils.Bkt(route.GetNamespace(), numWorkers)
+				c.workqueue[bkt].AddRateLimited(key)
+				utils.AviLog.Debugf("key: %s, msg: re-evaluated for the FQDN claims", key)
+				return
+			}
+		}
+	}
+
+	informers := akogatewayapilib.AKOControlConfig().GatewayApiInformers()
+	if httpRoutes, err := informers.HTTPRouteInformer.Lister().List(labels.Set(nil).AsSelector()); err == nil {
+		for _, route := range httpRoutes {
+			enqueue(lib.HTTPRoute, route, route.Spec.Hostnames)
+		}
+	}
+	if informers.GRPCRouteInformer != nil {
+		if grpcRoutes, err := informers.GRPCRouteInformer.Lister().List(labels.Set(nil).AsSelector()); err == nil {
+			for _, route := range grpcRoutes {
+				enqueue(lib.GRPCRoute, route, route.Spec.Hostnames)
+			}
+		}
+	}
+	if informers.TLSRouteInformer != nil {
+		if tlsRoutes, err := informers.TLSRouteInformer.Lister().List(labels.Set(nil).AsSelector()); err == nil {
+			for _, route := range tlsRoutes {
+				enqueue(lib.TLSRoute, route, route.Spec.Hostnames)
+			}
+		}
+	}
+}
+
+// ReferenceGrantToStore converts a ReferenceGrant to the form kept in the ReferenceGrant index.
+func ReferenceGrantToStore(grant *gatewayv1beta1.ReferenceGrant) akogatewayapiobjects.ReferenceGrantStore {
+	var grantStore akogatewayapiobjects.ReferenceGrantStore
+	for _, from := range grant.Spec.From {
+		grantStore.From = append(grantStore.From, akogatewayapiobjects.ReferenceGrantFrom{
+			Group:     string(from.Group),
+			Kind:      string(from.Kind),
+			Namespace: string(from.Namespace),
+		})
+	}
+	for _, to := range grant.Spec.To {
+		grantTo := akogatewayapiobjects.ReferenceGrantTo{
+			Group: string(to.Group),
+			Kind:  string(to.Kind),
+		}
+		if to.Name != nil {
+			grantTo.Name = string(*to.Name)
+		}
+		grantStore.To = append(grantStore.To, grantTo)
+	}
+	return grantStore
+}
+
+// addReferenceGrantReferrersToIngestionQueue re-evaluates the Gateways and Routes, in the namespaces listed in the
+// from section of a ReferenceGrant, that refer to the objects in the namespace of the ReferenceGrant.
+func addReferenceGrantReferrersToIngestionQueue(key, grantNs string, grantFrom []akogatewayapiobjects.ReferenceGrantFrom, numWorkers uint32, c *GatewayController) {
+	processed := make(map[string]struct{})
+	for _, from := range grantFrom {
+		if from.Group != akogatewayapilib.GatewayGroup || from.Namespace == grantNs {
+			continue
+		}
+		if _, ok := processed[from.Kind+"/"+from.Namespace]; ok {
+			continue
+		}
+		processed[from.Kind+"/"+from.Namespace] = struct{}{}
+		if from.Kind == lib.Gateway {
+			addGatewaysReferringNamespaceToIngestionQueue(key, from.Namespace, grantNs, numWorkers, c)
+			continue
+		}
+		for _, routeKey := range getRoutesReferringNamespace(key, from.Kind, from.Namespace, grantNs) {
+			bkt := utils.Bkt(from.Namespace, numWorkers)
+			c.workqueue[bkt].AddRateLimited(routeKey)
+			utils.AviLog.Debugf("key: %s, msg: %s re-evaluated for the ReferenceGrant", key, routeKey)
+		}
+	}
+}
+
+func addGatewaysReferringNamespaceToIngestionQueue(key, gwNs, grantNs string, numWorkers uint32, c *GatewayController) {
+	gateways, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GatewayInformer.Lister().Gateways(gwNs).List(labels.Set(nil).AsSelector())
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: failed to list Gateways in the Namespace %s, err: %s", key, gwNs, err.Error())
+		return
+	}
+	for _, gateway := range gateways {
+		var secretKeys []string
+		for _, listener := range gateway.Spec.Listeners {
+			if listener.TLS == nil {
+				continue
+			}
+			for _, certRef := range listener.TLS.CertificateRefs {
+				if certRef.Namespace != nil && string(*certRef.Namespace) == grantNs {
+					secretKeys = append(secretKeys, utils.Secret+"/"+grantNs+"/"+string(certRef.Name))
+				}
+			}
+		}
+		if len(secretKeys) == 0 {
+			continue
+		}
+		gwKey := lib.Gateway + "/" + utils.ObjKey(gateway)
+		bkt := utils.Bkt(gateway.Namespace, numWorkers)
+		if valid, _ := IsValidGateway(gwKey, gateway); valid {
+			c.workqueue[bkt].AddRateLimited(gwKey)
+			utils.AviLog.Debugf("key: %s, msg: %s re-evaluated for the ReferenceGrant", key, gwKey)
+			continue
+		}
+		// none of the listeners is valid, the secrets are removed from the parent VS
+		for _, secretKey := range secretKeys {
+			c.workqueue[bkt].AddRateLimited(secretKey)
+			utils.AviLog.Debugf("key: %s, msg: %s re-evaluated for the ReferenceGrant", key, secretKey)
+		}
+	}
+}
+
+// getRoutesReferringNamespace returns the keys of the Routes of the kind in routeNs, with a backendRef in backendNs.
+func getRoutesReferringNamespace(key, kind, routeNs, backendNs string) []string {
+	var routeKeys []string
+	inBackendNs := func(backendRef gatewayv1.BackendRef) bool {
+		return backendRef.Namespace != nil && string(*backendRef.Namespace) == backendNs
+	}
+	informers := akogatewayapilib.AKOControlConfig().GatewayApiInformers()
+	switch kind {
+	case lib.HTTPRoute:
+		routes, err := informers.HTTPRouteInformer.Lister().HTTPRoutes(routeNs).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Warnf("key: %s, msg: failed to list HTTPRoutes in the Namespace %s, err: %s", key, routeNs, err.Error())
+			return nil
+		}
+		for _, route := range routes {
+			for _, rule := range route.Spec.Rules {
+				if slices.ContainsFunc(rule.BackendRefs, func(backendRef gatewayv1.HTTPBackendRef) bool { return inBackendNs(backendRef.BackendRef) }) {
+					routeKeys = append(routeKeys, lib.HTTPRoute+"/"+utils.ObjKey(route))
+					break
+				}
+			}
+		}
+	case lib.GRPCRoute:
+		routes, err := informers.GRPCRouteInformer.Lister().GRPCRoutes(routeNs).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Warnf("key: %s, msg: failed to list GRPCRoutes in the Namespace %s, err: %s", key, routeNs, err.Error())
+			return nil
+		}
+		for _, route := range routes {
+			for _, rule := range route.Spec.Rules {
+				if slices.ContainsFunc(rule.BackendRefs, func(backendRef gatewayv1.GRPCBackendRef) bool { return inBackendNs(backendRef.BackendRef) }) {
+					routeKeys = append(routeKeys, lib.GRPCRoute+"/"+utils.ObjKey(route))
+					break
+				}
+			}
+		}
+	case lib.TLSRoute:
+		if informers.TLSRouteInformer == nil {
+			return nil
+		}
+		routes, err := informers.TLSRouteInformer.Lister().TLSRoutes(routeNs).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Warnf("key: %s, msg: failed to list TLSRoutes in the Namespace %s, err: %s", key, routeNs, err.Error())
+			return nil
+		}
+		for _, route := range routes {
+			for _, rule := range route.Spec.Rules {
+				if slices.ContainsFunc(rule.BackendRefs, inBackendNs) {
+					routeKeys = append(routeKeys, lib.TLSRoute+"/"+utils.ObjKey(route))
+					break
+				}
+			}
+		}
+	case lib.TCPRoute:
+		if informers.TCPRouteInformer == nil {
+			return nil
+		}
+		routes, err := informers.TCPRouteInformer.Lister().TCPRoutes(routeNs).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Warnf("key: %s, msg: failed to list TCPRoutes in the Namespace %s, err: %s", key, routeNs, err.Error())
+			return nil
+		}
+		for _, route := range routes {
+			for _, rule := range route.Spec.Rules {
+				if slices.ContainsFunc(rule.BackendRefs, inBackendNs) {
+					routeKeys = append(routeKeys, lib.TCPRoute+"/"+utils.ObjKey(route))
+					break
+				}
+			}
+		}
+	case lib.UDPRoute:
+		if informers.UDPRouteInformer == nil {
+			return nil
+		}
+		routes, err := informers.UDPRouteInformer.Lister().UDPRoutes(routeNs).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Warnf("key: %s, msg: failed to list UDPRoutes in the Namespace %s, err: %s", key, routeNs, err.Error())
+			return nil
+		}
+		for _, route := range routes {
+			for _, rule := range route.Spec.Rules {
+				if slices.ContainsFunc(rule.BackendRefs, inBackendNs) {
+					routeKeys = append(routeKeys, lib.UDPRoute+"/"+utils.ObjKey(route))
+					break
+				}
+			}
+		}
+	}
+	return routeKeys
+}
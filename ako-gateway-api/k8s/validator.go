@@ -17,10 +17,14 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
 	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
@@ -177,7 +181,10 @@ func isValidListener(key string, gateway *gatewayv1.Gateway, gatewayStatus *gate
 
 	// protocol validation
 	if listener.Protocol != gatewayv1.HTTPProtocolType &&
-		listener.Protocol != gatewayv1.HTTPSProtocolType {
+		listener.Protocol != gatewayv1.HTTPSProtocolType &&
+		listener.Protocol != gatewayv1.TLSProtocolType &&
+		listener.Protocol != gatewayv1.TCPProtocolType &&
+		listener.Protocol != gatewayv1.UDPProtocolType {
 		utils.AviLog.Errorf("key: %s, msg: protocol is not supported for listener %s", key, listener.Name)
 		defaultCondition.
 			Reason(string(gatewayv1.ListenerReasonUnsupportedProtocol)).
@@ -189,6 +196,16 @@ func isValidListener(key string, gateway *gatewayv1.Gateway, gatewayStatus *gate
 	}
 
 	if gatewayInDedicatedMode {
+		if akogatewayapilib.IsL4Protocol(listener.Protocol) {
+			utils.AviLog.Errorf("key: %s, msg: %s protocol is not supported in dedicated mode for gateway %+v", key, listener.Protocol, gateway.Name)
+			defaultCondition.
+				Reason(string(gatewayv1.ListenerReasonUnsupportedProtocol)).
+				Message(fmt.Sprintf("%s protocol is not supported in dedicated mode", listener.Protocol)).
+				SetIn(&gatewayStatus.Listeners[index].Conditions)
+			programmedCondition.
+				SetIn(&gatewayStatus.Listeners[index].Conditions)
+			return false
+		}
 		if listener.Hostname != nil {
 			utils.AviLog.Errorf("key: %s, msg: Hostname is not supported in dedicated mode for gateway %+v", key, gateway.Name)
 			defaultCondition.
@@ -251,8 +268,29 @@ func isValidListener(key string, gateway *gatewayv1.Gateway, gatewayStatus *gate
 		Type(string(gatewayv1.ListenerConditionResolvedRefs)).
 		Status(metav1.ConditionFalse).
 		ObservedGeneration(gateway.ObjectMeta.Generation)
-	// has valid TLS config
-	if listener.TLS != nil {
+	// TLS listeners are only supported in Passthrough mode, the TLS connection is
+	// terminated by the backends selected through TLSRoutes.
+	if listener.Protocol == gatewayv1.TLSProtocolType &&
+		(listener.TLS == nil || listener.TLS.Mode == nil || *listener.TLS.Mode != gatewayv1.TLSModePassthrough) {
+		utils.AviLog.Errorf("key: %s, msg: tls mode must be Passthrough for TLS listener %+v/%+v", key, gateway.Name, listener.Name)
+		defaultCondition.
+			Message("TLS mode must be Passthrough for TLS protocol").
+			SetIn(&gatewayStatus.Listeners[index].Conditions)
+		programmedCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
+		return false
+	}
+	if akogatewayapilib.IsL4Protocol(listener.Protocol) {
+		if conflictingProtocol, conflict := getConflictingListenerProtocol(gateway, listener); conflict {
+			utils.AviLog.Errorf("key: %s, msg: port %d of %s listener %+v/%+v is used by a listener with protocol %s", key, listener.Port, listener.Protocol, gateway.Name, listener.Name, conflictingProtocol)
+			defaultCondition.
+				Reason(string(gatewayv1.ListenerReasonProtocolConflict)).
+				Message(fmt.Sprintf("Port %d is used by a listener with protocol %s", listener.Port, conflictingProtocol)).
+				SetIn(&gatewayStatus.Listeners[index].Conditions)
+			programmedCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
+			return false
+		}
+	} else if listener.TLS != nil {
+		// has valid TLS config
 		if (listener.TLS.Mode != nil && *listener.TLS.Mode != gatewayv1.TLSModeTerminate) || len(listener.TLS.CertificateRefs) == 0 {
 			utils.AviLog.Errorf("key: %s, msg: tls mode/ref not valid %+v/%+v", key, gateway.Name, listener.Name)
 			defaultCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
@@ -275,11 +313,46 @@ func isValidListener(key string, gateway *gatewayv1.Gateway, gatewayStatus *gate
 				return false
 			}
 			name := string(certRef.Name)
-			_, err := utils.GetInformers().ClientSet.CoreV1().Secrets(gateway.ObjectMeta.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+			certNamespace := gateway.ObjectMeta.Namespace
+			if certRef.Namespace != nil && *certRef.Namespace != "" {
+				certNamespace = string(*certRef.Namespace)
+			}
+			gWNSName := gateway.ObjectMeta.Namespace + "/" + gateway.ObjectMeta.Name
+			secretNSName := certNamespace + "/" + name
+			if !isCertificateRefPermitted(gateway.ObjectMeta.Namespace, certNamespace, name) {
+				utils.AviLog.Errorf("key: %s, msg: Secret %s specified in CertificateRef is not permitted by any ReferenceGrant %+v/%+v", key, secretNSName, gateway.Name, listener.Name)
+				akogatewayapiobjects.GatewayApiLister().UpdateSecretToGateway(secretNSName, []string{gWNSName})
+				defaultCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
+				resolvedRefCondition.
+					Reason(string(gatewayv1.ListenerReasonRefNotPermitted)).
+					Message(fmt.Sprintf("Secret %s is not permitted by any ReferenceGrant", secretNSName)).
+					SetIn(&gatewayStatus.Listeners[index].Conditions)
+				programmedCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
+				return false
+			}
+			secret, err := utils.GetInformers().ClientSet.CoreV1().Secrets(certNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) && lib.IsCertManagerManaged(gateway.Annotations) {
+				// the Gateway is validated again once cert-manager issues the secret
+				pendingErr := &lib.CertificatePendingError{Namespace: certNamespace, Name: name}
+				utils.AviLog.Warnf("key: %s, msg: listener %+v/%+v is not programmed, %v", key, gateway.Name, listener.Name, pendingErr)
+				akogatewayapiobjects.GatewayApiLister().UpdateSecretToGateway(secretNSName, []string{gWNSName})
+				if lib.UpdateCertificatePendingState(lib.Gateway, gateway.Namespace, gateway.Name, secretNSName, true) {
+					akogatewayapilib.AKOControlConfig().EventRecorder().Eventf(gateway, corev1.EventTypeWarning, lib.CertificatePending,
+						"Listener %s is not programmed, %v", listener.Name, pendingErr)
+				}
+				defaultCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
+				resolvedRefCondition.
+					Reason(string(gatewayv1.ListenerReasonInvalidCertificateRef)).
+					Message(fmt.Sprintf("Secret %s is pending issuance by cert-manager", secretNSName)).
+					SetIn(&gatewayStatus.Listeners[index].Conditions)
+				programmedCondition.
+					Reason(string(gatewayv1.ListenerReasonPending)).
+					Message(fmt.Sprintf("Secret %s is pending issuance by cert-manager", secretNSName)).
+					SetIn(&gatewayStatus.Listeners[index].Conditions)
+				return false
+			}
 			if err != nil {
 				utils.AviLog.Errorf("key: %s, msg: Secret specified in CertificateRef does not exist %+v/%+v", key, gateway.Name, listener.Name)
-				gWNSName := gateway.ObjectMeta.Namespace + "/" + gateway.ObjectMeta.Name
-				secretNSName := gateway.ObjectMeta.Namespace + "/" + name
 				akogatewayapiobjects.GatewayApiLister().UpdateSecretToGateway(secretNSName, []string{gWNSName})
 				defaultCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
 				resolvedRefCondition.
@@ -289,6 +362,24 @@ func isValidListener(key string, gateway *gatewayv1.Gateway, gatewayStatus *gate
 				programmedCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
 				return false
 			}
+			if lib.UpdateCertificatePendingState(lib.Gateway, gateway.Namespace, gateway.Name, secretNSName, false) {
+				akogatewayapilib.AKOControlConfig().EventRecorder().Eventf(gateway, corev1.EventTypeNormal, lib.CertificateIssued,
+					"Secret %s is issued by cert-manager, programming the certificate of listener %s", secretNSName, listener.Name)
+			}
+			leaf, err := lib.ValidateSecretCertificate(secret)
+			lib.ReportCertificateState(key, akogatewayapilib.AKOControlConfig().EventRecorder(), gateway, lib.Gateway,
+				gateway.Namespace, gateway.Name, secretNSName, leaf, err)
+			if err != nil {
+				// the Gateway is validated again once the secret is renewed
+				akogatewayapiobjects.GatewayApiLister().UpdateSecretToGateway(secretNSName, []string{gWNSName})
+				defaultCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
+				resolvedRefCondition.
+					Reason(string(gatewayv1.ListenerReasonInvalidCertificateRef)).
+					Message(err.Error()).
+					SetIn(&gatewayStatus.Listeners[index].Conditions)
+				programmedCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
+				return false
+			}
 		}
 	}
 
@@ -296,12 +387,13 @@ func isValidListener(key string, gateway *gatewayv1.Gateway, gatewayStatus *gate
 	if listener.AllowedRoutes != nil {
 		if listener.AllowedRoutes.Kinds != nil {
 			for _, kindInAllowedRoute := range listener.AllowedRoutes.Kinds {
-				if kindInAllowedRoute.Kind != "" && string(kindInAllowedRoute.Kind) != utils.HTTPRoute {
-					utils.AviLog.Errorf("key: %s, msg: AllowedRoute kind is invalid %+v/%+v. Supported AllowedRoute kind is HTTPRoute.", key, gateway.Name, listener.Name)
+				if kindInAllowedRoute.Kind != "" && !akogatewayapilib.IsRouteKindSupported(listener.Protocol, string(kindInAllowedRoute.Kind)) {
+					supportedKinds := supportedRouteKindsMessage(listener.Protocol)
+					utils.AviLog.Errorf("key: %s, msg: AllowedRoute kind is invalid %+v/%+v. Supported AllowedRoute kinds for the listener are %s.", key, gateway.Name, listener.Name, supportedKinds)
 					defaultCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
 					resolvedRefCondition.
 						Reason(string(gatewayv1.ListenerReasonInvalidRouteKinds)).
-						Message("AllowedRoute kind is invalid. Only HTTPRoute is supported currently").
+						Message(fmt.Sprintf("AllowedRoute kind is invalid. Only %s supported currently", supportedKinds)).
 						SetIn(&gatewayStatus.Listeners[index].Conditions)
 					programmedCondition.SetIn(&gatewayStatus.Listeners[index].Conditions)
 					return false
@@ -348,6 +440,66 @@ func IsHTTPRouteConfigValid(key string, obj *gatewayv1.HTTPRoute) bool {
 	return true
 }
 
+func IsGRPCRouteConfigValid(key string, obj *gatewayv1.GRPCRoute) bool {
+	if len(obj.Spec.ParentRefs) == 0 {
+		utils.AviLog.Errorf("key: %s, msg: Parent Reference is empty for the GRPCRoute %s", key, obj.Name)
+		return false
+	}
+	return true
+}
+
+func IsTLSRouteConfigValid(key string, obj *gatewayv1alpha2.TLSRoute) bool {
+	if len(obj.Spec.ParentRefs) == 0 {
+		utils.AviLog.Errorf("key: %s, msg: Parent Reference is empty for the TLSRoute %s", key, obj.Name)
+		return false
+	}
+	return true
+}
+
+func IsTCPRouteConfigValid(key string, obj *gatewayv1alpha2.TCPRoute) bool {
+	if len(obj.Spec.ParentRefs) == 0 {
+		utils.AviLog.Errorf("key: %s, msg: Parent Reference is empty for the TCPRoute %s", key, obj.Name)
+		return false
+	}
+	return true
+}
+
+func IsUDPRouteConfigValid(key string, obj *gatewayv1alpha2.UDPRoute) bool {
+	if len(obj.Spec.ParentRefs) == 0 {
+		utils.AviLog.Errorf("key: %s, msg: Parent Reference is empty for the UDPRoute %s", key, obj.Name)
+		return false
+	}
+	return true
+}
+
+// getConflictingListenerProtocol returns the protocol of another listener of the gateway using the
+// same port as the L4 listener. UDP listeners do not conflict with listeners of the TCP based protocols.
+func getConflictingListenerProtocol(gateway *gatewayv1.Gateway, listener gatewayv1.Listener) (gatewayv1.ProtocolType, bool) {
+	for _, gwListener := range gateway.Spec.Listeners {
+		if gwListener.Port != listener.Port || gwListener.Protocol == listener.Protocol {
+			continue
+		}
+		if (gwListener.Protocol == gatewayv1.UDPProtocolType) != (listener.Protocol == gatewayv1.UDPProtocolType) {
+			continue
+		}
+		return gwListener.Protocol, true
+	}
+	return "", false
+}
+
+// supportedRouteKindsMessage returns the route kinds supported for a listener protocol,
+// for example "HTTPRoute and GRPCRoute are".
+func supportedRouteKindsMessage(protocol gatewayv1.ProtocolType) string {
+	var kinds []string
+	for _, supportedKind := range akogatewayapilib.SupportedKinds[protocol] {
+		kinds = append(kinds, string(supportedKind.Kind))
+	}
+	if len(kinds) == 1 {
+		return kinds[0] + " is"
+	}
+	return strings.Join(kinds, " and ") + " are"
+}
+
 func ValidateGatewayListenerWithSecret(key, namespace, name string, deleteFlag bool) {
 	secretNSName := namespace + "/" + name
 	present, gwList := akogatewayapiobjects.GatewayApiLister().GetSecretToGateway(secretNSName)
@@ -374,11 +526,14 @@ func ValidateGatewayListenerWithSecret(key, namespace, name string, deleteFlag b
 						continue
 					}
 					for _, certRef := range listener.TLS.CertificateRefs {
-						// TODO: In future, ref grant impact needs to be considered.
-						certNamespace := namespace
-						if certRef.Namespace != nil {
+						certNamespace := gwNamespace
+						if certRef.Namespace != nil && *certRef.Namespace != "" {
 							certNamespace = string(*certRef.Namespace)
 						}
+						// a secret in another namespace is used only if permitted by a ReferenceGrant
+						if !isCertificateRefPermitted(gwNamespace, certNamespace, name) {
+							continue
+						}
 						// add condition for checking gateway status
 						if gwStatus != nil && string(certRef.Name) == name && certNamespace == namespace {
 							setListenerConditions(gwStatus, listenerIndex, gatewayObj.ObjectMeta.Generation, deleteFlag)
@@ -405,6 +560,13 @@ func ValidateGatewayListenerWithSecret(key, namespace, name string, deleteFlag b
 	}
 }
 
+// isCertificateRefPermitted returns true if a Gateway in gwNamespace can use the Secret certNamespace/certName.
+func isCertificateRefPermitted(gwNamespace, certNamespace, certName string) bool {
+	from := akogatewayapiobjects.ReferenceGrantFrom{Group: akogatewayapilib.GatewayGroup, Kind: lib.Gateway, Namespace: gwNamespace}
+	to := akogatewayapiobjects.ReferenceGrantTo{Kind: utils.Secret, Name: certName}
+	return akogatewayapiobjects.GatewayApiLister().IsReferencePermitted(from, to, certNamespace)
+}
+
 // Mapping has to be taken care between secret and gateway
 func setListenerConditions(gwStatus *gatewayv1.GatewayStatus, index int, generation int64, isDelete bool) {
 	if !isDelete {
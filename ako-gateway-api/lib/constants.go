@@ -21,6 +21,7 @@ const (
 	GatewayGroup              = "gateway.networking.k8s.io"
 	HealthMonitorKind         = "HealthMonitor"
 	RouteBackendExtensionKind = "RouteBackendExtension"
+	ConfigMapKind             = "ConfigMap"
 	AKOCRDController          = "AKOCRDController"
 	CRDOperatorPrefix         = "ako-crd-operator-"
 	HTTPRouteAcceptedMessage  = "Parent reference is valid"
@@ -30,6 +31,58 @@ const (
 	ZeroAttachedRoutes = 0
 )
 
+const (
+	TLSRouteResource = "tlsroutes"
+	TCPRouteResource = "tcproutes"
+	UDPRouteResource = "udproutes"
+
+	BackendTLSPolicyResource = "backendtlspolicies"
+	// BackendTLSPolicyCACertKey is the key of the CA certificate in the ConfigMaps and Secrets
+	// referred in the caCertificateRefs of a BackendTLSPolicy.
+	BackendTLSPolicyCACertKey = "ca.crt"
+	// TLSPassthroughDatascript selects the poolgroup of a TLSRoute using the SNI of the
+	// client hello. The SNI to poolgroup map is populated from the TLSRoutes attached to
+	// the Gateway, a wildcard hostname matches the SNI when no exact hostname is present.
+	TLSPassthroughDatascript = `local avi_tls = require "Default-TLS"
+buffered = avi.l4.collect(20)
+payload = avi.l4.read()
+len = avi_tls.get_req_buffer_size(payload)
+if ( buffered < len ) then
+  avi.l4.collect(len)
+end
+if ( avi_tls.sanity_check(payload) ) then
+  local h = avi_tls.parse_record(payload)
+  local sname = avi_tls.get_sni(h)
+  if sname == nil then
+    avi.vs.log('SNI not present')
+    avi.vs.close_conn()
+  else
+    local sni_pg_map = {
+%s    }
+    local pg_name = sni_pg_map[sname]
+    local suffix = sname
+    while pg_name == nil do
+      local dot = string.find(suffix, ".", 1, true)
+      if dot == nil then
+        break
+      end
+      suffix = string.sub(suffix, dot + 1)
+      pg_name = sni_pg_map["*." .. suffix]
+    end
+    if pg_name == nil then
+      avi.vs.log("No TLSRoute found for SNI=" .. sname)
+      avi.vs.close_conn()
+    else
+      avi.poolgroup.select(pg_name)
+    end
+  end
+else
+  avi.vs.close_conn()
+end
+avi.l4.ds_done()
+avi_tls = nil`
+)
+
 const (
 	// Gateway annotations
 	DedicatedGatewayModeAnnotation = "ako.vmware.com/dedicated-gateway-mode"
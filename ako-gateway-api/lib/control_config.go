@@ -19,9 +19,13 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 	gatewayinformerv1 "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions/apis/v1"
+	gatewayinformerv1alpha2 "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions/apis/v1alpha2"
+	gatewayinformerv1alpha3 "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions/apis/v1alpha3"
+	gatewayinformerv1beta1 "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions/apis/v1beta1"
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	v1beta1akocrd "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1beta1/clientset/versioned"
@@ -33,6 +37,21 @@ type GatewayAPIInformers struct {
 	GatewayInformer      gatewayinformerv1.GatewayInformer
 	GatewayClassInformer gatewayinformerv1.GatewayClassInformer
 	HTTPRouteInformer    gatewayinformerv1.HTTPRouteInformer
+	GRPCRouteInformer    gatewayinformerv1.GRPCRouteInformer
+	// ReferenceGrantInformer is used to permit the cross namespace references
+	// of the Gateways and Routes.
+	ReferenceGrantInformer gatewayinformerv1beta1.ReferenceGrantInformer
+	// TLSRouteInformer, TCPRouteInformer and UDPRouteInformer are nil when the
+	// respective experimental CRD is not installed in the cluster.
+	TLSRouteInformer gatewayinformerv1alpha2.TLSRouteInformer
+	TCPRouteInformer gatewayinformerv1alpha2.TCPRouteInformer
+	UDPRouteInformer gatewayinformerv1alpha2.UDPRouteInformer
+	// BackendTLSPolicyInformer is nil when the experimental BackendTLSPolicy CRD
+	// is not installed in the cluster.
+	BackendTLSPolicyInformer gatewayinformerv1alpha3.BackendTLSPolicyInformer
+	// ConfigMapInformer is used to resolve the CA certificates of the BackendTLSPolicies,
+	// and is nil when BackendTLSPolicyInformer is nil.
+	ConfigMapInformer coreinformers.ConfigMapInformer
 }
 
 // akoControlConfig struct is intended to store all AKO related global
@@ -19,13 +19,17 @@ import (
 	"fmt"
 
 	"os"
+	"sort"
 	"strings"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
@@ -60,6 +64,21 @@ func GetGatewayParentName(namespace, gwName string) string {
 	return lib.GetNamePrefix() + namespace + "-" + gwName + "-EVH"
 }
 
+// passthrough vs name format - ako-gw-clustername--gatewayNs-gatewayName-passthrough
+func GetGatewayPassthroughName(namespace, gwName string) string {
+	return lib.GetNamePrefix() + namespace + "-" + gwName + "-passthrough"
+}
+
+// GetRouteNameForAviObjects returns the route name used in the names of the Avi objects of an L7 route. The kind is
+// prefixed for the routes other than HTTPRoute, so that an HTTPRoute and a GRPCRoute with the same namespace and name
+// do not share the child VS, pools and poolgroups. HTTPRoute names are unchanged to retain the existing Avi objects.
+func GetRouteNameForAviObjects(routeKind, routeName string) string {
+	if routeKind == "" || routeKind == lib.HTTPRoute {
+		return routeName
+	}
+	return routeKind + "/" + routeName
+}
+
 // child vs name format - ako-gw-clustername--encoded value of ako-gw-clustername--parentNs-parentName-routeNs-routeName-encodedMatch
 func GetChildName(parentNs, parentName, routeNs, routeName, matchName string) string {
 	name := parentNs + "-" + parentName + "-" + routeNs + "-" + routeName
@@ -94,11 +113,25 @@ func GetPersistenceProfileName(parentNs, parentName, routeNs, routeName, matchNa
 	return lib.EncodeWithPrefix(name, lib.ApplicationPersistenceProfile)
 }
 
+func GetTrafficCloneProfileName(parentNs, parentName, routeNs, routeName, matchName string) string {
+	name := parentNs + "-" + parentName + "-" + routeNs + "-" + routeName + "-trafficclone"
+	if matchName != "" {
+		name = fmt.Sprintf("%s-%s", name, utils.Stringify(utils.Hash(matchName)))
+	}
+	return lib.EncodeWithPrefix(name, lib.TrafficCloneProfile)
+}
+
 func GetHttpPolicySetName(parentNs, parentName, routeNs, routeName string) string {
 	name := parentNs + "-" + parentName + "-" + routeNs + "-" + routeName + "-httproute"
 	return lib.EncodeWithPrefix(name, lib.HTTPPS)
 }
 
+// passthrough datascript name format - ako-gw-clustername--encoded value of parentNs-parentName-passthrough
+func GetPassthroughDataScriptName(parentNs, parentName string) string {
+	name := parentNs + "-" + parentName + "-passthrough"
+	return lib.EncodeWithPrefix(name, lib.DataScript)
+}
+
 func GetDedicatedPoolName(poolGroupName, backendNs, backendName string, backendPort int32, backendIndex int) string {
 	var name string
 	if backendName != "" {
@@ -280,3 +313,157 @@ func IsGatewayInDedicatedMode(namespace, gatewayName string) bool {
 func GetGatewayDedicatedVSName(namespace, gatewayName string) string {
 	return lib.GetNamePrefix() + namespace + "-" + gatewayName
 }
+
+// IsRouteKindSupported checks whether routes of the given kind can attach to a listener of the given protocol.
+func IsRouteKindSupported(protocol gatewayv1.ProtocolType, kind string) bool {
+	for _, supportedKind := range SupportedKinds[protocol] {
+		if string(supportedKind.Kind) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// IsL4Protocol returns true for the listener protocols served by the L4 routes, TLSRoute, TCPRoute and UDPRoute.
+func IsL4Protocol(protocol gatewayv1.ProtocolType) bool {
+	return protocol == gatewayv1.TLSProtocolType ||
+		protocol == gatewayv1.TCPProtocolType ||
+		protocol == gatewayv1.UDPProtocolType
+}
+
+// IsL4Route returns true for the route kinds, TCPRoute and UDPRoute, that select the backends by the listener port only.
+func IsL4Route(kind string) bool {
+	return kind == lib.TCPRoute || kind == lib.UDPRoute
+}
+
+// L4 policyset name format - ako-gw-clustername--encoded value of parentNs-parentName-l4policy
+func GetL4PolicySetName(parentNs, parentName string) string {
+	name := parentNs + "-" + parentName + "-l4policy"
+	return lib.EncodeWithPrefix(name, lib.L4PS)
+}
+
+// IsGatewayAPIResourceServed checks whether a Gateway API resource, for example the
+// experimental TLSRoute, is served by the API server for the given group version.
+func IsGatewayAPIResourceServed(cs gatewayclientset.Interface, groupVersion, resource string) bool {
+	resourceList, err := cs.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		utils.AviLog.Infof("Unable to discover resources for %s, err: %v", groupVersion, err)
+		return false
+	}
+	for _, apiResource := range resourceList.APIResources {
+		if apiResource.Name == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBackendTLSPolicyTargetingService checks whether the Service is one of the targetRefs of the BackendTLSPolicy.
+func IsBackendTLSPolicyTargetingService(policy *gatewayv1alpha3.BackendTLSPolicy, svcName string) bool {
+	for _, targetRef := range policy.Spec.TargetRefs {
+		if targetRef.Group == "" && string(targetRef.Kind) == utils.Service && string(targetRef.Name) == svcName {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBackendTLSPoliciesForService returns the BackendTLSPolicies targeting the Service, the oldest
+// BackendTLSPolicy first. Only the first BackendTLSPolicy is applied, the rest are conflicted.
+func GetBackendTLSPoliciesForService(namespace, svcName string) []*gatewayv1alpha3.BackendTLSPolicy {
+	informers := AKOControlConfig().GatewayApiInformers()
+	if informers == nil || informers.BackendTLSPolicyInformer == nil {
+		return nil
+	}
+	policies, err := informers.BackendTLSPolicyInformer.Lister().BackendTLSPolicies(namespace).List(labels.Everything())
+	if err != nil {
+		utils.AviLog.Warnf("failed to list BackendTLSPolicies in namespace %s, err: %v", namespace, err)
+		return nil
+	}
+	var svcPolicies []*gatewayv1alpha3.BackendTLSPolicy
+	for _, policy := range policies {
+		if IsBackendTLSPolicyTargetingService(policy, svcName) {
+			svcPolicies = append(svcPolicies, policy)
+		}
+	}
+	sort.Slice(svcPolicies, func(i, j int) bool {
+		if !svcPolicies[i].CreationTimestamp.Equal(&svcPolicies[j].CreationTimestamp) {
+			return svcPolicies[i].CreationTimestamp.Before(&svcPolicies[j].CreationTimestamp)
+		}
+		return svcPolicies[i].Name < svcPolicies[j].Name
+	})
+	return svcPolicies
+}
+
+// GetBackendTLSPoliciesForCACertRef returns the BackendTLSPolicies with the ConfigMap or the Secret as one of the
+// caCertificateRefs.
+func GetBackendTLSPoliciesForCACertRef(kind, namespace, name string) []*gatewayv1alpha3.BackendTLSPolicy {
+	informers := AKOControlConfig().GatewayApiInformers()
+	if informers == nil || informers.BackendTLSPolicyInformer == nil {
+		return nil
+	}
+	policies, err := informers.BackendTLSPolicyInformer.Lister().BackendTLSPolicies(namespace).List(labels.Everything())
+	if err != nil {
+		utils.AviLog.Warnf("failed to list BackendTLSPolicies in namespace %s, err: %v", namespace, err)
+		return nil
+	}
+	var refPolicies []*gatewayv1alpha3.BackendTLSPolicy
+	for _, policy := range policies {
+		for _, caRef := range policy.Spec.Validation.CACertificateRefs {
+			if caRef.Group == "" && string(caRef.Kind) == kind && string(caRef.Name) == name {
+				refPolicies = append(refPolicies, policy)
+				break
+			}
+		}
+	}
+	return refPolicies
+}
+
+// GetBackendTLSPolicyCACert returns the CA certificates of the caCertificateRefs of the BackendTLSPolicy,
+// and an error if the validation settings of the BackendTLSPolicy are not supported or can not be resolved.
+func GetBackendTLSPolicyCACert(key string, policy *gatewayv1alpha3.BackendTLSPolicy) (string, error) {
+	validation := policy.Spec.Validation
+	if validation.WellKnownCACertificates != nil {
+		return "", fmt.Errorf("wellKnownCACertificates %s is not supported", *validation.WellKnownCACertificates)
+	}
+	for _, san := range validation.SubjectAltNames {
+		if san.Type != gatewayv1alpha3.HostnameSubjectAltNameType {
+			return "", fmt.Errorf("subjectAltNames of type %s are not supported", san.Type)
+		}
+	}
+	if len(validation.CACertificateRefs) == 0 {
+		return "", fmt.Errorf("caCertificateRefs must be specified")
+	}
+	caCerts := make([]string, 0, len(validation.CACertificateRefs))
+	for _, caRef := range validation.CACertificateRefs {
+		if caRef.Group != "" {
+			return "", fmt.Errorf("caCertificateRef %s of group %s is not supported", caRef.Name, caRef.Group)
+		}
+		var data string
+		var found bool
+		switch string(caRef.Kind) {
+		case ConfigMapKind:
+			cm, err := AKOControlConfig().GatewayApiInformers().ConfigMapInformer.Lister().ConfigMaps(policy.Namespace).Get(string(caRef.Name))
+			if err != nil {
+				return "", fmt.Errorf("ConfigMap %s/%s not found", policy.Namespace, caRef.Name)
+			}
+			data, found = cm.Data[BackendTLSPolicyCACertKey]
+		case utils.Secret:
+			secret, err := utils.GetInformers().SecretInformer.Lister().Secrets(policy.Namespace).Get(string(caRef.Name))
+			if err != nil {
+				return "", fmt.Errorf("Secret %s/%s not found", policy.Namespace, caRef.Name)
+			}
+			var dataBytes []byte
+			dataBytes, found = secret.Data[BackendTLSPolicyCACertKey]
+			data = string(dataBytes)
+		default:
+			return "", fmt.Errorf("caCertificateRef %s of kind %s is not supported", caRef.Name, caRef.Kind)
+		}
+		if !found || strings.TrimSpace(data) == "" {
+			return "", fmt.Errorf("%s %s/%s does not have a CA certificate in %s", caRef.Kind, policy.Namespace, caRef.Name, BackendTLSPolicyCACertKey)
+		}
+		caCerts = append(caCerts, strings.TrimSpace(data))
+	}
+	utils.AviLog.Debugf("key: %s, msg: resolved %d CA certificates for BackendTLSPolicy %s/%s", key, len(caCerts), policy.Namespace, policy.Name)
+	return strings.Join(caCerts, "\n"), nil
+}
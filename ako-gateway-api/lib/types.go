@@ -21,6 +21,9 @@ import (
 )
 
 var SupportedKinds = map[gatewayv1.ProtocolType][]gatewayv1.RouteGroupKind{
-	gatewayv1.HTTPProtocolType:  {{Kind: lib.HTTPRoute}},
-	gatewayv1.HTTPSProtocolType: {{Kind: lib.HTTPRoute}},
+	gatewayv1.HTTPProtocolType:  {{Kind: lib.HTTPRoute}, {Kind: lib.GRPCRoute}},
+	gatewayv1.HTTPSProtocolType: {{Kind: lib.HTTPRoute}, {Kind: lib.GRPCRoute}},
+	gatewayv1.TLSProtocolType:   {{Kind: lib.TLSRoute}},
+	gatewayv1.TCPProtocolType:   {{Kind: lib.TCPRoute}},
+	gatewayv1.UDPProtocolType:   {{Kind: lib.UDPRoute}},
 }
@@ -15,19 +15,381 @@
 package nodes
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/alb-sdk/go/models"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 )
 
 func (o *AviObjectGraph) ProcessL4Routes(key string, routeModel RouteModel, parentNsName string) {
-	for _, rule := range routeModel.ParseRouteConfig(key).Rules {
-		parentNode := o.GetAviEvhVS()
-		// create L4 policyset per rule
-		o.BuildL4PolicySet(key, parentNode[0], routeModel, rule)
-		// Logic to override the app profile per port also must be taken care here.
+	o.Lock.Lock()
+	defer o.Lock.Unlock()
+	parentNode := o.GetAviEvhVS()
+	routeConfig := routeModel.ParseRouteConfig(key)
+	switch routeModel.GetType() {
+	case lib.TLSRoute:
+		// TLSRoutes are attached to the passthrough VS of the parent VS, a poolgroup is created per hostname
+		// and the datascript on the passthrough VS selects the poolgroup using the SNI.
+		passChildVS := getPassthroughChild(parentNode[0])
+		if passChildVS == nil {
+			utils.AviLog.Warnf("key: %s, msg: no TLS passthrough listener on parent vs %s for the route %s/%s", key, parentNode[0].Name, routeModel.GetNamespace(), routeModel.GetName())
+			return
+		}
+		o.BuildTLSPassthroughPGPool(key, parentNsName, passChildVS, routeModel, routeConfig.Rules)
+		BuildTLSPassthroughDataScript(key, parentNsName, passChildVS)
+	case lib.TCPRoute, lib.UDPRoute:
+		// TCPRoutes and UDPRoutes are attached to the parent VS directly, a poolgroup is created per route
+		// and the L4 policyset on the parent VS selects the poolgroup using the listener port.
+		o.BuildL4RoutePGPool(key, parentNsName, parentNode[0], routeModel, routeConfig.Rules)
+		BuildL4PolicySet(key, parentNsName, parentNode[0])
+	}
+}
+
+func (o *AviObjectGraph) BuildL4RoutePGPool(key, parentNsName string, parentNode *nodes.AviEvhVsNode, routeModel RouteModel, rules []*Rule) {
+	routeTypeNsName := routeModel.GetType() + "/" + routeModel.GetNamespace() + "/" + routeModel.GetName()
+	gwRouteNsName := parentNsName + "/" + routeTypeNsName
+	parentNs, _, parentName := lib.ExtractTypeNameNamespace(parentNsName)
+
+	protocol := utils.TCP
+	if routeModel.GetType() == lib.UDPRoute {
+		protocol = utils.UDP
+	}
+
+	var pgNodes []*nodes.AviPoolGroupNode
+	var poolNodes []*nodes.AviPoolNode
+	listeners := akogatewayapiobjects.GatewayApiLister().GetRouteToGatewayListener(routeTypeNsName, parentNsName)
+	if len(listeners) == 0 {
+		utils.AviLog.Warnf("key: %s, msg: No matching listener available for the route : %s", key, routeTypeNsName)
+	} else {
+		// the route kind is part of the name, as a TCPRoute and a UDPRoute can have the same name
+		PG := &nodes.AviPoolGroupNode{
+			Name: akogatewayapilib.GetPoolGroupName(parentNs, parentName,
+				routeModel.GetNamespace(), routeModel.GetName(), routeModel.GetType()),
+			Tenant: parentNode.Tenant,
+		}
+		PG.AviMarkers = utils.AviObjectMarkers{
+			GatewayName:        parentName,
+			GatewayNamespace:   parentNs,
+			HTTPRouteName:      routeModel.GetName(),
+			HTTPRouteNamespace: routeModel.GetNamespace(),
+		}
+		for _, rule := range rules {
+			for _, backend := range rule.Backends {
+				poolName := akogatewayapilib.GetPoolName(parentNs, parentName,
+					routeModel.GetNamespace(), routeModel.GetName(),
+					routeModel.GetType()+"/"+rule.Name,
+					backend.Backend.Namespace, backend.Backend.Name, strconv.Itoa(int(backend.Backend.Port)))
+				poolNode := buildL4RoutePool(key, parentNsName, parentNode, routeModel, rule, backend, poolName, protocol)
+				if poolNode == nil {
+					continue
+				}
+				poolNodes = append(poolNodes, poolNode)
+				poolRef := fmt.Sprintf("/api/pool?name=%s", poolNode.Name)
+				ratio := uint32(backend.Backend.Weight)
+				PG.Members = append(PG.Members, &models.PoolGroupMember{PoolRef: &poolRef, Ratio: &ratio})
+			}
+		}
+		if len(PG.Members) > 0 {
+			pgNodes = append(pgNodes, PG)
+		}
+	}
+
+	updateRoutePGPool(key, gwRouteNsName, parentNode, pgNodes, poolNodes)
+}
+
+// BuildL4PolicySet builds the L4 policyset of the parent VS from the poolgroups of the TCPRoutes and
+// UDPRoutes attached to the parent VS. When a listener port is claimed by more than one route, the
+// poolgroup added first to the parent VS is selected.
+func BuildL4PolicySet(key, parentNsName string, parentNode *nodes.AviEvhVsNode) {
+	parentNs, _, parentName := lib.ExtractTypeNameNamespace(parentNsName)
+	pgToRoute := make(map[string]string)
+	_, routeTypeNsNames := akogatewayapiobjects.GatewayApiLister().GetGatewayToRoute(parentNsName)
+	for _, routeTypeNsName := range routeTypeNsNames {
+		routeType, _, _ := lib.ExtractTypeNameNamespace(routeTypeNsName)
+		if !akogatewayapilib.IsL4Route(routeType) {
+			continue
+		}
+		found, pgPool := akogatewayapiobjects.GatewayApiLister().GetGatewayRouteToHTTPSPGPool(parentNsName + "/" + routeTypeNsName)
+		if !found {
+			continue
+		}
+		for _, pgName := range pgPool.PoolGroup {
+			pgToRoute[pgName] = routeTypeNsName
+		}
+	}
+
+	portToPG := make(map[string]string)
+	var portPoolSet []nodes.AviHostPathPortPoolPG
+	for _, pgNode := range parentNode.PoolGroupRefs {
+		routeTypeNsName, ok := pgToRoute[pgNode.Name]
+		if !ok {
+			continue
+		}
+		protocol := utils.TCP
+		if strings.HasPrefix(routeTypeNsName, lib.UDPRoute+"/") {
+			protocol = utils.UDP
+		}
+		listeners := akogatewayapiobjects.GatewayApiLister().GetRouteToGatewayListener(routeTypeNsName, parentNsName)
+		for _, listener := range listeners {
+			if listener.Protocol != protocol {
+				continue
+			}
+			portProtocol := fmt.Sprintf("%s/%d", protocol, listener.Port)
+			if pgName, ok := portToPG[portProtocol]; ok {
+				if pgName != pgNode.Name {
+					utils.AviLog.Warnf("key: %s, msg: port %s is already served by poolgroup %s, ignoring poolgroup %s", key, portProtocol, pgName, pgNode.Name)
+				}
+				continue
+			}
+			portToPG[portProtocol] = pgNode.Name
+			portPoolSet = append(portPoolSet, nodes.AviHostPathPortPoolPG{
+				Name:      fmt.Sprintf("%s-%s-%d", pgNode.Name, strings.ToLower(protocol), listener.Port),
+				Port:      uint32(listener.Port),
+				PoolGroup: fmt.Sprintf("/api/poolgroup?name=%s", pgNode.Name),
+				Protocol:  protocol,
+			})
+		}
+	}
+	if len(portPoolSet) == 0 {
+		parentNode.L4PolicyRefs = nil
+		return
+	}
+	l4PolicyNode := &nodes.AviL4PolicyNode{
+		Name:     akogatewayapilib.GetL4PolicySetName(parentNs, parentName),
+		Tenant:   parentNode.Tenant,
+		PortPool: portPoolSet,
+	}
+	l4PolicyNode.AviMarkers = utils.AviObjectMarkers{
+		GatewayName:      parentName,
+		GatewayNamespace: parentNs,
+	}
+	parentNode.L4PolicyRefs = []*nodes.AviL4PolicyNode{l4PolicyNode}
+	utils.AviLog.Debugf("key: %s, msg: L4 policyset %s built for parent vs %s with ports %v", key, l4PolicyNode.Name, parentNode.Name, utils.Stringify(portToPG))
+}
+
+// getPassthroughChild returns the L4 VS of the TLS passthrough listeners of the parent VS, nil if the Gateway has no
+// TLS passthrough listener.
+func getPassthroughChild(parentNode *nodes.AviEvhVsNode) *nodes.AviVsNode {
+	if len(parentNode.PassthroughChildNodes) == 0 {
+		return nil
 	}
+	return parentNode.PassthroughChildNodes[0]
 }
 
-func (o *AviObjectGraph) BuildL4PolicySet(key string, vsNode *nodes.AviEvhVsNode, routeModel RouteModel, rule *Rule) {
+func (o *AviObjectGraph) BuildTLSPassthroughPGPool(key, parentNsName string, passChildVS *nodes.AviVsNode, routeModel RouteModel, rules []*Rule) {
+	routeTypeNsName := routeModel.GetType() + "/" + routeModel.GetNamespace() + "/" + routeModel.GetName()
+	gwRouteNsName := parentNsName + "/" + routeTypeNsName
+	parentNs, _, parentName := lib.ExtractTypeNameNamespace(parentNsName)
+
+	var hosts []string
+	listeners := akogatewayapiobjects.GatewayApiLister().GetRouteToGatewayListener(routeTypeNsName, parentNsName)
+	if len(listeners) == 0 {
+		utils.AviLog.Warnf("key: %s, msg: No matching listener available for the route : %s", key, routeTypeNsName)
+	} else {
+		_, hosts = akogatewayapiobjects.GatewayApiLister().GetGatewayRouteToHostname(gwRouteNsName)
+	}
+
+	var pgNodes []*nodes.AviPoolGroupNode
+	var poolNodes []*nodes.AviPoolNode
+	for _, host := range hosts {
+		PG := &nodes.AviPoolGroupNode{
+			Name: akogatewayapilib.GetPoolGroupName(parentNs, parentName,
+				routeModel.GetNamespace(), routeModel.GetName(), host),
+			Tenant: passChildVS.Tenant,
+		}
+		PG.AviMarkers = utils.AviObjectMarkers{
+			GatewayName:        parentName,
+			GatewayNamespace:   parentNs,
+			HTTPRouteName:      routeModel.GetName(),
+			HTTPRouteNamespace: routeModel.GetNamespace(),
+			Host:               []string{host},
+		}
+		for _, rule := range rules {
+			for _, backend := range rule.Backends {
+				// a pool can be a member of a single poolgroup, hence the hostname is part of the pool name
+				poolName := akogatewayapilib.GetPoolName(parentNs, parentName,
+					routeModel.GetNamespace(), routeModel.GetName(),
+					host+"/"+rule.Name,
+					backend.Backend.Namespace, backend.Backend.Name, strconv.Itoa(int(backend.Backend.Port)))
+				poolNode := buildL4RoutePool(key, parentNsName, passChildVS, routeModel, rule, backend, poolName, utils.TCP)
+				if poolNode == nil {
+					continue
+				}
+				poolNode.AviMarkers.Host = []string{host}
+				poolNodes = append(poolNodes, poolNode)
+				poolRef := fmt.Sprintf("/api/pool?name=%s", poolNode.Name)
+				ratio := uint32(backend.Backend.Weight)
+				PG.Members = append(PG.Members, &models.PoolGroupMember{PoolRef: &poolRef, Ratio: &ratio})
+			}
+		}
+		if len(PG.Members) > 0 {
+			pgNodes = append(pgNodes, PG)
+		}
+	}
+
+	updateRoutePGPool(key, gwRouteNsName, passChildVS, pgNodes, poolNodes)
+}
 
-	// TODO: add the l4policset code here
+// updateRoutePGPool replaces the poolgroups and pools of a route, which is attached to the parent VS or to its
+// passthrough VS directly, with the given ones. Poolgroups and pools are updated in place, as the order of the
+// poolgroups decides which route owns a hostname or a port claimed by more than one route.
+func updateRoutePGPool(key, gwRouteNsName string, vsNode nodes.AviVsEvhSniModel, pgNodes []*nodes.AviPoolGroupNode, poolNodes []*nodes.AviPoolNode) {
+	_, storedPGPool := akogatewayapiobjects.GatewayApiLister().GetGatewayRouteToHTTPSPGPool(gwRouteNsName)
+	var pgPool akogatewayapiobjects.HTTPPSPGPool
+	routePGs := make(map[string]*nodes.AviPoolGroupNode, len(pgNodes))
+	for _, pgNode := range pgNodes {
+		routePGs[pgNode.Name] = pgNode
+		pgPool.PoolGroup = append(pgPool.PoolGroup, pgNode.Name)
+	}
+	routePools := make(map[string]*nodes.AviPoolNode, len(poolNodes))
+	for _, poolNode := range poolNodes {
+		routePools[poolNode.Name] = poolNode
+		pgPool.Pool = append(pgPool.Pool, poolNode.Name)
+	}
+
+	var updatedPGs []*nodes.AviPoolGroupNode
+	for _, pgNode := range vsNode.GetPoolGroupRefs() {
+		if newPG, ok := routePGs[pgNode.Name]; ok {
+			updatedPGs = append(updatedPGs, newPG)
+			delete(routePGs, pgNode.Name)
+		} else if !utils.HasElem(storedPGPool.PoolGroup, pgNode.Name) {
+			updatedPGs = append(updatedPGs, pgNode)
+		}
+	}
+	for _, pgNode := range pgNodes {
+		if _, ok := routePGs[pgNode.Name]; ok {
+			updatedPGs = append(updatedPGs, pgNode)
+		}
+	}
+	vsNode.SetPoolGroupRefs(updatedPGs)
+
+	var updatedPools []*nodes.AviPoolNode
+	for _, poolNode := range vsNode.GetPoolRefs() {
+		if newPool, ok := routePools[poolNode.Name]; ok {
+			updatedPools = append(updatedPools, newPool)
+			delete(routePools, poolNode.Name)
+		} else if !utils.HasElem(storedPGPool.Pool, poolNode.Name) {
+			updatedPools = append(updatedPools, poolNode)
+		}
+	}
+	for _, poolNode := range poolNodes {
+		if _, ok := routePools[poolNode.Name]; ok {
+			updatedPools = append(updatedPools, poolNode)
+		}
+	}
+	vsNode.SetPoolRefs(updatedPools)
+
+	if len(pgPool.PoolGroup) == 0 {
+		akogatewayapiobjects.GatewayApiLister().DeleteGatewayRouteToHTTPSPGPool(gwRouteNsName)
+	} else {
+		akogatewayapiobjects.GatewayApiLister().UpdateGatewayRouteToHTTPPSPGPool(gwRouteNsName, pgPool)
+	}
+	utils.AviLog.Infof("key: %s, msg: poolgroups %v attached to vs %s for %s", key, pgPool.PoolGroup, vsNode.GetName(), gwRouteNsName)
+}
+
+func buildL4RoutePool(key, parentNsName string, vsNode nodes.AviVsEvhSniModel, routeModel RouteModel, rule *Rule, backend *HTTPBackend, poolName, protocol string) *nodes.AviPoolNode {
+	parentNs, _, parentName := lib.ExtractTypeNameNamespace(parentNsName)
+	svcObj, err := utils.GetInformers().ServiceInformer.Lister().Services(backend.Backend.Namespace).Get(backend.Backend.Name)
+	if err != nil {
+		utils.AviLog.Debugf("key: %s, msg: there was an error in retrieving the service", key)
+		return nil
+	}
+	poolNode := &nodes.AviPoolNode{
+		Name:       poolName,
+		Tenant:     vsNode.GetTenant(),
+		Protocol:   protocol,
+		PortName:   akogatewayapilib.FindPortName(backend.Backend.Name, backend.Backend.Namespace, backend.Backend.Port, key),
+		TargetPort: akogatewayapilib.FindTargetPort(backend.Backend.Name, backend.Backend.Namespace, backend.Backend.Port, key),
+		Port:       backend.Backend.Port,
+		ServiceMetadata: lib.ServiceMetadataObj{
+			NamespaceServiceName: []string{backend.Backend.Namespace + "/" + backend.Backend.Name},
+		},
+		VrfContext: lib.GetVrf(),
+	}
+	poolNode.AviMarkers = utils.AviObjectMarkers{
+		GatewayName:        parentName,
+		GatewayNamespace:   parentNs,
+		HTTPRouteName:      routeModel.GetName(),
+		HTTPRouteNamespace: routeModel.GetNamespace(),
+		BackendNs:          backend.Backend.Namespace,
+		BackendName:        backend.Backend.Name,
+	}
+	if rule.Name != "" {
+		poolNode.AviMarkers.HTTPRouteRuleName = rule.Name
+	}
+
+	t1LR := lib.GetT1LRPath()
+	if found, infraSettingName := akogatewayapiobjects.GatewayApiLister().GetGatewayToAviInfraSetting(parentNsName); found {
+		if infraSetting, err := akogatewayapilib.AKOControlConfig().AviInfraSettingInformer().Lister().Get(infraSettingName); err != nil {
+			utils.AviLog.Warnf("key: %s, msg: failed to retrieve AviInfraSetting %s, err: %s", key, infraSettingName, err.Error())
+		} else if infraSetting != nil && infraSetting.Status.Status == lib.StatusAccepted && infraSetting.Spec.NSXSettings.T1LR != nil {
+			t1LR = *infraSetting.Spec.NSXSettings.T1LR
+		}
+	}
+	if t1LR != "" {
+		poolNode.T1Lr = t1LR
+		poolNode.VrfContext = ""
+		utils.AviLog.Infof("key: %s, msg: setting t1LR: %s for pool node.", key, t1LR)
+	}
+	poolNode.NetworkPlacementSettings = lib.GetNodeNetworkMap()
+	nodes.PopulateL4PoolServers(poolNode, svcObj, key)
+	return poolNode
+}
+
+// BuildTLSPassthroughDataScript builds the SNI based poolgroup selection datascript from the
+// poolgroups of the passthrough VS. When a hostname is claimed by more than one TLSRoute,
+// the poolgroup added first to the passthrough VS is selected.
+func BuildTLSPassthroughDataScript(key, parentNsName string, passChildVS *nodes.AviVsNode) {
+	parentNs, _, parentName := lib.ExtractTypeNameNamespace(parentNsName)
+	dsName := akogatewayapilib.GetPassthroughDataScriptName(parentNs, parentName)
+	hostToPG := make(map[string]string)
+	var pgNames []string
+	var sniPGMap strings.Builder
+	for _, pgNode := range passChildVS.PoolGroupRefs {
+		if len(pgNode.AviMarkers.Host) != 1 {
+			continue
+		}
+		host := pgNode.AviMarkers.Host[0]
+		if pgName, ok := hostToPG[host]; ok {
+			utils.AviLog.Warnf("key: %s, msg: hostname %s is already served by poolgroup %s, ignoring poolgroup %s", key, host, pgName, pgNode.Name)
+			continue
+		}
+		hostToPG[host] = pgNode.Name
+		pgNames = append(pgNames, pgNode.Name)
+		fmt.Fprintf(&sniPGMap, "      [%q] = %q,\n", host, pgNode.Name)
+	}
+	// only the passthrough datascript is replaced or removed, other datascripts of the VS are retained
+	dsIndex := slices.IndexFunc(passChildVS.HTTPDSrefs, func(ds *nodes.AviHTTPDataScriptNode) bool {
+		return ds.Name == dsName
+	})
+	if len(pgNames) == 0 {
+		if dsIndex != -1 {
+			passChildVS.HTTPDSrefs = slices.Delete(passChildVS.HTTPDSrefs, dsIndex, dsIndex+1)
+		}
+		return
+	}
+	dsNode := &nodes.AviHTTPDataScriptNode{
+		Name:          dsName,
+		Tenant:        passChildVS.Tenant,
+		PoolGroupRefs: pgNames,
+		DataScript: &nodes.DataScript{
+			Script: fmt.Sprintf(akogatewayapilib.TLSPassthroughDatascript, sniPGMap.String()),
+			Evt:    "VS_DATASCRIPT_EVT_L4_REQUEST",
+		},
+		ProtocolParsers: []string{"/api/protocolparser/?name=Default-TLS"},
+	}
+	if dsIndex != -1 {
+		passChildVS.HTTPDSrefs[dsIndex] = dsNode
+	} else {
+		passChildVS.HTTPDSrefs = append(passChildVS.HTTPDSrefs, dsNode)
+	}
+	utils.AviLog.Debugf("key: %s, msg: passthrough datascript %s built for vs %s with poolgroups %v", key, dsNode.Name, passChildVS.Name, pgNames)
 }
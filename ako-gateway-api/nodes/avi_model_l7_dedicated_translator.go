@@ -36,6 +36,9 @@ func (o *AviObjectGraph) ProcessL7RoutesForDedicatedGateway(key string, routeMod
 	// Update metadata to include HTTPRoute information
 	if dedicatedVS.ServiceMetadata.HTTPRoute == "" {
 		dedicatedVS.ServiceMetadata.HTTPRoute = routeModel.GetNamespace() + "/" + routeModel.GetName()
+		if routeModel.GetType() != lib.HTTPRoute {
+			dedicatedVS.ServiceMetadata.RouteType = routeModel.GetType()
+		}
 	}
 
 	// Update AVI markers for the HTTPRoute
@@ -74,7 +77,7 @@ func (o *AviObjectGraph) ProcessL7RoutesForDedicatedGateway(key string, routeMod
 // BuildHTTPPolicySetsForDedicatedMode creates HTTP PolicySets for all HTTPRoute rules in dedicated mode
 func (o *AviObjectGraph) BuildHTTPPolicySetsForDedicatedMode(key string, vsNode *nodes.AviEvhVsNode, routeModel RouteModel, httpRouteRules []*Rule, ruleToPoolGroupIndex map[*Rule]int) {
 	// Create HTTP PolicySet name for the entire HTTPRoute with encoding
-	httpPSName := akogatewayapilib.GetHttpPolicySetName(vsNode.AviMarkers.GatewayNamespace, vsNode.AviMarkers.GatewayName, routeModel.GetNamespace(), routeModel.GetName())
+	httpPSName := akogatewayapilib.GetHttpPolicySetName(vsNode.AviMarkers.GatewayNamespace, vsNode.AviMarkers.GatewayName, routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()))
 
 	// Check if policy already exists, if so, reuse it
 	var policy *nodes.AviHttpPolicySetNode
@@ -116,7 +119,8 @@ func (o *AviObjectGraph) BuildHTTPPolicySetsForDedicatedMode(key string, vsNode
 	var allMatches []MatchWithMetadata
 	for ruleIndex, rule := range httpRouteRules {
 		for matchIndex, match := range rule.Matches {
-			if match.PathMatch.Path == "/" {
+			// a root path restricted to a method or query params does not match all the requests
+			if match.PathMatch.Path == "/" && match.MethodMatch == "" && len(match.QueryParamMatch) == 0 {
 				rootPathPresent = true
 			}
 			allMatches = append(allMatches, MatchWithMetadata{
@@ -146,6 +150,17 @@ func (o *AviObjectGraph) BuildHTTPPolicySetsForDedicatedMode(key string, vsNode
 			return len(pathI) > len(pathJ)
 		}
 
+		// for paths of the same type and length, prefer method, then more header and query param matches
+		if (allMatches[i].Match.MethodMatch != "") != (allMatches[j].Match.MethodMatch != "") {
+			return allMatches[i].Match.MethodMatch != ""
+		}
+		if len(allMatches[i].Match.HeaderMatch) != len(allMatches[j].Match.HeaderMatch) {
+			return len(allMatches[i].Match.HeaderMatch) > len(allMatches[j].Match.HeaderMatch)
+		}
+		if len(allMatches[i].Match.QueryParamMatch) != len(allMatches[j].Match.QueryParamMatch) {
+			return len(allMatches[i].Match.QueryParamMatch) > len(allMatches[j].Match.QueryParamMatch)
+		}
+
 		// maintain rule index
 		if allMatches[i].RuleIndex != allMatches[j].RuleIndex {
 			return allMatches[i].RuleIndex < allMatches[j].RuleIndex
@@ -421,6 +436,15 @@ func (o *AviObjectGraph) BuildMatchTarget(matchTarget *models.MatchTarget, match
 		}
 	}
 
+	// Handle query param matching
+	if len(match.QueryParamMatch) > 0 {
+		matchTarget.Query = o.buildQueryMatch(match.QueryParamMatch, tenant, vsNode)
+	}
+
+	// Handle method matching
+	if match.MethodMatch != "" {
+		matchTarget.Method = buildMethodMatch(match.MethodMatch)
+	}
 }
 
 // BuildResponseMatchTarget builds the ResponseMatchTarget for HTTP response rules
@@ -462,6 +486,28 @@ func (o *AviObjectGraph) BuildResponseMatchTarget(responseMatchTarget *models.Re
 			responseMatchTarget.Hdrs = append(responseMatchTarget.Hdrs, hdrMatch)
 		}
 	}
+
+	// Query param matching (applicable to response - matches the request query)
+	if len(match.QueryParamMatch) > 0 {
+		responseMatchTarget.Query = o.buildQueryMatch(match.QueryParamMatch, tenant, vsNode)
+	}
+
+	// Method matching (applicable to response - matches the request method)
+	if match.MethodMatch != "" {
+		responseMatchTarget.Method = buildMethodMatch(match.MethodMatch)
+	}
+}
+
+// buildQueryMatch builds the regex QueryMatch for the query params, with the regex attached through a string group
+func (o *AviObjectGraph) buildQueryMatch(queryParams []*QueryParamMatch, tenant string, vsNode *nodes.AviEvhVsNode) *models.QueryMatch {
+	queryRegex := buildQueryParamMatchRegex(queryParams)
+	regexStringGroupName := lib.GetEncodedStringGroupName("", queryRegex)
+	o.addStringGroup(regexStringGroupName, queryRegex, tenant, vsNode)
+	return &models.QueryMatch{
+		MatchCase:       proto.String("SENSITIVE"),
+		MatchCriteria:   proto.String("QUERY_MATCH_REGEX_MATCH"),
+		StringGroupRefs: []string{"/api/stringgroup?name=" + regexStringGroupName},
+	}
 }
 
 // addStringGroup checks if a string group already exists in vsNode and adds it only if it doesn't exist
@@ -494,11 +540,11 @@ func (o *AviObjectGraph) addStringGroup(stringGroupName, pathPattern, tenant str
 func (o *AviObjectGraph) GetPoolGroupNameForRule(routeModel RouteModel, rule *Rule, ruleIndex int, gatewayNamespace, gatewayName string) string {
 	if rule.Name == "" {
 		return akogatewayapilib.GetPoolGroupName(gatewayNamespace, gatewayName,
-			routeModel.GetNamespace(), routeModel.GetName(),
+			routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()),
 			utils.Stringify(rule.Matches))
 	} else {
 		return akogatewayapilib.GetPoolGroupName(gatewayNamespace, gatewayName,
-			routeModel.GetNamespace(), routeModel.GetName(),
+			routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()),
 			rule.Name)
 	}
 }
@@ -564,6 +610,9 @@ func (o *AviObjectGraph) BuildPGPoolForDedicatedMode(key string, vsNode *nodes.A
 				NamespaceServiceName: []string{backend.Namespace + "/" + backend.Name},
 			},
 		}
+		if routeModel.GetType() == lib.GRPCRoute {
+			poolNode.EnableHttp2 = proto.Bool(true)
+		}
 
 		// Set pool markers
 		poolNode.AviMarkers = utils.AviObjectMarkers{
@@ -622,7 +671,9 @@ func (o *AviObjectGraph) BuildPGPoolForDedicatedMode(key string, vsNode *nodes.A
 			ratio = uint32(backend.Weight)
 		}
 
+		applyRuleTimeoutsAndRetry(key, poolNode, rule)
 		buildPoolWithBackendExtensionRefs(key, poolNode, routeModel.GetNamespace(), httpbackend)
+		applyBackendTLSPolicy(key, poolNode, httpbackend)
 		if vsNode.CheckPoolNChecksum(poolNode.Name, poolNode.GetCheckSum()) {
 			// Replace the poolNode.
 			vsNode.ReplaceEvhPoolInEVHNode(poolNode, key)
@@ -17,6 +17,7 @@ package nodes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -84,6 +85,9 @@ func (o *AviObjectGraph) ProcessL7Routes(key string, routeModel RouteModel, pare
 	defer o.Lock.Unlock()
 	httpRouteConfig := routeModel.ParseRouteConfig(key)
 	httpRouteRules := httpRouteConfig.Rules
+	if routeModel.GetType() == lib.GRPCRoute {
+		o.enableHTTP2OnListenerPorts(key, parentNsName, routeModel)
+	}
 	if o.GetAviEvhVS()[0].Dedicated {
 		o.ProcessL7RoutesForDedicatedGateway(key, routeModel, httpRouteRules, parentNsName, childVSes, fullsync)
 		return
@@ -97,11 +101,27 @@ func (o *AviObjectGraph) ProcessL7Routes(key string, routeModel RouteModel, pare
 	}
 }
 
+// enableHTTP2OnListenerPorts enables HTTP/2 on the parent VS ports of the listeners
+// a GRPCRoute is attached to, as gRPC requires HTTP/2 between the client and the VS.
+func (o *AviObjectGraph) enableHTTP2OnListenerPorts(key, parentNsName string, routeModel RouteModel) {
+	routeTypeNsName := routeModel.GetType() + "/" + routeModel.GetNamespace() + "/" + routeModel.GetName()
+	listeners := akogatewayapiobjects.GatewayApiLister().GetRouteToGatewayListener(routeTypeNsName, parentNsName)
+	parentNode := o.GetAviEvhVS()[0]
+	for i := range parentNode.PortProto {
+		for _, listener := range listeners {
+			if parentNode.PortProto[i].Port == listener.Port && !parentNode.PortProto[i].EnableHTTP2 {
+				utils.AviLog.Debugf("key: %s, msg: enabling HTTP/2 on port %d of parent vs %s for route %s", key, listener.Port, parentNode.Name, routeTypeNsName)
+				parentNode.PortProto[i].EnableHTTP2 = true
+			}
+		}
+	}
+}
+
 func (o *AviObjectGraph) BuildChildVS(key string, routeModel RouteModel, parentNsName string, rule *Rule, childVSes map[string]struct{}, fullsync bool) {
 
 	parentNode := o.GetAviEvhVS()
 	parentNs, _, parentName := lib.ExtractTypeNameNamespace(parentNsName)
-	routeTypeNsName := lib.HTTPRoute + "/" + routeModel.GetNamespace() + "/" + routeModel.GetName()
+	routeTypeNsName := routeModel.GetType() + "/" + routeModel.GetNamespace() + "/" + routeModel.GetName()
 
 	gwRouteNsName := fmt.Sprintf("%s/%s", parentNsName, routeTypeNsName)
 	found, hosts := akogatewayapiobjects.GatewayApiLister().GetGatewayRouteToHostname(gwRouteNsName)
@@ -111,9 +131,9 @@ func (o *AviObjectGraph) BuildChildVS(key string, routeModel RouteModel, parentN
 	}
 	var childVSName string
 	if rule.Name == "" {
-		childVSName = akogatewayapilib.GetChildName(parentNs, parentName, routeModel.GetNamespace(), routeModel.GetName(), utils.Stringify(rule.Matches))
+		childVSName = akogatewayapilib.GetChildName(parentNs, parentName, routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()), utils.Stringify(rule.Matches))
 	} else {
-		childVSName = akogatewayapilib.GetChildName(parentNs, parentName, routeModel.GetNamespace(), routeModel.GetName(), rule.Name)
+		childVSName = akogatewayapilib.GetChildName(parentNs, parentName, routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()), rule.Name)
 	}
 	childVSes[childVSName] = struct{}{}
 
@@ -135,6 +155,9 @@ func (o *AviObjectGraph) BuildChildVS(key string, routeModel RouteModel, parentN
 		HTTPRoute:         routeModel.GetNamespace() + "/" + routeModel.GetName(),
 		HTTPRouteRuleName: ruleName,
 	}
+	if routeModel.GetType() != lib.HTTPRoute {
+		childNode.ServiceMetadata.RouteType = routeModel.GetType()
+	}
 
 	childNode.ApplicationProfile = utils.DEFAULT_L7_APP_PROFILE
 	childNode.ServiceEngineGroup = lib.GetSEGName()
@@ -166,6 +189,8 @@ func (o *AviObjectGraph) BuildChildVS(key string, routeModel RouteModel, parentN
 	o.BuildHTTPPolicySet(key, childNode, routeModel, rule, 0, childVSName)
 	// Apply Extension Ref
 	o.ApplyRuleExtensionRefs(key, childNode, routeModel, rule)
+	// create the traffic clone profile if the RequestMirror filter is present
+	o.BuildTrafficCloneProfile(key, parentNsName, childNode, routeModel, rule)
 
 	foundEvhModel := nodes.FindAndReplaceEvhInModel(childNode, parentNode, key)
 	if !foundEvhModel {
@@ -240,6 +265,91 @@ func parseGatewayDurationToMinutes(key string, gwDuration *gatewayv1.Duration) *
 	return &minutes
 }
 
+// Avi specific: server_timeout of a Pool is allowed in 0-21600000 milliseconds and the retry_timeout
+// of the server reselect in 0-3600000 milliseconds.
+const (
+	maxPoolServerTimeout = 6 * time.Hour
+	maxPoolRetryTimeout  = time.Hour
+)
+
+// parseGatewayDurationToMilliseconds converts Gateway API Duration string to milliseconds.
+// Returns nil if duration is nil.
+func parseGatewayDurationToMilliseconds(gwDuration *gatewayv1.Duration) (*uint32, error) {
+	if gwDuration == nil {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(string(*gwDuration))
+	if err != nil {
+		return nil, err
+	}
+	if d < 0 {
+		return nil, fmt.Errorf("negative duration %s is not supported", *gwDuration)
+	}
+	if d > maxPoolServerTimeout {
+		return nil, fmt.Errorf("duration %s exceeds the maximum supported value of %s", *gwDuration, maxPoolServerTimeout)
+	}
+	milliseconds := uint32(d.Milliseconds())
+	return &milliseconds, nil
+}
+
+// getRuleBackendTimeout returns the timeout in milliseconds for a single request to a backend of the rule.
+// The backendRequest timeout is used if set to a non zero value, else the request timeout, as a single
+// request can not take longer than the request timeout either.
+func getRuleBackendTimeout(key string, timeouts *gatewayv1.HTTPRouteTimeouts) *uint32 {
+	if timeouts == nil {
+		return nil
+	}
+	backendRequest, err := parseGatewayDurationToMilliseconds(timeouts.BackendRequest)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: invalid backendRequest timeout, err: %v", key, err)
+	}
+	if backendRequest != nil && *backendRequest != 0 {
+		return backendRequest
+	}
+	request, err := parseGatewayDurationToMilliseconds(timeouts.Request)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: invalid request timeout, err: %v", key, err)
+	}
+	if request != nil {
+		return request
+	}
+	return backendRequest
+}
+
+// applyRuleTimeoutsAndRetry sets the timeouts and the retry policy of the rule on a pool of the rule.
+// The backend timeout is set as the server timeout of the pool, and the retries are done by the
+// server reselect of the pool for the configured response codes. Avi reselects another server on
+// connection failures irrespective of the server reselect settings.
+func applyRuleTimeoutsAndRetry(key string, poolNode *nodes.AviPoolNode, rule *Rule) {
+	serverTimeout := getRuleBackendTimeout(key, rule.Timeouts)
+	poolNode.ServerTimeout = serverTimeout
+	if rule.Retry == nil {
+		return
+	}
+	serverReselect := &models.HttpserverReselect{
+		Enabled: proto.Bool(true),
+	}
+	if rule.Retry.Attempts != nil {
+		if *rule.Retry.Attempts == 0 {
+			poolNode.ServerReselect = &models.HttpserverReselect{Enabled: proto.Bool(false)}
+			return
+		}
+		serverReselect.NumRetries = proto.Uint32(uint32(*rule.Retry.Attempts))
+	}
+	if len(rule.Retry.Codes) > 0 {
+		codes := make([]int64, 0, len(rule.Retry.Codes))
+		for _, code := range rule.Retry.Codes {
+			codes = append(codes, int64(code))
+		}
+		slices.Sort(codes)
+		serverReselect.SvrRespCode = &models.HTTPReselectRespCode{Codes: codes}
+	}
+	if serverTimeout != nil && time.Duration(*serverTimeout)*time.Millisecond <= maxPoolRetryTimeout {
+		serverReselect.RetryTimeout = serverTimeout
+	}
+	poolNode.ServerReselect = serverReselect
+}
+
 func (o *AviObjectGraph) BuildApplicationPersistenceProfile(key string, rule *Rule, routeModel RouteModel, parentNs, parentName string, markers utils.AviObjectMarkers) *nodes.AviApplicationPersistenceProfileNode {
 	sp := rule.SessionPersistence
 	persistProfileNode := &nodes.AviApplicationPersistenceProfileNode{
@@ -273,11 +383,11 @@ func (o *AviObjectGraph) BuildApplicationPersistenceProfile(key string, rule *Ru
 	var appPersistProfileName string
 	if rule.Name == "" {
 		appPersistProfileName = akogatewayapilib.GetPersistenceProfileName(parentNs, parentName,
-			routeModel.GetNamespace(), routeModel.GetName(),
+			routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()),
 			utils.Stringify(rule.Matches), persistProfileNode.PersistenceType)
 	} else {
 		appPersistProfileName = akogatewayapilib.GetPersistenceProfileName(parentNs, parentName,
-			routeModel.GetNamespace(), routeModel.GetName(),
+			routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()),
 			rule.Name, persistProfileNode.PersistenceType)
 	}
 	persistProfileNode.Name = appPersistProfileName
@@ -383,13 +493,54 @@ func buildPoolWithBackendExtensionRefs(key string, poolNode *nodes.AviPoolNode,
 	}
 }
 
+// applyBackendTLSPolicy sets the TLS settings of the BackendTLSPolicy, attached to the Service of the backend,
+// on the pool. The TLS settings of a RouteBackendExtension on the backend take precedence over the BackendTLSPolicy.
+func applyBackendTLSPolicy(key string, poolNode *nodes.AviPoolNode, backend *HTTPBackend) {
+	policies := akogatewayapilib.GetBackendTLSPoliciesForService(backend.Backend.Namespace, backend.Backend.Name)
+	if len(policies) == 0 {
+		return
+	}
+	policy := policies[0]
+	if poolNode.SslProfileRef != nil {
+		utils.AviLog.Warnf("key: %s, msg: TLS settings of RouteBackendExtension are used for pool %s instead of BackendTLSPolicy %s/%s", key, poolNode.Name, policy.Namespace, policy.Name)
+		return
+	}
+	caCert, err := akogatewayapilib.GetBackendTLSPolicyCACert(key, policy)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: BackendTLSPolicy %s/%s is not applied on pool %s, err: %v", key, policy.Namespace, policy.Name, poolNode.Name, err)
+		return
+	}
+	hostname := string(policy.Spec.Validation.Hostname)
+	poolNode.SniEnabled = true
+	poolNode.SslProfileRef = proto.String(fmt.Sprintf("/api/sslprofile?name=%s", lib.DefaultPoolSSLProfile))
+	poolNode.ServerName = &hostname
+	poolNode.HostCheckEnabled = proto.Bool(true)
+	// the hostname is used to authenticate the server only when no subjectAltNames are specified
+	var domainNames []string
+	for _, san := range policy.Spec.Validation.SubjectAltNames {
+		domainNames = append(domainNames, string(san.Hostname))
+	}
+	if len(domainNames) == 0 {
+		domainNames = []string{hostname}
+	}
+	poolNode.DomainName = domainNames
+	pkiProfile := &nodes.AviPkiProfileNode{
+		Name:       lib.GetPoolPKIProfileName(poolNode.Name),
+		Tenant:     poolNode.Tenant,
+		CACert:     caCert,
+		AviMarkers: poolNode.AviMarkers,
+	}
+	poolNode.PkiProfile = pkiProfile
+	utils.AviLog.Infof("key: %s, msg: applied BackendTLSPolicy %s/%s on pool %s", key, policy.Namespace, policy.Name, poolNode.Name)
+}
+
 func (o *AviObjectGraph) BuildPGPool(key, parentNsName string, childVsNode *nodes.AviEvhVsNode, routeModel RouteModel, rule *Rule) {
 	//reset pool, poolgroupreferences
 	childVsNode.PoolGroupRefs = nil
 	childVsNode.DefaultPoolGroup = ""
 	childVsNode.PoolRefs = nil
 	// create the PG from backends
-	routeTypeNsName := lib.HTTPRoute + "/" + routeModel.GetNamespace() + "/" + routeModel.GetName()
+	routeTypeNsName := routeModel.GetType() + "/" + routeModel.GetNamespace() + "/" + routeModel.GetName()
 	parentNs, _, parentName := lib.ExtractTypeNameNamespace(parentNsName)
 	listeners := akogatewayapiobjects.GatewayApiLister().GetRouteToGatewayListener(routeTypeNsName, parentNsName)
 	if len(listeners) == 0 {
@@ -401,11 +552,11 @@ func (o *AviObjectGraph) BuildPGPool(key, parentNsName string, childVsNode *node
 	var PGName string
 	if rule.Name == "" {
 		PGName = akogatewayapilib.GetPoolGroupName(parentNs, parentName,
-			routeModel.GetNamespace(), routeModel.GetName(),
+			routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()),
 			utils.Stringify(rule.Matches))
 	} else {
 		PGName = akogatewayapilib.GetPoolGroupName(parentNs, parentName,
-			routeModel.GetNamespace(), routeModel.GetName(),
+			routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()),
 			rule.Name)
 	}
 	PG := &nodes.AviPoolGroupNode{
@@ -432,12 +583,12 @@ func (o *AviObjectGraph) BuildPGPool(key, parentNsName string, childVsNode *node
 		var poolName string
 		if rule.Name == "" {
 			poolName = akogatewayapilib.GetPoolName(parentNs, parentName,
-				routeModel.GetNamespace(), routeModel.GetName(),
+				routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()),
 				utils.Stringify(rule.Matches),
 				httpbackend.Backend.Namespace, httpbackend.Backend.Name, strconv.Itoa(int(httpbackend.Backend.Port)))
 		} else {
 			poolName = akogatewayapilib.GetPoolName(parentNs, parentName,
-				routeModel.GetNamespace(), routeModel.GetName(),
+				routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()),
 				rule.Name,
 				httpbackend.Backend.Namespace, httpbackend.Backend.Name, strconv.Itoa(int(httpbackend.Backend.Port)))
 		}
@@ -459,6 +610,9 @@ func (o *AviObjectGraph) BuildPGPool(key, parentNsName string, childVsNode *node
 			},
 			VrfContext: lib.GetVrf(),
 		}
+		if routeModel.GetType() == lib.GRPCRoute {
+			poolNode.EnableHttp2 = proto.Bool(true)
+		}
 		poolNode.AviMarkers = utils.AviObjectMarkers{
 			GatewayName:        parentName,
 			GatewayNamespace:   parentNs,
@@ -493,24 +647,13 @@ func (o *AviObjectGraph) BuildPGPool(key, parentNsName string, childVsNode *node
 			utils.AviLog.Infof("key: %s, msg: setting t1LR: %s for pool node.", key, t1LR)
 		}
 		poolNode.NetworkPlacementSettings = lib.GetNodeNetworkMap()
-		serviceType := lib.GetServiceType()
-		if serviceType == lib.NodePortLocal {
-			servers := nodes.PopulateServersForNPL(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key)
-			if servers != nil {
-				poolNode.Servers = servers
-			}
-		} else if serviceType == lib.NodePort {
-			servers := nodes.PopulateServersForNodePort(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key)
-			if servers != nil {
-				poolNode.Servers = servers
-			}
-		} else {
-			servers := nodes.PopulateServers(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key)
-			if servers != nil {
-				poolNode.Servers = servers
-			}
+		servers := populateServersForService(key, poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name)
+		if servers != nil {
+			poolNode.Servers = servers
 		}
+		applyRuleTimeoutsAndRetry(key, poolNode, rule)
 		buildPoolWithBackendExtensionRefs(key, poolNode, routeModel.GetNamespace(), httpbackend)
+		applyBackendTLSPolicy(key, poolNode, httpbackend)
 		if childVsNode.CheckPoolNChecksum(poolNode.Name, poolNode.GetCheckSum()) {
 			// Replace the poolNode.
 			childVsNode.ReplaceEvhPoolInEVHNode(poolNode, key)
@@ -526,6 +669,89 @@ func (o *AviObjectGraph) BuildPGPool(key, parentNsName string, childVsNode *node
 	}
 }
 
+// populateServersForService returns the servers of the service, based on the service type AKO is running with.
+func populateServersForService(key string, poolNode *nodes.AviPoolNode, namespace, name string) []nodes.AviPoolMetaServer {
+	switch lib.GetServiceType() {
+	case lib.NodePortLocal:
+		return nodes.PopulateServersForNPL(poolNode, namespace, name, false, key)
+	case lib.NodePort:
+		return nodes.PopulateServersForNodePort(poolNode, namespace, name, false, key)
+	default:
+		return nodes.PopulateServers(poolNode, namespace, name, false, key)
+	}
+}
+
+// BuildTrafficCloneProfile translates the RequestMirror filters of the rule to a traffic clone profile
+// of the child VS. The endpoint IP addresses of the mirror backends are used as the clone servers, to which
+// the traffic of the child VS is cloned at L2, hence the port of the backend is not used. RequestMirror is
+// rejected with NodePort and NodePortLocal, where the endpoints are reached on the node ports.
+// The traffic clone profile takes precedence over the one set by L7Rule.
+func (o *AviObjectGraph) BuildTrafficCloneProfile(key, parentNsName string, childVsNode *nodes.AviEvhVsNode, routeModel RouteModel, rule *Rule) {
+	parentNs, _, parentName := lib.ExtractTypeNameNamespace(parentNsName)
+	matchName := rule.Name
+	if matchName == "" {
+		matchName = utils.Stringify(rule.Matches)
+	}
+	trafficCloneName := akogatewayapilib.GetTrafficCloneProfileName(parentNs, parentName, routeModel.GetNamespace(), routeModel.GetName(), matchName)
+	trafficCloneRef := fmt.Sprintf("/api/trafficcloneprofile?name=%s", trafficCloneName)
+
+	var cloneServers []models.IPAddr
+	cloneServerAddrs := sets.New[string]()
+	mirrorPresent := false
+	for _, filter := range rule.Filters {
+		if filter.MirrorFilter == nil {
+			continue
+		}
+		mirrorPresent = true
+		mirrorBackend := filter.MirrorFilter
+		poolNode := &nodes.AviPoolNode{
+			Port:       mirrorBackend.Port,
+			PortName:   akogatewayapilib.FindPortName(mirrorBackend.Name, mirrorBackend.Namespace, mirrorBackend.Port, key),
+			TargetPort: akogatewayapilib.FindTargetPort(mirrorBackend.Name, mirrorBackend.Namespace, mirrorBackend.Port, key),
+		}
+		for _, server := range nodes.PopulateServers(poolNode, mirrorBackend.Namespace, mirrorBackend.Name, false, key) {
+			if server.Ip.Addr == nil || cloneServerAddrs.Has(*server.Ip.Addr) {
+				continue
+			}
+			cloneServerAddrs.Insert(*server.Ip.Addr)
+			cloneServers = append(cloneServers, server.Ip)
+		}
+	}
+
+	if !mirrorPresent || len(cloneServers) == 0 {
+		if mirrorPresent {
+			utils.AviLog.Warnf("key: %s, msg: no endpoints found for the RequestMirror backends of child vs %s, traffic will not be mirrored", key, childVsNode.Name)
+		}
+		if childVsNode.TrafficCloneProfileRef != nil && *childVsNode.TrafficCloneProfileRef == trafficCloneRef {
+			childVsNode.TrafficCloneProfileRef = nil
+		}
+		childVsNode.TrafficCloneProfile = nil
+		return
+	}
+	if childVsNode.TrafficCloneProfileRef != nil && *childVsNode.TrafficCloneProfileRef != trafficCloneRef {
+		utils.AviLog.Warnf("key: %s, msg: RequestMirror filter overrides the traffic clone profile %s set on child vs %s", key, *childVsNode.TrafficCloneProfileRef, childVsNode.Name)
+	}
+	slices.SortFunc(cloneServers, func(a, b models.IPAddr) int {
+		return strings.Compare(*a.Addr, *b.Addr)
+	})
+
+	trafficCloneNode := &nodes.AviTrafficCloneProfileNode{
+		Name:         trafficCloneName,
+		Tenant:       childVsNode.Tenant,
+		CloneServers: cloneServers,
+		AviMarkers: utils.AviObjectMarkers{
+			GatewayName:        parentName,
+			GatewayNamespace:   parentNs,
+			HTTPRouteName:      routeModel.GetName(),
+			HTTPRouteNamespace: routeModel.GetNamespace(),
+			HTTPRouteRuleName:  rule.Name,
+		},
+	}
+	childVsNode.TrafficCloneProfile = trafficCloneNode
+	childVsNode.TrafficCloneProfileRef = &trafficCloneRef
+	utils.AviLog.Debugf("key: %s, msg: traffic clone profile %s attached to child vs %s", key, utils.Stringify(trafficCloneNode), childVsNode.Name)
+}
+
 func (o *AviObjectGraph) BuildVHMatch(key string, parentNsName string, routeTypeNsName string, vsNode *nodes.AviEvhVsNode, rule *Rule, hosts []string) {
 	var vhMatches []*models.VHMatch
 
@@ -572,6 +798,20 @@ func (o *AviObjectGraph) BuildVHMatch(key string, parentNsName string, routeType
 				rule.Matches.Hdrs = append(rule.Matches.Hdrs, hdrMatch)
 			}
 
+			// query param match
+			if len(match.QueryParamMatch) > 0 {
+				rule.Matches.Query = &models.QueryMatch{
+					MatchCase:     proto.String("SENSITIVE"),
+					MatchCriteria: proto.String("QUERY_MATCH_REGEX_MATCH"),
+					MatchStr:      []string{buildQueryParamMatchRegex(match.QueryParamMatch)},
+				}
+			}
+
+			// method match
+			if match.MethodMatch != "" {
+				rule.Matches.Method = buildMethodMatch(match.MethodMatch)
+			}
+
 			//port match from listener
 			matchCriteria := "IS_IN"
 			rule.Matches.VsPort = &models.PortMatch{
@@ -592,6 +832,29 @@ func (o *AviObjectGraph) BuildVHMatch(key string, parentNsName string, routeType
 	utils.AviLog.Infof("key: %s, msg: Attached match criteria to vs %s", key, vsNode.Name)
 }
 
+// buildQueryParamMatchRegex returns a regex matching the query strings that carry all the query params.
+// Avi matches the query string as a whole, so each query param is matched by a lookahead irrespective
+// of its position in the query string.
+func buildQueryParamMatchRegex(queryParams []*QueryParamMatch) string {
+	var regex strings.Builder
+	regex.WriteString("^")
+	for _, queryParam := range queryParams {
+		regex.WriteString("(?=(?:.*&)?")
+		regex.WriteString(regexp.QuoteMeta(queryParam.Name))
+		regex.WriteString("=")
+		regex.WriteString(regexp.QuoteMeta(queryParam.Value))
+		regex.WriteString("(?:&|$))")
+	}
+	return regex.String()
+}
+
+func buildMethodMatch(method string) *models.MethodMatch {
+	return &models.MethodMatch{
+		MatchCriteria: proto.String("IS_IN"),
+		Methods:       []string{SupportedMethodsOnHTTPRouteMatch[method]},
+	}
+}
+
 func (o *AviObjectGraph) BuildHTTPPolicySet(key string, vsNode *nodes.AviEvhVsNode, routeModel RouteModel, rule *Rule, index int, httpPSName string) {
 
 	if len(rule.Filters) == 0 {
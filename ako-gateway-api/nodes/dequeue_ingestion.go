@@ -16,20 +16,28 @@ package nodes
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
 	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/status"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 )
 
 func DequeueIngestion(key string, fullsync bool) {
 	utils.AviLog.Infof("key: %s, msg: starting graph Sync", key)
+	lib.ObserveIngestionQueueWait(key)
+	defer lib.ObserveSyncStageDuration(lib.GraphStage, time.Now())
 	objType, namespace, name := lib.ExtractTypeNameNamespace(key)
 
 	utils.AviLog.Infof("Key: %s, msg: objectType: %s, namespace: %s, name: %s", key, objType, namespace, name)
@@ -37,18 +45,37 @@ func DequeueIngestion(key string, fullsync bool) {
 	if !valid {
 		return
 	}
-	// For HTTPRoute updates, capture old gateways BEFORE schema.GetGateways updates the mapping
+	// For route updates, capture old gateways BEFORE schema.GetGateways updates the mapping
 	var oldGatewaysForCleanup []string
-	if objType == lib.HTTPRoute {
-		httpRoute, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().HTTPRouteInformer.Lister().HTTPRoutes(namespace).Get(name)
+	// Services of the route before the update, the BackendTLSPolicies of these Services lose the Gateways of the route
+	var oldRouteServices []string
+	if objType == lib.HTTPRoute || objType == lib.GRPCRoute {
+		_, oldRouteServices = akogatewayapiobjects.GatewayApiLister().GetRouteToService(key)
+		oldRouteServices = slices.Clone(oldRouteServices)
+	}
+	if objType == lib.HTTPRoute || objType == lib.GRPCRoute || objType == lib.TLSRoute || akogatewayapilib.IsL4Route(objType) {
+		route, err := getRouteObject(objType, namespace, name)
 		if err == nil {
-			utils.AviLog.Debugf("key: %s, msg: Successfully retrieved the HTTPRoute object %s", key, name)
-			if !IsHTTPRouteValid(key, httpRoute) {
+			utils.AviLog.Debugf("key: %s, msg: Successfully retrieved the %s object %s", key, objType, name)
+			isValid := false
+			switch routeObj := route.obj.(type) {
+			case *gatewayv1.HTTPRoute:
+				isValid = IsHTTPRouteValid(key, routeObj)
+			case *gatewayv1.GRPCRoute:
+				isValid = IsGRPCRouteValid(key, routeObj)
+			case *gatewayv1alpha2.TLSRoute:
+				isValid = IsTLSRouteValid(key, routeObj)
+			case *gatewayv1alpha2.TCPRoute:
+				isValid = IsTCPRouteValid(key, routeObj)
+			case *gatewayv1alpha2.UDPRoute:
+				isValid = IsUDPRouteValid(key, routeObj)
+			}
+			if !isValid {
 				return
 			}
-			// Get current gateways from the HTTPRoute spec
+			// Get current gateways from the route spec
 			var currentGateways []string
-			for _, parentRef := range httpRoute.Spec.ParentRefs {
+			for _, parentRef := range route.Spec.ParentRefs {
 				parentNs := namespace
 				if parentRef.Namespace != nil {
 					parentNs = string(*parentRef.Namespace)
@@ -58,7 +85,7 @@ func DequeueIngestion(key string, fullsync bool) {
 			}
 
 			// Get old gateways that need cleanup before the mapping is updated
-			oldGatewaysForCleanup = GetOldGatewaysForHTTPRouteCleanup(namespace, name, key, currentGateways)
+			oldGatewaysForCleanup = GetOldGatewaysForRouteCleanup(objType, namespace, name, key, currentGateways)
 		}
 	}
 
@@ -160,8 +187,10 @@ func DequeueIngestion(key string, fullsync bool) {
 			childVSes := make(map[string]struct{}, 0)
 
 			switch objType {
-			case lib.HTTPRoute:
+			case lib.HTTPRoute, lib.GRPCRoute:
 				model.ProcessL7Routes(key, routeModel, gatewayNsName, childVSes, fullsync)
+			case lib.TLSRoute, lib.TCPRoute, lib.UDPRoute:
+				model.ProcessL4Routes(key, routeModel, gatewayNsName)
 			default:
 				utils.AviLog.Warnf("key: %s, msg: route of type %s not supported", key, objType)
 				continue
@@ -180,8 +209,8 @@ func DequeueIngestion(key string, fullsync bool) {
 		}
 	}
 
-	// For HTTPRoute updates, process old gateways for cleanup only
-	if objType == lib.HTTPRoute {
+	// For route updates, process old gateways for cleanup only
+	if objType == lib.HTTPRoute || objType == lib.GRPCRoute || objType == lib.TLSRoute || akogatewayapilib.IsL4Route(objType) {
 		utils.AviLog.Infof("key: %s, msg: Checking for old gateways to cleanup. Current gateways: %v, Old gateways: %v", key, gatewayNsNameList, oldGatewaysForCleanup)
 		if len(oldGatewaysForCleanup) > 0 {
 			utils.AviLog.Infof("key: %s, msg: Processing old gateways for cleanup: %v", key, oldGatewaysForCleanup)
@@ -200,11 +229,11 @@ func DequeueIngestion(key string, fullsync bool) {
 				}
 
 				model := &AviObjectGraph{modelIntf.(*nodes.AviObjectGraph)}
-				routeTypeNsName := lib.HTTPRoute + "/" + namespace + "/" + name
+				routeTypeNsName := objType + "/" + namespace + "/" + name
 				utils.AviLog.Infof("key: %s, msg: cleaning up route %s from old gateway %s", key, routeTypeNsName, oldGatewayNsName)
 
 				// Process deletion for this route on the old gateway
-				routeModel, err := NewRouteModel(key, lib.HTTPRoute, name, namespace)
+				routeModel, err := NewRouteModel(key, objType, name, namespace)
 				if err != nil {
 					utils.AviLog.Warnf("key: %s, msg: error getting route model for cleanup: %v", key, err)
 					continue
@@ -221,10 +250,40 @@ func DequeueIngestion(key string, fullsync bool) {
 		}
 	}
 
+	if objType == utils.Service {
+		updateBackendTLSPolicyStatus(key, []string{namespace + "/" + name})
+	} else if objType == lib.HTTPRoute || objType == lib.GRPCRoute {
+		_, routeServices := akogatewayapiobjects.GatewayApiLister().GetRouteToService(key)
+		updateBackendTLSPolicyStatus(key, append(oldRouteServices, routeServices...))
+	}
+
 	utils.AviLog.Infof("key: %s, msg: finished graph Sync", key)
 }
+
+// updateBackendTLSPolicyStatus publishes the BackendTLSPolicies targeting the Services to the status layer, which
+// updates their status with the Gateways of the Routes using the Services as backends as the ancestors.
+func updateBackendTLSPolicyStatus(key string, svcNsNames []string) {
+	processed := sets.New[string]()
+	for _, svcNsName := range svcNsNames {
+		svcNs, svcName := utils.ExtractNamespaceObjectName(svcNsName)
+		for _, policy := range akogatewayapilib.GetBackendTLSPoliciesForService(svcNs, svcName) {
+			if processed.Has(policy.Name) {
+				continue
+			}
+			processed.Insert(policy.Name)
+			status.PublishToStatusQueue(policy.Namespace+"/"+policy.Name, status.StatusOptions{
+				ObjType:   lib.BackendTLSPolicy,
+				Op:        lib.UpdateStatus,
+				Namespace: policy.Namespace,
+				ObjName:   policy.Name,
+				Key:       key,
+			})
+		}
+	}
+}
+
 func handleSecrets(gatewayNamespace string, gatewayName string, key string, object *AviObjectGraph) bool {
-	_, _, secretName := lib.ExtractTypeNameNamespace(key)
+	_, secretNamespace, secretName := lib.ExtractTypeNameNamespace(key)
 	utils.AviLog.Infof("key: %s, msg: Processing secret update %s has been added.", key, secretName)
 	cs := utils.GetInformers().ClientSet
 	gatewayObj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GatewayInformer.Lister().Gateways(gatewayNamespace).Get(gatewayName)
@@ -232,7 +291,14 @@ func handleSecrets(gatewayNamespace string, gatewayName string, key string, obje
 		utils.AviLog.Errorf("key: %s, msg: unable to get the gateway object. err: %s", key, err)
 		return false
 	}
-	secretObj, err := cs.CoreV1().Secrets(gatewayNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	// a secret in another namespace, not permitted by a ReferenceGrant, is handled as a deleted secret
+	from := akogatewayapiobjects.ReferenceGrantFrom{Group: akogatewayapilib.GatewayGroup, Kind: lib.Gateway, Namespace: gatewayNamespace}
+	to := akogatewayapiobjects.ReferenceGrantTo{Kind: utils.Secret, Name: secretName}
+	if !akogatewayapiobjects.GatewayApiLister().IsReferencePermitted(from, to, secretNamespace) {
+		utils.AviLog.Warnf("key: %s, msg: secret %s/%s is not permitted by any ReferenceGrant", key, secretNamespace, secretName)
+		return DeleteTLSNode(key, object, gatewayObj, nil)
+	}
+	secretObj, err := cs.CoreV1().Secrets(secretNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
 	if err != nil || secretObj == nil {
 		utils.AviLog.Warnf("key: %s, msg: secret %s has been deleted, err: %s", key, secretName, err)
 		vsToDelete := DeleteTLSNode(key, object, gatewayObj, secretObj)
@@ -379,6 +445,18 @@ func (o *AviObjectGraph) processRouteDeletionInternal(key, parentNsName string,
 				}
 			}
 		}
+		switch routeModel.GetType() {
+		case lib.TLSRoute:
+			if passChildVS := getPassthroughChild(parentNode[0]); passChildVS != nil {
+				removeL4RoutePGPool(key, parentNsName, routeTypeNsName, passChildVS)
+				BuildTLSPassthroughDataScript(key, parentNsName, passChildVS)
+			} else {
+				akogatewayapiobjects.GatewayApiLister().DeleteGatewayRouteToHTTPSPGPool(parentNsName + "/" + routeTypeNsName)
+			}
+		case lib.TCPRoute, lib.UDPRoute:
+			removeL4RoutePGPool(key, parentNsName, routeTypeNsName, parentNode[0])
+			BuildL4PolicySet(key, parentNsName, parentNode[0])
+		}
 	}
 	updateHostname(key, parentNsName, parentNode[0])
 }
@@ -393,7 +471,7 @@ func (o *AviObjectGraph) ProcessRouteDeletionForDedicatedMode(key, parentNsName
 
 	dedicatedVS := gatewayVSes[0]
 
-	httpPSName := akogatewayapilib.GetHttpPolicySetName(dedicatedVS.AviMarkers.GatewayNamespace, dedicatedVS.AviMarkers.GatewayName, routeModel.GetNamespace(), routeModel.GetName())
+	httpPSName := akogatewayapilib.GetHttpPolicySetName(dedicatedVS.AviMarkers.GatewayNamespace, dedicatedVS.AviMarkers.GatewayName, routeModel.GetNamespace(), akogatewayapilib.GetRouteNameForAviObjects(routeModel.GetType(), routeModel.GetName()))
 
 	var updatedHttpPolicyRefs []*nodes.AviHttpPolicySetNode
 	for _, policy := range dedicatedVS.HttpPolicyRefs {
@@ -434,3 +512,21 @@ func (o *AviObjectGraph) DeleteStaleChildVSes(key string, routeModel RouteModel,
 		}
 	}
 }
+
+// removeL4RoutePGPool removes the poolgroups and pools of a TLSRoute, TCPRoute or UDPRoute from the parent VS, or
+// from its passthrough VS for a TLSRoute.
+func removeL4RoutePGPool(key, parentNsName, routeTypeNsName string, vsNode nodes.AviVsEvhSniModel) {
+	gwRouteNsName := parentNsName + "/" + routeTypeNsName
+	found, pgPool := akogatewayapiobjects.GatewayApiLister().GetGatewayRouteToHTTPSPGPool(gwRouteNsName)
+	if !found {
+		return
+	}
+	utils.AviLog.Infof("key: %s, msg: poolgroups %v and pools %v retrieved for deletion", key, pgPool.PoolGroup, pgPool.Pool)
+	vsNode.SetPoolGroupRefs(slices.DeleteFunc(vsNode.GetPoolGroupRefs(), func(pg *nodes.AviPoolGroupNode) bool {
+		return utils.HasElem(pgPool.PoolGroup, pg.Name)
+	}))
+	vsNode.SetPoolRefs(slices.DeleteFunc(vsNode.GetPoolRefs(), func(pool *nodes.AviPoolNode) bool {
+		return utils.HasElem(pgPool.Pool, pool.Name)
+	}))
+	akogatewayapiobjects.GatewayApiLister().DeleteGatewayRouteToHTTPSPGPool(gwRouteNsName)
+}
@@ -102,7 +102,14 @@ func (o *AviObjectGraph) BuildGatewayParent(gateway *gatewayv1.Gateway, key stri
 		utils.AviLog.Infof("key: %s, msg: T1LR is %s.", key, t1LR)
 		parentVsNode.VrfContext = ""
 	}
-	parentVsNode.PortProto = BuildPortProtocols(gateway, key)
+	var passthroughPortProtocols []nodes.AviPortHostProtocol
+	for _, pp := range BuildPortProtocols(gateway, key) {
+		if pp.Passthrough {
+			passthroughPortProtocols = append(passthroughPortProtocols, pp)
+		} else {
+			parentVsNode.PortProto = append(parentVsNode.PortProto, pp)
+		}
+	}
 
 	tlsNodes := BuildTLSNodesForGateway(gateway, parentVsNode, key)
 	if len(tlsNodes) > 0 {
@@ -117,6 +124,7 @@ func (o *AviObjectGraph) BuildGatewayParent(gateway *gatewayv1.Gateway, key stri
 	}
 
 	buildWithInfraSettingForGateway(key, parentVsNode, vsvipNode, infraSetting)
+	buildPassthroughChildForGateway(key, gateway, parentVsNode, passthroughPortProtocols)
 
 	// Check for dedicated mode via Gateway annotation
 	if annotation, exists := gateway.GetAnnotations()[akogatewayapilib.DedicatedGatewayModeAnnotation]; exists && annotation == "true" {
@@ -142,8 +150,11 @@ func BuildPortProtocols(gateway *gatewayv1.Gateway, key string) []nodes.AviPortH
 			continue
 		}
 		pp := nodes.AviPortHostProtocol{Port: int32(listener.Port), Protocol: string(listener.Protocol)}
-		//TLS config on listener is present
-		if listener.TLS != nil && len(listener.TLS.CertificateRefs) > 0 {
+		if listener.Protocol == gatewayv1.TLSProtocolType {
+			// TLS is passthrough, the connection is terminated by the backends of the TLSRoutes
+			pp.Passthrough = true
+		} else if listener.TLS != nil && len(listener.TLS.CertificateRefs) > 0 {
+			//TLS config on listener is present
 			pp.EnableSSL = true
 		}
 		if !utils.HasElem(portProtocols, pp) {
@@ -163,7 +174,8 @@ func BuildTLSNodesForGateway(gateway *gatewayv1.Gateway, parentVsNode *nodes.Avi
 		if akogatewayapilib.IsListenerInvalid(gwStatus, i) {
 			continue
 		}
-		if listener.TLS != nil {
+		// certificates are not used for the TLS passthrough listeners
+		if listener.TLS != nil && listener.Protocol != gatewayv1.TLSProtocolType {
 			for _, certRef := range listener.TLS.CertificateRefs {
 				//kind is validated at ingestion
 				if certRef.Namespace == nil || *certRef.Namespace == "" {
@@ -198,11 +210,13 @@ func TLSNodeFromSecret(secretObj *corev1.Secret, parentVsNode *nodes.AviEvhVsNod
 		utils.AviLog.Infof("key: %s, msg: key not found for secret: %s", key, secretObj.Name)
 	}
 	tlsNode := &nodes.AviTLSKeyCertNode{
-		Name:   akogatewayapilib.GetTLSKeyCertNodeName(gatewayNamespace, gatewayName, certificateNamespace, certName),
-		Tenant: parentVsNode.Tenant,
-		Type:   lib.CertTypeVS,
-		Key:    tlskey,
-		Cert:   tlscert,
+		Name:            akogatewayapilib.GetTLSKeyCertNodeName(gatewayNamespace, gatewayName, certificateNamespace, certName),
+		Tenant:          parentVsNode.Tenant,
+		Type:            lib.CertTypeVS,
+		Key:             tlskey,
+		Cert:            tlscert,
+		SecretNamespace: certificateNamespace,
+		SecretName:      certName,
 	}
 	return tlsNode
 }
@@ -275,7 +289,8 @@ func AddTLSNode(key string, object *AviObjectGraph, gateway *gatewayv1.Gateway,
 		if akogatewayapilib.IsListenerInvalid(gwStatus, i) {
 			continue
 		}
-		if listener.TLS != nil {
+		// certificates are not used for the TLS passthrough listeners
+		if listener.TLS != nil && listener.Protocol != gatewayv1.TLSProtocolType {
 			for _, certRef := range listener.TLS.CertificateRefs {
 				name := string(certRef.Name)
 				listenerCertRefNamespace := gateway.Namespace
@@ -304,6 +319,31 @@ func AddTLSNode(key string, object *AviObjectGraph, gateway *gatewayv1.Gateway,
 	object.GetAviEvhVS()[0].SSLKeyCertRefs = tlsNodes
 }
 
+// buildPassthroughChildForGateway builds the L4 VS of the TLS passthrough listeners of the Gateway. The VS shares the
+// VsVip of the parent VS, and the datascript on it selects the poolgroup of a TLSRoute using the SNI.
+func buildPassthroughChildForGateway(key string, gateway *gatewayv1.Gateway, parentVsNode *nodes.AviEvhVsNode, portProtocols []nodes.AviPortHostProtocol) {
+	if len(portProtocols) == 0 {
+		return
+	}
+	passChildVS := &nodes.AviVsNode{
+		Name:               akogatewayapilib.GetGatewayPassthroughName(gateway.Namespace, gateway.Name),
+		Tenant:             parentVsNode.Tenant,
+		VrfContext:         parentVsNode.VrfContext,
+		ServiceEngineGroup: parentVsNode.ServiceEngineGroup,
+		ApplicationProfile: utils.DEFAULT_L4_APP_PROFILE,
+		NetworkProfile:     utils.DEFAULT_TCP_NW_PROFILE,
+		PortProto:          portProtocols,
+		SharedVS:           true,
+		EnableRhi:          parentVsNode.EnableRhi,
+		TrafficEnabled:     parentVsNode.TrafficEnabled,
+		VSVIPRefs:          parentVsNode.VSVIPRefs,
+	}
+	passChildVS.ServiceMetadata.PassthroughParentRef = parentVsNode.Name
+	parentVsNode.ServiceMetadata.PassthroughChildRef = passChildVS.Name
+	parentVsNode.PassthroughChildNodes = []*nodes.AviVsNode{passChildVS}
+	utils.AviLog.Debugf("key: %s, msg: passthrough vs %s built for parent vs %s with ports %v", key, passChildVS.Name, parentVsNode.Name, utils.Stringify(portProtocols))
+}
+
 // We are only supporting SE Group and T1LR fields in InfraSetting right now, support for other fields will be added on need basis
 func buildWithInfraSettingForGateway(key string, vs *nodes.AviEvhVsNode, vsvip *nodes.AviVSVIPNode, infraSetting *v1beta1.AviInfraSetting) {
 	if infraSetting != nil && infraSetting.Status.Status == lib.StatusAccepted {
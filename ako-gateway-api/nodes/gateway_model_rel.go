@@ -88,6 +88,26 @@ var (
 		GetGateways: HTTPRouteToGateway,
 		GetRoutes:   HTTPRouteChanges,
 	}
+	GRPCRoute = GraphSchema{
+		Type:        lib.GRPCRoute,
+		GetGateways: GRPCRouteToGateway,
+		GetRoutes:   GRPCRouteChanges,
+	}
+	TLSRoute = GraphSchema{
+		Type:        lib.TLSRoute,
+		GetGateways: TLSRouteToGateway,
+		GetRoutes:   TLSRouteChanges,
+	}
+	TCPRoute = GraphSchema{
+		Type:        lib.TCPRoute,
+		GetGateways: TCPRouteToGateway,
+		GetRoutes:   TCPRouteChanges,
+	}
+	UDPRoute = GraphSchema{
+		Type:        lib.UDPRoute,
+		GetGateways: UDPRouteToGateway,
+		GetRoutes:   UDPRouteChanges,
+	}
 	Pod = GraphSchema{
 		Type:        "Pod",
 		GetGateways: PodToGateway,
@@ -100,6 +120,10 @@ var (
 		Service,
 		EndpointSlices,
 		HTTPRoute,
+		GRPCRoute,
+		TLSRoute,
+		TCPRoute,
+		UDPRoute,
 		Pod,
 	}
 )
@@ -142,8 +166,8 @@ func GatewayGetGw(namespace, name, key string) ([]string, bool) {
 
 		if listenerObj.AllowedRoutes == nil {
 			gwListener.AllowedRouteNs = gwObj.Namespace
-			gwListener.AllowedRouteTypes = []akogatewayapiobjects.GatewayRouteKind{
-				{Group: akogatewayapilib.GatewayGroup, Kind: akogatewayapilib.ProtocolToRoute(gwListener.Protocol)},
+			for _, routeKind := range akogatewayapilib.SupportedKinds[listenerObj.Protocol] {
+				gwListener.AllowedRouteTypes = append(gwListener.AllowedRouteTypes, akogatewayapiobjects.GatewayRouteKind{Group: akogatewayapilib.GatewayGroup, Kind: string(routeKind.Kind)})
 			}
 		} else {
 			if listenerObj.AllowedRoutes.Namespaces != nil {
@@ -169,6 +193,10 @@ func GatewayGetGw(namespace, name, key string) ([]string, bool) {
 						}
 					}
 				}
+			} else {
+				for _, routeKind := range akogatewayapilib.SupportedKinds[listenerObj.Protocol] {
+					gwListener.AllowedRouteTypes = append(gwListener.AllowedRouteTypes, akogatewayapiobjects.GatewayRouteKind{Group: akogatewayapilib.GatewayGroup, Kind: string(routeKind.Kind)})
+				}
 			}
 		}
 		if listenerObj.TLS != nil {
@@ -224,7 +252,7 @@ func GatewayToRoutes(namespace, name, key string) ([]string, bool) {
 			}
 		}
 	}
-	routeTypeNsNameList, _ := validateReferredHTTPRoute(key, name, namespace, allowedRoutes)
+	routeTypeNsNameList, _ := validateReferredRoutes(key, name, namespace, allowedRoutes)
 	return routeTypeNsNameList, true
 }
 
@@ -259,9 +287,28 @@ func GatewayClassGetGw(namespace, name, key string) ([]string, bool) {
 }
 
 func HTTPRouteToGateway(namespace, name, key string) ([]string, bool) {
+	return routeToGateway(lib.HTTPRoute, namespace, name, key)
+}
 
-	routeTypeNsName := lib.HTTPRoute + "/" + namespace + "/" + name
-	hrObj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().HTTPRouteInformer.Lister().HTTPRoutes(namespace).Get(name)
+func GRPCRouteToGateway(namespace, name, key string) ([]string, bool) {
+	return routeToGateway(lib.GRPCRoute, namespace, name, key)
+}
+
+func TLSRouteToGateway(namespace, name, key string) ([]string, bool) {
+	return routeToGateway(lib.TLSRoute, namespace, name, key)
+}
+
+func TCPRouteToGateway(namespace, name, key string) ([]string, bool) {
+	return routeToGateway(lib.TCPRoute, namespace, name, key)
+}
+
+func UDPRouteToGateway(namespace, name, key string) ([]string, bool) {
+	return routeToGateway(lib.UDPRoute, namespace, name, key)
+}
+
+func routeToGateway(routeType, namespace, name, key string) ([]string, bool) {
+	routeTypeNsName := routeType + "/" + namespace + "/" + name
+	hrObj, err := getRouteObject(routeType, namespace, name)
 	if err != nil {
 		if !errors.IsNotFound(err) {
 			utils.AviLog.Errorf("key: %s, msg: got error while getting gateway: %v", key, err)
@@ -324,10 +371,10 @@ outerLoop:
 	return gwNsNameList, true
 }
 
-// GetOldGatewaysForHTTPRouteCleanup returns the list of old gateways that are no longer referenced by an HTTPRoute.
+// GetOldGatewaysForRouteCleanup returns the list of old gateways that are no longer referenced by a route.
 // These gateways need to be reconciled to clean up their stale child VSes and pools.
-func GetOldGatewaysForHTTPRouteCleanup(namespace, name, key string, currentGateways []string) []string {
-	routeTypeNsName := lib.HTTPRoute + "/" + namespace + "/" + name
+func GetOldGatewaysForRouteCleanup(routeType, namespace, name, key string, currentGateways []string) []string {
+	routeTypeNsName := routeType + "/" + namespace + "/" + name
 	var oldGatewaysToCleanup []string
 
 	// Get the old gateways from cache
@@ -411,6 +458,16 @@ func HTTPRouteChanges(namespace, name, key string) ([]string, bool) {
 				case lib.ApplicationProfile:
 					appProfileNsNameList.Add(ns)
 				}
+			} else if filter.Type == gatewayv1.HTTPRouteFilterRequestMirror && filter.RequestMirror != nil {
+				// endpoints of the mirror service are the clone servers of the traffic clone profile
+				ns := namespace
+				if filter.RequestMirror.BackendRef.Namespace != nil {
+					ns = string(*filter.RequestMirror.BackendRef.Namespace)
+				}
+				svcNsName := ns + "/" + string(filter.RequestMirror.BackendRef.Name)
+				if !utils.HasElem(svcNsNameList, svcNsName) {
+					svcNsNameList = append(svcNsNameList, svcNsName)
+				}
 			}
 		}
 	}
@@ -531,6 +588,214 @@ func HTTPRouteChanges(namespace, name, key string) ([]string, bool) {
 	return []string{routeTypeNsName}, true
 }
 
+// GRPCRouteChanges updates the gateway and service mappings of a GRPCRoute.
+// Extension references are not supported on GRPCRoutes, so unlike HTTPRoutes
+// there are no AKO CRD mappings to maintain.
+func GRPCRouteChanges(namespace, name, key string) ([]string, bool) {
+	routeTypeNsName := lib.GRPCRoute + "/" + namespace + "/" + name
+	grpcRouteObj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GRPCRouteInformer.Lister().GRPCRoutes(namespace).Get(name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			utils.AviLog.Errorf("key: %s, msg: got error while getting grpcroute: %v", key, err)
+			return []string{}, false
+		}
+		// grpcroute must be deleted so remove mappings
+		akogatewayapiobjects.GatewayApiLister().DeleteRouteFromStore(routeTypeNsName, key)
+		return []string{routeTypeNsName}, true
+	}
+
+	var gwNsNameList []string
+	for _, parentRef := range grpcRouteObj.Spec.ParentRefs {
+		ns := namespace
+		if parentRef.Namespace != nil {
+			ns = string(*parentRef.Namespace)
+		}
+		gwNsName := ns + "/" + string(parentRef.Name)
+		gwNsNameList = append(gwNsNameList, gwNsName)
+	}
+
+	var svcNsNameList []string
+	for _, rule := range grpcRouteObj.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			ns := namespace
+			if backendRef.Namespace != nil {
+				ns = string(*backendRef.Namespace)
+			}
+			svcNsName := ns + "/" + string(backendRef.Name)
+			if !utils.HasElem(svcNsNameList, svcNsName) {
+				svcNsNameList = append(svcNsNameList, svcNsName)
+			}
+		}
+	}
+
+	updateRouteMappings(key, routeTypeNsName, gwNsNameList, svcNsNameList)
+
+	utils.AviLog.Debugf("key: %s, msg: GRPCRoutes retrieved %s", key, []string{routeTypeNsName})
+	return []string{routeTypeNsName}, true
+}
+
+// TLSRouteChanges updates the gateway and service mappings of a TLSRoute.
+func TLSRouteChanges(namespace, name, key string) ([]string, bool) {
+	routeTypeNsName := lib.TLSRoute + "/" + namespace + "/" + name
+	informer := akogatewayapilib.AKOControlConfig().GatewayApiInformers().TLSRouteInformer
+	if informer == nil {
+		utils.AviLog.Warnf("key: %s, msg: TLSRoute CRD is not present in the cluster", key)
+		return []string{}, false
+	}
+	tlsRouteObj, err := informer.Lister().TLSRoutes(namespace).Get(name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			utils.AviLog.Errorf("key: %s, msg: got error while getting tlsroute: %v", key, err)
+			return []string{}, false
+		}
+		// tlsroute must be deleted so remove mappings
+		akogatewayapiobjects.GatewayApiLister().DeleteRouteFromStore(routeTypeNsName, key)
+		return []string{routeTypeNsName}, true
+	}
+
+	var backendRefs []gatewayv1.BackendRef
+	for _, rule := range tlsRouteObj.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs...)
+	}
+	gwNsNameList, svcNsNameList := getRouteGatewaysAndServices(namespace, tlsRouteObj.Spec.ParentRefs, backendRefs)
+	updateRouteMappings(key, routeTypeNsName, gwNsNameList, svcNsNameList)
+
+	utils.AviLog.Debugf("key: %s, msg: TLSRoutes retrieved %s", key, []string{routeTypeNsName})
+	return []string{routeTypeNsName}, true
+}
+
+// TCPRouteChanges updates the gateway and service mappings of a TCPRoute.
+func TCPRouteChanges(namespace, name, key string) ([]string, bool) {
+	routeTypeNsName := lib.TCPRoute + "/" + namespace + "/" + name
+	informer := akogatewayapilib.AKOControlConfig().GatewayApiInformers().TCPRouteInformer
+	if informer == nil {
+		utils.AviLog.Warnf("key: %s, msg: TCPRoute CRD is not present in the cluster", key)
+		return []string{}, false
+	}
+	tcpRouteObj, err := informer.Lister().TCPRoutes(namespace).Get(name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			utils.AviLog.Errorf("key: %s, msg: got error while getting tcproute: %v", key, err)
+			return []string{}, false
+		}
+		// tcproute must be deleted so remove mappings
+		akogatewayapiobjects.GatewayApiLister().DeleteRouteFromStore(routeTypeNsName, key)
+		return []string{routeTypeNsName}, true
+	}
+
+	var backendRefs []gatewayv1.BackendRef
+	for _, rule := range tcpRouteObj.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs...)
+	}
+	gwNsNameList, svcNsNameList := getRouteGatewaysAndServices(namespace, tcpRouteObj.Spec.ParentRefs, backendRefs)
+	updateRouteMappings(key, routeTypeNsName, gwNsNameList, svcNsNameList)
+
+	utils.AviLog.Debugf("key: %s, msg: TCPRoutes retrieved %s", key, []string{routeTypeNsName})
+	return []string{routeTypeNsName}, true
+}
+
+// UDPRouteChanges updates the gateway and service mappings of a UDPRoute.
+func UDPRouteChanges(namespace, name, key string) ([]string, bool) {
+	routeTypeNsName := lib.UDPRoute + "/" + namespace + "/" + name
+	informer := akogatewayapilib.AKOControlConfig().GatewayApiInformers().UDPRouteInformer
+	if informer == nil {
+		utils.AviLog.Warnf("key: %s, msg: UDPRoute CRD is not present in the cluster", key)
+		return []string{}, false
+	}
+	udpRouteObj, err := informer.Lister().UDPRoutes(namespace).Get(name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			utils.AviLog.Errorf("key: %s, msg: got error while getting udproute: %v", key, err)
+			return []string{}, false
+		}
+		// udproute must be deleted so remove mappings
+		akogatewayapiobjects.GatewayApiLister().DeleteRouteFromStore(routeTypeNsName, key)
+		return []string{routeTypeNsName}, true
+	}
+
+	var backendRefs []gatewayv1.BackendRef
+	for _, rule := range udpRouteObj.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs...)
+	}
+	gwNsNameList, svcNsNameList := getRouteGatewaysAndServices(namespace, udpRouteObj.Spec.ParentRefs, backendRefs)
+	updateRouteMappings(key, routeTypeNsName, gwNsNameList, svcNsNameList)
+
+	utils.AviLog.Debugf("key: %s, msg: UDPRoutes retrieved %s", key, []string{routeTypeNsName})
+	return []string{routeTypeNsName}, true
+}
+
+// getRouteGatewaysAndServices returns the parent gateways and the backend services of a route in namespace/name format.
+func getRouteGatewaysAndServices(namespace string, parentRefs []gatewayv1.ParentReference, backendRefs []gatewayv1.BackendRef) ([]string, []string) {
+	var gwNsNameList []string
+	for _, parentRef := range parentRefs {
+		ns := namespace
+		if parentRef.Namespace != nil {
+			ns = string(*parentRef.Namespace)
+		}
+		gwNsName := ns + "/" + string(parentRef.Name)
+		gwNsNameList = append(gwNsNameList, gwNsName)
+	}
+
+	var svcNsNameList []string
+	for _, backendRef := range backendRefs {
+		ns := namespace
+		if backendRef.Namespace != nil {
+			ns = string(*backendRef.Namespace)
+		}
+		svcNsName := ns + "/" + string(backendRef.Name)
+		if !utils.HasElem(svcNsNameList, svcNsName) {
+			svcNsNameList = append(svcNsNameList, svcNsName)
+		}
+	}
+	return gwNsNameList, svcNsNameList
+}
+
+// updateRouteMappings replaces the gateway and service mappings of a route, which has no AKO CRD references, with the given ones.
+func updateRouteMappings(key, routeTypeNsName string, gwNsNameList, svcNsNameList []string) {
+	// deletes the services, which are removed, from the gateway <-> service and route <-> service mappings
+	found, oldSvcs := akogatewayapiobjects.GatewayApiLister().GetRouteToService(routeTypeNsName)
+	if found {
+		for _, svcNsName := range oldSvcs {
+			if !utils.HasElem(svcNsNameList, svcNsName) {
+				akogatewayapiobjects.GatewayApiLister().DeleteRouteToServiceMappings(routeTypeNsName, svcNsName, key)
+			}
+		}
+	}
+
+	found, oldGateways := akogatewayapiobjects.GatewayApiLister().GetRouteToGateway(routeTypeNsName)
+	if found {
+		for _, gwNsName := range oldGateways {
+			if !utils.HasElem(gwNsNameList, gwNsName) {
+				akogatewayapiobjects.GatewayApiLister().DeleteRouteToGatewayMappings(routeTypeNsName, gwNsName)
+			}
+		}
+	}
+
+	// updates route <-> service mappings with new services
+	for _, svcNsName := range svcNsNameList {
+		akogatewayapiobjects.GatewayApiLister().UpdateRouteServiceMappings(routeTypeNsName, svcNsName, key)
+	}
+
+	// updates gateway <-> service mappings with new services
+	for _, gwNsName := range gwNsNameList {
+		for _, svcNsName := range svcNsNameList {
+			akogatewayapiobjects.GatewayApiLister().UpdateGatewayServiceMappings(gwNsName, svcNsName)
+		}
+	}
+
+	for _, gwNsName := range oldGateways {
+		if utils.HasElem(gwNsNameList, gwNsName) {
+			continue
+		}
+		for _, svcNsName := range oldSvcs {
+			if utils.HasElem(svcNsNameList, svcNsName) {
+				continue
+			}
+			akogatewayapiobjects.GatewayApiLister().DeleteGatewayServiceMappings(gwNsName, svcNsName)
+		}
+	}
+}
+
 func ServiceToGateways(namespace, name, key string) ([]string, bool) {
 	svcNsName := namespace + "/" + name
 	found, gwNsNameList := akogatewayapiobjects.GatewayApiLister().GetServiceToGateway(svcNsName)
@@ -703,10 +968,10 @@ func parentRefGatewayMappings(parentRef gatewayv1.ParentReference,
 	parentNameToHostnameMap map[string][]string,
 	gatewayToListenersMap map[string][]akogatewayapiobjects.GatewayListenerStore,
 	gwNsNameList *[]string,
-	hrObj *gatewayv1.HTTPRoute,
+	hrObj *routeObject,
 	namespace, key string) {
-	routeTypeNsName := lib.HTTPRoute + "/" + hrObj.Namespace + "/" + hrObj.Name
-	httpGroupKind := akogatewayapiobjects.GatewayRouteKind{Group: akogatewayapilib.GatewayGroup, Kind: lib.HTTPRoute}
+	routeTypeNsName := hrObj.Kind + "/" + hrObj.Namespace + "/" + hrObj.Name
+	routeGroupKind := akogatewayapiobjects.GatewayRouteKind{Group: akogatewayapilib.GatewayGroup, Kind: hrObj.Kind}
 	hostnameIntersection, _ := parentNameToHostnameMap[string(parentRef.Name)]
 	ns := namespace
 	if parentRef.Namespace != nil {
@@ -722,12 +987,20 @@ func parentRefGatewayMappings(parentRef gatewayv1.ParentReference,
 	for _, listener := range listeners {
 		//check if namespace is allowed
 		// TODO: akshay: add selector condition here.
-		if (len(listener.AllowedRouteTypes) == 0 || utils.HasElem(listener.AllowedRouteTypes, httpGroupKind)) &&
+		if (len(listener.AllowedRouteTypes) == 0 || utils.HasElem(listener.AllowedRouteTypes, routeGroupKind)) &&
 			(listener.AllowedRouteNs == akogatewayapilib.AllowedRoutesNamespaceFromAll || listener.AllowedRouteNs == hrObj.Namespace) {
 			//if provided, check if section name and port matches
 			if (parentRef.SectionName == nil || string(*parentRef.SectionName) == listener.Name) &&
 				(parentRef.Port == nil || int32(*parentRef.Port) == listener.Port) {
 
+				// TCPRoute and UDPRoute have no hostnames, these are attached to the listener by the port only
+				if akogatewayapilib.IsL4Route(hrObj.Kind) {
+					if !utils.HasElem(gatewayListenerList, listener) {
+						gatewayListenerList = append(gatewayListenerList, listener)
+					}
+					continue
+				}
+
 				gwListenerNsName := gwNsName + "/" + listener.Name
 				listenerHostname := akogatewayapiobjects.GatewayApiLister().GetGatewayListenerToHostname(gwListenerNsName)
 
@@ -778,53 +1051,99 @@ func parentRefGatewayMappings(parentRef gatewayv1.ParentReference,
 	parentNameToHostnameMap[string(parentRef.Name)] = hostnameIntersection
 }
 
-func validateReferredHTTPRoute(key, name, namespace string, allowedRoutesAll bool) ([]string, error) {
+func validateReferredRoutes(key, name, namespace string, allowedRoutesAll bool) ([]string, error) {
 	ns := namespace
 	if allowedRoutesAll {
 		ns = metav1.NamespaceAll
 	}
-	hrObjs, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().HTTPRouteInformer.Lister().HTTPRoutes(ns).List(labels.Set(nil).AsSelector())
+	informers := akogatewayapilib.AKOControlConfig().GatewayApiInformers()
+	hrObjs, err := informers.HTTPRouteInformer.Lister().HTTPRoutes(ns).List(labels.Set(nil).AsSelector())
+	if err != nil {
+		return nil, err
+	}
+	grpcRouteObjs, err := informers.GRPCRouteInformer.Lister().GRPCRoutes(ns).List(labels.Set(nil).AsSelector())
 	if err != nil {
 		return nil, err
 	}
-	httpRoutes := make([]*gatewayv1.HTTPRoute, 0)
+	routeObjs := make([]*routeObject, 0, len(hrObjs)+len(grpcRouteObjs))
+	routeStatuses := make([]*gatewayv1.HTTPRouteStatus, 0, len(hrObjs)+len(grpcRouteObjs))
 	for _, httpRoute := range hrObjs {
-		httpRouteStatus := httpRoute.Status.DeepCopy()
-		httpRouteStatus.Parents = make([]gatewayv1.RouteParentStatus, 0, len(httpRoute.Spec.ParentRefs))
-		routeTypeNsName := lib.HTTPRoute + "/" + httpRoute.Namespace + "/" + httpRoute.Name
-		httpRouteStatusInCache := akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(routeTypeNsName)
-		if httpRouteStatusInCache == nil {
+		routeObjs = append(routeObjs, newRouteObject(httpRoute))
+		routeStatuses = append(routeStatuses, httpRoute.Status.DeepCopy())
+	}
+	for _, grpcRoute := range grpcRouteObjs {
+		routeObjs = append(routeObjs, newRouteObject(grpcRoute))
+		routeStatuses = append(routeStatuses, &gatewayv1.HTTPRouteStatus{RouteStatus: *grpcRoute.Status.RouteStatus.DeepCopy()})
+	}
+	if informers.TLSRouteInformer != nil {
+		tlsRouteObjs, err := informers.TLSRouteInformer.Lister().TLSRoutes(ns).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			return nil, err
+		}
+		for _, tlsRoute := range tlsRouteObjs {
+			routeObjs = append(routeObjs, newRouteObject(tlsRoute))
+			routeStatuses = append(routeStatuses, &gatewayv1.HTTPRouteStatus{RouteStatus: *tlsRoute.Status.RouteStatus.DeepCopy()})
+		}
+	}
+	if informers.TCPRouteInformer != nil {
+		tcpRouteObjs, err := informers.TCPRouteInformer.Lister().TCPRoutes(ns).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			return nil, err
+		}
+		for _, tcpRoute := range tcpRouteObjs {
+			routeObjs = append(routeObjs, newRouteObject(tcpRoute))
+			routeStatuses = append(routeStatuses, &gatewayv1.HTTPRouteStatus{RouteStatus: *tcpRoute.Status.RouteStatus.DeepCopy()})
+		}
+	}
+	if informers.UDPRouteInformer != nil {
+		udpRouteObjs, err := informers.UDPRouteInformer.Lister().UDPRoutes(ns).List(labels.Set(nil).AsSelector())
+		if err != nil {
+			return nil, err
+		}
+		for _, udpRoute := range udpRouteObjs {
+			routeObjs = append(routeObjs, newRouteObject(udpRoute))
+			routeStatuses = append(routeStatuses, &gatewayv1.HTTPRouteStatus{RouteStatus: *udpRoute.Status.RouteStatus.DeepCopy()})
+		}
+	}
+
+	routes := make([]*routeObject, 0)
+	for i, route := range routeObjs {
+		routeStatus := routeStatuses[i]
+		routeStatus.Parents = make([]gatewayv1.RouteParentStatus, 0, len(route.Spec.ParentRefs))
+		routeTypeNsName := route.Kind + "/" + route.Namespace + "/" + route.Name
+		routeStatusInCache := akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(routeTypeNsName)
+		if routeStatusInCache == nil {
 			continue
 		}
-		parentRefIndexInHttpRouteStatus := 0
+		parentRefIndexInRouteStatus := 0
 		indexInCache := 0
 		appendRoute := false
-		for parentRefIndexFromSpec, parentRef := range httpRoute.Spec.ParentRefs {
-			matchNamespace := httpRoute.Namespace
+		for parentRefIndexFromSpec, parentRef := range route.Spec.ParentRefs {
+			matchNamespace := route.Namespace
 			if parentRef.Namespace != nil {
 				matchNamespace = string(*parentRef.Namespace)
 			}
 			if (parentRef.Name == gatewayv1.ObjectName(name)) && (matchNamespace == namespace) {
-				isValidHttprouteRules := validateHTTPRouteRules(key, httpRoute, httpRouteStatus)
-				if isValidHttprouteRules {
-					err := validateParentReference(key, httpRoute, httpRouteStatus, parentRefIndexFromSpec, &parentRefIndexInHttpRouteStatus, &indexInCache)
+				isValidRouteRules := validateRouteRules(key, route, routeStatus)
+				if isValidRouteRules {
+					err := validateParentReference(key, route, routeStatus, parentRefIndexFromSpec, &parentRefIndexInRouteStatus, &indexInCache)
 					if err != nil {
 						parentRefName := parentRef.Name
-						utils.AviLog.Warnf("key: %s, msg: Parent Reference %s of HTTPRoute object %s is not valid, err: %v", key, parentRefName, httpRoute.Name, err)
+						utils.AviLog.Warnf("key: %s, msg: Parent Reference %s of %s object %s is not valid, err: %v", key, parentRefName, route.Kind, route.Name, err)
 					} else {
 						appendRoute = true
 					}
 				} else {
-					utils.AviLog.Warnf("key: %s, msg: HTTPUrlRewrite PathType has Unsupported value.", key)
+					utils.AviLog.Warnf("key: %s, msg: Rules of %s object %s are not valid.", key, route.Kind, route.Name)
 					appendRoute = false
 				}
 			} else {
 				gwName := parentRef.Name
-				namespace := httpRoute.Namespace
+				namespace := route.Namespace
 				if parentRef.Namespace != nil {
 					namespace = string(*parentRef.Namespace)
 				}
-				gateway, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GatewayInformer.Lister().Gateways(namespace).Get(string(gwName))
+				gateway, err := informers.GatewayInformer.Lister().Gateways(namespace).Get(string(gwName))
 				if err != nil {
 					utils.AviLog.Errorf("key: %s, msg: unable to get the gateway object %s . err: %s", key, gwName, err)
 					continue
@@ -832,39 +1151,52 @@ func validateReferredHTTPRoute(key, name, namespace string, allowedRoutesAll boo
 				gwClass := string(gateway.Spec.GatewayClassName)
 				_, isAKOCtrl := akogatewayapiobjects.GatewayApiLister().IsGatewayClassControllerAKO(gwClass)
 				if !isAKOCtrl {
-					utils.AviLog.Warnf("key: %s, msg: controller for the parent reference %s of HTTPRoute object %s is not ako", key, name, httpRoute.Name)
+					utils.AviLog.Warnf("key: %s, msg: controller for the parent reference %s of %s object %s is not ako", key, name, route.Kind, route.Name)
 				} else {
-					httpRouteStatus.Parents = append(httpRouteStatus.Parents, httpRouteStatusInCache.Parents[indexInCache])
+					routeStatus.Parents = append(routeStatus.Parents, routeStatusInCache.Parents[indexInCache])
 				}
 			}
 		}
 
-		akogatewayapistatus.Record(key, httpRoute, &status.Status{HTTPRouteStatus: httpRouteStatus})
+		akogatewayapistatus.Record(key, route.obj, &status.Status{HTTPRouteStatus: routeStatus})
 		if appendRoute {
-			httpRoutes = append(httpRoutes, httpRoute)
+			routes = append(routes, route)
 		}
 	}
-	sort.Slice(httpRoutes, func(i, j int) bool {
-		if httpRoutes[i].GetCreationTimestamp().Unix() == httpRoutes[j].GetCreationTimestamp().Unix() {
-			return httpRoutes[i].Namespace+"/"+httpRoutes[i].Name < httpRoutes[j].Namespace+"/"+httpRoutes[j].Name
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].GetCreationTimestamp().Unix() == routes[j].GetCreationTimestamp().Unix() {
+			return routes[i].Kind+"/"+routes[i].Namespace+"/"+routes[i].Name < routes[j].Kind+"/"+routes[j].Namespace+"/"+routes[j].Name
 		}
-		return httpRoutes[i].GetCreationTimestamp().Unix() < httpRoutes[j].GetCreationTimestamp().Unix()
+		return routes[i].GetCreationTimestamp().Unix() < routes[j].GetCreationTimestamp().Unix()
 	})
-	var routes []string
-	for _, httpRoute := range httpRoutes {
-		httpRouteToGatewayOperation(httpRoute, key, name, namespace)
-		routeTypeNsNameList, found := HTTPRouteChanges(httpRoute.Namespace, httpRoute.Name, key)
+	var routeTypeNsNames []string
+	for _, route := range routes {
+		routeToGatewayOperation(route, key, name, namespace)
+		var routeTypeNsNameList []string
+		var found bool
+		switch route.Kind {
+		case lib.HTTPRoute:
+			routeTypeNsNameList, found = HTTPRouteChanges(route.Namespace, route.Name, key)
+		case lib.GRPCRoute:
+			routeTypeNsNameList, found = GRPCRouteChanges(route.Namespace, route.Name, key)
+		case lib.TLSRoute:
+			routeTypeNsNameList, found = TLSRouteChanges(route.Namespace, route.Name, key)
+		case lib.TCPRoute:
+			routeTypeNsNameList, found = TCPRouteChanges(route.Namespace, route.Name, key)
+		case lib.UDPRoute:
+			routeTypeNsNameList, found = UDPRouteChanges(route.Namespace, route.Name, key)
+		}
 		if !found {
-			utils.AviLog.Warnf("key: %s, msg: got error while getting HTTPRoute changes: %v", key, err)
+			utils.AviLog.Warnf("key: %s, msg: got error while getting %s changes", key, route.Kind)
 			continue
 		}
-		routes = append(routes, routeTypeNsNameList...)
+		routeTypeNsNames = append(routeTypeNsNames, routeTypeNsNameList...)
 	}
-	return routes, nil
+	return routeTypeNsNames, nil
 }
 
-func httpRouteToGatewayOperation(hrObj *gatewayv1.HTTPRoute, key, gwName, gwNamespace string) {
-	routeTypeNsName := lib.HTTPRoute + "/" + hrObj.Namespace + "/" + hrObj.Name
+func routeToGatewayOperation(hrObj *routeObject, key, gwName, gwNamespace string) {
+	routeTypeNsName := hrObj.Kind + "/" + hrObj.Namespace + "/" + hrObj.Name
 	var gwNsNameList []string
 	parentNameToHostnameMap := make(map[string][]string)
 	gatewayToListenersMap := make(map[string][]akogatewayapiobjects.GatewayListenerStore)
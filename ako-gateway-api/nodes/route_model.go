@@ -16,12 +16,14 @@ package nodes
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
 	akogatewayapistatus "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/status"
@@ -42,6 +44,14 @@ func NewRouteModel(key, objType, name, namespace string) (RouteModel, error) {
 	switch objType {
 	case lib.HTTPRoute:
 		return GetHTTPRouteModel(key, name, namespace)
+	case lib.GRPCRoute:
+		return GetGRPCRouteModel(key, name, namespace)
+	case lib.TLSRoute:
+		return GetTLSRouteModel(key, name, namespace)
+	case lib.TCPRoute:
+		return GetTCPRouteModel(key, name, namespace)
+	case lib.UDPRoute:
+		return GetUDPRouteModel(key, name, namespace)
 	}
 	return nil, fmt.Errorf("object of type %s not supported", objType)
 }
@@ -58,9 +68,19 @@ type PathMatch struct {
 	Type string
 }
 
+type QueryParamMatch struct {
+	Type  string
+	Name  string
+	Value string
+}
+
+// Match fields added after PathMatch and HeaderMatch are omitted when empty, as
+// the serialized matches are used to derive the names of the Avi objects.
 type Match struct {
-	PathMatch   *PathMatch
-	HeaderMatch []*HeaderMatch
+	PathMatch       *PathMatch
+	HeaderMatch     []*HeaderMatch
+	QueryParamMatch []*QueryParamMatch `json:",omitempty"`
+	MethodMatch     string             `json:",omitempty"`
 }
 
 type Matches []*Match
@@ -113,6 +133,7 @@ type Filter struct {
 	RedirectFilter   *RedirectFilter
 	UrlRewriteFilter *HTTPUrlRewriteFilter
 	ExtensionRef     *ExtensionRefFilter
+	MirrorFilter     *Backend
 }
 
 type Backend struct {
@@ -134,6 +155,8 @@ type Rule struct {
 	Filters            []*Filter
 	Backends           []*HTTPBackend
 	SessionPersistence *gatewayv1.SessionPersistence
+	Timeouts           *gatewayv1.HTTPRouteTimeouts
+	Retry              *gatewayv1.HTTPRouteRetry
 }
 
 type RouteConfig struct {
@@ -226,6 +249,24 @@ func (hr *httpRoute) ParseRouteConfig(key string) *RouteConfig {
 				match.HeaderMatch = append(match.HeaderMatch, headerMatch)
 			}
 
+			// query param match
+			for _, queryParam := range ruleMatch.QueryParams {
+				queryParamMatch := &QueryParamMatch{
+					Type: akogatewayapilib.EXACT,
+				}
+				if queryParam.Type != nil {
+					queryParamMatch.Type = string(*queryParam.Type)
+				}
+				queryParamMatch.Name = string(queryParam.Name)
+				queryParamMatch.Value = queryParam.Value
+				match.QueryParamMatch = append(match.QueryParamMatch, queryParamMatch)
+			}
+
+			// method match
+			if ruleMatch.Method != nil {
+				match.MethodMatch = string(*ruleMatch.Method)
+			}
+
 			routeConfigRule.Matches = append(routeConfigRule.Matches, match)
 		}
 		sort.Sort((Matches)(routeConfigRule.Matches))
@@ -235,6 +276,12 @@ func (hr *httpRoute) ParseRouteConfig(key string) *RouteConfig {
 		if rule.SessionPersistence != nil {
 			routeConfigRule.SessionPersistence = rule.SessionPersistence.DeepCopy()
 		}
+		if rule.Timeouts != nil {
+			routeConfigRule.Timeouts = rule.Timeouts.DeepCopy()
+		}
+		if rule.Retry != nil {
+			routeConfigRule.Retry = rule.Retry.DeepCopy()
+		}
 		routeConfigRule.Filters = make([]*Filter, 0, len(rule.Filters))
 
 		// var hasInvalidFilter bool
@@ -244,56 +291,12 @@ func (hr *httpRoute) ParseRouteConfig(key string) *RouteConfig {
 
 			// request header filter
 			if ruleFilter.RequestHeaderModifier != nil {
-				filter.RequestFilter = &HeaderFilter{}
-				filter.RequestFilter.Add = make([]*Header, 0, len(ruleFilter.RequestHeaderModifier.Add))
-				for _, addFilter := range ruleFilter.RequestHeaderModifier.Add {
-					addHeader := &Header{
-						Name:  string(addFilter.Name),
-						Value: addFilter.Value,
-					}
-					filter.RequestFilter.Add = append(filter.RequestFilter.Add, addHeader)
-				}
-				filter.RequestFilter.Set = make([]*Header, 0, len(ruleFilter.RequestHeaderModifier.Set))
-				for _, setFilter := range ruleFilter.RequestHeaderModifier.Set {
-					setHeader := &Header{
-						Name:  string(setFilter.Name),
-						Value: setFilter.Value,
-					}
-					filter.RequestFilter.Set = append(filter.RequestFilter.Set, setHeader)
-				}
-				filter.RequestFilter.Remove = make([]string, len(ruleFilter.RequestHeaderModifier.Remove))
-				copy(filter.RequestFilter.Remove, ruleFilter.RequestHeaderModifier.Remove)
-
-				sort.Sort((Headers)(filter.RequestFilter.Add))
-				sort.Sort((Headers)(filter.RequestFilter.Set))
-				sort.Strings(filter.RequestFilter.Remove)
+				filter.RequestFilter = parseHeaderFilter(ruleFilter.RequestHeaderModifier)
 			}
 
 			// response header filter
 			if ruleFilter.ResponseHeaderModifier != nil {
-				filter.ResponseFilter = &HeaderFilter{}
-				filter.ResponseFilter.Add = make([]*Header, 0, len(ruleFilter.ResponseHeaderModifier.Add))
-				for _, addFilter := range ruleFilter.ResponseHeaderModifier.Add {
-					addHeader := &Header{
-						Name:  string(addFilter.Name),
-						Value: addFilter.Value,
-					}
-					filter.ResponseFilter.Add = append(filter.ResponseFilter.Add, addHeader)
-				}
-				filter.ResponseFilter.Set = make([]*Header, 0, len(ruleFilter.ResponseHeaderModifier.Set))
-				for _, setFilter := range ruleFilter.ResponseHeaderModifier.Set {
-					setHeader := &Header{
-						Name:  string(setFilter.Name),
-						Value: setFilter.Value,
-					}
-					filter.ResponseFilter.Set = append(filter.ResponseFilter.Set, setHeader)
-				}
-				filter.ResponseFilter.Remove = make([]string, len(ruleFilter.ResponseHeaderModifier.Remove))
-				copy(filter.ResponseFilter.Remove, ruleFilter.ResponseHeaderModifier.Remove)
-
-				sort.Sort((Headers)(filter.ResponseFilter.Add))
-				sort.Sort((Headers)(filter.ResponseFilter.Set))
-				sort.Strings(filter.ResponseFilter.Remove)
+				filter.ResponseFilter = parseHeaderFilter(ruleFilter.ResponseHeaderModifier)
 			}
 
 			// request redirect filter
@@ -337,6 +340,31 @@ func (hr *httpRoute) ParseRouteConfig(key string) *RouteConfig {
 				}
 
 			}
+			// request mirror filter
+			if ruleFilter.RequestMirror != nil {
+				mirrorBackend := &Backend{
+					Name:      string(ruleFilter.RequestMirror.BackendRef.Name),
+					Namespace: hr.namespace,
+				}
+				if ruleFilter.RequestMirror.BackendRef.Namespace != nil {
+					mirrorBackend.Namespace = string(*ruleFilter.RequestMirror.BackendRef.Namespace)
+				}
+				if ruleFilter.RequestMirror.BackendRef.Port != nil {
+					mirrorBackend.Port = int32(*ruleFilter.RequestMirror.BackendRef.Port)
+				}
+				if ruleFilter.RequestMirror.BackendRef.Kind != nil {
+					mirrorBackend.Kind = string(*ruleFilter.RequestMirror.BackendRef.Kind)
+				}
+				var isValid bool
+				isValid, resolvedRefConditionRuleFilter = validateBackendReference(key, *mirrorBackend, nil, lib.HTTPRoute, hr.namespace)
+				if !isValid {
+					if resolvedRefConditionRuleFilter != nil {
+						resolvedRefCondition = resolvedRefConditionRuleFilter
+					}
+					continue
+				}
+				filter.MirrorFilter = mirrorBackend
+			}
 			routeConfigRule.Filters = append(routeConfigRule.Filters, filter)
 		}
 
@@ -375,7 +403,7 @@ func (hr *httpRoute) ParseRouteConfig(key string) *RouteConfig {
 				}
 			}
 			isValidBackend := false
-			isValidBackend, resolvedRefConditionRuleBackend = validateBackendReference(key, *backend, httpBackend.Filters, hr.namespace)
+			isValidBackend, resolvedRefConditionRuleBackend = validateBackendReference(key, *backend, httpBackend.Filters, lib.HTTPRoute, hr.namespace)
 			if isValidBackend {
 				routeConfigRule.Backends = append(routeConfigRule.Backends, httpBackend)
 			}
@@ -415,3 +443,508 @@ func (hr *httpRoute) GetParents() sets.Set[string] {
 	}
 	return parents
 }
+
+func parseHeaderFilter(headerModifier *gatewayv1.HTTPHeaderFilter) *HeaderFilter {
+	headerFilter := &HeaderFilter{}
+	headerFilter.Add = make([]*Header, 0, len(headerModifier.Add))
+	for _, addFilter := range headerModifier.Add {
+		addHeader := &Header{
+			Name:  string(addFilter.Name),
+			Value: addFilter.Value,
+		}
+		headerFilter.Add = append(headerFilter.Add, addHeader)
+	}
+	headerFilter.Set = make([]*Header, 0, len(headerModifier.Set))
+	for _, setFilter := range headerModifier.Set {
+		setHeader := &Header{
+			Name:  string(setFilter.Name),
+			Value: setFilter.Value,
+		}
+		headerFilter.Set = append(headerFilter.Set, setHeader)
+	}
+	headerFilter.Remove = make([]string, len(headerModifier.Remove))
+	copy(headerFilter.Remove, headerModifier.Remove)
+
+	sort.Sort((Headers)(headerFilter.Add))
+	sort.Sort((Headers)(headerFilter.Set))
+	sort.Strings(headerFilter.Remove)
+	return headerFilter
+}
+
+type grpcRoute struct {
+	key         string
+	name        string
+	namespace   string
+	routeConfig *RouteConfig
+	spec        *gatewayv1.GRPCRouteSpec
+}
+
+func GetGRPCRouteModel(key string, name, namespace string) (RouteModel, error) {
+	gr := &grpcRoute{
+		key:       key,
+		name:      name,
+		namespace: namespace,
+	}
+
+	grObj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GRPCRouteInformer.Lister().GRPCRoutes(namespace).Get(name)
+	if err != nil {
+		return gr, err
+	}
+	gr.spec = grObj.Spec.DeepCopy()
+	return gr, nil
+}
+
+func (gr *grpcRoute) GetName() string {
+	return gr.name
+}
+
+func (gr *grpcRoute) GetNamespace() string {
+	return gr.namespace
+}
+
+func (gr *grpcRoute) GetType() string {
+	return lib.GRPCRoute
+}
+
+func (gr *grpcRoute) GetSpec() interface{} {
+	return gr.spec
+}
+
+func (gr *grpcRoute) ParseRouteConfig(key string) *RouteConfig {
+	if gr.routeConfig != nil {
+		return gr.routeConfig
+	}
+	routeConfig := &RouteConfig{}
+
+	routeConfig.Hosts = make([]string, len(gr.spec.Hostnames))
+	for i := range gr.spec.Hostnames {
+		routeConfig.Hosts[i] = string(gr.spec.Hostnames[i])
+	}
+	var resolvedRefCondition, resolvedRefConditionRuleBackend akogatewayapistatus.Condition
+	routeConfig.Rules = make([]*Rule, 0, len(gr.spec.Rules))
+
+	for _, rule := range gr.spec.Rules {
+		routeConfigRule := &Rule{}
+		routeConfigRule.Matches = make([]*Match, 0, len(rule.Matches))
+		for _, ruleMatch := range rule.Matches {
+			match := &Match{}
+
+			// gRPC requests are routed on the :path pseudo header, /<service>/<method>
+			match.PathMatch = grpcMethodToPathMatch(ruleMatch.Method)
+
+			// header match
+			match.HeaderMatch = make([]*HeaderMatch, 0, len(ruleMatch.Headers))
+			for _, header := range ruleMatch.Headers {
+				headerMatch := &HeaderMatch{
+					Type: akogatewayapilib.EXACT,
+				}
+				if header.Type != nil {
+					headerMatch.Type = string(*header.Type)
+				}
+				headerMatch.Name = string(header.Name)
+				headerMatch.Value = header.Value
+				match.HeaderMatch = append(match.HeaderMatch, headerMatch)
+			}
+
+			routeConfigRule.Matches = append(routeConfigRule.Matches, match)
+		}
+		// A rule without matches, matches all the gRPC requests
+		if len(routeConfigRule.Matches) == 0 {
+			routeConfigRule.Matches = append(routeConfigRule.Matches, &Match{
+				PathMatch:   grpcMethodToPathMatch(nil),
+				HeaderMatch: []*HeaderMatch{},
+			})
+		}
+		sort.Sort((Matches)(routeConfigRule.Matches))
+		if rule.Name != nil {
+			routeConfigRule.Name = string(*rule.Name)
+		}
+		if rule.SessionPersistence != nil {
+			routeConfigRule.SessionPersistence = rule.SessionPersistence.DeepCopy()
+		}
+
+		// only header modifier filters are supported on GRPCRoute, rest are rejected during validation
+		routeConfigRule.Filters = make([]*Filter, 0, len(rule.Filters))
+		for _, ruleFilter := range rule.Filters {
+			filter := &Filter{}
+			filter.Type = string(ruleFilter.Type)
+			if ruleFilter.RequestHeaderModifier != nil {
+				filter.RequestFilter = parseHeaderFilter(ruleFilter.RequestHeaderModifier)
+			}
+			if ruleFilter.ResponseHeaderModifier != nil {
+				filter.ResponseFilter = parseHeaderFilter(ruleFilter.ResponseHeaderModifier)
+			}
+			routeConfigRule.Filters = append(routeConfigRule.Filters, filter)
+		}
+
+		for _, ruleBackend := range rule.BackendRefs {
+			grpcBackend := &HTTPBackend{}
+			backend := &Backend{}
+			backend.Name = string(ruleBackend.BackendRef.Name)
+			if ruleBackend.BackendRef.Namespace != nil {
+				backend.Namespace = string(*ruleBackend.BackendRef.Namespace)
+			} else {
+				backend.Namespace = gr.namespace
+			}
+			if ruleBackend.BackendRef.Port != nil {
+				//Default 0
+				backend.Port = int32(*ruleBackend.Port)
+			}
+			if ruleBackend.BackendRef.Kind != nil {
+				backend.Kind = string(*ruleBackend.Kind)
+			}
+			backend.Weight = 1
+			if ruleBackend.Weight != nil {
+				backend.Weight = *ruleBackend.Weight
+			}
+			grpcBackend.Backend = backend
+			isValidBackend := false
+			isValidBackend, resolvedRefConditionRuleBackend = validateBackendReference(key, *backend, nil, lib.GRPCRoute, gr.namespace)
+			if isValidBackend {
+				routeConfigRule.Backends = append(routeConfigRule.Backends, grpcBackend)
+			}
+			if resolvedRefConditionRuleBackend != nil {
+				resolvedRefCondition = resolvedRefConditionRuleBackend
+			}
+		}
+		routeConfig.Rules = append(routeConfig.Rules, routeConfigRule)
+	}
+	if resolvedRefCondition == nil {
+		resolvedRefCondition = akogatewayapistatus.NewCondition().
+			Type(string(gatewayv1.RouteConditionResolvedRefs)).
+			Status(metav1.ConditionTrue).
+			Reason(string(gatewayv1.RouteReasonResolvedRefs))
+	}
+	gr.routeConfig = routeConfig
+	setResolvedRefConditionInHTTPRouteStatus(key, resolvedRefCondition, lib.GRPCRoute+"/"+gr.GetNamespace()+"/"+gr.GetName())
+	return gr.routeConfig
+}
+
+func (gr *grpcRoute) Exists() bool {
+	return gr != nil
+}
+
+func (gr *grpcRoute) GetParents() sets.Set[string] {
+	parents := sets.New[string]()
+	for _, ref := range gr.spec.ParentRefs {
+		namespace := gr.namespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		parents.Insert(namespace + "/" + string(ref.Name))
+	}
+	return parents
+}
+
+// grpcMethodToPathMatch converts a GRPCRoute method match to a match on the request path.
+// A nil method match, or one without service and method, matches all the gRPC requests.
+func grpcMethodToPathMatch(method *gatewayv1.GRPCMethodMatch) *PathMatch {
+	pathMatch := &PathMatch{
+		Path: "/",
+		Type: akogatewayapilib.PATHPREFIX,
+	}
+	if method == nil || (method.Service == nil && method.Method == nil) {
+		return pathMatch
+	}
+	if method.Type != nil && *method.Type == gatewayv1.GRPCMethodMatchRegularExpression {
+		service, methodName := "[^/]+", "[^/]+"
+		if method.Service != nil {
+			service = *method.Service
+		}
+		if method.Method != nil {
+			methodName = *method.Method
+		}
+		pathMatch.Path = fmt.Sprintf("^/(%s)/(%s)$", service, methodName)
+		pathMatch.Type = akogatewayapilib.REGULAREXPRESSION
+		return pathMatch
+	}
+	switch {
+	case method.Service != nil && method.Method != nil:
+		pathMatch.Path = "/" + *method.Service + "/" + *method.Method
+		pathMatch.Type = akogatewayapilib.EXACT
+	case method.Service != nil:
+		pathMatch.Path = "/" + *method.Service + "/"
+	default:
+		pathMatch.Path = "^/[^/]+/" + regexp.QuoteMeta(*method.Method) + "$"
+		pathMatch.Type = akogatewayapilib.REGULAREXPRESSION
+	}
+	return pathMatch
+}
+
+type tlsRoute struct {
+	key         string
+	name        string
+	namespace   string
+	routeConfig *RouteConfig
+	spec        *gatewayv1alpha2.TLSRouteSpec
+}
+
+func GetTLSRouteModel(key string, name, namespace string) (RouteModel, error) {
+	tr := &tlsRoute{
+		key:       key,
+		name:      name,
+		namespace: namespace,
+	}
+
+	informer := akogatewayapilib.AKOControlConfig().GatewayApiInformers().TLSRouteInformer
+	if informer == nil {
+		return tr, fmt.Errorf("TLSRoute CRD is not present in the cluster")
+	}
+	trObj, err := informer.Lister().TLSRoutes(namespace).Get(name)
+	if err != nil {
+		return tr, err
+	}
+	tr.spec = trObj.Spec.DeepCopy()
+	return tr, nil
+}
+
+func (tr *tlsRoute) GetName() string {
+	return tr.name
+}
+
+func (tr *tlsRoute) GetNamespace() string {
+	return tr.namespace
+}
+
+func (tr *tlsRoute) GetType() string {
+	return lib.TLSRoute
+}
+
+func (tr *tlsRoute) GetSpec() interface{} {
+	return tr.spec
+}
+
+// ParseRouteConfig parses the TLSRoute rules, TLSRoute rules have no matches or filters
+// and the backends are selected using the SNI of the connection.
+func (tr *tlsRoute) ParseRouteConfig(key string) *RouteConfig {
+	if tr.routeConfig != nil {
+		return tr.routeConfig
+	}
+	routeConfig := &RouteConfig{}
+
+	routeConfig.Hosts = make([]string, len(tr.spec.Hostnames))
+	for i := range tr.spec.Hostnames {
+		routeConfig.Hosts[i] = string(tr.spec.Hostnames[i])
+	}
+	var resolvedRefCondition, resolvedRefConditionRuleBackend akogatewayapistatus.Condition
+	routeConfig.Rules = make([]*Rule, 0, len(tr.spec.Rules))
+
+	for _, rule := range tr.spec.Rules {
+		routeConfigRule := &Rule{}
+		if rule.Name != nil {
+			routeConfigRule.Name = string(*rule.Name)
+		}
+		for _, ruleBackend := range rule.BackendRefs {
+			tlsBackend := &HTTPBackend{}
+			backend := &Backend{}
+			backend.Name = string(ruleBackend.Name)
+			if ruleBackend.Namespace != nil {
+				backend.Namespace = string(*ruleBackend.Namespace)
+			} else {
+				backend.Namespace = tr.namespace
+			}
+			if ruleBackend.Port != nil {
+				backend.Port = int32(*ruleBackend.Port)
+			}
+			if ruleBackend.Kind != nil {
+				backend.Kind = string(*ruleBackend.Kind)
+			}
+			backend.Weight = 1
+			if ruleBackend.Weight != nil {
+				backend.Weight = *ruleBackend.Weight
+			}
+			tlsBackend.Backend = backend
+			isValidBackend := false
+			isValidBackend, resolvedRefConditionRuleBackend = validateBackendReference(key, *backend, nil, lib.TLSRoute, tr.namespace)
+			if isValidBackend {
+				routeConfigRule.Backends = append(routeConfigRule.Backends, tlsBackend)
+			}
+			if resolvedRefConditionRuleBackend != nil {
+				resolvedRefCondition = resolvedRefConditionRuleBackend
+			}
+		}
+		routeConfig.Rules = append(routeConfig.Rules, routeConfigRule)
+	}
+	if resolvedRefCondition == nil {
+		resolvedRefCondition = akogatewayapistatus.NewCondition().
+			Type(string(gatewayv1.RouteConditionResolvedRefs)).
+			Status(metav1.ConditionTrue).
+			Reason(string(gatewayv1.RouteReasonResolvedRefs))
+	}
+	tr.routeConfig = routeConfig
+	setResolvedRefConditionInHTTPRouteStatus(key, resolvedRefCondition, lib.TLSRoute+"/"+tr.GetNamespace()+"/"+tr.GetName())
+	return tr.routeConfig
+}
+
+func (tr *tlsRoute) Exists() bool {
+	return tr != nil
+}
+
+func (tr *tlsRoute) GetParents() sets.Set[string] {
+	parents := sets.New[string]()
+	for _, ref := range tr.spec.ParentRefs {
+		namespace := tr.namespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		parents.Insert(namespace + "/" + string(ref.Name))
+	}
+	return parents
+}
+
+// l4Route is the route model of TCPRoute and UDPRoute, both select the backends by the
+// port of the listener the route is attached to.
+type l4Route struct {
+	key         string
+	kind        string
+	name        string
+	namespace   string
+	routeConfig *RouteConfig
+	spec        interface{}
+	parentRefs  []gatewayv1.ParentReference
+	rules       []l4RouteRule
+}
+
+type l4RouteRule struct {
+	name        *gatewayv1.SectionName
+	backendRefs []gatewayv1.BackendRef
+}
+
+func GetTCPRouteModel(key string, name, namespace string) (RouteModel, error) {
+	tr := &l4Route{
+		key:       key,
+		kind:      lib.TCPRoute,
+		name:      name,
+		namespace: namespace,
+	}
+
+	informer := akogatewayapilib.AKOControlConfig().GatewayApiInformers().TCPRouteInformer
+	if informer == nil {
+		return tr, fmt.Errorf("TCPRoute CRD is not present in the cluster")
+	}
+	trObj, err := informer.Lister().TCPRoutes(namespace).Get(name)
+	if err != nil {
+		return tr, err
+	}
+	spec := trObj.Spec.DeepCopy()
+	tr.spec = spec
+	tr.parentRefs = spec.ParentRefs
+	for _, rule := range spec.Rules {
+		tr.rules = append(tr.rules, l4RouteRule{name: rule.Name, backendRefs: rule.BackendRefs})
+	}
+	return tr, nil
+}
+
+func GetUDPRouteModel(key string, name, namespace string) (RouteModel, error) {
+	ur := &l4Route{
+		key:       key,
+		kind:      lib.UDPRoute,
+		name:      name,
+		namespace: namespace,
+	}
+
+	informer := akogatewayapilib.AKOControlConfig().GatewayApiInformers().UDPRouteInformer
+	if informer == nil {
+		return ur, fmt.Errorf("UDPRoute CRD is not present in the cluster")
+	}
+	urObj, err := informer.Lister().UDPRoutes(namespace).Get(name)
+	if err != nil {
+		return ur, err
+	}
+	spec := urObj.Spec.DeepCopy()
+	ur.spec = spec
+	ur.parentRefs = spec.ParentRefs
+	for _, rule := range spec.Rules {
+		ur.rules = append(ur.rules, l4RouteRule{name: rule.Name, backendRefs: rule.BackendRefs})
+	}
+	return ur, nil
+}
+
+func (lr *l4Route) GetName() string {
+	return lr.name
+}
+
+func (lr *l4Route) GetNamespace() string {
+	return lr.namespace
+}
+
+func (lr *l4Route) GetType() string {
+	return lr.kind
+}
+
+func (lr *l4Route) GetSpec() interface{} {
+	return lr.spec
+}
+
+// ParseRouteConfig parses the backends of the TCPRoute and UDPRoute rules, the rules have no
+// matches or filters.
+func (lr *l4Route) ParseRouteConfig(key string) *RouteConfig {
+	if lr.routeConfig != nil {
+		return lr.routeConfig
+	}
+	routeConfig := &RouteConfig{}
+	var resolvedRefCondition, resolvedRefConditionRuleBackend akogatewayapistatus.Condition
+	routeConfig.Rules = make([]*Rule, 0, len(lr.rules))
+
+	for _, rule := range lr.rules {
+		routeConfigRule := &Rule{}
+		if rule.name != nil {
+			routeConfigRule.Name = string(*rule.name)
+		}
+		for _, ruleBackend := range rule.backendRefs {
+			l4Backend := &HTTPBackend{}
+			backend := &Backend{}
+			backend.Name = string(ruleBackend.Name)
+			if ruleBackend.Namespace != nil {
+				backend.Namespace = string(*ruleBackend.Namespace)
+			} else {
+				backend.Namespace = lr.namespace
+			}
+			if ruleBackend.Port != nil {
+				backend.Port = int32(*ruleBackend.Port)
+			}
+			if ruleBackend.Kind != nil {
+				backend.Kind = string(*ruleBackend.Kind)
+			}
+			backend.Weight = 1
+			if ruleBackend.Weight != nil {
+				backend.Weight = *ruleBackend.Weight
+			}
+			l4Backend.Backend = backend
+			isValidBackend := false
+			isValidBackend, resolvedRefConditionRuleBackend = validateBackendReference(key, *backend, nil, lr.kind, lr.namespace)
+			if isValidBackend {
+				routeConfigRule.Backends = append(routeConfigRule.Backends, l4Backend)
+			}
+			if resolvedRefConditionRuleBackend != nil {
+				resolvedRefCondition = resolvedRefConditionRuleBackend
+			}
+		}
+		routeConfig.Rules = append(routeConfig.Rules, routeConfigRule)
+	}
+	if resolvedRefCondition == nil {
+		resolvedRefCondition = akogatewayapistatus.NewCondition().
+			Type(string(gatewayv1.RouteConditionResolvedRefs)).
+			Status(metav1.ConditionTrue).
+			Reason(string(gatewayv1.RouteReasonResolvedRefs))
+	}
+	lr.routeConfig = routeConfig
+	setResolvedRefConditionInHTTPRouteStatus(key, resolvedRefCondition, lr.kind+"/"+lr.GetNamespace()+"/"+lr.GetName())
+	return lr.routeConfig
+}
+
+func (lr *l4Route) Exists() bool {
+	return lr != nil
+}
+
+func (lr *l4Route) GetParents() sets.Set[string] {
+	parents := sets.New[string]()
+	for _, ref := range lr.parentRefs {
+		namespace := lr.namespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		parents.Insert(namespace + "/" + string(ref.Name))
+	}
+	return parents
+}
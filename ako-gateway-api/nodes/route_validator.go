@@ -19,10 +19,13 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
 	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
@@ -41,6 +44,19 @@ var SupportedExtensionRefKindsOnHTTPRouteBackendRef = map[string]string{
 	"HealthMonitor":         "HealthMonitor",
 }
 
+// SupportedMethodsOnHTTPRouteMatch maps the methods of a HTTPRoute-Rule-Match to the Avi HTTP methods.
+var SupportedMethodsOnHTTPRouteMatch = map[string]string{
+	string(gatewayv1.HTTPMethodGet):     "HTTP_METHOD_GET",
+	string(gatewayv1.HTTPMethodHead):    "HTTP_METHOD_HEAD",
+	string(gatewayv1.HTTPMethodPost):    "HTTP_METHOD_POST",
+	string(gatewayv1.HTTPMethodPut):     "HTTP_METHOD_PUT",
+	string(gatewayv1.HTTPMethodDelete):  "HTTP_METHOD_DELETE",
+	string(gatewayv1.HTTPMethodConnect): "HTTP_METHOD_CONNECT",
+	string(gatewayv1.HTTPMethodOptions): "HTTP_METHOD_OPTIONS",
+	string(gatewayv1.HTTPMethodTrace):   "HTTP_METHOD_TRACE",
+	string(gatewayv1.HTTPMethodPatch):   "HTTP_METHOD_PATCH",
+}
+
 func isRegexMatch(stringWithWildCard string, stringToBeMatched string, key string) bool {
 	// replace the wildcard character with a regex
 	replacedHostname := strings.Replace(stringWithWildCard, utils.WILDCARD, utils.FQDN_LABEL_REGEX, 1)
@@ -53,39 +69,208 @@ func isRegexMatch(stringWithWildCard string, stringToBeMatched string, key strin
 	return expr.MatchString(stringToBeMatched)
 }
 
+// routeObject is a kind agnostic view of a Gateway API route, holding the fields
+// required to attach the route to its parent Gateways.
+type routeObject struct {
+	Kind string
+	metav1.ObjectMeta
+	Spec routeObjectSpec
+	obj  runtime.Object
+}
+
+type routeObjectSpec struct {
+	ParentRefs []gatewayv1.ParentReference
+	Hostnames  []gatewayv1.Hostname
+}
+
+func newRouteObject(obj runtime.Object) *routeObject {
+	switch routeObj := obj.(type) {
+	case *gatewayv1.HTTPRoute:
+		return &routeObject{
+			Kind:       lib.HTTPRoute,
+			ObjectMeta: routeObj.ObjectMeta,
+			Spec: routeObjectSpec{
+				ParentRefs: routeObj.Spec.ParentRefs,
+				Hostnames:  routeObj.Spec.Hostnames,
+			},
+			obj: routeObj,
+		}
+	case *gatewayv1.GRPCRoute:
+		return &routeObject{
+			Kind:       lib.GRPCRoute,
+			ObjectMeta: routeObj.ObjectMeta,
+			Spec: routeObjectSpec{
+				ParentRefs: routeObj.Spec.ParentRefs,
+				Hostnames:  routeObj.Spec.Hostnames,
+			},
+			obj: routeObj,
+		}
+	case *gatewayv1alpha2.TLSRoute:
+		return &routeObject{
+			Kind:       lib.TLSRoute,
+			ObjectMeta: routeObj.ObjectMeta,
+			Spec: routeObjectSpec{
+				ParentRefs: routeObj.Spec.ParentRefs,
+				Hostnames:  routeObj.Spec.Hostnames,
+			},
+			obj: routeObj,
+		}
+	case *gatewayv1alpha2.TCPRoute:
+		return &routeObject{
+			Kind:       lib.TCPRoute,
+			ObjectMeta: routeObj.ObjectMeta,
+			Spec: routeObjectSpec{
+				ParentRefs: routeObj.Spec.ParentRefs,
+			},
+			obj: routeObj,
+		}
+	case *gatewayv1alpha2.UDPRoute:
+		return &routeObject{
+			Kind:       lib.UDPRoute,
+			ObjectMeta: routeObj.ObjectMeta,
+			Spec: routeObjectSpec{
+				ParentRefs: routeObj.Spec.ParentRefs,
+			},
+			obj: routeObj,
+		}
+	}
+	return nil
+}
+
+func getRouteObject(routeType, namespace, name string) (*routeObject, error) {
+	informers := akogatewayapilib.AKOControlConfig().GatewayApiInformers()
+	switch routeType {
+	case lib.HTTPRoute:
+		httpRoute, err := informers.HTTPRouteInformer.Lister().HTTPRoutes(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return newRouteObject(httpRoute), nil
+	case lib.GRPCRoute:
+		grpcRoute, err := informers.GRPCRouteInformer.Lister().GRPCRoutes(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return newRouteObject(grpcRoute), nil
+	case lib.TLSRoute:
+		if informers.TLSRouteInformer == nil {
+			return nil, fmt.Errorf("TLSRoute CRD is not present in the cluster")
+		}
+		tlsRoute, err := informers.TLSRouteInformer.Lister().TLSRoutes(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return newRouteObject(tlsRoute), nil
+	case lib.TCPRoute:
+		if informers.TCPRouteInformer == nil {
+			return nil, fmt.Errorf("TCPRoute CRD is not present in the cluster")
+		}
+		tcpRoute, err := informers.TCPRouteInformer.Lister().TCPRoutes(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return newRouteObject(tcpRoute), nil
+	case lib.UDPRoute:
+		if informers.UDPRouteInformer == nil {
+			return nil, fmt.Errorf("UDPRoute CRD is not present in the cluster")
+		}
+		udpRoute, err := informers.UDPRouteInformer.Lister().UDPRoutes(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return newRouteObject(udpRoute), nil
+	}
+	return nil, fmt.Errorf("unsupported route type %s", routeType)
+}
+
 func IsHTTPRouteValid(key string, obj *gatewayv1.HTTPRoute) bool {
 	httpRoute := obj.DeepCopy()
 	httpRouteStatus := obj.Status.DeepCopy()
-	httpRouteStatus.Parents = make([]gatewayv1.RouteParentStatus, 0, len(httpRoute.Spec.ParentRefs))
+	return isRouteValid(key, newRouteObject(httpRoute), httpRouteStatus)
+}
+
+func IsGRPCRouteValid(key string, obj *gatewayv1.GRPCRoute) bool {
+	grpcRoute := obj.DeepCopy()
+	// Route status is tracked as HTTPRouteStatus for all the route kinds, as all of them share the RouteStatus.
+	routeStatus := &gatewayv1.HTTPRouteStatus{RouteStatus: *obj.Status.RouteStatus.DeepCopy()}
+	return isRouteValid(key, newRouteObject(grpcRoute), routeStatus)
+}
+
+func IsTLSRouteValid(key string, obj *gatewayv1alpha2.TLSRoute) bool {
+	tlsRoute := obj.DeepCopy()
+	routeStatus := &gatewayv1.HTTPRouteStatus{RouteStatus: *obj.Status.RouteStatus.DeepCopy()}
+	return isRouteValid(key, newRouteObject(tlsRoute), routeStatus)
+}
+
+func IsTCPRouteValid(key string, obj *gatewayv1alpha2.TCPRoute) bool {
+	tcpRoute := obj.DeepCopy()
+	routeStatus := &gatewayv1.HTTPRouteStatus{RouteStatus: *obj.Status.RouteStatus.DeepCopy()}
+	return isRouteValid(key, newRouteObject(tcpRoute), routeStatus)
+}
+
+func IsUDPRouteValid(key string, obj *gatewayv1alpha2.UDPRoute) bool {
+	udpRoute := obj.DeepCopy()
+	routeStatus := &gatewayv1.HTTPRouteStatus{RouteStatus: *obj.Status.RouteStatus.DeepCopy()}
+	return isRouteValid(key, newRouteObject(udpRoute), routeStatus)
+}
+
+func isRouteValid(key string, route *routeObject, routeStatus *gatewayv1.HTTPRouteStatus) bool {
+	routeStatus.Parents = make([]gatewayv1.RouteParentStatus, 0, len(route.Spec.ParentRefs))
 	var invalidParentRefCount int
-	parentRefIndexInHttpRouteStatus := 0
+	parentRefIndexInRouteStatus := 0
 	indexInCache := -1
-	isValidHttprouteRules := validateHTTPRouteRules(key, httpRoute, httpRouteStatus)
-	if isValidHttprouteRules {
-		for parentRefIndexFromSpec := range httpRoute.Spec.ParentRefs {
-			err := validateParentReference(key, httpRoute, httpRouteStatus, parentRefIndexFromSpec, &parentRefIndexInHttpRouteStatus, &indexInCache)
+	isValidRouteRules := validateRouteRules(key, route, routeStatus)
+	if isValidRouteRules {
+		for parentRefIndexFromSpec := range route.Spec.ParentRefs {
+			err := validateParentReference(key, route, routeStatus, parentRefIndexFromSpec, &parentRefIndexInRouteStatus, &indexInCache)
 			if err != nil {
 				invalidParentRefCount++
-				parentRefName := httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].Name
-				utils.AviLog.Warnf("key: %s, msg: Parent Reference %s of HTTPRoute object %s is not valid, err: %v", key, parentRefName, httpRoute.Name, err)
+				parentRefName := route.Spec.ParentRefs[parentRefIndexFromSpec].Name
+				utils.AviLog.Warnf("key: %s, msg: Parent Reference %s of %s object %s is not valid, err: %v", key, parentRefName, route.Kind, route.Name, err)
 			}
 		}
 	}
 
-	akogatewayapistatus.Record(key, httpRoute, &status.Status{HTTPRouteStatus: httpRouteStatus})
+	akogatewayapistatus.Record(key, route.obj, &status.Status{HTTPRouteStatus: routeStatus})
+
+	// No valid attachment, we can't proceed with this route object.
+	if invalidParentRefCount == len(route.Spec.ParentRefs) || !isValidRouteRules {
+		utils.AviLog.Errorf("key: %s, msg: %s object %s is not valid", key, route.Kind, route.Name)
+		akogatewayapilib.AKOControlConfig().EventRecorder().Eventf(route.obj, corev1.EventTypeWarning,
+			lib.Detached, "%s object %s is not valid", route.Kind, route.Name)
+		return false
+	}
+	utils.AviLog.Infof("key: %s, msg: %s object %s is valid", key, route.Kind, route.Name)
+	return true
+}
 
-	// No valid attachment, we can't proceed with this HTTPRoute object.
-	if invalidParentRefCount == len(httpRoute.Spec.ParentRefs) || !isValidHttprouteRules {
-		utils.AviLog.Errorf("key: %s, msg: HTTPRoute object %s is not valid", key, httpRoute.Name)
-		akogatewayapilib.AKOControlConfig().EventRecorder().Eventf(httpRoute, corev1.EventTypeWarning,
-			lib.Detached, "HTTPRoute object %s is not valid", httpRoute.Name)
+// hasRequestMirrorFilter returns true if a rule of the HTTPRoute has a RequestMirror filter.
+func hasRequestMirrorFilter(route *routeObject) bool {
+	httpRoute, ok := route.obj.(*gatewayv1.HTTPRoute)
+	if !ok {
 		return false
 	}
-	utils.AviLog.Infof("key: %s, msg: HTTPRoute object %s is valid", key, httpRoute.Name)
+	for _, rule := range httpRoute.Spec.Rules {
+		for _, filter := range rule.Filters {
+			if filter.Type == gatewayv1.HTTPRouteFilterRequestMirror && filter.RequestMirror != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateRouteRules(key string, route *routeObject, routeStatus *gatewayv1.HTTPRouteStatus) bool {
+	switch routeObj := route.obj.(type) {
+	case *gatewayv1.HTTPRoute:
+		return validateHTTPRouteRules(key, routeObj, routeStatus)
+	case *gatewayv1.GRPCRoute:
+		return validateGRPCRouteRules(key, routeObj, routeStatus)
+	}
 	return true
 }
 
-func validateBackendReference(key string, backend Backend, backendFilters []*Filter, httpRouteNamespace string) (bool, akogatewayapistatus.Condition) {
+func validateBackendReference(key string, backend Backend, backendFilters []*Filter, routeKind, httpRouteNamespace string) (bool, akogatewayapistatus.Condition) {
 	routeConditionResolvedRef := akogatewayapistatus.NewCondition().
 		Type(string(gatewayv1.RouteConditionResolvedRefs)).
 		Status(metav1.ConditionFalse)
@@ -97,6 +282,18 @@ func validateBackendReference(key string, backend Backend, backendFilters []*Fil
 			Message(err.Error())
 		return false, routeConditionResolvedRef
 	}
+
+	// a backend in another namespace must be permitted by a ReferenceGrant in the namespace of the backend
+	from := akogatewayapiobjects.ReferenceGrantFrom{Group: akogatewayapilib.GatewayGroup, Kind: routeKind, Namespace: httpRouteNamespace}
+	to := akogatewayapiobjects.ReferenceGrantTo{Kind: utils.Service, Name: backend.Name}
+	if !akogatewayapiobjects.GatewayApiLister().IsReferencePermitted(from, to, backend.Namespace) {
+		utils.AviLog.Errorf("key: %s, msg: BackendRef %s/%s is not permitted by any ReferenceGrant", key, backend.Namespace, backend.Name)
+		err := fmt.Errorf("backendRef %s/%s is not permitted by any ReferenceGrant", backend.Namespace, backend.Name)
+		routeConditionResolvedRef.
+			Reason(string(gatewayv1.RouteReasonRefNotPermitted)).
+			Message(err.Error())
+		return false, routeConditionResolvedRef
+	}
 	backendRefTenant := lib.GetTenantInNamespace(backend.Namespace)
 	httpRouteTenant := lib.GetTenantInNamespace(httpRouteNamespace)
 
@@ -104,7 +301,7 @@ func validateBackendReference(key string, backend Backend, backendFilters []*Fil
 	if backendRefTenant != httpRouteTenant {
 		utils.AviLog.Errorf("key: %s, msg: BackendRef %s tenant %s is not equal to HTTPRoute tenant %s", key, backend.Name, backendRefTenant, httpRouteTenant)
 		err := fmt.Errorf("backendRef %s tenant %s is not equal to HTTPRoute tenant %s", backend.Name, backendRefTenant, httpRouteTenant)
-		// using RouteReasonRefNotPermitted for now, may change when reference grant is supported
+		// a backend in a namespace of another tenant is not permitted, even by a ReferenceGrant
 		routeConditionResolvedRef.
 			Reason(string(gatewayv1.RouteReasonRefNotPermitted)).
 			Message(err.Error())
@@ -251,19 +448,43 @@ func validateFilterExtensionRef(key, namespace string, filter *ExtensionRefFilte
 // Current behaviour: Any invalid Rule, that HTTPRoute object is not processed.
 // TODO: Need to modify this behaviour by keeping map of rule to valid/invalid for a given route.
 func validateHTTPRouteRules(key string, httpRoute *gatewayv1.HTTPRoute, httpRouteStatus *gatewayv1.HTTPRouteStatus) bool {
+	route := newRouteObject(httpRoute)
 	//Validate Filters
 	//Validate URL Rewrite Filter, ExtensionRef
 	if httpRoute.Spec.Rules != nil {
 		for _, rule := range httpRoute.Spec.Rules {
+			if !validateHTTPRouteMatches(key, rule.Matches, route, httpRouteStatus) {
+				return false
+			}
+			if !validateHTTPRouteTimeoutsAndRetry(key, rule.Timeouts, rule.Retry, route, httpRouteStatus) {
+				return false
+			}
 			extensionRefType := utils.NewSet[string]()
 			for _, filter := range rule.Filters {
 				if filter.Type == gatewayv1.HTTPRouteFilterURLRewrite && filter.URLRewrite != nil && filter.URLRewrite.Path != nil && filter.URLRewrite.Path.Type != gatewayv1.FullPathHTTPPathModifier {
 					setRouteConditionInHTTPRouteStatus(key,
 						string(gatewayv1.RouteReasonUnsupportedValue),
 						"HTTPUrlRewrite PathType has Unsupported value",
-						httpRoute, httpRouteStatus, "False", "Accepted")
+						route, httpRouteStatus, "False", "Accepted")
 					utils.AviLog.Errorf("key: %s, msg: HTTPUrlRewrite PathType has Unsupported value %s.", key, filter.URLRewrite.Path.Type)
 					return false
+				} else if filter.Type == gatewayv1.HTTPRouteFilterRequestMirror && filter.RequestMirror != nil && !isCompleteRequestMirror(filter.RequestMirror) {
+					// traffic clone in Avi clones all the traffic of the virtual service, mirroring a fraction is not possible
+					setRouteConditionInHTTPRouteStatus(key,
+						string(gatewayv1.RouteReasonUnsupportedValue),
+						"RequestMirror filter with percent or fraction other than 100% is not supported",
+						route, httpRouteStatus, "False", "Accepted")
+					utils.AviLog.Errorf("key: %s, msg: RequestMirror filter with percent or fraction other than 100%% is not supported", key)
+					return false
+				} else if filter.Type == gatewayv1.HTTPRouteFilterRequestMirror && filter.RequestMirror != nil && (lib.IsNodePortMode() || lib.GetServiceType() == lib.NodePortLocal) {
+					// traffic is cloned to the IP addresses of the mirror backends at L2, without a port, the mirrored
+					// traffic would reach the nodes instead of the pods with NodePort and NodePortLocal
+					setRouteConditionInHTTPRouteStatus(key,
+						string(gatewayv1.RouteReasonUnsupportedValue),
+						fmt.Sprintf("RequestMirror filter is not supported with the %s service type", lib.GetServiceType()),
+						route, httpRouteStatus, "False", "Accepted")
+					utils.AviLog.Errorf("key: %s, msg: RequestMirror filter is not supported with the %s service type", key, lib.GetServiceType())
+					return false
 				} else if filter.Type == gatewayv1.HTTPRouteFilterExtensionRef && filter.ExtensionRef != nil {
 					// can convert to function
 					// Allows only ako.vmware.com
@@ -280,7 +501,7 @@ func validateHTTPRouteRules(key string, httpRoute *gatewayv1.HTTPRoute, httpRout
 						setRouteConditionInHTTPRouteStatus(key,
 							string(gatewayv1.RouteReasonInvalidKind),
 							fmt.Sprintf("Unsupported kind %s defined on HTTPRoute-Rule", kind),
-							httpRoute, httpRouteStatus, "False", "ResolvedRefs")
+							route, httpRouteStatus, "False", "ResolvedRefs")
 						return false
 					}
 					if extensionRefType.Has(kind) {
@@ -290,7 +511,7 @@ func validateHTTPRouteRules(key string, httpRoute *gatewayv1.HTTPRoute, httpRout
 						setRouteConditionInHTTPRouteStatus(key,
 							string(gatewayv1.RouteReasonInvalidKind),
 							"MultipleExtensionRef of same kind defined on HTTPRoute-Rule",
-							httpRoute, httpRouteStatus, "False", "ResolvedRefs")
+							route, httpRouteStatus, "False", "ResolvedRefs")
 					}
 
 					extensionRefType.Add(kind)
@@ -304,7 +525,7 @@ func validateHTTPRouteRules(key string, httpRoute *gatewayv1.HTTPRoute, httpRout
 							setRouteConditionInHTTPRouteStatus(key,
 								string(gatewayv1.RouteReasonBackendNotFound),
 								err.Error(),
-								httpRoute, httpRouteStatus, "False", "ResolvedRefs")
+								route, httpRouteStatus, "False", "ResolvedRefs")
 						}
 					}
 				}
@@ -337,13 +558,154 @@ func validateHTTPRouteRules(key string, httpRoute *gatewayv1.HTTPRoute, httpRout
 	return true
 }
 
-func setRouteConditionInHTTPRouteStatus(key, reason, msg string, httpRoute *gatewayv1.HTTPRoute, httpRouteStatus *gatewayv1.HTTPRouteStatus, conditionStatus, conditionType string) {
-	for parentRefIndexFromSpec := range httpRoute.Spec.ParentRefs {
+func isCompleteRequestMirror(mirror *gatewayv1.HTTPRequestMirrorFilter) bool {
+	if mirror.Percent != nil && *mirror.Percent != 100 {
+		return false
+	}
+	if mirror.Fraction != nil {
+		denominator := int32(100)
+		if mirror.Fraction.Denominator != nil {
+			denominator = *mirror.Fraction.Denominator
+		}
+		if mirror.Fraction.Numerator != denominator {
+			return false
+		}
+	}
+	return true
+}
+
+// validateHTTPRouteMatches rejects the query param and method matches which can not be translated
+// to the match criteria of the Avi objects, instead of matching more traffic than intended.
+func validateHTTPRouteMatches(key string, matches []gatewayv1.HTTPRouteMatch, route *routeObject, httpRouteStatus *gatewayv1.HTTPRouteStatus) bool {
+	for _, match := range matches {
+		for _, queryParam := range match.QueryParams {
+			if queryParam.Type != nil && *queryParam.Type != gatewayv1.QueryParamMatchExact {
+				setRouteConditionInHTTPRouteStatus(key,
+					string(gatewayv1.RouteReasonUnsupportedValue),
+					fmt.Sprintf("HTTPRoute QueryParam match type %s is not supported", *queryParam.Type),
+					route, httpRouteStatus, "False", "Accepted")
+				utils.AviLog.Errorf("key: %s, msg: HTTPRoute QueryParam match type %s is not supported for query param %s", key, *queryParam.Type, queryParam.Name)
+				return false
+			}
+		}
+		if match.Method != nil {
+			if _, ok := SupportedMethodsOnHTTPRouteMatch[string(*match.Method)]; !ok {
+				setRouteConditionInHTTPRouteStatus(key,
+					string(gatewayv1.RouteReasonUnsupportedValue),
+					fmt.Sprintf("HTTPRoute Method match %s is not supported", *match.Method),
+					route, httpRouteStatus, "False", "Accepted")
+				utils.AviLog.Errorf("key: %s, msg: HTTPRoute Method match %s is not supported", key, *match.Method)
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validateHTTPRouteTimeoutsAndRetry rejects the timeouts and retry configurations of a rule which
+// can not be set on the pools of the rule.
+func validateHTTPRouteTimeoutsAndRetry(key string, timeouts *gatewayv1.HTTPRouteTimeouts, retry *gatewayv1.HTTPRouteRetry, route *routeObject, httpRouteStatus *gatewayv1.HTTPRouteStatus) bool {
+	msg := getUnsupportedTimeoutsAndRetryMessage(timeouts, retry)
+	if msg == "" {
+		return true
+	}
+	setRouteConditionInHTTPRouteStatus(key,
+		string(gatewayv1.RouteReasonUnsupportedValue),
+		msg,
+		route, httpRouteStatus, "False", "Accepted")
+	utils.AviLog.Errorf("key: %s, msg: %s", key, msg)
+	return false
+}
+
+func getUnsupportedTimeoutsAndRetryMessage(timeouts *gatewayv1.HTTPRouteTimeouts, retry *gatewayv1.HTTPRouteRetry) string {
+	var request, backendRequest *uint32
+	var err error
+	if timeouts != nil {
+		if request, err = parseGatewayDurationToMilliseconds(timeouts.Request); err != nil {
+			return fmt.Sprintf("HTTPRoute Request timeout is not supported: %s", err.Error())
+		}
+		if backendRequest, err = parseGatewayDurationToMilliseconds(timeouts.BackendRequest); err != nil {
+			return fmt.Sprintf("HTTPRoute BackendRequest timeout is not supported: %s", err.Error())
+		}
+		// zero value of a timeout disables it
+		if request != nil && *request != 0 && backendRequest != nil && *backendRequest > *request {
+			return fmt.Sprintf("HTTPRoute BackendRequest timeout %s must not be greater than Request timeout %s", *timeouts.BackendRequest, *timeouts.Request)
+		}
+	}
+	if retry == nil || (retry.Attempts != nil && *retry.Attempts == 0) {
+		return ""
+	}
+	if retry.Backoff != nil {
+		return "HTTPRoute Retry backoff is not supported"
+	}
+	if backendRequest != nil && time.Duration(*backendRequest)*time.Millisecond > maxPoolRetryTimeout {
+		return fmt.Sprintf("HTTPRoute BackendRequest timeout %s with Retry exceeds the maximum supported value of %s", *timeouts.BackendRequest, maxPoolRetryTimeout)
+	}
+	// The server reselect of the pool can not bound the total time of all the attempts of a request,
+	// so the Request timeout is supported only if it covers the BackendRequest timeout of every attempt.
+	if request != nil && *request != 0 {
+		if retry.Attempts == nil || backendRequest == nil || *backendRequest == 0 {
+			return "HTTPRoute Request timeout with Retry requires BackendRequest timeout and Retry attempts to be set"
+		}
+		if uint64(*backendRequest)*uint64(*retry.Attempts+1) > uint64(*request) {
+			return fmt.Sprintf("HTTPRoute Request timeout %s is less than BackendRequest timeout %s for %d Retry attempts", *timeouts.Request, *timeouts.BackendRequest, *retry.Attempts)
+		}
+	}
+	return ""
+}
+
+func validateGRPCRouteRules(key string, grpcRoute *gatewayv1.GRPCRoute, routeStatus *gatewayv1.HTTPRouteStatus) bool {
+	route := newRouteObject(grpcRoute)
+	for _, rule := range grpcRoute.Spec.Rules {
+		for _, filter := range rule.Filters {
+			if filter.Type != gatewayv1.GRPCRouteFilterRequestHeaderModifier && filter.Type != gatewayv1.GRPCRouteFilterResponseHeaderModifier {
+				setRouteConditionInHTTPRouteStatus(key,
+					string(gatewayv1.RouteReasonUnsupportedValue),
+					fmt.Sprintf("GRPCRoute filter type %s is not supported", filter.Type),
+					route, routeStatus, "False", "Accepted")
+				utils.AviLog.Errorf("key: %s, msg: GRPCRoute filter type %s is not supported", key, filter.Type)
+				return false
+			}
+		}
+		for _, backendRef := range rule.BackendRefs {
+			if len(backendRef.Filters) > 0 {
+				setRouteConditionInHTTPRouteStatus(key,
+					string(gatewayv1.RouteReasonUnsupportedValue),
+					"Filters on GRPCRoute BackendRef are not supported",
+					route, routeStatus, "False", "Accepted")
+				utils.AviLog.Errorf("key: %s, msg: Filters on GRPCRoute BackendRef %s are not supported", key, backendRef.Name)
+				return false
+			}
+		}
+		if rule.SessionPersistence != nil {
+			if rule.SessionPersistence.Type != nil && *rule.SessionPersistence.Type == gatewayv1.HeaderBasedSessionPersistence {
+				setRouteConditionInHTTPRouteStatus(key,
+					string(gatewayv1.RouteReasonUnsupportedValue),
+					"Header based session persistence type is not supported",
+					route, routeStatus, "False", "Accepted")
+				utils.AviLog.Errorf("key: %s, msg: Header based session persistence type is not supported ", key)
+				return false
+			}
+			if rule.SessionPersistence.SessionName == nil || *rule.SessionPersistence.SessionName == "" {
+				setRouteConditionInHTTPRouteStatus(key,
+					string(gatewayv1.RouteReasonUnsupportedValue),
+					"Session Name is needed in SessionPersistence",
+					route, routeStatus, "False", "Accepted")
+				utils.AviLog.Errorf("key: %s, msg: Session Name is needed in SessionPersistence", key)
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func setRouteConditionInHTTPRouteStatus(key, reason, msg string, route *routeObject, httpRouteStatus *gatewayv1.HTTPRouteStatus, conditionStatus, conditionType string) {
+	for parentRefIndexFromSpec := range route.Spec.ParentRefs {
 		// creates the Parent status only when the AKO is the gateway controller
-		name := string(httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].Name)
-		namespace := httpRoute.Namespace
-		if httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].Namespace != nil {
-			namespace = string(*httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].Namespace)
+		name := string(route.Spec.ParentRefs[parentRefIndexFromSpec].Name)
+		namespace := route.Namespace
+		if route.Spec.ParentRefs[parentRefIndexFromSpec].Namespace != nil {
+			namespace = string(*route.Spec.ParentRefs[parentRefIndexFromSpec].Namespace)
 		}
 		obj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GatewayInformer.Lister().Gateways(namespace).Get(name)
 		if err != nil {
@@ -355,7 +717,7 @@ func setRouteConditionInHTTPRouteStatus(key, reason, msg string, httpRoute *gate
 		gwClass := string(gateway.Spec.GatewayClassName)
 		_, isAKOCtrl := akogatewayapiobjects.GatewayApiLister().IsGatewayClassControllerAKO(gwClass)
 		if !isAKOCtrl {
-			utils.AviLog.Warnf("key: %s, msg: controller for the parent reference %s of HTTPRoute object %s is not ako", key, name, httpRoute.Name)
+			utils.AviLog.Warnf("key: %s, msg: controller for the parent reference %s of %s object %s is not ako", key, name, route.Kind, route.Name)
 			continue
 		}
 
@@ -365,14 +727,14 @@ func setRouteConditionInHTTPRouteStatus(key, reason, msg string, httpRoute *gate
 		httpRouteStatus.Parents[parentRefIndexFromSpec].ControllerName = akogatewayapilib.GatewayController
 		httpRouteStatus.Parents[parentRefIndexFromSpec].ParentRef.Name = gatewayv1.ObjectName(name)
 		httpRouteStatus.Parents[parentRefIndexFromSpec].ParentRef.Namespace = (*gatewayv1.Namespace)(&namespace)
-		if httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].SectionName != nil {
-			httpRouteStatus.Parents[parentRefIndexFromSpec].ParentRef.SectionName = httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].SectionName
+		if route.Spec.ParentRefs[parentRefIndexFromSpec].SectionName != nil {
+			httpRouteStatus.Parents[parentRefIndexFromSpec].ParentRef.SectionName = route.Spec.ParentRefs[parentRefIndexFromSpec].SectionName
 		}
 		if conditionType == string(gatewayv1.RouteConditionResolvedRefs) {
 			routeResolvedRef := akogatewayapistatus.NewCondition().
 				Type(string(gatewayv1.RouteConditionResolvedRefs)).
 				Status(metav1.ConditionStatus(conditionStatus)).
-				ObservedGeneration(httpRoute.ObjectMeta.Generation).
+				ObservedGeneration(route.ObjectMeta.Generation).
 				Reason(reason).
 				Message(msg)
 			routeResolvedRef.SetIn(&httpRouteStatus.Parents[parentRefIndexFromSpec].Conditions)
@@ -380,7 +742,7 @@ func setRouteConditionInHTTPRouteStatus(key, reason, msg string, httpRoute *gate
 			routeConditionAccepted := akogatewayapistatus.NewCondition().
 				Type(string(gatewayv1.RouteConditionAccepted)).
 				Status(metav1.ConditionFalse).
-				ObservedGeneration(httpRoute.ObjectMeta.Generation).
+				ObservedGeneration(route.ObjectMeta.Generation).
 				Reason(reason).
 				Message(msg)
 			routeConditionAccepted.SetIn(&httpRouteStatus.Parents[parentRefIndexFromSpec].Conditions)
@@ -394,7 +756,7 @@ func setResolvedRefConditionInHTTPRouteStatus(key string, routeConditionResolved
 	}
 	httpRouteStatus := akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(routeTypeNamespaceName)
 	if httpRouteStatus == nil || len(httpRouteStatus.Parents) == 0 {
-		utils.AviLog.Warnf("key: %s, msg: HTTPRoute status not initialized yet for %s", key, routeTypeNamespaceName)
+		utils.AviLog.Warnf("key: %s, msg: Route status not initialized yet for %s", key, routeTypeNamespaceName)
 		return
 	}
 	// TODO: Use HTTPRoute status instead of status from cache
@@ -417,21 +779,21 @@ func setResolvedRefConditionInHTTPRouteStatus(key string, routeConditionResolved
 			routeConditionResolvedRef.SetIn(&httpRouteStatus.Parents[parentRefIndex].Conditions)
 		}
 	}
-	_, namespace, name := lib.ExtractTypeNameNamespace(routeTypeNamespaceName)
-	httpRoute, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().HTTPRouteInformer.Lister().HTTPRoutes(namespace).Get(name)
+	routeType, namespace, name := lib.ExtractTypeNameNamespace(routeTypeNamespaceName)
+	route, err := getRouteObject(routeType, namespace, name)
 	if err != nil {
-		utils.AviLog.Warnf("key: %s, msg: Unable to extract the HTTPRoute object %s for BackendRef validation", key, name)
+		utils.AviLog.Warnf("key: %s, msg: Unable to extract the %s object %s for BackendRef validation", key, routeType, name)
 		return
 	}
-	akogatewayapistatus.Record(key, httpRoute, &status.Status{HTTPRouteStatus: httpRouteStatus})
+	akogatewayapistatus.Record(key, route.obj, &status.Status{HTTPRouteStatus: httpRouteStatus})
 }
 
-func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRouteStatus *gatewayv1.HTTPRouteStatus, parentRefIndexFromSpec int, parentRefIndexInHttpRouteStatus *int, indexInCache *int) error {
+func validateParentReference(key string, route *routeObject, httpRouteStatus *gatewayv1.HTTPRouteStatus, parentRefIndexFromSpec int, parentRefIndexInHttpRouteStatus *int, indexInCache *int) error {
 
-	name := string(httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].Name)
-	namespace := httpRoute.Namespace
-	if httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].Namespace != nil {
-		namespace = string(*httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].Namespace)
+	name := string(route.Spec.ParentRefs[parentRefIndexFromSpec].Name)
+	namespace := route.Namespace
+	if route.Spec.ParentRefs[parentRefIndexFromSpec].Namespace != nil {
+		namespace = string(*route.Spec.ParentRefs[parentRefIndexFromSpec].Namespace)
 	}
 	gwNsName := namespace + "/" + name
 	obj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GatewayInformer.Lister().Gateways(namespace).Get(name)
@@ -444,8 +806,8 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 	gwClass := string(gateway.Spec.GatewayClassName)
 	_, isAKOCtrl := akogatewayapiobjects.GatewayApiLister().IsGatewayClassControllerAKO(gwClass)
 	if !isAKOCtrl {
-		utils.AviLog.Warnf("key: %s, msg: controller for the parent reference %s of HTTPRoute object %s is not ako", key, name, httpRoute.Name)
-		return fmt.Errorf("controller for the parent reference %s of HTTPRoute object %s is not ako", name, httpRoute.Name)
+		utils.AviLog.Warnf("key: %s, msg: controller for the parent reference %s of %s object %s is not ako", key, name, route.Kind, route.Name)
+		return fmt.Errorf("controller for the parent reference %s of %s object %s is not ako", name, route.Kind, route.Name)
 	}
 	// creates the Parent status only when the AKO is the gateway controller
 	if len(httpRouteStatus.Parents) <= *parentRefIndexInHttpRouteStatus {
@@ -454,10 +816,10 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 	httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].ControllerName = akogatewayapilib.GatewayController
 	httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].ParentRef.Name = gatewayv1.ObjectName(name)
 	httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].ParentRef.Namespace = (*gatewayv1.Namespace)(&namespace)
-	if httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].SectionName != nil {
-		httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].ParentRef.SectionName = httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].SectionName
+	if route.Spec.ParentRefs[parentRefIndexFromSpec].SectionName != nil {
+		httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].ParentRef.SectionName = route.Spec.ParentRefs[parentRefIndexFromSpec].SectionName
 	}
-	routeTypeNsName := lib.HTTPRoute + "/" + httpRoute.Namespace + "/" + httpRoute.Name
+	routeTypeNsName := route.Kind + "/" + route.Namespace + "/" + route.Name
 	routeStatusInCache := akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(routeTypeNsName)
 	if *indexInCache != -1 && routeStatusInCache != nil {
 		if *indexInCache < len(routeStatusInCache.Parents) {
@@ -469,7 +831,7 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 	defaultCondition := akogatewayapistatus.NewCondition().
 		Type(string(gatewayv1.RouteConditionAccepted)).
 		Status(metav1.ConditionFalse).
-		ObservedGeneration(httpRoute.ObjectMeta.Generation)
+		ObservedGeneration(route.ObjectMeta.Generation)
 
 	gwStatus := akogatewayapiobjects.GatewayApiLister().GetGatewayToGatewayStatusMapping(gwNsName)
 	if len(gwStatus.Conditions) == 0 {
@@ -486,15 +848,18 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 	dedicatedGatewayMode := akogatewayapilib.IsGatewayInDedicatedMode(namespace, name)
 	if dedicatedGatewayMode {
 		var err error
-		if len(httpRoute.Spec.ParentRefs) > 1 {
-			utils.AviLog.Errorf("key: %s, msg: Dedicated Gateway Mode is enabled. Only one parent reference is allowed in HTTPRoute %s", key, httpRoute.Name)
-			err = fmt.Errorf("Dedicated Gateway Mode is enabled. Only one parent reference is allowed in HTTPRoute")
-		} else if httpRoute.Spec.ParentRefs[0].Namespace != nil && string(*httpRoute.Spec.ParentRefs[0].Namespace) != namespace {
-			utils.AviLog.Errorf("key: %s, msg: Dedicated Gateway Mode is enabled. Parent Reference %s is not in the same namespace as HTTPRoute %s", key, name, httpRoute.Name)
-			err = fmt.Errorf("Dedicated Gateway Mode is enabled. Parent Reference %s is not in the same namespace as HTTPRoute %s", name, httpRoute.Name)
-		} else if len(httpRoute.Spec.Hostnames) > 0 {
-			utils.AviLog.Errorf("key: %s, msg: Dedicated Gateway Mode is enabled. Hostnames are not allowed in HTTPRoute %s", key, httpRoute.Name)
-			err = fmt.Errorf("Dedicated Gateway Mode is enabled. Hostnames are not allowed in HTTPRoute")
+		if len(route.Spec.ParentRefs) > 1 {
+			utils.AviLog.Errorf("key: %s, msg: Dedicated Gateway Mode is enabled. Only one parent reference is allowed in %s %s", key, route.Kind, route.Name)
+			err = fmt.Errorf("Dedicated Gateway Mode is enabled. Only one parent reference is allowed in %s", route.Kind)
+		} else if route.Spec.ParentRefs[0].Namespace != nil && string(*route.Spec.ParentRefs[0].Namespace) != namespace {
+			utils.AviLog.Errorf("key: %s, msg: Dedicated Gateway Mode is enabled. Parent Reference %s is not in the same namespace as %s %s", key, name, route.Kind, route.Name)
+			err = fmt.Errorf("Dedicated Gateway Mode is enabled. Parent Reference %s is not in the same namespace as %s %s", name, route.Kind, route.Name)
+		} else if len(route.Spec.Hostnames) > 0 {
+			utils.AviLog.Errorf("key: %s, msg: Dedicated Gateway Mode is enabled. Hostnames are not allowed in %s %s", key, route.Kind, route.Name)
+			err = fmt.Errorf("Dedicated Gateway Mode is enabled. Hostnames are not allowed in %s", route.Kind)
+		} else if hasRequestMirrorFilter(route) {
+			utils.AviLog.Errorf("key: %s, msg: Dedicated Gateway Mode is enabled. RequestMirror filter is not supported in %s %s", key, route.Kind, route.Name)
+			err = fmt.Errorf("Dedicated Gateway Mode is enabled. RequestMirror filter is not supported in %s", route.Kind)
 		}
 		if err != nil {
 			defaultCondition.
@@ -507,10 +872,10 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 	}
 
 	// If Gateway and HTTPRoute are in different namespace, validate that both namespaces are scoped to the same tenant
-	if httpRoute.Namespace != namespace {
-		if lib.GetTenantInNamespace(httpRoute.Namespace) != lib.GetTenantInNamespace(namespace) {
-			utils.AviLog.Errorf("key: %s, msg: Tenant mismatch between HTTPRoute %s and Parent Reference %s", key, httpRoute.GetName(), name)
-			err := fmt.Errorf("Tenant mismatch between HTTPRoute %s and Parent Reference %s", httpRoute.GetName(), name)
+	if route.Namespace != namespace {
+		if lib.GetTenantInNamespace(route.Namespace) != lib.GetTenantInNamespace(namespace) {
+			utils.AviLog.Errorf("key: %s, msg: Tenant mismatch between %s %s and Parent Reference %s", key, route.Kind, route.GetName(), name)
+			err := fmt.Errorf("Tenant mismatch between %s %s and Parent Reference %s", route.Kind, route.GetName(), name)
 			defaultCondition.
 				Reason(string(gatewayv1.RouteReasonPending)).
 				Message(err.Error()).
@@ -520,6 +885,24 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 		}
 	}
 
+	// Hostnames claimed by an AviInfraSetting are allowed only in the namespaces they are claimed for
+	if akogatewayapilib.AKOControlConfig().AviInfraSettingEnabled() {
+		for _, host := range route.Spec.Hostnames {
+			err := lib.CheckFQDNClaim(string(host), route.Namespace)
+			if err == nil {
+				continue
+			}
+			utils.AviLog.Errorf("key: %s, msg: %s %s is not accepted, err: %v", key, route.Kind, route.Name, err)
+			akogatewayapilib.AKOControlConfig().EventRecorder().Event(route.obj, corev1.EventTypeWarning, lib.HostNotClaimed, err.Error())
+			defaultCondition.
+				Reason(lib.HostNotClaimed).
+				Message(err.Error()).
+				SetIn(&httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].Conditions)
+			*parentRefIndexInHttpRouteStatus = *parentRefIndexInHttpRouteStatus + 1
+			return err
+		}
+	}
+
 	// Attach only when gateway configuration is valid
 	currentGatewayStatusCondition := gwStatus.Conditions[0]
 	if currentGatewayStatusCondition.Status != metav1.ConditionTrue {
@@ -536,8 +919,8 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 
 	//section name is optional
 	var listenersForRoute []gatewayv1.Listener
-	if httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].SectionName != nil {
-		listenerName := *httpRoute.Spec.ParentRefs[parentRefIndexFromSpec].SectionName
+	if route.Spec.ParentRefs[parentRefIndexFromSpec].SectionName != nil {
+		listenerName := *route.Spec.ParentRefs[parentRefIndexFromSpec].SectionName
 		i := akogatewayapilib.FindListenerByName(string(listenerName), gateway.Spec.Listeners)
 		if i == -1 {
 			// listener is not present in gateway
@@ -565,6 +948,24 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 	} else {
 		listenersForRoute = append(listenersForRoute, gateway.Spec.Listeners...)
 	}
+	// route can only attach to the listeners supporting its kind, for example TLSRoute to TLS listeners
+	var listenersAllowingRoute []gatewayv1.Listener
+	for _, listenerObj := range listenersForRoute {
+		if akogatewayapilib.IsRouteKindSupported(listenerObj.Protocol, route.Kind) {
+			listenersAllowingRoute = append(listenersAllowingRoute, listenerObj)
+		}
+	}
+	if len(listenersAllowingRoute) == 0 {
+		utils.AviLog.Errorf("key: %s, msg: no listener in Parent Reference %s supports the %s %s", key, name, route.Kind, route.Name)
+		err := fmt.Errorf("No listener in the Gateway supports the route kind %s", route.Kind)
+		defaultCondition.
+			Reason(string(gatewayv1.RouteReasonNotAllowedByListeners)).
+			Message(err.Error()).
+			SetIn(&httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].Conditions)
+		*parentRefIndexInHttpRouteStatus = *parentRefIndexInHttpRouteStatus + 1
+		return err
+	}
+	listenersForRoute = listenersAllowingRoute
 	// TODO: Validation for hostname (those being fqdns) need to validate as per the K8 gateway req.
 	// Here I need to check
 	var listenersMatchedToRoute []gatewayv1.Listener
@@ -577,8 +978,11 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 		// Use case to handle for validations of hostname:
 		// USe case 1: Shouldn't contain mor than 1 *
 		// USe case 2: * should be at the beginning only
-		if hostInListener == nil || *hostInListener == "" || *hostInListener == utils.WILDCARD {
-			if len(httpRoute.Spec.Hostnames) != 0 || dedicatedGatewayMode {
+		if akogatewayapilib.IsL4Route(route.Kind) {
+			// hostname of the listener does not apply to TCPRoute and UDPRoute
+			matched = true
+		} else if hostInListener == nil || *hostInListener == "" || *hostInListener == utils.WILDCARD {
+			if len(route.Spec.Hostnames) != 0 || dedicatedGatewayMode {
 				matched = true
 			}
 		} else {
@@ -586,7 +990,7 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 			if hostInListener != nil && strings.HasPrefix(string(*hostInListener), utils.WILDCARD) {
 				isListenerFqdnWildcard = true
 			}
-			for _, host := range httpRoute.Spec.Hostnames {
+			for _, host := range route.Spec.Hostnames {
 				// casese to consider:
 				// Case 1: hostname of gateway is wildcard(empty) and hostname from httproute is not wild card
 				// Case 2: hostname of gateway is not wild card and hostname from httproute is wildcard
@@ -624,12 +1028,12 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 
 			}
 			// if there are no hostnames specified, all parent listneres should be matched.
-			if len(httpRoute.Spec.Hostnames) == 0 && (hostInListener != nil && *hostInListener != "" && *hostInListener != utils.WILDCARD) {
+			if len(route.Spec.Hostnames) == 0 && (hostInListener != nil && *hostInListener != "" && *hostInListener != utils.WILDCARD) {
 				matched = true
 			}
 		}
 		if !matched {
-			utils.AviLog.Warnf("key: %s, msg: Gateway object %s don't have any listeners that matches the hostnames in HTTPRoute %s", key, gateway.Name, httpRoute.Name)
+			utils.AviLog.Warnf("key: %s, msg: Gateway object %s don't have any listeners that matches the hostnames in %s %s", key, gateway.Name, route.Kind, route.Name)
 			continue
 		}
 		listenersMatchedToRoute = append(listenersMatchedToRoute, listenerObj)
@@ -641,11 +1045,11 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 			Message(err.Error()).
 			SetIn(&httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].Conditions)
 		*parentRefIndexInHttpRouteStatus = *parentRefIndexInHttpRouteStatus + 1
-		gwRouteNsName := fmt.Sprintf("%s/%s/%s/%s", gwNsName, lib.HTTPRoute, httpRoute.Namespace, httpRoute.Name)
+		gwRouteNsName := fmt.Sprintf("%s/%s", gwNsName, routeTypeNsName)
 		found, hosts := akogatewayapiobjects.GatewayApiLister().GetGatewayRouteToHostname(gwRouteNsName)
 		if found {
 			utils.AviLog.Warnf("key: %s, msg: Hostname in Gateway Listener doesn't match with any of the hostnames in HTTPRoute", key)
-			utils.AviLog.Debugf("key: %s, msg: %d hosts mapped to the route %s/%s/%s", key, len(hosts), "HTTPRoute", httpRoute.Namespace, httpRoute.Name)
+			utils.AviLog.Debugf("key: %s, msg: %d hosts mapped to the route %s", key, len(hosts), routeTypeNsName)
 			return nil
 		}
 		return err
@@ -713,7 +1117,7 @@ func validateParentReference(key string, httpRoute *gatewayv1.HTTPRoute, httpRou
 		Status(metav1.ConditionTrue).
 		Message(acceptedConditionMessage).
 		SetIn(&httpRouteStatus.Parents[*parentRefIndexInHttpRouteStatus].Conditions)
-	utils.AviLog.Infof("key: %s, msg: Parent Reference %s of HTTPRoute object %s is valid", key, name, httpRoute.Name)
+	utils.AviLog.Infof("key: %s, msg: Parent Reference %s of %s object %s is valid", key, name, route.Kind, route.Name)
 	*parentRefIndexInHttpRouteStatus = *parentRefIndexInHttpRouteStatus + 1
 	return nil
 }
@@ -63,6 +63,7 @@ func GatewayApiLister() *GWLister {
 			httpRouteToRouteBackendExtensionCache: objects.NewObjectMapStore(),
 			appProfileToHTTPRouteCache:            objects.NewObjectMapStore(),
 			httpRouteToAppProfileCache:            objects.NewObjectMapStore(),
+			namespaceToReferenceGrant:             objects.NewObjectMapStore(),
 		}
 	})
 	return gwLister
@@ -162,6 +163,10 @@ type GWLister struct {
 
 	// HTTPRoute --> ApplicationProfile
 	httpRouteToAppProfileCache *objects.ObjectMapStore
+
+	// ReferenceGrants permitting references to the objects of a namespace
+	// grantNs -> {grantName -> ReferenceGrantStore}
+	namespaceToReferenceGrant *objects.ObjectMapStore
 }
 
 type GatewayRouteKind struct {
@@ -178,6 +183,27 @@ type GatewayListenerStore struct {
 	AllowedRouteTypes []GatewayRouteKind
 }
 
+// ReferenceGrantFrom identifies the kind and namespace of the objects that are allowed to refer
+// to the objects in the namespace of a ReferenceGrant.
+type ReferenceGrantFrom struct {
+	Group     string
+	Kind      string
+	Namespace string
+}
+
+// ReferenceGrantTo identifies the objects in the namespace of a ReferenceGrant that can be referred.
+// An empty Name refers to all the objects of the kind.
+type ReferenceGrantTo struct {
+	Group string
+	Kind  string
+	Name  string
+}
+
+type ReferenceGrantStore struct {
+	From []ReferenceGrantFrom
+	To   []ReferenceGrantTo
+}
+
 // This struct is used to store HTTPPS, PG, Pool associated with Parent VS (HTTPRoute that is mapped to parent VS)
 
 type HTTPPSPGPool struct {
@@ -688,6 +714,75 @@ func (g *GWLister) UpdateGatewayToSecret(gwNsName string, secretNsNameList []str
 	}
 }
 
+//=====All ReferenceGrant functions go here
+
+func (g *GWLister) GetReferenceGrant(grantNs, grantName string) (bool, ReferenceGrantStore) {
+	g.gwLock.RLock()
+	defer g.gwLock.RUnlock()
+	if found, obj := g.namespaceToReferenceGrant.Get(grantNs); found {
+		grant, ok := obj.(map[string]ReferenceGrantStore)[grantName]
+		return ok, grant
+	}
+	return false, ReferenceGrantStore{}
+}
+
+func (g *GWLister) UpdateReferenceGrant(grantNs, grantName string, grant ReferenceGrantStore) {
+	g.gwLock.Lock()
+	defer g.gwLock.Unlock()
+	grants := make(map[string]ReferenceGrantStore)
+	if found, obj := g.namespaceToReferenceGrant.Get(grantNs); found {
+		grants = obj.(map[string]ReferenceGrantStore)
+	}
+	grants[grantName] = grant
+	g.namespaceToReferenceGrant.AddOrUpdate(grantNs, grants)
+}
+
+func (g *GWLister) DeleteReferenceGrant(grantNs, grantName string) {
+	g.gwLock.Lock()
+	defer g.gwLock.Unlock()
+	if found, obj := g.namespaceToReferenceGrant.Get(grantNs); found {
+		grants := obj.(map[string]ReferenceGrantStore)
+		delete(grants, grantName)
+		if len(grants) == 0 {
+			g.namespaceToReferenceGrant.Delete(grantNs)
+		}
+	}
+}
+
+// IsReferencePermitted returns true if an object identified by from can refer to the object identified by
+// to in the namespace toNs. References within a namespace are always permitted, a cross namespace reference
+// is permitted only if a ReferenceGrant in toNs allows it.
+func (g *GWLister) IsReferencePermitted(from ReferenceGrantFrom, to ReferenceGrantTo, toNs string) bool {
+	if from.Namespace == toNs {
+		return true
+	}
+	g.gwLock.RLock()
+	defer g.gwLock.RUnlock()
+	found, obj := g.namespaceToReferenceGrant.Get(toNs)
+	if !found {
+		return false
+	}
+	for _, grant := range obj.(map[string]ReferenceGrantStore) {
+		fromMatched := false
+		for _, grantFrom := range grant.From {
+			if grantFrom == from {
+				fromMatched = true
+				break
+			}
+		}
+		if !fromMatched {
+			continue
+		}
+		for _, grantTo := range grant.To {
+			if grantTo.Group == to.Group && grantTo.Kind == to.Kind &&
+				(grantTo.Name == "" || grantTo.Name == to.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (g *GWLister) DeleteGatewayFromStore(gwNsName string) {
 	g.gwLock.Lock()
 	defer g.gwLock.Unlock()
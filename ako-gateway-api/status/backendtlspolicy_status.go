@@ -0,0 +1,172 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/status"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+type backendTLSPolicy struct{}
+
+func (o *backendTLSPolicy) Delete(key string, option status.StatusOptions) {
+	// The status of a deleted BackendTLSPolicy is not updated
+}
+
+func (o *backendTLSPolicy) Update(key string, option status.StatusOptions) {
+	policy, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().BackendTLSPolicyInformer.Lister().BackendTLSPolicies(option.Namespace).Get(option.ObjName)
+	if err != nil {
+		utils.AviLog.Debugf("key: %s, msg: BackendTLSPolicy %s/%s not found, err: %v", key, option.Namespace, option.ObjName, err)
+		return
+	}
+	o.Patch(key, policy, &status.Status{PolicyStatus: o.buildStatus(key, policy)})
+}
+
+func (o *backendTLSPolicy) BulkUpdate(key string, options []status.StatusOptions) {
+	for _, option := range options {
+		o.Update(key, option)
+	}
+}
+
+// buildStatus returns the status of the BackendTLSPolicy, with the Gateways of the Routes using the targeted
+// Services as backends as the ancestors.
+func (o *backendTLSPolicy) buildStatus(key string, policy *gatewayv1alpha3.BackendTLSPolicy) *gatewayv1alpha2.PolicyStatus {
+	conditionStatus := metav1.ConditionTrue
+	reason := gatewayv1alpha2.PolicyReasonAccepted
+	message := "BackendTLSPolicy is accepted"
+
+	gateways := sets.New[string]()
+	conflicted := false
+	for _, targetRef := range policy.Spec.TargetRefs {
+		if !akogatewayapilib.IsBackendTLSPolicyTargetingService(policy, string(targetRef.Name)) {
+			continue
+		}
+		if policies := akogatewayapilib.GetBackendTLSPoliciesForService(policy.Namespace, string(targetRef.Name)); len(policies) > 0 && policies[0].Name != policy.Name {
+			conflicted = true
+		}
+		_, gwNsNames := akogatewayapiobjects.GatewayApiLister().GetServiceToGateway(policy.Namespace + "/" + string(targetRef.Name))
+		gateways.Insert(gwNsNames...)
+	}
+	if _, err := akogatewayapilib.GetBackendTLSPolicyCACert(key, policy); err != nil {
+		conditionStatus = metav1.ConditionFalse
+		reason = gatewayv1alpha2.PolicyReasonInvalid
+		message = err.Error()
+	} else if conflicted {
+		conditionStatus = metav1.ConditionFalse
+		reason = gatewayv1alpha2.PolicyReasonConflicted
+		message = "Service is targeted by an older BackendTLSPolicy"
+	}
+
+	policyStatus := &gatewayv1alpha2.PolicyStatus{Ancestors: []gatewayv1alpha2.PolicyAncestorStatus{}}
+	existingConditions := make(map[string][]metav1.Condition)
+	for _, ancestor := range policy.Status.Ancestors {
+		if string(ancestor.ControllerName) != akogatewayapilib.GatewayController {
+			policyStatus.Ancestors = append(policyStatus.Ancestors, ancestor)
+			continue
+		}
+		if ancestor.AncestorRef.Namespace != nil {
+			existingConditions[string(*ancestor.AncestorRef.Namespace)+"/"+string(ancestor.AncestorRef.Name)] = ancestor.Conditions
+		}
+	}
+	gatewayGroup := gatewayv1.Group(akogatewayapilib.GatewayGroup)
+	gatewayKind := gatewayv1.Kind(lib.Gateway)
+	for _, gwNsName := range sets.List(gateways) {
+		gwNs, gwName := utils.ExtractNamespaceObjectName(gwNsName)
+		ancestor := gatewayv1alpha2.PolicyAncestorStatus{
+			AncestorRef: gatewayv1.ParentReference{
+				Group:     &gatewayGroup,
+				Kind:      &gatewayKind,
+				Namespace: (*gatewayv1.Namespace)(&gwNs),
+				Name:      gatewayv1.ObjectName(gwName),
+			},
+			ControllerName: akogatewayapilib.GatewayController,
+			Conditions:     slices.Clone(existingConditions[gwNsName]),
+		}
+		NewCondition().
+			Type(string(gatewayv1alpha2.PolicyConditionAccepted)).
+			Status(conditionStatus).
+			Reason(string(reason)).
+			Message(message).
+			ObservedGeneration(policy.Generation).
+			SetIn(&ancestor.Conditions)
+		policyStatus.Ancestors = append(policyStatus.Ancestors, ancestor)
+	}
+	return policyStatus
+}
+
+func (o *backendTLSPolicy) Patch(key string, obj runtime.Object, status *status.Status, retryNum ...int) error {
+	retry := 0
+	if len(retryNum) > 0 {
+		retry = retryNum[0]
+		if retry >= 5 {
+			utils.AviLog.Errorf("key: %s, msg: Patch retried 5 times, aborting", key)
+			return errors.New("Patch retried 5 times, aborting")
+		}
+	}
+
+	policy := obj.(*gatewayv1alpha3.BackendTLSPolicy)
+	if o.isStatusEqual(&policy.Status, status.PolicyStatus) {
+		return nil
+	}
+
+	patchPayload, _ := json.Marshal(map[string]interface{}{
+		"status": status.PolicyStatus,
+	})
+	_, err := akogatewayapilib.AKOControlConfig().GatewayAPIClientset().GatewayV1alpha3().BackendTLSPolicies(policy.Namespace).Patch(context.TODO(), policy.Name, types.MergePatchType, patchPayload, metav1.PatchOptions{}, "status")
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: there was an error in updating the BackendTLSPolicy status. err: %+v, retry: %d", key, err, retry)
+		updatedObj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().BackendTLSPolicyInformer.Lister().BackendTLSPolicies(policy.Namespace).Get(policy.Name)
+		if err != nil {
+			utils.AviLog.Warnf("BackendTLSPolicy not found %v", err)
+			return err
+		}
+		return o.Patch(key, updatedObj, status, retry+1)
+	}
+	utils.AviLog.Infof("key: %s, msg: Successfully updated the BackendTLSPolicy %s/%s status %+v", key, policy.Namespace, policy.Name, utils.Stringify(status))
+	return nil
+}
+
+func (o *backendTLSPolicy) isStatusEqual(old, new *gatewayv1alpha2.PolicyStatus) bool {
+	oldStatus, newStatus := old.DeepCopy(), new.DeepCopy()
+	currentTime := metav1.Now()
+	for i := range oldStatus.Ancestors {
+		for j := range oldStatus.Ancestors[i].Conditions {
+			oldStatus.Ancestors[i].Conditions[j].LastTransitionTime = currentTime
+		}
+	}
+	for i := range newStatus.Ancestors {
+		for j := range newStatus.Ancestors[i].Conditions {
+			newStatus.Ancestors[i].Conditions[j].LastTransitionTime = currentTime
+		}
+	}
+	return reflect.DeepEqual(oldStatus, newStatus)
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/status"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// grpcroute shares the route status handling of httproute, as the status of both
+// kinds is a RouteStatus. The status is carried as HTTPRouteStatus in the status layer.
+type grpcroute struct {
+	httproute
+}
+
+func (o *grpcroute) Get(key string, name string, namespace string) *gatewayv1.GRPCRoute {
+
+	obj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GRPCRouteInformer.Lister().GRPCRoutes(namespace).Get(name)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: unable to get the GRPCRoute object. err: %s", key, err)
+		return nil
+	}
+	utils.AviLog.Debugf("key: %s, msg: Successfully retrieved the GRPCRoute object %s", key, name)
+	return obj.DeepCopy()
+}
+
+func (o *grpcroute) GetAll(key string) map[string]*gatewayv1.GRPCRoute {
+
+	objs, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GRPCRouteInformer.Lister().List(labels.Everything())
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: unable to get the GRPCRoute objects. err: %s", key, err)
+		return nil
+	}
+
+	grpcRouteMap := make(map[string]*gatewayv1.GRPCRoute)
+	for _, obj := range objs {
+		grpcRouteMap[obj.Namespace+"/"+obj.Name] = obj.DeepCopy()
+	}
+
+	utils.AviLog.Debugf("key: %s, msg: Successfully retrieved the GRPCRoute objects", key)
+	return grpcRouteMap
+}
+
+func (o *grpcroute) Delete(key string, option status.StatusOptions) {
+	nsName := strings.Split(option.Options.ServiceMetadata.HTTPRoute, "/")
+	if len(nsName) != 2 {
+		utils.AviLog.Warnf("key: %s, msg: invalid GRPCRoute name and namespace", key)
+		return
+	}
+	namespace := nsName[0]
+	name := nsName[1]
+	grpcRoute := o.Get(key, name, namespace)
+	if grpcRoute == nil {
+		return
+	}
+	// Update GRPCRoute status to remove VS UUID
+	routeStatus, err := o.unsetVSUUIDInRouteStatus(key, lib.GRPCRoute, grpcRoute.ObjectMeta.Generation, option.Options)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: failed to remove VS UUID from GRPCRoute status: %v", key, err)
+		return
+	}
+	if routeStatus != nil {
+		o.Patch(key, grpcRoute, &status.Status{HTTPRouteStatus: routeStatus})
+	}
+}
+
+func (o *grpcroute) Update(key string, option status.StatusOptions) {
+	nsName := strings.Split(option.Options.ServiceMetadata.HTTPRoute, "/")
+	if len(nsName) != 2 {
+		utils.AviLog.Warnf("key: %s, msg: invalid GRPCRoute name and namespace", key)
+		return
+	}
+	namespace := nsName[0]
+	name := nsName[1]
+	grpcRoute := o.Get(key, name, namespace)
+	if grpcRoute == nil {
+		return
+	}
+	if option.Options.Status != nil {
+		option.Options.Status.HTTPRouteStatus = akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(lib.GRPCRoute + "/" + namespace + "/" + name)
+		o.Patch(key, grpcRoute, option.Options.Status)
+	}
+	o.updateVSUUID(key, grpcRoute, option.Options)
+}
+
+func (o *grpcroute) BulkUpdate(key string, options []status.StatusOptions) {
+	grpcRouteMap := o.GetAll(key)
+	for _, option := range options {
+		grpcRoute := grpcRouteMap[option.Options.ServiceMetadata.HTTPRoute]
+		if grpcRoute != nil {
+			o.updateVSUUID(key, grpcRoute, option.Options)
+		}
+	}
+}
+
+// updateVSUUID updates GRPCRoute status Accepted condition with VS UUID
+func (o *grpcroute) updateVSUUID(key string, grpcRoute *gatewayv1.GRPCRoute, options *status.UpdateOptions) {
+	routeStatus, err := o.setVSUUIDInRouteStatus(key, lib.GRPCRoute, grpcRoute.ObjectMeta.Generation, options)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: failed to update GRPCRoute status with VS UUID: %v", key, err)
+		return
+	}
+	if routeStatus != nil {
+		o.Patch(key, grpcRoute, &status.Status{HTTPRouteStatus: routeStatus})
+	}
+}
+
+func (o *grpcroute) Patch(key string, obj runtime.Object, status *status.Status, retryNum ...int) error {
+	retry := 0
+	if len(retryNum) > 0 {
+		retry = retryNum[0]
+		if retry >= 5 {
+			utils.AviLog.Errorf("key: %s, msg: Patch retried 5 times, aborting", key)
+			akogatewayapilib.AKOControlConfig().EventRecorder().Eventf(obj, corev1.EventTypeWarning, lib.PatchFailed, "Patch of status failed after multiple retries")
+			return errors.New("Patch retried 5 times, aborting")
+		}
+	}
+
+	grpcRoute := obj.(*gatewayv1.GRPCRoute)
+	if o.isStatusEqual(&gatewayv1.HTTPRouteStatus{RouteStatus: grpcRoute.Status.RouteStatus}, status.HTTPRouteStatus) {
+		return nil
+	}
+
+	patchPayload, _ := json.Marshal(map[string]interface{}{
+		"status": gatewayv1.GRPCRouteStatus{RouteStatus: status.HTTPRouteStatus.RouteStatus},
+	})
+	_, err := akogatewayapilib.AKOControlConfig().GatewayAPIClientset().GatewayV1().GRPCRoutes(grpcRoute.Namespace).Patch(context.TODO(), grpcRoute.Name, types.MergePatchType, patchPayload, metav1.PatchOptions{}, "status")
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: there was an error in updating the GRPCRoute status. err: %+v, retry: %d", key, err, retry)
+		updatedObj, err := akogatewayapilib.AKOControlConfig().GatewayApiInformers().GRPCRouteInformer.Lister().GRPCRoutes(grpcRoute.Namespace).Get(grpcRoute.Name)
+		if err != nil {
+			utils.AviLog.Warnf("GRPCRoute not found %v", err)
+			return err
+		}
+		return o.Patch(key, updatedObj, status, retry+1)
+	}
+
+	utils.AviLog.Infof("key: %s, msg: Successfully updated the GRPCRoute %s/%s status %+v", key, grpcRoute.Namespace, grpcRoute.Name, utils.Stringify(status))
+	return nil
+}
@@ -168,26 +168,38 @@ func (o *httproute) isStatusEqual(old, new *gatewayv1.HTTPRouteStatus) bool {
 
 // updateHTTPRouteStatusWithVSUUID updates HTTPRoute status with VS UUID
 func (o *httproute) updateHTTPRouteStatusWithVSUUID(key string, httpRoute *gatewayv1.HTTPRoute, options *status.UpdateOptions) error {
+	httpRouteStatus, err := o.setVSUUIDInRouteStatus(key, lib.HTTPRoute, httpRoute.ObjectMeta.Generation, options)
+	if err != nil || httpRouteStatus == nil {
+		return err
+	}
+	// Patch the HTTPRoute status
+	return o.Patch(key, httpRoute, &status.Status{HTTPRouteStatus: httpRouteStatus})
+}
+
+// setVSUUIDInRouteStatus adds the VS UUID of the rule to the cached status of the route
+// of type routeType and returns the updated status. A nil status is returned when
+// there is nothing to update.
+func (o *httproute) setVSUUIDInRouteStatus(key, routeType string, generation int64, options *status.UpdateOptions) (*gatewayv1.HTTPRouteStatus, error) {
 	// Loop over route parent status and match with gateway name
 	gatewayNSName := options.ServiceMetadata.Gateway
 	ruleName := options.ServiceMetadata.HTTPRouteRuleName
 	virtualServiceUUID := options.VirtualServiceUUID
 
 	if gatewayNSName == "" || ruleName == "" || virtualServiceUUID == "" {
-		utils.AviLog.Debugf("key: %s, msg: Missing required fields for HTTPRoute status update - Gateway: %s, RuleName: %s, VSUUID: %s", key, gatewayNSName, ruleName, virtualServiceUUID)
-		return nil
+		utils.AviLog.Debugf("key: %s, msg: Missing required fields for %s status update - Gateway: %s, RuleName: %s, VSUUID: %s", key, routeType, gatewayNSName, ruleName, virtualServiceUUID)
+		return nil, nil
 	}
 
 	// Parse gateway namespace and name
 	gatewayParts := strings.Split(gatewayNSName, "/")
 	if len(gatewayParts) != 2 {
-		return fmt.Errorf("invalid gateway name format: %s", gatewayNSName)
+		return nil, fmt.Errorf("invalid gateway name format: %s", gatewayNSName)
 	}
 	gatewayNamespace := gatewayParts[0]
 	gatewayName := gatewayParts[1]
 
-	// Create or update HTTPRoute status
-	httpRouteStatus := akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(lib.HTTPRoute + "/" + options.ServiceMetadata.HTTPRoute)
+	// Create or update route status
+	httpRouteStatus := akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(routeType + "/" + options.ServiceMetadata.HTTPRoute)
 	if httpRouteStatus.Parents == nil {
 		httpRouteStatus.Parents = []gatewayv1.RouteParentStatus{}
 	}
@@ -198,18 +210,18 @@ func (o *httproute) updateHTTPRouteStatusWithVSUUID(key string, httpRoute *gatew
 			(httpRouteStatus.Parents[i].ParentRef.Namespace == nil || string(*httpRouteStatus.Parents[i].ParentRef.Namespace) == gatewayNamespace) {
 			parentStatus := &httpRouteStatus.Parents[i]
 
-			// Add VSUUID only if the HTTPRoute is Accepted for this Gateway
+			// Add VSUUID only if the route is Accepted for this Gateway
 			for _, condition := range parentStatus.Conditions {
 				if condition.Type == string(gatewayv1.RouteConditionAccepted) && condition.Status == metav1.ConditionTrue {
 					message, err := o.buildJSONMessage(parentStatus.Conditions, ruleName, virtualServiceUUID, false)
 					if err != nil {
-						return err
+						return nil, err
 					}
 					newCondition := NewCondition().
 						Type(string(gatewayv1.RouteConditionAccepted)).
 						Status(metav1.ConditionTrue).
 						Reason(string(gatewayv1.RouteReasonAccepted)).
-						ObservedGeneration(httpRoute.ObjectMeta.Generation).
+						ObservedGeneration(generation).
 						Message(message)
 					newCondition.SetIn(&parentStatus.Conditions)
 				}
@@ -217,35 +229,46 @@ func (o *httproute) updateHTTPRouteStatusWithVSUUID(key string, httpRoute *gatew
 		}
 	}
 
-	akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(lib.HTTPRoute+"/"+options.ServiceMetadata.HTTPRoute, httpRouteStatus)
-	// Patch the HTTPRoute status
-	return o.Patch(key, httpRoute, &status.Status{HTTPRouteStatus: httpRouteStatus})
+	akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(routeType+"/"+options.ServiceMetadata.HTTPRoute, httpRouteStatus)
+	return httpRouteStatus, nil
 }
 
 // removeVSUUIDFromHTTPRouteStatus updates HTTPRoute status to remove VS UUID
 func (o *httproute) removeVSUUIDFromHTTPRouteStatus(key string, httpRoute *gatewayv1.HTTPRoute, options *status.UpdateOptions) error {
+	httpRouteStatus, err := o.unsetVSUUIDInRouteStatus(key, lib.HTTPRoute, httpRoute.ObjectMeta.Generation, options)
+	if err != nil || httpRouteStatus == nil {
+		return err
+	}
+	// Patch the HTTPRoute status
+	return o.Patch(key, httpRoute, &status.Status{HTTPRouteStatus: httpRouteStatus})
+}
+
+// unsetVSUUIDInRouteStatus removes the VS UUID of the rule from the cached status of the
+// route of type routeType and returns the updated status. A nil status is returned when
+// there is nothing to update.
+func (o *httproute) unsetVSUUIDInRouteStatus(key, routeType string, generation int64, options *status.UpdateOptions) (*gatewayv1.HTTPRouteStatus, error) {
 	// Loop over route parent status and match with gateway name
 	gatewayNSName := options.ServiceMetadata.Gateway
 	ruleName := options.ServiceMetadata.HTTPRouteRuleName
 
 	if gatewayNSName == "" {
-		utils.AviLog.Debugf("key: %s, msg: Missing gateway name for HTTPRoute status delete", key)
-		return nil
+		utils.AviLog.Debugf("key: %s, msg: Missing gateway name for %s status delete", key, routeType)
+		return nil, nil
 	}
 
 	// Parse gateway namespace and name
 	gatewayParts := strings.Split(gatewayNSName, "/")
 	if len(gatewayParts) != 2 {
-		return fmt.Errorf("invalid gateway name format: %s", gatewayNSName)
+		return nil, fmt.Errorf("invalid gateway name format: %s", gatewayNSName)
 	}
 	gatewayNamespace := gatewayParts[0]
 	gatewayName := gatewayParts[1]
 
-	// Update HTTPRoute status
-	httpRouteStatus := akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(lib.HTTPRoute + "/" + options.ServiceMetadata.HTTPRoute)
+	// Update route status
+	httpRouteStatus := akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(routeType + "/" + options.ServiceMetadata.HTTPRoute)
 	if httpRouteStatus.Parents == nil {
-		utils.AviLog.Debugf("key: %s, msg: No parents to update for HTTPRoute status delete", key)
-		return nil // No parents to update
+		utils.AviLog.Debugf("key: %s, msg: No parents to update for %s status delete", key, routeType)
+		return nil, nil // No parents to update
 	}
 
 	// Find parent status for this gateway
@@ -258,7 +281,7 @@ func (o *httproute) removeVSUUIDFromHTTPRouteStatus(key string, httpRoute *gatew
 				if condition.Type == string(gatewayv1.RouteConditionAccepted) && condition.Status == metav1.ConditionTrue {
 					message, err := o.buildJSONMessage(httpRouteStatus.Parents[i].Conditions, ruleName, "", true)
 					if err != nil {
-						return err
+						return nil, err
 					}
 					status := metav1.ConditionTrue
 					reason := string(gatewayv1.RouteReasonAccepted)
@@ -270,7 +293,7 @@ func (o *httproute) removeVSUUIDFromHTTPRouteStatus(key string, httpRoute *gatew
 						Type(string(gatewayv1.RouteConditionAccepted)).
 						Status(status).
 						Reason(reason).
-						ObservedGeneration(httpRoute.ObjectMeta.Generation).
+						ObservedGeneration(generation).
 						Message(message)
 					newCondition.SetIn(&httpRouteStatus.Parents[i].Conditions)
 				}
@@ -278,9 +301,8 @@ func (o *httproute) removeVSUUIDFromHTTPRouteStatus(key string, httpRoute *gatew
 		}
 	}
 
-	akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(lib.HTTPRoute+"/"+options.ServiceMetadata.HTTPRoute, httpRouteStatus)
-	// Patch the HTTPRoute status
-	return o.Patch(key, httpRoute, &status.Status{HTTPRouteStatus: httpRouteStatus})
+	akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(routeType+"/"+options.ServiceMetadata.HTTPRoute, httpRouteStatus)
+	return httpRouteStatus, nil
 }
 
 // buildJSONMessage builds or updates a JSON message for HTTPRoute conditions
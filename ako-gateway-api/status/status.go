@@ -15,8 +15,11 @@
 package status
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
@@ -39,20 +42,36 @@ func New(ObjectType string) StatusUpdater {
 		return &gateway{}
 	case lib.HTTPRoute:
 		return &httproute{}
+	case lib.GRPCRoute:
+		return &grpcroute{}
+	case lib.TLSRoute:
+		return &tlsroute{}
+	case lib.TCPRoute:
+		return &tcproute{}
+	case lib.UDPRoute:
+		return &udproute{}
 	case lib.NPLService:
 		return &nplservice{publisher: status.NewStatusPublisher()}
+	case lib.BackendTLSPolicy:
+		return &backendTLSPolicy{}
 	}
 	return nil
 }
 
 func DequeueStatus(objIntf interface{}) error {
 	option, ok := objIntf.(status.StatusOptions)
+	defer lib.ObserveSyncStageDuration(lib.StatusStage, time.Now())
 	if !ok {
 		utils.AviLog.Warnf("Object is not of type StatusOptions, %T", objIntf)
 		return nil
 	}
 	utils.AviLog.Infof("key: %s, msg: starting status Sync", option.Key)
-	obj := New(option.ObjType)
+	objType := option.ObjType
+	// Child VSes of all the route kinds are published as HTTPRoute, with the actual kind in the service metadata
+	if objType == lib.HTTPRoute && option.Options != nil && option.Options.ServiceMetadata.RouteType != "" {
+		objType = option.Options.ServiceMetadata.RouteType
+	}
+	obj := New(objType)
 	if obj == nil {
 		utils.AviLog.Debugf("key: %s, msg: unknown object received", option.Key)
 		return nil
@@ -100,6 +119,30 @@ func Record(key string, obj runtime.Object, objStatus *status.Status) {
 		serviceMetadata.HTTPRoute = gwObject.Namespace + "/" + gwObject.Name
 		key = serviceMetadata.HTTPRoute
 		akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(objectType+"/"+serviceMetadata.HTTPRoute, objStatus.HTTPRouteStatus)
+	case *gatewayv1.GRPCRoute:
+		objectType = lib.GRPCRoute
+		serviceMetadata.HTTPRoute = gwObject.Namespace + "/" + gwObject.Name
+		serviceMetadata.RouteType = lib.GRPCRoute
+		key = serviceMetadata.HTTPRoute
+		akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(objectType+"/"+serviceMetadata.HTTPRoute, objStatus.HTTPRouteStatus)
+	case *gatewayv1alpha2.TLSRoute:
+		objectType = lib.TLSRoute
+		serviceMetadata.HTTPRoute = gwObject.Namespace + "/" + gwObject.Name
+		serviceMetadata.RouteType = lib.TLSRoute
+		key = serviceMetadata.HTTPRoute
+		akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(objectType+"/"+serviceMetadata.HTTPRoute, objStatus.HTTPRouteStatus)
+	case *gatewayv1alpha2.TCPRoute:
+		objectType = lib.TCPRoute
+		serviceMetadata.HTTPRoute = gwObject.Namespace + "/" + gwObject.Name
+		serviceMetadata.RouteType = lib.TCPRoute
+		key = serviceMetadata.HTTPRoute
+		akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(objectType+"/"+serviceMetadata.HTTPRoute, objStatus.HTTPRouteStatus)
+	case *gatewayv1alpha2.UDPRoute:
+		objectType = lib.UDPRoute
+		serviceMetadata.HTTPRoute = gwObject.Namespace + "/" + gwObject.Name
+		serviceMetadata.RouteType = lib.UDPRoute
+		key = serviceMetadata.HTTPRoute
+		akogatewayapiobjects.GatewayApiLister().UpdateRouteToRouteStatusMapping(objectType+"/"+serviceMetadata.HTTPRoute, objStatus.HTTPRouteStatus)
 	default:
 		utils.AviLog.Warnf("key %s, msg: Unsupported object received at the status layer, %T", key, obj)
 		return
@@ -0,0 +1,115 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	akogatewayapiobjects "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/status"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// tlsroute shares the route status handling of httproute. TLSRoutes are served by the
+// Gateway VS itself, so unlike HTTPRoutes there is no child VS UUID to be updated.
+type tlsroute struct {
+	httproute
+}
+
+func (o *tlsroute) Get(key string, name string, namespace string) *gatewayv1alpha2.TLSRoute {
+	informer := akogatewayapilib.AKOControlConfig().GatewayApiInformers().TLSRouteInformer
+	if informer == nil {
+		utils.AviLog.Warnf("key: %s, msg: TLSRoute CRD is not present in the cluster", key)
+		return nil
+	}
+	obj, err := informer.Lister().TLSRoutes(namespace).Get(name)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: unable to get the TLSRoute object. err: %s", key, err)
+		return nil
+	}
+	utils.AviLog.Debugf("key: %s, msg: Successfully retrieved the TLSRoute object %s", key, name)
+	return obj.DeepCopy()
+}
+
+func (o *tlsroute) Delete(key string, option status.StatusOptions) {
+	utils.AviLog.Debugf("key: %s, msg: no VS status to be removed for TLSRoute", key)
+}
+
+func (o *tlsroute) Update(key string, option status.StatusOptions) {
+	nsName := strings.Split(option.Options.ServiceMetadata.HTTPRoute, "/")
+	if len(nsName) != 2 {
+		utils.AviLog.Warnf("key: %s, msg: invalid TLSRoute name and namespace", key)
+		return
+	}
+	namespace := nsName[0]
+	name := nsName[1]
+	tlsRoute := o.Get(key, name, namespace)
+	if tlsRoute == nil {
+		return
+	}
+	if option.Options.Status != nil {
+		option.Options.Status.HTTPRouteStatus = akogatewayapiobjects.GatewayApiLister().GetRouteToRouteStatusMapping(lib.TLSRoute + "/" + namespace + "/" + name)
+		o.Patch(key, tlsRoute, option.Options.Status)
+	}
+}
+
+func (o *tlsroute) BulkUpdate(key string, options []status.StatusOptions) {
+	utils.AviLog.Debugf("key: %s, msg: no VS status to be updated for TLSRoutes", key)
+}
+
+func (o *tlsroute) Patch(key string, obj runtime.Object, status *status.Status, retryNum ...int) error {
+	retry := 0
+	if len(retryNum) > 0 {
+		retry = retryNum[0]
+		if retry >= 5 {
+			utils.AviLog.Errorf("key: %s, msg: Patch retried 5 times, aborting", key)
+			akogatewayapilib.AKOControlConfig().EventRecorder().Eventf(obj, corev1.EventTypeWarning, lib.PatchFailed, "Patch of status failed after multiple retries")
+			return errors.New("Patch retried 5 times, aborting")
+		}
+	}
+
+	tlsRoute := obj.(*gatewayv1alpha2.TLSRoute)
+	if status.HTTPRouteStatus == nil || o.isStatusEqual(&gatewayv1.HTTPRouteStatus{RouteStatus: tlsRoute.Status.RouteStatus}, status.HTTPRouteStatus) {
+		return nil
+	}
+
+	patchPayload, _ := json.Marshal(map[string]interface{}{
+		"status": gatewayv1alpha2.TLSRouteStatus{RouteStatus: status.HTTPRouteStatus.RouteStatus},
+	})
+	_, err := akogatewayapilib.AKOControlConfig().GatewayAPIClientset().GatewayV1alpha2().TLSRoutes(tlsRoute.Namespace).Patch(context.TODO(), tlsRoute.Name, types.MergePatchType, patchPayload, metav1.PatchOptions{}, "status")
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: there was an error in updating the TLSRoute status. err: %+v, retry: %d", key, err, retry)
+		updatedObj := o.Get(key, tlsRoute.Name, tlsRoute.Namespace)
+		if updatedObj == nil {
+			return err
+		}
+		return o.Patch(key, updatedObj, status, retry+1)
+	}
+
+	utils.AviLog.Infof("key: %s, msg: Successfully updated the TLSRoute %s/%s status %+v", key, tlsRoute.Namespace, tlsRoute.Name, utils.Stringify(status))
+	return nil
+}
@@ -116,7 +116,9 @@ func removeFinalizer(finalizers []string, key string) (result []string) {
 // +kubebuilder:rbac:groups="",resources=secrets;secrets/status;secrets/finalizers,verbs=create;delete;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=create;get;update
 // +kubebuilder:rbac:groups=cilium.io,resources=ciliumnodes,verbs=get;watch;list
-// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses;gatewayclasses/status;gateways;gateways/status;httproutes;httproutes/status,verbs=get;watch;list;patch;update;create;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses;gatewayclasses/status;gateways;gateways/status;httproutes;httproutes/status;grpcroutes;grpcroutes/status;tlsroutes;tlsroutes/status;tcproutes;tcproutes/status;udproutes;udproutes/status,verbs=get;watch;list;patch;update;create;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;watch;list
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies;backendtlspolicies/status,verbs=get;watch;list;patch;update
 // +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;watch;list
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
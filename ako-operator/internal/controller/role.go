@@ -163,7 +163,17 @@ func BuildClusterrole(ako akov1beta1.AKOConfig, r *AKOConfigReconciler, log logr
 			},
 			{
 				APIGroups: []string{"gateway.networking.k8s.io"},
-				Resources: []string{"gatewayclasses", "gatewayclasses/status", "gateways", "gateways/status", "httproutes", "httproutes/status"},
+				Resources: []string{"gatewayclasses", "gatewayclasses/status", "gateways", "gateways/status", "httproutes", "httproutes/status", "grpcroutes", "grpcroutes/status", "tlsroutes", "tlsroutes/status", "tcproutes", "tcproutes/status", "udproutes", "udproutes/status"},
+				Verbs:     []string{"get", "watch", "list", "patch", "update"},
+			},
+			{
+				APIGroups: []string{"gateway.networking.k8s.io"},
+				Resources: []string{"referencegrants"},
+				Verbs:     []string{"get", "watch", "list"},
+			},
+			{
+				APIGroups: []string{"gateway.networking.k8s.io"},
+				Resources: []string{"backendtlspolicies", "backendtlspolicies/status"},
 				Verbs:     []string{"get", "watch", "list", "patch", "update"},
 			},
 			{
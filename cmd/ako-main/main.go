@@ -31,6 +31,9 @@ import (
 	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/k8s"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	akorest "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/rest"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/tracing"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api/models"
 	crd "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1alpha1/clientset/versioned"
@@ -70,7 +73,11 @@ func InitializeAKOApi() {
 	if lib.IsPrometheusEnabled() {
 		lib.SetPrometheusRegistry()
 	}
-	akoApi := api.NewServer(lib.GetAkoApiServerPort(), []models.ApiModel{}, lib.IsPrometheusEnabled(), lib.GetPrometheusRegistry())
+	apiModels := []models.ApiModel{&nodes.ShardPlanModel{}, &akorest.SyncStatusModel{}}
+	if lib.IsPlanAPIEnabled() {
+		apiModels = append(apiModels, &akorest.PlanModel{})
+	}
+	akoApi := api.NewServer(lib.GetAkoApiServerPort(), apiModels, lib.IsPrometheusEnabled(), lib.GetPrometheusRegistry())
 	akoApi.InitApi()
 	lib.SetApiServerInstance(akoApi)
 }
@@ -80,6 +87,9 @@ func InitializeAKC() {
 	kubeCluster := false
 	utils.AviLog.Infof("AKO is running with version: %s", version)
 
+	tracing.InitFromEnv()
+	defer tracing.Shutdown()
+
 	// set the logger for k8s as AviLogger.
 	klog.SetLogger(zapr.NewLogger(utils.AviLog.Sugar.Desugar()))
 
@@ -305,12 +315,19 @@ func InitializeAKC() {
 
 	if akoControlConfig.GetAKOAKOPrometheusFlag() {
 		lib.RegisterPromMetrics()
+		lib.SetCacheSizeFunc(avicache.SharedAviObjCache().CacheSizes)
 	}
 
 	if aviRestClientPool != nil && !avicache.IsAviClusterActive(aviRestClientPool.AviClient[0]) {
-		akoControlConfig.PodEventf(corev1.EventTypeWarning, lib.AKOShutdown, "Avi Controller Cluster state is not Active")
-		utils.AviLog.Fatalf("Avi Controller Cluster state is not Active, shutting down AKO")
+		// Fail over to another endpoint of the controller, if any, before shutting down.
+		activeEndpoint := lib.GetControllerIP()
+		if len(lib.GetControllerEndpoints()) < 2 || avicache.CheckControllerEndpoints() == activeEndpoint {
+			akoControlConfig.PodEventf(corev1.EventTypeWarning, lib.AKOShutdown, "Avi Controller Cluster state is not Active")
+			utils.AviLog.Fatalf("Avi Controller Cluster state is not Active, shutting down AKO")
+		}
+		aviRestClientPool = avicache.SharedAVIClients(lib.GetTenant())
 	}
+	go avicache.MonitorControllerEndpoints(stopCh)
 
 	akoControlConfig.SetLicenseType(aviRestClientPool.AviClient[0])
 
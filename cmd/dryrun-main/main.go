@@ -0,0 +1,74 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/dryrun"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+var (
+	files                       = ""
+	output                      = ""
+	logLevel                    = ""
+	ExitCodeRequiredArgsMissing = 1
+	ExitCodeDryRunFailed        = 2
+)
+
+func main() {
+	flag.StringVar(&files, "f", "", "Comma separated list of manifest files or directories")
+	flag.StringVar(&output, "o", "yaml", "Output format, yaml or json")
+	flag.StringVar(&logLevel, "log-level", "WARN", "Log level, written to stderr")
+	flag.Parse()
+
+	utils.SetConsoleOutput(os.Stderr)
+	utils.AviLog.SetLevel(logLevel)
+
+	if files == "" || (output != "yaml" && output != "json") {
+		flag.Usage()
+		os.Exit(ExitCodeRequiredArgsMissing)
+	}
+
+	objs, err := dryrun.LoadManifests(strings.Split(files, ","))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load manifests, err: %s\n", err.Error())
+		os.Exit(ExitCodeDryRunFailed)
+	}
+	models, err := dryrun.Run(objs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build Avi objects, err: %s\n", err.Error())
+		os.Exit(ExitCodeDryRunFailed)
+	}
+
+	var out []byte
+	if output == "json" {
+		out, err = json.MarshalIndent(models, "", "  ")
+	} else {
+		out, err = yaml.Marshal(models)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to marshal Avi objects, err: %s\n", err.Error())
+		os.Exit(ExitCodeDryRunFailed)
+	}
+	fmt.Println(string(out))
+}
@@ -185,9 +185,14 @@ func Initialize() {
 	}
 
 	if aviRestClientPool != nil && !avicache.IsAviClusterActive(aviRestClientPool.AviClient[0]) {
-		akoControlConfig.PodEventf(corev1.EventTypeWarning, lib.AKOShutdown, "Avi Controller Cluster state is not Active")
-		utils.AviLog.Fatalf("Avi Controller Cluster state is not Active, shutting down AKO")
+		// Fail over to another endpoint of the controller, if any, before shutting down.
+		activeEndpoint := lib.GetControllerIP()
+		if len(lib.GetControllerEndpoints()) < 2 || avicache.CheckControllerEndpoints() == activeEndpoint {
+			akoControlConfig.PodEventf(corev1.EventTypeWarning, lib.AKOShutdown, "Avi Controller Cluster state is not Active")
+			utils.AviLog.Fatalf("Avi Controller Cluster state is not Active, shutting down AKO")
+		}
 	}
+	go avicache.MonitorControllerEndpoints(stopCh)
 
 	err = c.HandleConfigMap(informers, ctrlCh, stopCh, quickSyncCh)
 	if err != nil {
@@ -15,6 +15,7 @@
 package cache
 
 import (
+	"fmt"
 	"sync"
 
 	corev1 "k8s.io/api/core/v1"
@@ -37,7 +38,6 @@ func SharedAVIClients(tenant string) *utils.AviRestClientPool {
 	ctrlUsername := ctrlProp[utils.ENV_CTRL_USERNAME]
 	ctrlPassword := ctrlProp[utils.ENV_CTRL_PASSWORD]
 	ctrlAuthToken := ctrlProp[utils.ENV_CTRL_AUTHTOKEN]
-	ctrlCAData := ctrlProp[utils.ENV_CTRL_CADATA]
 	ctrlIpAddress := lib.GetControllerIP()
 	if ctrlUsername == "" || (ctrlPassword == "" && ctrlAuthToken == "") || ctrlIpAddress == "" {
 		var passwordLog, authTokenLog string
@@ -64,32 +64,62 @@ func SharedAVIClients(tenant string) *utils.AviRestClientPool {
 		return aviClientInstance.(*utils.AviRestClientPool)
 	}
 
+	// Start with the active endpoint, and fail over to the other endpoints of the controller
+	// in the order of preference, if AKO is unable to connect to the active endpoint.
+	endpoints := orderedControllerEndpoints(ctrlIpAddress)
+	var aviRestClientPool *utils.AviRestClientPool
+	for _, endpoint := range endpoints {
+		// Always create 9 clients irrespective of shard size
+		aviRestClientPool, err = newAviRestClientPool(9, endpoint, tenant)
+		if err != nil {
+			utils.AviLog.Warnf("Unable to connect to the Avi Controller endpoint %s, err: %v", endpoint, err)
+			continue
+		}
+		if endpoint != ctrlIpAddress {
+			if !IsAviClusterActive(aviRestClientPool.AviClient[0]) {
+				err = fmt.Errorf("Avi cluster is not active on the endpoint %s", endpoint)
+				continue
+			}
+			switchControllerEndpoint(ctrlIpAddress, endpoint)
+		}
+		break
+	}
+
+	connectionStatus = utils.AVIAPI_CONNECTED
+	if err != nil {
+		connectionStatus = utils.AVIAPI_DISCONNECTED
+		utils.AviLog.Errorf("AVI controller initialization failed")
+		return nil
+	}
+
+	AviClientInstanceMap.Store(tenant, aviRestClientPool)
+	models.RestStatus.UpdateAviApiRestStatus(connectionStatus, err)
+	models.RestStatus.UpdateAviApiControllerEndpoint(lib.GetControllerIP())
+	return aviRestClientPool
+}
+
+// newAviRestClientPool creates a pool of num clients for the tenant, connected to the given endpoint of the Avi Controller.
+func newAviRestClientPool(num uint32, endpoint, tenant string) (*utils.AviRestClientPool, error) {
+	ctrlProp := utils.SharedCtrlProp().GetAllCtrlProp()
 	userHeaders := utils.SharedCtrlProp().GetCtrlUserHeader()
 	userHeaders[utils.XAviUserAgentHeader] = "AKO"
 	apiScheme := utils.SharedCtrlProp().GetCtrlAPIScheme()
 
-	// Always create 9 clients irrespective of shard size
-	var currentControllerVersion string
-	var aviRestClientPool *utils.AviRestClientPool
 	ctrlVersion := lib.AKOControlConfig().ControllerVersion()
-	aviRestClientPool, currentControllerVersion, err = utils.NewAviRestClientPool(
-		9,
-		ctrlIpAddress,
-		ctrlUsername,
-		ctrlPassword,
-		ctrlAuthToken,
+	aviRestClientPool, currentControllerVersion, err := utils.NewAviRestClientPool(
+		num,
+		endpoint,
+		ctrlProp[utils.ENV_CTRL_USERNAME],
+		ctrlProp[utils.ENV_CTRL_PASSWORD],
+		ctrlProp[utils.ENV_CTRL_AUTHTOKEN],
 		ctrlVersion,
-		ctrlCAData,
+		ctrlProp[utils.ENV_CTRL_CADATA],
 		tenant,
 		apiScheme,
 		userHeaders,
 	)
-
-	connectionStatus = utils.AVIAPI_CONNECTED
 	if err != nil {
-		connectionStatus = utils.AVIAPI_DISCONNECTED
-		utils.AviLog.Errorf("AVI controller initialization failed")
-		return nil
+		return nil, err
 	}
 
 	if ctrlVersion == "" {
@@ -101,7 +131,5 @@ func SharedAVIClients(tenant string) *utils.AviRestClientPool {
 		SetVersion := session.SetVersion(ctrlVersion)
 		SetVersion(client.AviSession)
 	}
-	AviClientInstanceMap.Store(tenant, aviRestClientPool)
-	models.RestStatus.UpdateAviApiRestStatus(connectionStatus, err)
-	return aviRestClientPool
+	return aviRestClientPool, nil
 }
@@ -89,6 +89,7 @@ type AviVsCache struct {
 	InvalidData              bool
 	VSCacheLock              sync.RWMutex
 	StringGroupKeyCollection []NamespaceName
+	TrafficCloneProfile      NamespaceName
 }
 
 func (c *AviCache) AviCacheAddVS(k NamespaceName) *AviVsCache {
@@ -320,6 +321,15 @@ type AviPersistenceProfileCache struct {
 	InvalidData      bool
 }
 
+type AviTrafficCloneProfileCache struct {
+	Name             string
+	Tenant           string
+	Uuid             string
+	CloudConfigCksum uint32
+	LastModified     string
+	InvalidData      bool
+}
+
 type NextPage struct {
 	NextURI    string
 	Collection interface{}
@@ -370,6 +380,7 @@ type AviL4PolicyCache struct {
 	Uuid             string
 	CloudConfigCksum uint32
 	Pools            []string
+	PoolGroups       []string
 	LastModified     string
 	HasReference     bool
 }
@@ -555,6 +566,12 @@ func (c *AviCache) AviCacheGetNameByUuid(uuid string) (interface{}, bool) {
 			} else if value.(*AviPersistenceProfileCache).Uuid == uuid {
 				return value.(*AviPersistenceProfileCache).Name, true
 			}
+		case *AviTrafficCloneProfileCache:
+			if value.(*AviTrafficCloneProfileCache) == nil {
+				utils.AviLog.Warnf("Got nil value in cache for traffic clone profile key %v", reflect.ValueOf(key))
+			} else if value.(*AviTrafficCloneProfileCache).Uuid == uuid {
+				return value.(*AviTrafficCloneProfileCache).Name, true
+			}
 		}
 	}
 	return nil, false
@@ -572,6 +589,12 @@ func (c *AviCache) AviCacheDelete(k interface{}) {
 	delete(c.cache, k)
 }
 
+func (c *AviCache) AviCacheLen() int {
+	c.cache_lock.RLock()
+	defer c.cache_lock.RUnlock()
+	return len(c.cache)
+}
+
 func (c *AviCache) ShallowCopy() map[interface{}]interface{} {
 	// Shallow copy, does not dereference the pointers.
 	c.cache_lock.Lock()
@@ -0,0 +1,116 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package cache
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api/models"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+const controllerHealthCheckInterval = 30 * time.Second
+
+var controllerFailoverLock sync.Mutex
+
+// orderedControllerEndpoints returns the endpoints of the Avi Controller starting with the active endpoint,
+// followed by the rest of the endpoints in the order of preference.
+func orderedControllerEndpoints(activeEndpoint string) []string {
+	endpoints := []string{activeEndpoint}
+	for _, endpoint := range lib.GetControllerEndpoints() {
+		if endpoint != activeEndpoint {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// MonitorControllerEndpoints periodically checks the health of the active endpoint of the Avi Controller,
+// when more than one endpoint is configured, and fails over to a healthy endpoint.
+func MonitorControllerEndpoints(stopCh <-chan struct{}) {
+	if len(lib.GetControllerEndpoints()) < 2 {
+		return
+	}
+	ticker := time.NewTicker(controllerHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			CheckControllerEndpoints()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// CheckControllerEndpoints checks if the Avi cluster is active on the active endpoint of the Avi Controller.
+// Otherwise the other endpoints are checked in the order of preference, and the client pools are rebuilt
+// against the first healthy endpoint. It returns the active endpoint after the check.
+func CheckControllerEndpoints() string {
+	activeEndpoint := lib.GetControllerIP()
+	if pool, ok := AviClientInstanceMap.Load(lib.GetTenant()); ok {
+		aviRestClientPool := pool.(*utils.AviRestClientPool)
+		if len(aviRestClientPool.AviClient) > 0 && IsAviClusterActive(aviRestClientPool.AviClient[0]) {
+			return activeEndpoint
+		}
+	}
+	utils.AviLog.Warnf("Avi cluster is not active on the Avi Controller endpoint %s, checking the other endpoints", activeEndpoint)
+
+	for _, endpoint := range orderedControllerEndpoints(activeEndpoint)[1:] {
+		aviRestClientPool, err := newAviRestClientPool(1, endpoint, lib.GetTenant())
+		if err != nil {
+			utils.AviLog.Warnf("Unable to connect to the Avi Controller endpoint %s, err: %v", endpoint, err)
+			continue
+		}
+		if !IsAviClusterActive(aviRestClientPool.AviClient[0]) {
+			continue
+		}
+		switchControllerEndpoint(activeEndpoint, endpoint)
+		return endpoint
+	}
+	utils.AviLog.Warnf("Avi cluster is not active on any of the Avi Controller endpoints %v", lib.GetControllerEndpoints())
+	return activeEndpoint
+}
+
+// switchControllerEndpoint makes newEndpoint the active endpoint of the Avi Controller, and rebuilds the
+// client pools of all the tenants against it. A client pool which can not be rebuilt is left as is.
+func switchControllerEndpoint(oldEndpoint, newEndpoint string) {
+	controllerFailoverLock.Lock()
+	defer controllerFailoverLock.Unlock()
+	if lib.GetControllerIP() == newEndpoint {
+		return
+	}
+
+	utils.AviLog.Warnf("Switching the Avi Controller endpoint from %s to %s", oldEndpoint, newEndpoint)
+	lib.SetActiveControllerIP(newEndpoint)
+	AviClientInstanceMap.Range(func(key, value interface{}) bool {
+		tenant := key.(string)
+		oldPool := value.(*utils.AviRestClientPool)
+		aviRestClientPool, err := newAviRestClientPool(uint32(len(oldPool.AviClient)), newEndpoint, tenant)
+		if err != nil {
+			utils.AviLog.Warnf("Unable to rebuild the Avi clients of tenant %s against the endpoint %s, err: %v", tenant, newEndpoint, err)
+			return true
+		}
+		AviClientInstanceMap.Store(tenant, aviRestClientPool)
+		return true
+	})
+	models.RestStatus.UpdateAviApiControllerEndpoint(newEndpoint)
+	lib.AKOControlConfig().PodEventf(corev1.EventTypeWarning, lib.ControllerFailover,
+		"Avi Controller endpoint %s is not healthy, switched to the endpoint %s", oldEndpoint, newEndpoint)
+}
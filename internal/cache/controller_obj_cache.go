@@ -56,6 +56,7 @@ type AviObjCache struct {
 	VsCacheMeta         *AviCache
 	VsCacheLocal        *AviCache
 	AppPersProfileCache *AviCache
+	TrafficCloneCache   *AviCache
 	ClusterStatusCache  *AviCache
 }
 
@@ -75,6 +76,7 @@ func NewAviObjCache() *AviObjCache {
 	c.VrfCache = NewAviCache()
 	c.PKIProfileCache = NewAviCache()
 	c.AppPersProfileCache = NewAviCache()
+	c.TrafficCloneCache = NewAviCache()
 	c.ClusterStatusCache = NewAviCache()
 	return &c
 }
@@ -89,6 +91,25 @@ func SharedAviObjCache() *AviObjCache {
 	return cacheInstance
 }
 
+// CacheSizes returns the number of cached Avi objects per object type.
+func (c *AviObjCache) CacheSizes() map[string]int {
+	return map[string]int{
+		"VirtualService":                c.VsCacheMeta.AviCacheLen(),
+		"VsVip":                         c.VSVIPCache.AviCacheLen(),
+		"PoolGroup":                     c.PgCache.AviCacheLen(),
+		"Pool":                          c.PoolCache.AviCacheLen(),
+		"HTTPPolicySet":                 c.HTTPPolicyCache.AviCacheLen(),
+		"L4PolicySet":                   c.L4PolicyCache.AviCacheLen(),
+		"SSLKeyAndCertificate":          c.SSLKeyCache.AviCacheLen(),
+		"PKIProfile":                    c.PKIProfileCache.AviCacheLen(),
+		"VSDataScriptSet":               c.DSCache.AviCacheLen(),
+		"StringGroup":                   c.StringGroupCache.AviCacheLen(),
+		"ApplicationPersistenceProfile": c.AppPersProfileCache.AviCacheLen(),
+		"TrafficCloneProfile":           c.TrafficCloneCache.AviCacheLen(),
+		"VrfContext":                    c.VrfCache.AviCacheLen(),
+	}
+}
+
 func (c *AviObjCache) AviRefreshObjectCache(client []*clients.AviClient, cloud string) {
 	var wg sync.WaitGroup
 	// We want to run 9 go routines which will simultanesouly fetch objects from the controller.
@@ -103,6 +124,7 @@ func (c *AviObjCache) AviRefreshObjectCache(client []*clients.AviClient, cloud s
 	}()
 	c.PopulatePkiProfilesToCache(client[0])
 	c.PopulateAppPersistenceProfileToCache(client[0])
+	c.PopulateTrafficCloneProfileToCache(client[0])
 	c.PopulatePoolsToCache(client[1], cloud)
 	c.PopulatePgDataToCache(client[2], cloud)
 	c.PopulateStringGroupDataToCache(client[8], cloud)
@@ -1108,6 +1130,7 @@ func (c *AviObjCache) AviPopulateAllSSLKeys(client *clients.AviClient, cloud str
 			CACertUUID:       cacertUUID,
 			CloudConfigCksum: lib.SSLKeyCertChecksum(*sslkey.Name, *sslkey.Certificate.Certificate, cacert, emptyIngestionMarkers, sslkey.Markers, true),
 		}
+		lib.TrackCertificateExpiry(sslCacheObj.Tenant, sslCacheObj.Name, sslkey.Markers, sslCacheObj.Cert)
 		*SslData = append(*SslData, sslCacheObj)
 	}
 	if result.Next != "" {
@@ -1183,6 +1206,7 @@ func (c *AviObjCache) AviPopulateOneSSLCache(client *clients.AviClient,
 		}
 		k := NamespaceName{Namespace: tenant, Name: *sslkey.Name}
 		c.SSLKeyCache.AviCacheAdd(k, &sslCacheObj)
+		lib.TrackCertificateExpiry(tenant, *sslkey.Name, sslkey.Markers, *sslkey.Certificate.Certificate)
 		utils.AviLog.Debugf("Adding sslkey to Cache during refresh %s", k)
 	}
 	return nil
@@ -1643,6 +1667,15 @@ func (c *AviObjCache) AviPopulateOneVsHttpPolCache(client *clients.AviClient,
 						}
 					}
 				}
+				if rule.Match != nil && rule.Match.Query != nil {
+					for _, sg := range rule.Match.Query.StringGroupRefs {
+						sgUuid := ExtractUUID(sg, "stringgroup-.*.#")
+						sgName, found := c.StringGroupCache.AviCacheGetNameByUuid(sgUuid)
+						if found {
+							stringGroupRefs = append(stringGroupRefs, sgName.(string))
+						}
+					}
+				}
 			}
 		}
 
@@ -1698,17 +1731,25 @@ func (c *AviObjCache) AviPopulateOneVsL4PolCache(client *clients.AviClient,
 			continue
 		}
 		// Fetch the pools associated with the l4 policyset object
-		var pools []string
+		var pools, poolGroups []string
 		var ports []int64
 		var protocols []string
 		if l4pol.L4ConnectionPolicy != nil {
 			for _, rule := range l4pol.L4ConnectionPolicy.Rules {
 				protocols = append(protocols, *rule.Match.Protocol.Protocol)
-				if rule.Action != nil {
-					poolUuid := ExtractUUID(*rule.Action.SelectPool.PoolRef, "pool-.*.#")
-					poolName, found := c.PoolCache.AviCacheGetNameByUuid(poolUuid)
-					if found {
-						pools = append(pools, poolName.(string))
+				if rule.Action != nil && rule.Action.SelectPool != nil {
+					if rule.Action.SelectPool.PoolGroupRef != nil {
+						pgUuid := ExtractUUID(*rule.Action.SelectPool.PoolGroupRef, "poolgroup-.*.#")
+						pgName, found := c.PgCache.AviCacheGetNameByUuid(pgUuid)
+						if found {
+							poolGroups = append(poolGroups, pgName.(string))
+						}
+					} else if rule.Action.SelectPool.PoolRef != nil {
+						poolUuid := ExtractUUID(*rule.Action.SelectPool.PoolRef, "pool-.*.#")
+						poolName, found := c.PoolCache.AviCacheGetNameByUuid(poolUuid)
+						if found {
+							pools = append(pools, poolName.(string))
+						}
 					}
 				}
 				if rule.Match != nil {
@@ -1717,13 +1758,14 @@ func (c *AviObjCache) AviPopulateOneVsL4PolCache(client *clients.AviClient,
 			}
 		}
 		emptyIngestionMarkers := utils.AviObjectMarkers{}
-		cksum := lib.L4PolicyChecksum(ports, protocols, pools, emptyIngestionMarkers, l4pol.Markers, true)
+		cksum := lib.L4PolicyChecksum(ports, protocols, append(pools, poolGroups...), emptyIngestionMarkers, l4pol.Markers, true)
 		tenant := getTenantFromTenantRef(*l4pol.TenantRef)
 		l4PolCacheObj := AviL4PolicyCache{
 			Name:             *l4pol.Name,
 			Tenant:           tenant,
 			Uuid:             *l4pol.UUID,
 			Pools:            pools,
+			PoolGroups:       poolGroups,
 			LastModified:     *l4pol.LastModified,
 			CloudConfigCksum: cksum,
 		}
@@ -1762,12 +1804,13 @@ func (c *AviObjCache) PopulateSSLKeyToCache(client *clients.AviClient, cloud str
 	}
 	//The data that is left in sslCacheData should be explicitly removed
 	for key := range sslCacheData {
-		_, ok := key.(NamespaceName)
+		sslKey, ok := key.(NamespaceName)
 		if !ok {
 			continue
 		}
 		utils.AviLog.Debugf("Deleting key from sslkey cache :%s", key)
 		c.SSLKeyCache.AviCacheDelete(key)
+		lib.UntrackCertificateExpiry(sslKey.Namespace, sslKey.Name)
 	}
 }
 
@@ -1836,6 +1879,15 @@ func (c *AviObjCache) AviPopulateAllHttpPolicySets(client *clients.AviClient, cl
 						}
 					}
 				}
+				if rule.Match != nil && rule.Match.Query != nil {
+					for _, sg := range rule.Match.Query.StringGroupRefs {
+						sgUuid := ExtractUUID(sg, "stringgroup-.*.#")
+						sgName, found := c.StringGroupCache.AviCacheGetNameByUuid(sgUuid)
+						if found {
+							stringGroupRefs = append(stringGroupRefs, sgName.(string))
+						}
+					}
+				}
 			}
 		}
 		tenant := getTenantFromTenantRef(*httppol.TenantRef)
@@ -1998,7 +2050,7 @@ func (c *AviObjCache) AviPopulateAllL4PolicySets(client *clients.AviClient, clou
 
 		// Fetch the pgs associated with the http policyset object
 		// Fetch the pools associated with the l4 policyset object
-		var pools []string
+		var pools, poolGroups []string
 		var ports []int64
 		var protocols []string
 		if l4pol.L4ConnectionPolicy != nil {
@@ -2011,10 +2063,18 @@ func (c *AviObjCache) AviPopulateAllL4PolicySets(client *clients.AviClient, clou
 						protocol = utils.UDP
 					}
 					protocols = append(protocols, protocol)
-					poolUuid := ExtractUUID(*rule.Action.SelectPool.PoolRef, "pool-.*.#")
-					poolName, found := c.PoolCache.AviCacheGetNameByUuid(poolUuid)
-					if found {
-						pools = append(pools, poolName.(string))
+					if rule.Action.SelectPool != nil && rule.Action.SelectPool.PoolGroupRef != nil {
+						pgUuid := ExtractUUID(*rule.Action.SelectPool.PoolGroupRef, "poolgroup-.*.#")
+						pgName, found := c.PgCache.AviCacheGetNameByUuid(pgUuid)
+						if found {
+							poolGroups = append(poolGroups, pgName.(string))
+						}
+					} else if rule.Action.SelectPool != nil && rule.Action.SelectPool.PoolRef != nil {
+						poolUuid := ExtractUUID(*rule.Action.SelectPool.PoolRef, "pool-.*.#")
+						poolName, found := c.PoolCache.AviCacheGetNameByUuid(poolUuid)
+						if found {
+							pools = append(pools, poolName.(string))
+						}
 					}
 				}
 				if rule.Match != nil {
@@ -2024,12 +2084,13 @@ func (c *AviObjCache) AviPopulateAllL4PolicySets(client *clients.AviClient, clou
 		}
 
 		emptyIngestionMarkers := utils.AviObjectMarkers{}
-		cksum := lib.L4PolicyChecksum(ports, protocols, pools, emptyIngestionMarkers, l4pol.Markers, true)
+		cksum := lib.L4PolicyChecksum(ports, protocols, append(pools, poolGroups...), emptyIngestionMarkers, l4pol.Markers, true)
 		l4PolCacheObj := AviL4PolicyCache{
 			Name:             *l4pol.Name,
 			Tenant:           getTenantFromTenantRef(*l4pol.TenantRef),
 			Uuid:             *l4pol.UUID,
 			Pools:            pools,
+			PoolGroups:       poolGroups,
 			LastModified:     *l4pol.LastModified,
 			CloudConfigCksum: cksum,
 		}
@@ -2330,6 +2391,172 @@ func (c *AviObjCache) PopulateAppPersistenceProfileToCache(client *clients.AviCl
 		c.AppPersProfileCache.AviCacheDelete(key)
 	}
 }
+func (c *AviObjCache) AviPopulateAllTrafficCloneProfiles(client *clients.AviClient, trafficCloneData *[]AviTrafficCloneProfileCache, nextPage ...NextPage) (*[]AviTrafficCloneProfileCache, int, error) {
+	var uri string
+	if len(nextPage) == 1 {
+		uri = nextPage[0].NextURI
+	} else {
+		uri = "/api/trafficcloneprofile/?" + "name.contains=" + lib.GetNamePrefix() + "&include_name=true" + "&page_size=100"
+	}
+	utils.AviLog.Debugf("Get uri %v for trafficcloneprofile: ", uri)
+
+	result, err := lib.AviGetCollectionRaw(client, uri)
+	if err != nil {
+		utils.AviLog.Warnf("Get uri %v returned err for trafficcloneprofile %v", uri, err)
+		return nil, 0, err
+	}
+	elems := make([]json.RawMessage, result.Count)
+	err = json.Unmarshal(result.Results, &elems)
+	if err != nil {
+		utils.AviLog.Warnf("Failed to unmarshal trafficcloneprofile data, err: %v", err)
+		return nil, 0, err
+	}
+	for i := 0; i < len(elems); i++ {
+		trafficClone := models.TrafficCloneProfile{}
+		err = json.Unmarshal(elems[i], &trafficClone)
+		if err != nil {
+			utils.AviLog.Warnf("Failed to unmarshal trafficcloneprofile data, err: %v", err)
+			continue
+		}
+		if trafficClone.Name == nil || trafficClone.UUID == nil {
+			utils.AviLog.Warnf("Incomplete trafficcloneprofile data unmarshalled, %s", utils.Stringify(trafficClone))
+			continue
+		}
+		trafficCloneCacheObj := AviTrafficCloneProfileCache{
+			Name:             *trafficClone.Name,
+			Tenant:           getTenantFromTenantRef(*trafficClone.TenantRef),
+			Uuid:             *trafficClone.UUID,
+			CloudConfigCksum: CalculateTrafficCloneProfileChecksum(trafficClone),
+		}
+		if trafficClone.LastModified != nil {
+			trafficCloneCacheObj.LastModified = *trafficClone.LastModified
+		}
+		*trafficCloneData = append(*trafficCloneData, trafficCloneCacheObj)
+	}
+
+	if result.Next != "" {
+		next_uri := strings.Split(result.Next, "/api/trafficcloneprofile")
+		if len(next_uri) > 1 {
+			overrideUri := "/api/trafficcloneprofile" + next_uri[1]
+			nextPage := NextPage{NextURI: overrideUri}
+			_, _, err := c.AviPopulateAllTrafficCloneProfiles(client, trafficCloneData, nextPage)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	return trafficCloneData, result.Count, nil
+}
+
+func (c *AviObjCache) PopulateTrafficCloneProfileToCache(client *clients.AviClient) {
+	var trafficCloneData []AviTrafficCloneProfileCache
+	setDefaultTenant := session.SetTenant(lib.GetTenant())
+	setTenant := session.SetTenant(lib.GetQueryTenant())
+	setTenant(client.AviSession)
+	defer setDefaultTenant(client.AviSession)
+	c.AviPopulateAllTrafficCloneProfiles(client, &trafficCloneData)
+
+	trafficCloneCacheData := c.TrafficCloneCache.ShallowCopy()
+	for i, trafficClone := range trafficCloneData {
+		k := NamespaceName{Namespace: trafficClone.Tenant, Name: trafficClone.Name}
+		oldTrafficCloneIntf, found := c.TrafficCloneCache.AviCacheGet(k)
+		if found {
+			oldTrafficCloneData, ok := oldTrafficCloneIntf.(*AviTrafficCloneProfileCache)
+			if ok {
+				if oldTrafficCloneData.InvalidData {
+					trafficCloneData[i].InvalidData = true
+					utils.AviLog.Infof("Invalid cache data for traffic clone profile: %s", k)
+				}
+			} else {
+				utils.AviLog.Infof("Wrong data type for traffic clone profile: %s in cache", k)
+			}
+		}
+		utils.AviLog.Infof("Adding key to traffic clone profile cache :%s value :%s", k, trafficClone.Uuid)
+		c.TrafficCloneCache.AviCacheAdd(k, &trafficCloneData[i])
+		delete(trafficCloneCacheData, k)
+	}
+	// The data that is left in trafficCloneCacheData should be explicitly removed
+	for key := range trafficCloneCacheData {
+		_, ok := key.(NamespaceName)
+		if !ok {
+			continue
+		}
+		utils.AviLog.Infof("Deleting key from traffic clone profile cache :%s", key)
+		c.TrafficCloneCache.AviCacheDelete(key)
+	}
+}
+
+func (c *AviObjCache) AviPopulateOneTrafficCloneProfileCache(client *clients.AviClient, objName string) error {
+	uri := "/api/trafficcloneprofile?name=" + objName + "&include_name=true"
+	result, err := lib.AviGetCollectionRaw(client, uri)
+	if err != nil {
+		utils.AviLog.Warnf("Get uri %v returned err for trafficcloneprofile %v", uri, err)
+		return err
+	}
+	elems := make([]json.RawMessage, result.Count)
+	err = json.Unmarshal(result.Results, &elems)
+	if err != nil {
+		utils.AviLog.Warnf("Failed to unmarshal trafficcloneprofile data, err: %v", err)
+		return err
+	}
+	for i := 0; i < len(elems); i++ {
+		trafficClone := models.TrafficCloneProfile{}
+		err = json.Unmarshal(elems[i], &trafficClone)
+		if err != nil {
+			utils.AviLog.Warnf("Failed to unmarshal trafficcloneprofile data, err: %v", err)
+			continue
+		}
+		if trafficClone.Name == nil || trafficClone.UUID == nil {
+			utils.AviLog.Warnf("Incomplete trafficcloneprofile data unmarshalled, %s", utils.Stringify(trafficClone))
+			continue
+		}
+		// Only cache a traffic clone profile that belongs to this AKO.
+		if !strings.HasPrefix(*trafficClone.Name, lib.GetNamePrefix()) {
+			continue
+		}
+		tenant := getTenantFromTenantRef(*trafficClone.TenantRef)
+		cacheObj := AviTrafficCloneProfileCache{
+			Name:             *trafficClone.Name,
+			Tenant:           tenant,
+			Uuid:             *trafficClone.UUID,
+			CloudConfigCksum: CalculateTrafficCloneProfileChecksum(trafficClone),
+		}
+		if trafficClone.LastModified != nil {
+			cacheObj.LastModified = *trafficClone.LastModified
+		}
+		k := NamespaceName{Namespace: tenant, Name: *trafficClone.Name}
+		c.TrafficCloneCache.AviCacheAdd(k, &cacheObj)
+		utils.AviLog.Debugf("Adding trafficcloneprofile to Cache during refresh %s", k)
+	}
+	return nil
+}
+
+// GetTrafficCloneProfileKeyFromRef returns the cache key of the traffic clone profile referred by a VS,
+// only if the profile is one created by AKO.
+func (c *AviObjCache) GetTrafficCloneProfileKeyFromRef(ref interface{}, tenant string) NamespaceName {
+	trafficCloneRef, ok := ref.(string)
+	if !ok || trafficCloneRef == "" {
+		return NamespaceName{}
+	}
+	trafficCloneUuid := ExtractUUID(trafficCloneRef, "trafficcloneprofile-.*.#")
+	trafficCloneName, found := c.TrafficCloneCache.AviCacheGetNameByUuid(trafficCloneUuid)
+	if !found {
+		return NamespaceName{}
+	}
+	return NamespaceName{Namespace: tenant, Name: trafficCloneName.(string)}
+}
+
+func CalculateTrafficCloneProfileChecksum(trafficCloneModel models.TrafficCloneProfile) uint32 {
+	cloneServers := make([]string, 0, len(trafficCloneModel.CloneServers))
+	for _, cloneServer := range trafficCloneModel.CloneServers {
+		if cloneServer.IPAddress != nil && cloneServer.IPAddress.Addr != nil {
+			cloneServers = append(cloneServers, *cloneServer.IPAddress.Addr)
+		}
+	}
+	emptyIngestionMarkers := utils.AviObjectMarkers{}
+	return lib.TrafficCloneProfileChecksum(*trafficCloneModel.Name, cloneServers, emptyIngestionMarkers, trafficCloneModel.Markers, true)
+}
+
 func (c *AviObjCache) AviObjVrfCachePopulate(client *clients.AviClient, cloud string) error {
 	if lib.GetDisableStaticRoute() {
 		utils.AviLog.Debugf("Static route sync disabled, skipping vrf cache population")
@@ -2564,6 +2791,12 @@ func (c *AviObjCache) AviObjVSCachePopulate(client *clients.AviClient, cloud str
 									poolKey := NamespaceName{Namespace: tenant, Name: poolName}
 									poolKeys = append(poolKeys, poolKey)
 								}
+								for _, pgName := range l4Obj.(*AviL4PolicyCache).PoolGroups {
+									pgKey := NamespaceName{Namespace: tenant, Name: pgName}
+									poolgroupKeys = append(poolgroupKeys, pgKey)
+									pgpoolKeys := c.AviPGPoolCachePopulate(client, cloud, pgName, tenant)
+									poolKeys = append(poolKeys, pgpoolKeys...)
+								}
 								l4Keys = append(l4Keys, l4key)
 							}
 						}
@@ -2657,6 +2890,7 @@ func (c *AviObjCache) AviObjVSCachePopulate(client *clients.AviClient, cloud str
 				if val, ok := vs["enable_rhi"]; ok {
 					vsMetaObj.EnableRhi = val.(bool)
 				}
+				vsMetaObj.TrafficCloneProfile = c.GetTrafficCloneProfileKeyFromRef(vs["traffic_clone_profile_ref"], tenant)
 				c.VsCacheLocal.AviCacheAdd(k, &vsMetaObj)
 				utils.AviLog.Debugf("Added VS cache key :%s", utils.Stringify(&vsMetaObj))
 			}
@@ -2892,6 +3126,12 @@ func (c *AviObjCache) AviObjOneVSCachePopulate(client *clients.AviClient, cloud
 									poolKey := NamespaceName{Namespace: tenant, Name: poolName}
 									poolKeys = append(poolKeys, poolKey)
 								}
+								for _, pgName := range l4Obj.(*AviL4PolicyCache).PoolGroups {
+									pgKey := NamespaceName{Namespace: tenant, Name: pgName}
+									poolgroupKeys = append(poolgroupKeys, pgKey)
+									pgpoolKeys := c.AviPGPoolCachePopulate(client, cloud, pgName, tenant)
+									poolKeys = append(poolKeys, pgpoolKeys...)
+								}
 								l4Keys = append(l4Keys, l4key)
 							}
 						}
@@ -2962,6 +3202,7 @@ func (c *AviObjCache) AviObjOneVSCachePopulate(client *clients.AviClient, cloud
 				if val, ok := vs["enable_rhi"]; ok {
 					vsMetaObj.EnableRhi = val.(bool)
 				}
+				vsMetaObj.TrafficCloneProfile = c.GetTrafficCloneProfileKeyFromRef(vs["traffic_clone_profile_ref"], tenant)
 				c.VsCacheMeta.AviCacheAdd(k, &vsMetaObj)
 				vs_cache, found := c.VsCacheMeta.AviCacheGet(parentVSKey)
 				if found {
@@ -3010,32 +3251,41 @@ func (c *AviObjCache) AviPGPoolCachePopulate(client *clients.AviClient, cloud st
 }
 
 func IsAviClusterActive(client *clients.AviClient) bool {
+	clusterState, err := GetAviClusterState(client)
+	if err != nil {
+		return false
+	}
+	utils.AviLog.Infof("Avi cluster state is %s", clusterState)
+	return IsAviClusterStateActive(clusterState)
+}
+
+// GetAviClusterState returns the state of the Avi cluster, e.g. CLUSTER_UP_HA_ACTIVE.
+func GetAviClusterState(client *clients.AviClient) (string, error) {
 	uri := "/api/cluster/runtime"
 	var response map[string]interface{}
 	err := lib.AviGet(client, uri, &response)
 	if err != nil {
 		utils.AviLog.Warnf("Cluster status Get uri %v returned err %v", uri, err)
-		return false
+		return "", err
 	}
 
 	clusterStateMap, ok := response["cluster_state"].(map[string]interface{})
 	if !ok {
 		utils.AviLog.Warnf("Unexpected type for cluster_state map %T", response["cluster_states"])
-		return false
+		return "", fmt.Errorf("unexpected type for cluster_state map %T", response["cluster_state"])
 	}
 
 	clusterState, ok := clusterStateMap["state"].(string)
 	if !ok {
 		utils.AviLog.Warnf("Unexpected type for cluster state %T", clusterStateMap["state"])
-		return false
-	}
-
-	utils.AviLog.Infof("Avi cluster state is %s", clusterState)
-	if clusterState == "CLUSTER_UP_NO_HA" || clusterState == "CLUSTER_UP_HA_ACTIVE" || clusterState == "CLUSTER_UP_HA_COMPROMISED" {
-		return true
+		return "", fmt.Errorf("unexpected type for cluster state %T", clusterStateMap["state"])
 	}
+	return clusterState, nil
+}
 
-	return false
+// IsAviClusterStateActive returns true if the Avi cluster in the given state accepts the configuration.
+func IsAviClusterStateActive(clusterState string) bool {
+	return clusterState == "CLUSTER_UP_NO_HA" || clusterState == "CLUSTER_UP_HA_ACTIVE" || clusterState == "CLUSTER_UP_HA_COMPROMISED"
 }
 
 func (c *AviObjCache) AviClusterStatusPopulate(client *clients.AviClient) error {
@@ -0,0 +1,341 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+// Package dryrun translates Kubernetes manifests to the Avi objects AKO would create, without a
+// Kubernetes cluster or an Avi controller. The manifests are loaded in fake clientsets, and the
+// graph layer and the rest layer builders are run on them the same way as during an AKO full sync.
+package dryrun
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	routev1 "github.com/openshift/api/route/v1"
+	oshiftfake "github.com/openshift/client-go/route/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/k8s"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/rest"
+	akov1alpha2 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1alpha2"
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
+	crdfake "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1alpha1/clientset/versioned/fake"
+	v1alpha2crdfake "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1alpha2/clientset/versioned/fake"
+	v1beta1crdfake "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1beta1/clientset/versioned/fake"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// defaultEnv has the values.yaml defaults of the AKO settings, used when they are not set in the environment.
+var defaultEnv = map[string]string{
+	"CLUSTER_NAME":              "dry-run",
+	"CLOUD_NAME":                "Default-Cloud",
+	"SEG_NAME":                  "Default-Group",
+	"SHARD_VS_SIZE":             "LARGE",
+	"PASSTHROUGH_SHARD_SIZE":    "SMALL",
+	"SERVICE_TYPE":              "ClusterIP",
+	"DEFAULT_ING_CONTROLLER":    "true",
+	"AUTO_L4_FQDN":              "default",
+	"DISABLE_STATIC_ROUTE_SYNC": "true",
+	"POD_NAMESPACE":             utils.AKO_DEFAULT_NS,
+	"POD_NAME":                  "ako-0",
+	"BLOCKED_NS_LIST":           "[]",
+	"VIP_NETWORK_LIST":          `[{"networkName":"vip-network"}]`,
+}
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	clientgoscheme.AddToScheme(scheme)
+	routev1.AddToScheme(scheme)
+	akov1beta1.AddToScheme(scheme)
+	akov1alpha2.AddToScheme(scheme)
+}
+
+// AviObject is an Avi object which AKO would create.
+type AviObject struct {
+	Model  string      `json:"model"`
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Tenant string      `json:"tenant"`
+	Object interface{} `json:"object"`
+}
+
+// Model is an Avi object graph built by AKO, e.g. a shared virtualservice with its child
+// virtualservices, pools, poolgroups, httppolicysets and vsvip.
+type Model struct {
+	Name    string      `json:"name"`
+	Objects []AviObject `json:"objects"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// LoadManifests decodes the Kubernetes objects in the YAML or JSON files. Directories are
+// walked for files with the .yaml, .yml or .json extension. List objects are expanded.
+func LoadManifests(paths []string) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	for _, path := range paths {
+		err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			ext := strings.ToLower(filepath.Ext(file))
+			if file != path && ext != ".yaml" && ext != ".yml" && ext != ".json" {
+				return nil
+			}
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			fileObjs, err := DecodeManifests(data)
+			if err != nil {
+				return fmt.Errorf("%s: %v", file, err)
+			}
+			objs = append(objs, fileObjs...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objs, nil
+}
+
+// DecodeManifests decodes the Kubernetes objects in a multi-document YAML or JSON stream.
+func DecodeManifests(data []byte) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		jsonDoc, err := utilyaml.ToJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+		if string(jsonDoc) == "null" {
+			continue
+		}
+		obj, _, err := decoder.Decode(jsonDoc, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if list, ok := obj.(*corev1.List); ok {
+			for _, item := range list.Items {
+				itemObj, _, err := decoder.Decode(item.Raw, nil, nil)
+				if err != nil {
+					return nil, err
+				}
+				objs = append(objs, itemObj)
+			}
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// Run builds the Avi models for the Kubernetes objects and returns the Avi objects of every
+// model, sorted by model name. It can be called once per process, as AKO keeps its state in
+// shared instances.
+func Run(objs []runtime.Object) ([]Model, error) {
+	for env, value := range defaultEnv {
+		if os.Getenv(env) == "" {
+			os.Setenv(env, value)
+		}
+	}
+	utils.SetCloudName(os.Getenv("CLOUD_NAME"))
+	if _, err := lib.IsClusterNameValid(); err != nil {
+		return nil, err
+	}
+	akoControlConfig := lib.AKOControlConfig()
+	akoControlConfig.SetAKOInstanceFlag(true)
+	lib.SetNamePrefix("")
+	lib.SetAKOUser(lib.AKOPrefix)
+	lib.SetClusterLabelChecksum()
+	vipNetworks, err := lib.GetVipNetworkListEnv()
+	if err != nil {
+		return nil, err
+	}
+	utils.SetVipNetworkList(vipNetworks)
+
+	var kubeObjs, routeObjs, crdObjs, v1alpha2CRDObjs []runtime.Object
+	namespaces := make(map[string]bool)
+	hasNamespace := make(map[string]bool)
+	hasAviIngressClass := false
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *routev1.Route:
+			routeObjs = append(routeObjs, obj)
+		// The references in the CRDs can not be validated without the Avi controller, so they are
+		// accepted as is, the way a follower AKO expects the leader to have accepted them.
+		case *akov1beta1.HostRule:
+			o.Status.Status = lib.StatusAccepted
+			crdObjs = append(crdObjs, obj)
+		case *akov1beta1.HTTPRule:
+			o.Status.Status = lib.StatusAccepted
+			crdObjs = append(crdObjs, obj)
+		case *akov1beta1.AviInfraSetting:
+			o.Status.Status = lib.StatusAccepted
+			crdObjs = append(crdObjs, obj)
+		case *akov1alpha2.SSORule:
+			o.Status.Status = lib.StatusAccepted
+			v1alpha2CRDObjs = append(v1alpha2CRDObjs, obj)
+		case *akov1alpha2.L4Rule:
+			o.Status.Status = lib.StatusAccepted
+			v1alpha2CRDObjs = append(v1alpha2CRDObjs, obj)
+		case *akov1alpha2.L7Rule:
+			o.Status.Status = lib.StatusAccepted
+			v1alpha2CRDObjs = append(v1alpha2CRDObjs, obj)
+		case *corev1.Namespace:
+			hasNamespace[o.Name] = true
+			kubeObjs = append(kubeObjs, obj)
+		case *networkingv1.IngressClass:
+			if o.Spec.Controller == lib.AviIngressController {
+				hasAviIngressClass = true
+			}
+			kubeObjs = append(kubeObjs, obj)
+		default:
+			kubeObjs = append(kubeObjs, obj)
+		}
+		if objMeta, err := metaAccessor(obj); err == nil && objMeta.GetNamespace() != "" {
+			namespaces[objMeta.GetNamespace()] = true
+		}
+	}
+	namespaces[utils.GetAKONamespace()] = true
+	for namespace := range namespaces {
+		if !hasNamespace[namespace] {
+			kubeObjs = append(kubeObjs, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
+		}
+	}
+	if !hasAviIngressClass && len(routeObjs) == 0 {
+		// without an ingressclass, ingresses are accepted only if avi-lb is the default ingressclass.
+		kubeObjs = append(kubeObjs, &networkingv1.IngressClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "avi-lb",
+				Annotations: map[string]string{lib.DefaultIngressClassAnnotation: "true"},
+			},
+			Spec: networkingv1.IngressClassSpec{Controller: lib.AviIngressController},
+		})
+	}
+
+	kubeClient := k8sfake.NewSimpleClientset(kubeObjs...)
+	akoControlConfig.SetAKOBlockedNSList(lib.GetGlobalBlockedNSList())
+	akoControlConfig.SetDefaultLBController(true)
+	akoControlConfig.SetEventRecorder(lib.AKOEventComponent, kubeClient, true)
+	crdClient := crdfake.NewSimpleClientset()
+	akoControlConfig.SetCRDClientset(crdClient)
+	akoControlConfig.SetCRDEnabledParams(crdClient)
+	v1beta1CRDClient := v1beta1crdfake.NewSimpleClientset(crdObjs...)
+	akoControlConfig.Setv1beta1CRDClientset(v1beta1CRDClient)
+	v1alpha2CRDClient := v1alpha2crdfake.NewSimpleClientset(v1alpha2CRDObjs...)
+	akoControlConfig.Setv1alpha2CRDClientset(v1alpha2CRDClient)
+	akoControlConfig.Setv1alpha2CRDEnabledParams(v1alpha2CRDClient)
+
+	registeredInformers := []string{
+		utils.ServiceInformer,
+		utils.SecretInformer,
+		utils.ConfigMapInformer,
+		utils.NSInformer,
+		utils.EndpointSlicesInformer,
+		utils.NodeInformer,
+	}
+	informersArg := make(map[string]interface{})
+	if len(routeObjs) > 0 {
+		registeredInformers = append(registeredInformers, utils.RouteInformer)
+		informersArg[utils.INFORMERS_OPENSHIFT_CLIENT] = oshiftfake.NewSimpleClientset(routeObjs...)
+	} else {
+		registeredInformers = append(registeredInformers, utils.IngressInformer, utils.IngressClassInformer)
+	}
+	utils.NewInformers(utils.KubeClientIntf{ClientSet: kubeClient}, registeredInformers, informersArg)
+	k8s.NewCRDInformers()
+
+	avicache.SharedAviObjCache().CloudKeyCache.AviCacheAdd(utils.CloudName, &avicache.AviCloudPropertyCache{Name: utils.CloudName})
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c := k8s.SharedAviController()
+	c.AddIndexers()
+	c.Start(stopCh)
+	c.DisableSync = false
+	if err := c.FullSyncK8s(false); err != nil {
+		return nil, err
+	}
+
+	restOps := rest.NewRestOperations(avicache.SharedAviObjCache())
+	allModels := objects.SharedAviGraphLister().GetAll().(map[string]interface{})
+	modelNames := make([]string, 0, len(allModels))
+	for modelName := range allModels {
+		modelNames = append(modelNames, modelName)
+	}
+	sort.Strings(modelNames)
+
+	models := make([]Model, 0, len(modelNames))
+	for _, modelName := range modelNames {
+		avimodel, ok := allModels[modelName].(*nodes.AviObjectGraph)
+		if !ok || avimodel == nil {
+			continue
+		}
+		model := Model{Name: modelName, Objects: []AviObject{}}
+		ops, err := restOps.BuildRestOpsForModel(modelName, avimodel)
+		if err != nil {
+			model.Error = err.Error()
+		}
+		for _, op := range ops {
+			model.Objects = append(model.Objects, AviObject{
+				Model:  op.Model,
+				Method: string(op.Method),
+				Path:   op.Path,
+				Tenant: op.Tenant,
+				Object: op.Obj,
+			})
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+func metaAccessor(obj runtime.Object) (metav1.Object, error) {
+	objMeta, ok := obj.(metav1.Object)
+	if !ok {
+		return nil, fmt.Errorf("object %T has no metadata", obj)
+	}
+	return objMeta, nil
+}
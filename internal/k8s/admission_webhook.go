@@ -0,0 +1,192 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	akov1alpha2 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1alpha2"
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+const (
+	ValidatingWebhookPath = "/validate-ako-crds"
+	maxAdmissionBodySize  = 3 * 1024 * 1024
+)
+
+// AdmissionWebhook serves the validating admission webhook for the AKO CRDs. It runs the same checks as
+// the validation of the CRDs during the ingestion, so that an invalid object is rejected when it is applied,
+// instead of being set to Rejected status later.
+type AdmissionWebhook struct{}
+
+func NewAdmissionWebhook() *AdmissionWebhook {
+	return &AdmissionWebhook{}
+}
+
+func (a *AdmissionWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxAdmissionBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := &admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(w, "invalid AdmissionReview", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = ValidateAdmissionRequest(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	resp, err := json.Marshal(review)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+// ValidateAdmissionRequest validates the AKO CRD object in the admission request, and denies the request
+// with the validation error of the object.
+func ValidateAdmissionRequest(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	key, err := validateAdmissionObject(req.Kind.Kind, req.Object.Raw)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: denying %s request, err: %v", key, req.Operation, err)
+		return &admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusForbidden,
+				Reason:  metav1.StatusReasonForbidden,
+				Message: err.Error(),
+			},
+		}
+	}
+	utils.AviLog.Debugf("key: %s, msg: allowing %s request", key, req.Operation)
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func validateAdmissionObject(kind string, raw []byte) (string, error) {
+	var key string
+	switch kind {
+	case lib.HostRule:
+		hostrule := &akov1beta1.HostRule{}
+		if err := json.Unmarshal(raw, hostrule); err != nil {
+			return kind, err
+		}
+		key = lib.HostRule + "/" + utils.ObjKey(hostrule)
+		if err := ValidateHostRuleSpec(key, hostrule); err != nil && !lib.IsCertificatePending(err) {
+			return key, err
+		}
+		// the secret of a HostRule managed by cert-manager can be issued after the HostRule is created
+		return key, nil
+	case lib.HTTPRule:
+		httprule := &akov1beta1.HTTPRule{}
+		if err := json.Unmarshal(raw, httprule); err != nil {
+			return kind, err
+		}
+		key = lib.HTTPRule + "/" + utils.ObjKey(httprule)
+		return key, ValidateHTTPRuleSpec(key, httprule)
+	case lib.AviInfraSetting:
+		infraSetting := &akov1beta1.AviInfraSetting{}
+		if err := json.Unmarshal(raw, infraSetting); err != nil {
+			return kind, err
+		}
+		key = lib.AviInfraSetting + "/" + utils.ObjKey(infraSetting)
+		return key, ValidateAviInfraSettingSpec(key, infraSetting)
+	case lib.SSORule:
+		ssoRule := &akov1alpha2.SSORule{}
+		if err := json.Unmarshal(raw, ssoRule); err != nil {
+			return kind, err
+		}
+		if ssoRule.Spec.Fqdn == nil {
+			return kind, fmt.Errorf("fqdn is not specified")
+		}
+		key = lib.SSORule + "/" + utils.ObjKey(ssoRule)
+		return key, ValidateSSORuleSpec(key, ssoRule)
+	case lib.L4Rule:
+		l4Rule := &akov1alpha2.L4Rule{}
+		if err := json.Unmarshal(raw, l4Rule); err != nil {
+			return kind, err
+		}
+		key = lib.L4Rule + "/" + utils.ObjKey(l4Rule)
+		return key, ValidateL4RuleSpec(key, l4Rule)
+	case lib.L7Rule:
+		l7Rule := &akov1alpha2.L7Rule{}
+		if err := json.Unmarshal(raw, l7Rule); err != nil {
+			return kind, err
+		}
+		key = lib.L7Rule + "/" + utils.ObjKey(l7Rule)
+		return key, ValidateL7RuleSpec(key, l7Rule)
+	}
+	// Objects of other kinds are not validated by AKO.
+	return kind, nil
+}
+
+// StartValidatingWebhook starts the HTTPS server of the validating admission webhook. The certificate is
+// read from the webhook cert directory on every handshake, so that a rotated certificate is used without
+// restarting AKO.
+func StartValidatingWebhook(stopCh <-chan struct{}) {
+	certDir := lib.GetValidatingWebhookCertDir()
+	certFile, keyFile := filepath.Join(certDir, "tls.crt"), filepath.Join(certDir, "tls.key")
+	mux := http.NewServeMux()
+	mux.Handle(ValidatingWebhookPath, NewAdmissionWebhook())
+	server := &http.Server{
+		Addr:              ":" + lib.GetValidatingWebhookPort(),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+				cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+				if err != nil {
+					return nil, err
+				}
+				return &cert, nil
+			},
+		},
+	}
+	go func() {
+		utils.AviLog.Infof("Starting the validating admission webhook on port %s", lib.GetValidatingWebhookPort())
+		if err := server.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			utils.AviLog.Errorf("Validating admission webhook stopped, err: %v", err)
+		}
+	}()
+	go func() {
+		<-stopCh
+		server.Shutdown(context.TODO())
+	}()
+}
@@ -520,7 +520,7 @@ func (c *AviController) InitController(informers K8sinformers, registeredInforme
 	fastRetryQParams := utils.WorkerQueue{NumWorkers: retryQueueWorkers, WorkqueueName: lib.FAST_RETRY_LAYER}
 
 	numWorkers := uint32(1)
-	ingestionQueueParams := utils.WorkerQueue{NumWorkers: numWorkers, WorkqueueName: utils.ObjectIngestionLayer}
+	ingestionQueueParams := utils.WorkerQueue{NumWorkers: numWorkers, WorkqueueName: utils.ObjectIngestionLayer, TrackEnqueueTime: true}
 	numGraphWorkers := lib.GetshardSize()
 	if numGraphWorkers == 0 {
 		// For dedicated VSes - we will have 8 threads layer 3
@@ -536,7 +536,7 @@ func (c *AviController) InitController(informers K8sinformers, registeredInforme
 		utils.AviLog.Errorf("failed to populate cache, disabling sync")
 		lib.ShutdownApi()
 	}
-	c.addIndexers()
+	c.AddIndexers()
 	c.Start(stopCh)
 
 	fullSyncInterval := os.Getenv(utils.FULL_SYNC_INTERVAL)
@@ -551,6 +551,12 @@ func (c *AviController) InitController(informers K8sinformers, registeredInforme
 	if err != nil {
 		utils.AviLog.Errorf("Cannot convert full sync interval value to integer, pls correct the value and restart AKO. Error: %s", err)
 	} else {
+		// The persisted shard placements are loaded before the hostnames are placed on the shared VSs.
+		if lib.IsStickyShardPlacement() {
+			if err := lib.LoadShardPlacements(c.informers.ClientSet); err != nil {
+				utils.AviLog.Warnf("Failed to load the shard placements from configmap %s, err: %v", lib.ShardPlacementConfigMap, err)
+			}
+		}
 		// First boot sync
 		err = c.FullSyncK8s(false)
 		if err != nil {
@@ -570,6 +576,11 @@ func (c *AviController) InitController(informers K8sinformers, registeredInforme
 
 	}
 
+	// The webhook is started after the full sync, so that the duplicate FQDN checks see the existing CRDs.
+	if lib.IsValidatingWebhookEnabled() {
+		StartValidatingWebhook(stopCh)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	if utils.IsWCP() {
 		c.OnStartedLeading()
@@ -593,6 +604,10 @@ func (c *AviController) InitController(informers K8sinformers, registeredInforme
 	}
 	graphQueue.SyncFunc = SyncFromNodesLayer
 	graphQueue.Run(stopCh, graphwg)
+	go MonitorControllerSync(stopCh)
+	if lib.IsStickyShardPlacement() {
+		go c.SyncShardPlacements(stopCh)
+	}
 
 	c.SetupEventHandlers(informers)
 	if ctrlAuthToken, ok := utils.SharedCtrlProp().AviCacheGet(utils.ENV_CTRL_AUTHTOKEN); ok && ctrlAuthToken != nil && ctrlAuthToken.(string) != "" {
@@ -651,11 +666,34 @@ LABEL:
 	statusQueue.StopWorkers(stopCh)
 }
 
+// shardPlacementSyncInterval is the interval at which the changed shard placements are persisted.
+const shardPlacementSyncInterval = 30 * time.Second
+
+// SyncShardPlacements persists the shard placements of the hostnames periodically, from the leader AKO. It is
+// started after the bootup full sync, so that the placements of the hostnames which are removed are dropped.
+func (c *AviController) SyncShardPlacements(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(shardPlacementSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if !lib.AKOControlConfig().IsLeader() {
+				continue
+			}
+			if err := lib.SaveShardPlacements(c.informers.ClientSet, nodes.SharedHostNameLister().GetAllHosts()); err != nil {
+				utils.AviLog.Warnf("Failed to save the shard placements in configmap %s, err: %v", lib.ShardPlacementConfigMap, err)
+			}
+		}
+	}
+}
+
 func (c *AviController) RefreshAuthToken() {
 	lib.RefreshAuthToken(c.informers.KubeClientIntf.ClientSet)
 }
 
-func (c *AviController) addIndexers() {
+func (c *AviController) AddIndexers() {
 	if c.informers.IngressClassInformer != nil {
 		c.informers.IngressClassInformer.Informer().AddIndexers(
 			cache.Indexers{
@@ -689,6 +727,23 @@ func (c *AviController) addIndexers() {
 			},
 		},
 	)
+	if utils.UseMCSServiceImport() {
+		c.informers.EpSlicesInformer.Informer().AddIndexers(
+			cache.Indexers{
+				lib.MCSServiceImportIndex: func(obj interface{}) ([]string, error) {
+					eps, ok := obj.(*discovery.EndpointSlice)
+					if !ok {
+						utils.AviLog.Debugf("error indexing epslice object by upstream service import")
+						return []string{}, nil
+					}
+					if val, ok := eps.Labels[lib.MCSServiceNameLabel]; ok && val != "" {
+						return []string{eps.Namespace + "/" + val}, nil
+					}
+					return []string{}, nil
+				},
+			},
+		)
+	}
 
 	c.informers.ServiceInformer.Informer().AddIndexers(
 		cache.Indexers{
@@ -806,6 +861,10 @@ func (c *AviController) addIndexers() {
 }
 
 func (c *AviController) FullSync() {
+	if lib.IsControllerSyncPaused() {
+		utils.AviLog.Infof("Sync with the Avi Controller is paused, skipping full sync")
+		return
+	}
 	aviRestClientPool := avicache.SharedAVIClients(lib.GetTenant())
 	aviObjCache := avicache.SharedAviObjCache()
 
@@ -845,6 +904,7 @@ func (c *AviController) FullSyncK8s(sync bool) error {
 		utils.AviLog.Infof("Sync disabled, skipping full sync")
 		return nil
 	}
+	defer lib.ObserveFullSyncDuration(time.Now())
 	sharedQueue := utils.SharedWorkQueue().GetQueueByName(utils.GraphLayer)
 	var vrfModelName string
 	if lib.GetDisableStaticRoute() && !lib.IsNodePortMode() {
@@ -975,6 +1035,8 @@ func (c *AviController) FullSyncK8s(sync bool) error {
 				if err := c.GetValidator().ValidateAviInfraSetting(key, aviInfraObj); err != nil {
 					utils.AviLog.Warnf("key: %s, Error retrieved during validation of AviInfraSetting: %v", key, err)
 				}
+				// the claims are indexed before the ingresses and routes are synced
+				lib.UpdateFQDNClaims(aviInfraObj)
 				lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
 				nodes.DequeueIngestion(key, true)
 			}
@@ -1237,23 +1299,27 @@ func (c *AviController) FullSyncK8s(sync bool) error {
 					nodes.DequeueIngestion(key, true)
 				}
 			}
-			siObjs, err := utils.GetInformers().ServiceImportInformer.Lister().ServiceImports(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
-			if err != nil {
-				utils.AviLog.Errorf("Unable to retrieve the service imports during full sync: %s", err)
-				return err
-			}
-			for _, siObj := range siObjs {
-				siLabel := utils.ObjKey(siObj)
-				ns := strings.Split(siLabel, "/")
-				if !lib.IsNamespaceBlocked(ns[0]) && utils.CheckIfNamespaceAccepted(ns[0]) {
-					key := lib.MultiClusterIngress + "/" + siLabel
-					meta, err := meta.Accessor(siObj)
-					if err == nil {
-						resVer := meta.GetResourceVersion()
-						objects.SharedResourceVerInstanceLister().Save(key, resVer)
+			// The servers of the multi-cluster ingresses are populated from the upstream ServiceImports, if enabled,
+			// during the sync of the multi-cluster ingresses.
+			if utils.GetInformers().ServiceImportInformer != nil {
+				siObjs, err := utils.GetInformers().ServiceImportInformer.Lister().ServiceImports(metav1.NamespaceAll).List(labels.Set(nil).AsSelector())
+				if err != nil {
+					utils.AviLog.Errorf("Unable to retrieve the service imports during full sync: %s", err)
+					return err
+				}
+				for _, siObj := range siObjs {
+					siLabel := utils.ObjKey(siObj)
+					ns := strings.Split(siLabel, "/")
+					if !lib.IsNamespaceBlocked(ns[0]) && utils.CheckIfNamespaceAccepted(ns[0]) {
+						key := lib.MultiClusterIngress + "/" + siLabel
+						meta, err := meta.Accessor(siObj)
+						if err == nil {
+							resVer := meta.GetResourceVersion()
+							objects.SharedResourceVerInstanceLister().Save(key, resVer)
+						}
+						lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
+						nodes.DequeueIngestion(key, true)
 					}
-					lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
-					nodes.DequeueIngestion(key, true)
 				}
 			}
 		}
@@ -1272,6 +1338,8 @@ func (c *AviController) FullSyncK8s(sync bool) error {
 				if err := c.GetValidator().ValidateAviInfraSetting(key, aviInfraObj); err != nil {
 					utils.AviLog.Warnf("key: %s, Error retrieved during validation of AviInfraSetting: %v", key, err)
 				}
+				// the claims are indexed before the ingresses and routes are synced
+				lib.UpdateFQDNClaims(aviInfraObj)
 				lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
 				nodes.DequeueIngestion(key, true)
 			}
@@ -1519,6 +1587,9 @@ func SyncFromIngestionLayer(key interface{}, wg *sync.WaitGroup) error {
 		lib.DecrementQueueCounter(utils.ObjectIngestionLayer)
 		return nil
 	}
+	if objType, namespace, name := lib.ExtractTypeNameNamespace(keyStr); objType == utils.Secret {
+		revalidateSecretHostRules(keyStr, namespace, name)
+	}
 	nodes.DequeueIngestion(keyStr, false)
 	return nil
 }
@@ -1552,6 +1623,11 @@ func SyncFromNodesLayer(key interface{}, wg *sync.WaitGroup) error {
 		lib.DecrementQueueCounter(utils.GraphLayer)
 		return nil
 	}
+	if lib.BufferKeyIfControllerSyncPaused(keyStr) {
+		utils.AviLog.Infof("key: %s, msg: sync with the Avi Controller is paused, buffering the key", keyStr)
+		lib.DecrementQueueCounter(utils.GraphLayer)
+		return nil
+	}
 	cache := avicache.SharedAviObjCache()
 	restlayer := rest.NewRestOperations(cache)
 	restlayer.DequeueNodes(keyStr)
@@ -1613,11 +1689,13 @@ func (c *AviController) IstioBootstrap() {
 		}
 		newAviModel.AddModelNode(pkinode)
 		sslNode := &nodes.AviTLSKeyCertNode{
-			Name:   lib.GetIstioWorkloadCertificateName(),
-			Tenant: lib.GetTenant(),
-			Type:   lib.CertTypeVS,
-			Cert:   sslCert,
-			Key:    sslKey,
+			Name:            lib.GetIstioWorkloadCertificateName(),
+			Tenant:          lib.GetTenant(),
+			Type:            lib.CertTypeVS,
+			Cert:            sslCert,
+			Key:             sslKey,
+			SecretNamespace: utils.GetAKONamespace(),
+			SecretName:      lib.IstioSecret,
 		}
 		newAviModel.AddModelNode(sslNode)
 
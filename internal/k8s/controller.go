@@ -163,9 +163,13 @@ func isIngressUpdated(oldIngress, newIngress *networkingv1.Ingress) bool {
 	oldAnnotation := oldIngress.DeepCopy().Annotations
 	delete(oldAnnotation, lib.VSAnnotation)
 	delete(oldAnnotation, lib.ControllerAnnotation)
+	delete(oldAnnotation, lib.MaintenanceStatusAnnotation)
+	delete(oldAnnotation, lib.IngressStatusAnnotation)
 	newAnnotation := newIngress.DeepCopy().Annotations
 	delete(newAnnotation, lib.VSAnnotation)
 	delete(newAnnotation, lib.ControllerAnnotation)
+	delete(newAnnotation, lib.MaintenanceStatusAnnotation)
+	delete(newAnnotation, lib.IngressStatusAnnotation)
 
 	oldAnnotationHash := utils.Hash(utils.Stringify(oldAnnotation))
 	newAnnotationHash := utils.Hash(utils.Stringify(newAnnotation))
@@ -756,6 +760,9 @@ func (c *AviController) SetupEventHandlers(k8sinfo K8sinformers) {
 				return
 			}
 			eps := obj.(*discovery.EndpointSlice)
+			if mcsSvcName := eps.Labels[lib.MCSServiceNameLabel]; mcsSvcName != "" && utils.UseMCSServiceImport() {
+				c.addMCSServiceImportToIngestionQueue(eps.Namespace, mcsSvcName, numWorkers, "Endpointslice ADD")
+			}
 			namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(eps))
 			svcName, ok := eps.Labels[discovery.LabelServiceName]
 			if !ok || svcName == "" {
@@ -790,6 +797,9 @@ func (c *AviController) SetupEventHandlers(k8sinfo K8sinformers) {
 					return
 				}
 			}
+			if mcsSvcName := eps.Labels[lib.MCSServiceNameLabel]; mcsSvcName != "" && utils.UseMCSServiceImport() {
+				c.addMCSServiceImportToIngestionQueue(eps.Namespace, mcsSvcName, numWorkers, "Endpointslice DELETE")
+			}
 			namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(eps))
 			svcName, ok := eps.Labels[discovery.LabelServiceName]
 			if !ok || svcName == "" {
@@ -813,6 +823,9 @@ func (c *AviController) SetupEventHandlers(k8sinfo K8sinformers) {
 			oeps := old.(*discovery.EndpointSlice)
 			ceps := cur.(*discovery.EndpointSlice)
 			if oeps.ResourceVersion != ceps.ResourceVersion {
+				if mcsSvcName := ceps.Labels[lib.MCSServiceNameLabel]; mcsSvcName != "" && utils.UseMCSServiceImport() {
+					c.addMCSServiceImportToIngestionQueue(ceps.Namespace, mcsSvcName, numWorkers, "Endpointslice UPDATE")
+				}
 				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(ceps))
 				svcName, ok := ceps.Labels[discovery.LabelServiceName]
 				if !ok || svcName == "" {
@@ -903,6 +916,7 @@ func (c *AviController) SetupEventHandlers(k8sinfo K8sinformers) {
 					return
 				}
 			}
+			status.DeleteServiceMaintenanceState(utils.ObjKey(svc))
 			if !lib.ValidServiceType(svc) {
 				key := utils.Service + "/" + utils.ObjKey(svc)
 				utils.AviLog.Warnf("key: %s, msg: Invalid service type: [%s] Currently Allowed: [ClusterIP, NodePort, LoadBalancer]", key, string(svc.Spec.Type))
@@ -1169,6 +1183,7 @@ func (c *AviController) SetupEventHandlers(k8sinfo K8sinformers) {
 					return
 				}
 			}
+			lib.DeleteSecretCertificateStates(secret.Namespace, secret.Name)
 			if checkAviSecretUpdateAndShutdown(secret) {
 				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(secret))
 				key := "Secret" + "/" + utils.ObjKey(secret)
@@ -1289,6 +1304,8 @@ func (c *AviController) SetupEventHandlers(k8sinfo K8sinformers) {
 			}
 			key := utils.Ingress + "/" + utils.ObjKey(ingress)
 			namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(ingress))
+			lib.DeleteCertificateStates(utils.Ingress, ingress.Namespace, ingress.Name)
+			status.DeleteIngressMessages(utils.ObjKey(ingress))
 			if lib.IsNamespaceBlocked(namespace) || !utils.CheckIfNamespaceAccepted(namespace) {
 				utils.AviLog.Debugf("key: %s, msg: Ingress Delete event: Namespace: %s didn't qualify filter. Not deleting ingress", key, namespace)
 				return
@@ -1511,7 +1528,11 @@ func (c *AviController) SetupEventHandlers(k8sinfo K8sinformers) {
 	// Add MultiClusterIngress and ServiceImport CRD event handlers
 	if utils.IsMultiClusterIngressEnabled() {
 		c.SetupMultiClusterIngressEventHandlers(numWorkers)
-		c.SetupServiceImportEventHandlers(numWorkers)
+		if utils.UseMCSServiceImport() {
+			c.SetupMCSServiceImportEventHandlers(numWorkers)
+		} else {
+			c.SetupServiceImportEventHandlers(numWorkers)
+		}
 	}
 
 	//Add namespace event handler if migration is enabled and informer not nil
@@ -1686,8 +1707,14 @@ func (c *AviController) Start(stopCh <-chan struct{}) {
 		if utils.IsMultiClusterIngressEnabled() {
 			go c.informers.MultiClusterIngressInformer.Informer().Run(stopCh)
 			informersList = append(informersList, c.informers.MultiClusterIngressInformer.Informer().HasSynced)
-			go c.informers.ServiceImportInformer.Informer().Run(stopCh)
-			informersList = append(informersList, c.informers.ServiceImportInformer.Informer().HasSynced)
+			if c.informers.ServiceImportInformer != nil {
+				go c.informers.ServiceImportInformer.Informer().Run(stopCh)
+				informersList = append(informersList, c.informers.ServiceImportInformer.Informer().HasSynced)
+			}
+			if c.dynamicInformers != nil && c.dynamicInformers.MCSServiceImportInformer != nil {
+				go c.dynamicInformers.MCSServiceImportInformer.Informer().Run(stopCh)
+				informersList = append(informersList, c.dynamicInformers.MCSServiceImportInformer.Informer().HasSynced)
+			}
 		}
 
 	}
@@ -1734,6 +1761,12 @@ func isIngAcceptedWithFQDNRestriction(key string, ingress *networkingv1.Ingress)
 	isAdded := false
 	for _, host := range sets.List(ingHosts) {
 		isAdded, _, _ = objects.SharedUniqueNamespaceLister().UpdateHostnameToRoute(host, routeNamespaceName)
+		if lib.IsFQDNClaimed(host) {
+			// the host is registered, so that the FQDN reuse policy applies once the claim is removed, but the
+			// namespaces allowed to use a claimed host are validated in the nodes layer
+			isAdded = true
+			continue
+		}
 		if !isAdded {
 			utils.AviLog.Warnf("key:%s, msg: ingress is not accepted as host %s is already claimed", key, host)
 			err_msg := fmt.Sprintf("Host %s already claimed", host)
@@ -1758,6 +1791,10 @@ func isRouteAcceptedWithFQDNRestriction(key string, route *routev1.Route) bool {
 	}
 	isAdded := false
 	isAdded, _, _ = objects.SharedUniqueNamespaceLister().UpdateHostnameToRoute(route.Spec.Host, routeNamespaceName)
+	if lib.IsFQDNClaimed(route.Spec.Host) {
+		// the host is registered, but the namespaces allowed to use a claimed host are validated by its claims
+		return true
+	}
 	if !isAdded {
 		utils.AviLog.Warnf("key: %s, msg: Route is not added due to hostname conflict", key)
 	}
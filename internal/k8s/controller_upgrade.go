@@ -0,0 +1,105 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package k8s
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vmware/alb-sdk/go/clients"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+const controllerSyncCheckInterval = 30 * time.Second
+
+// MonitorControllerSync periodically checks if the Avi Controller is under maintenance or being upgraded,
+// and pauses the sync with the controller till it is back. The sync is also paused by the rest layer, when
+// the controller repeatedly fails the requests with 503, or disallows the configuration during an upgrade.
+func MonitorControllerSync(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(controllerSyncCheckInterval)
+	defer ticker.Stop()
+	var version string
+	for {
+		select {
+		case <-ticker.C:
+			version = CheckControllerSync(version)
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// CheckControllerSync pauses the sync if the Avi cluster is not active, or if the version of the controller
+// has changed since lastVersion, i.e. the controller was upgraded. A paused sync is resumed once the cluster
+// is active. It returns the current version of the controller.
+func CheckControllerSync(lastVersion string) string {
+	aviRestClientPool := avicache.SharedAVIClients(lib.GetTenant())
+	if aviRestClientPool == nil || len(aviRestClientPool.AviClient) == 0 {
+		return lastVersion
+	}
+	client := aviRestClientPool.AviClient[0]
+	version, err := client.AviSession.GetControllerVersion()
+	if err != nil {
+		// The session is re-initiated on a failure, so a failure which persists on a retry is due to the
+		// controller being unavailable, e.g. rebooted during the upgrade.
+		version, err = client.AviSession.GetControllerVersion()
+	}
+	if err != nil {
+		utils.AviLog.Warnf("Failed to get the version of the Avi Controller, err: %v", err)
+		lib.PauseControllerSync("Avi Controller is unavailable")
+		return lastVersion
+	}
+	clusterState, err := avicache.GetAviClusterState(client)
+	if err != nil {
+		return lastVersion
+	}
+	if !avicache.IsAviClusterStateActive(clusterState) {
+		lib.PauseControllerSync("Avi cluster state is " + clusterState)
+		return lastVersion
+	}
+	if lastVersion != "" && version != lastVersion {
+		// The API version used by AKO is not changed, which is supported by the upgraded controller.
+		lib.PauseControllerSync(fmt.Sprintf("Avi Controller version changed from %s to %s", lastVersion, version))
+	}
+	if lib.IsControllerSyncPaused() {
+		resumeControllerSync(client)
+	}
+	return version
+}
+
+// resumeControllerSync re-populates the Avi object cache, which may be stale after the upgrade of the
+// controller, resumes the sync and publishes the models buffered during the pause to the rest layer.
+func resumeControllerSync(client *clients.AviClient) {
+	aviObjCache := avicache.SharedAviObjCache()
+	// The leader node of the cluster changes during the upgrade, which is not a reason to restart AKO.
+	aviObjCache.ClusterStatusCache.AviCacheDelete(lib.ClusterStatusCacheKey)
+	if err := aviObjCache.AviClusterStatusPopulate(client); err != nil {
+		utils.AviLog.Warnf("Failed to populate the Avi cluster status, sync stays paused, err: %v", err)
+		return
+	}
+	if err := PopulateCache(); err != nil {
+		utils.AviLog.Warnf("Failed to re-populate the Avi object cache, sync stays paused, err: %v", err)
+		return
+	}
+
+	sharedQueue := utils.SharedWorkQueue().GetQueueByName(utils.GraphLayer)
+	for _, modelName := range lib.ResumeControllerSync() {
+		nodes.PublishKeyToRestLayer(modelName, "controllerresync", sharedQueue)
+	}
+}
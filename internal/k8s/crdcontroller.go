@@ -236,6 +236,7 @@ func (c *AviController) SetupAKOCRDEventHandlers(numWorkers uint32) {
 				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(hostrule))
 				key := lib.HostRule + "/" + utils.ObjKey(hostrule)
 				utils.AviLog.Debugf("key: %s, msg: DELETE", key)
+				lib.DeleteCertificateStates(lib.HostRule, hostrule.Namespace, hostrule.Name)
 				objects.SharedResourceVerInstanceLister().Delete(key)
 				bkt := utils.Bkt(namespace, numWorkers)
 				c.workqueue[bkt].AddRateLimited(key)
@@ -317,10 +318,11 @@ func (c *AviController) SetupAKOCRDEventHandlers(numWorkers uint32) {
 	if lib.AKOControlConfig().AviInfraSettingEnabled() {
 		aviInfraEventHandler := cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
+				aviinfra := obj.(*akov1beta1.AviInfraSetting)
+				lib.UpdateFQDNClaims(aviinfra)
 				if c.DisableSync {
 					return
 				}
-				aviinfra := obj.(*akov1beta1.AviInfraSetting)
 				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(aviinfra))
 				key := lib.AviInfraSetting + "/" + utils.ObjKey(aviinfra)
 				if err := c.GetValidator().ValidateAviInfraSetting(key, aviinfra); err != nil {
@@ -330,13 +332,15 @@ func (c *AviController) SetupAKOCRDEventHandlers(numWorkers uint32) {
 				bkt := utils.Bkt(namespace, numWorkers)
 				c.workqueue[bkt].AddRateLimited(key)
 				lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
+				c.addFQDNClaimedObjectsToIngestionQueue(nil, aviinfra, numWorkers)
 			},
 			UpdateFunc: func(old, new interface{}) {
+				oldObj := old.(*akov1beta1.AviInfraSetting)
+				aviInfra := new.(*akov1beta1.AviInfraSetting)
+				lib.UpdateFQDNClaims(aviInfra)
 				if c.DisableSync {
 					return
 				}
-				oldObj := old.(*akov1beta1.AviInfraSetting)
-				aviInfra := new.(*akov1beta1.AviInfraSetting)
 				if isAviInfraUpdated(oldObj, aviInfra) {
 					namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(aviInfra))
 					key := lib.AviInfraSetting + "/" + utils.ObjKey(aviInfra)
@@ -347,12 +351,10 @@ func (c *AviController) SetupAKOCRDEventHandlers(numWorkers uint32) {
 					bkt := utils.Bkt(namespace, numWorkers)
 					c.workqueue[bkt].AddRateLimited(key)
 					lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
+					c.addFQDNClaimedObjectsToIngestionQueue(oldObj, aviInfra, numWorkers)
 				}
 			},
 			DeleteFunc: func(obj interface{}) {
-				if c.DisableSync {
-					return
-				}
 				aviinfra, ok := obj.(*akov1beta1.AviInfraSetting)
 				if !ok {
 					tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
@@ -366,6 +368,10 @@ func (c *AviController) SetupAKOCRDEventHandlers(numWorkers uint32) {
 						return
 					}
 				}
+				lib.DeleteFQDNClaims(aviinfra.Name)
+				if c.DisableSync {
+					return
+				}
 				key := lib.AviInfraSetting + "/" + utils.ObjKey(aviinfra)
 				namespace, _, _ := cache.SplitMetaNamespaceKey(utils.ObjKey(aviinfra))
 				utils.AviLog.Debugf("key: %s, msg: DELETE", key)
@@ -374,6 +380,7 @@ func (c *AviController) SetupAKOCRDEventHandlers(numWorkers uint32) {
 				bkt := utils.Bkt(namespace, numWorkers)
 				c.workqueue[bkt].AddRateLimited(key)
 				lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
+				c.addFQDNClaimedObjectsToIngestionQueue(aviinfra, nil, numWorkers)
 			},
 		}
 
@@ -1074,6 +1081,172 @@ func (c *AviController) SetupServiceImportEventHandlers(numWorkers uint32) {
 	c.informers.ServiceImportInformer.Informer().AddEventHandler(serviceImportEventHandler)
 }
 
+// SetupMCSServiceImportEventHandlers handles setting up of the event handlers of the upstream Multi-Cluster Services
+// API ServiceImports. The EndpointSlices of these ServiceImports are handled by the EndpointSlice event handlers.
+func (c *AviController) SetupMCSServiceImportEventHandlers(numWorkers uint32) {
+	if c.dynamicInformers == nil || c.dynamicInformers.MCSServiceImportInformer == nil {
+		utils.AviLog.Warnf("Dynamic informer for the upstream ServiceImports is not initialized")
+		return
+	}
+	utils.AviLog.Infof("Setting up upstream ServiceImport Event handlers")
+
+	mcsServiceImportEventHandler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if c.DisableSync {
+				return
+			}
+			si := obj.(*unstructured.Unstructured)
+			c.addMCSServiceImportToIngestionQueue(si.GetNamespace(), si.GetName(), numWorkers, "ADD")
+		},
+		UpdateFunc: func(old, new interface{}) {
+			if c.DisableSync {
+				return
+			}
+			oldObj := old.(*unstructured.Unstructured)
+			si := new.(*unstructured.Unstructured)
+			if !reflect.DeepEqual(oldObj.Object["spec"], si.Object["spec"]) {
+				c.addMCSServiceImportToIngestionQueue(si.GetNamespace(), si.GetName(), numWorkers, "UPDATE")
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if c.DisableSync {
+				return
+			}
+			si, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					utils.AviLog.Errorf("couldn't get object from tombstone %#v", obj)
+					return
+				}
+				si, ok = tombstone.Obj.(*unstructured.Unstructured)
+				if !ok {
+					utils.AviLog.Errorf("Tombstone contained object that is not a ServiceImport: %#v", obj)
+					return
+				}
+			}
+			c.addMCSServiceImportToIngestionQueue(si.GetNamespace(), si.GetName(), numWorkers, "DELETE")
+		},
+	}
+	c.dynamicInformers.MCSServiceImportInformer.Informer().AddEventHandler(mcsServiceImportEventHandler)
+}
+
+// addMCSServiceImportToIngestionQueue adds the upstream ServiceImport to the ingestion queue, on a change in
+// the ServiceImport or one of its EndpointSlices.
+func (c *AviController) addMCSServiceImportToIngestionQueue(namespace, name string, numWorkers uint32, op string) {
+	key := lib.MCSServiceImport + "/" + namespace + "/" + name
+	if lib.IsNamespaceBlocked(namespace) || !utils.CheckIfNamespaceAccepted(namespace) {
+		utils.AviLog.Debugf("key: %s, msg: upstream ServiceImport %s event: Namespace: %s didn't qualify filter", key, op, namespace)
+		return
+	}
+	utils.AviLog.Debugf("key: %s, msg: %s", key, op)
+	bkt := utils.Bkt(namespace, numWorkers)
+	c.workqueue[bkt].AddRateLimited(key)
+	lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
+}
+
+// addFQDNClaimedObjectsToIngestionQueue re-evaluates the Ingresses and Routes with hosts matching the FQDN claims
+// of the AviInfraSetting before and after the change, so that the hosts are accepted or rejected as per the
+// current claims.
+func (c *AviController) addFQDNClaimedObjectsToIngestionQueue(oldInfraSetting, newInfraSetting *akov1beta1.AviInfraSetting, numWorkers uint32) {
+	if !lib.FQDNClaimsUpdated(oldInfraSetting, newInfraSetting) {
+		return
+	}
+	var domains []string
+	for _, infraSetting := range []*akov1beta1.AviInfraSetting{oldInfraSetting, newInfraSetting} {
+		if infraSetting == nil {
+			continue
+		}
+		for _, claim := range infraSetting.Spec.FQDNClaims {
+			domains = append(domains, claim.Domains...)
+		}
+	}
+	isClaimed := func(host string) bool {
+		for _, domain := range domains {
+			if lib.IsFQDNClaimedByDomain(domain, host) {
+				return true
+			}
+		}
+		return false
+	}
+	enqueue := func(key, namespace string) {
+		if lib.IsNamespaceBlocked(namespace) || !utils.CheckIfNamespaceAccepted(namespace) {
+			return
+		}
+		utils.AviLog.Debugf("key: %s, msg: FQDN claims updated", key)
+		bkt := utils.Bkt(namespace, numWorkers)
+		c.workqueue[bkt].AddRateLimited(key)
+		lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
+	}
+
+	if utils.GetInformers().IngressInformer != nil {
+		ingresses, err := utils.GetInformers().IngressInformer.Lister().List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Warnf("Unable to list Ingresses for the FQDN claims, err: %v", err)
+		}
+		for _, ingress := range ingresses {
+			for _, rule := range ingress.Spec.Rules {
+				if isClaimed(rule.Host) {
+					enqueue(utils.Ingress+"/"+utils.ObjKey(ingress), ingress.Namespace)
+					break
+				}
+			}
+		}
+	}
+	if utils.GetInformers().RouteInformer != nil {
+		routes, err := utils.GetInformers().RouteInformer.Lister().List(labels.Set(nil).AsSelector())
+		if err != nil {
+			utils.AviLog.Warnf("Unable to list Routes for the FQDN claims, err: %v", err)
+		}
+		for _, route := range routes {
+			if isClaimed(route.Spec.Host) {
+				enqueue(utils.OshiftRoute+"/"+utils.ObjKey(route), route.Namespace)
+			}
+		}
+	}
+}
+
+// revalidateSecretHostRules re-processes the HostRules using the Secret as the SSL key and certificate, when the
+// Secret is synced by the ingestion worker. Only the HostRules pending issuance of the Secret by cert-manager, or
+// rejected for an invalid certificate, are validated again; the certificate state of the accepted HostRules is
+// reported, and the renewed certificate is pushed by processing them again.
+func revalidateSecretHostRules(key, namespace, secretName string) {
+	if !lib.AKOControlConfig().HostRuleEnabled() {
+		return
+	}
+	hostrules, err := lib.AKOControlConfig().CRDInformers().HostRuleInformer.Lister().HostRules(namespace).List(labels.Set(nil).AsSelector())
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: Unable to list HostRules for the secret, err: %v", key, err)
+		return
+	}
+	ingestionQueue := utils.SharedWorkQueue().GetQueueByName(utils.ObjectIngestionLayer)
+	for _, hostrule := range hostrules {
+		sslKeyCert := hostrule.Spec.VirtualHost.TLS.SSLKeyCertificate
+		if !(sslKeyCert.Type == akov1beta1.HostRuleSecretTypeSecretReference && sslKeyCert.Name == secretName) &&
+			!(sslKeyCert.AlternateCertificate.Type == akov1beta1.HostRuleSecretTypeSecretReference && sslKeyCert.AlternateCertificate.Name == secretName) {
+			continue
+		}
+		hrKey := lib.HostRule + "/" + utils.ObjKey(hostrule)
+		switch {
+		case hostrule.Status.Status == lib.StatusPending,
+			hostrule.Status.Status == lib.StatusRejected && lib.IsInvalidCertificateMessage(hostrule.Status.Error):
+			if err := NewValidator().ValidateHostRuleObj(hrKey, hostrule); err != nil {
+				utils.AviLog.Warnf("key: %s, msg: Error retrieved during validation of HostRule: %v", hrKey, err)
+			}
+		case hostrule.Status.Status == lib.StatusAccepted:
+			if lib.AKOControlConfig().IsLeader() {
+				reportHostRuleCertificateState(hrKey, hostrule)
+			}
+		default:
+			continue
+		}
+		utils.AviLog.Debugf("key: %s, msg: secret %s updated", hrKey, secretName)
+		bkt := utils.Bkt(hostrule.Namespace, ingestionQueue.NumWorkers)
+		ingestionQueue.Workqueue[bkt].AddRateLimited(hrKey)
+		lib.IncrementQueueCounter(utils.ObjectIngestionLayer)
+	}
+}
+
 func checkRefsOnController(key string, refMap map[string]string, tenant string) error {
 	for k, value := range refMap {
 		if k == "" {
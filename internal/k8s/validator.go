@@ -16,6 +16,7 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"regexp"
@@ -63,12 +64,44 @@ func NewValidator() Validator {
 // update internal CRD caches, and push relevant ingresses to ingestion
 func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule) error {
 
+	if hostrule.Spec.VirtualHost.L7Rule != "" {
+		objects.SharedCRDLister().UpdateL7RuleToHostRuleMapping(hostrule.Namespace+"/"+hostrule.Spec.VirtualHost.L7Rule, hostrule.Name)
+	}
+
+	err := ValidateHostRuleSpec(key, hostrule)
+	reportHostRuleCertificateState(key, hostrule)
+	if err != nil {
+		if lib.IsCertificatePending(err) {
+			// The HostRule is validated again once cert-manager issues the secret.
+			if hostrule.Status.Status != lib.StatusPending {
+				lib.AKOControlConfig().EventRecorder().Eventf(hostrule, v1.EventTypeWarning, lib.CertificatePending,
+					"Certificate is not programmed, %v", err)
+			}
+			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusPending, Error: err.Error()})
+			return err
+		}
+		status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
+		return err
+	}
+
+	// No need to update status of hostrule object as accepted since it was accepted before.
+	if hostrule.Status.Status == lib.StatusAccepted {
+		return nil
+	}
+
+	status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusAccepted, Error: ""})
+	return nil
+}
+
+// ValidateHostRuleSpec runs the structural and the controller ref checks on the HostRule, without
+// updating its status or the CRD caches. It is used by the ingestion and the admission webhook.
+func ValidateHostRuleSpec(key string, hostrule *akov1beta1.HostRule) error {
+
 	var err error
 	fqdn := hostrule.Spec.VirtualHost.Fqdn
 	foundHost, foundHR := objects.SharedCRDLister().GetFQDNToHostruleMapping(fqdn)
 	if foundHost && foundHR != hostrule.Namespace+"/"+hostrule.Name {
 		err = fmt.Errorf("duplicate fqdn %s found in %s", fqdn, foundHR)
-		status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 		return err
 	}
 
@@ -85,7 +118,6 @@ func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule)
 		re := regexp.MustCompile(lib.IPRegex)
 		if !re.MatchString(hostrule.Spec.VirtualHost.TCPSettings.LoadBalancerIP) {
 			err = fmt.Errorf("loadBalancerIP %s is not a valid IP", hostrule.Spec.VirtualHost.TCPSettings.LoadBalancerIP)
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 	}
@@ -93,7 +125,6 @@ func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule)
 	if hostrule.Spec.VirtualHost.Gslb.Fqdn != "" {
 		if fqdn == hostrule.Spec.VirtualHost.Gslb.Fqdn {
 			err = fmt.Errorf("GSLB FQDN and local FQDN are same")
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 	}
@@ -108,7 +139,6 @@ func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule)
 		}
 		if !sslEnabled {
 			err = fmt.Errorf("Hosting parent virtualservice must have SSL enabled")
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 	}
@@ -116,26 +146,22 @@ func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule)
 	if hostrule.Spec.VirtualHost.Aliases != nil {
 		if hostrule.Spec.VirtualHost.FqdnType != akov1beta1.Exact {
 			err = fmt.Errorf("Aliases is supported only when FQDN type is set as Exact")
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 
 		if utils.HasElem(hostrule.Spec.VirtualHost.Aliases, fqdn) {
 			err = fmt.Errorf("Duplicate entry found. Aliases field has same entry as the FQDN field")
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 
 		if utils.ContainsDuplicate(hostrule.Spec.VirtualHost.Aliases) {
 			err = fmt.Errorf("Aliases must be unique")
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 
 		if hostrule.Spec.VirtualHost.Gslb.Fqdn != "" &&
 			utils.HasElem(hostrule.Spec.VirtualHost.Aliases, hostrule.Spec.VirtualHost.Gslb.Fqdn) {
 			err = fmt.Errorf("Aliases must not contain GSLB FQDN")
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 
@@ -147,7 +173,6 @@ func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule)
 			for _, alias := range hostrule.Spec.VirtualHost.Aliases {
 				if utils.HasElem(aliases, alias) {
 					err = fmt.Errorf("%s is already in use by hostrule %s", alias, cachedFQDN)
-					status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 					return err
 				}
 			}
@@ -167,9 +192,8 @@ func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule)
 
 	if hostrule.Spec.VirtualHost.TLS.SSLKeyCertificate.Type == akov1beta1.HostRuleSecretTypeSecretReference {
 		secretName := hostrule.Spec.VirtualHost.TLS.SSLKeyCertificate.Name
-		err := validateSecretReferenceInHostrule(hostrule.Namespace, secretName)
+		err := validateSecretReferenceInHostrule(hostrule, secretName)
 		if err != nil {
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 	}
@@ -179,14 +203,12 @@ func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule)
 
 	if hostrule.Spec.VirtualHost.TLS.SSLKeyCertificate.AlternateCertificate.Type == akov1beta1.HostRuleSecretTypeSecretReference {
 		secretName := hostrule.Spec.VirtualHost.TLS.SSLKeyCertificate.AlternateCertificate.Name
-		err := validateSecretReferenceInHostrule(hostrule.Namespace, secretName)
+		err := validateSecretReferenceInHostrule(hostrule, secretName)
 		if err != nil {
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 	}
 	if len(hostrule.Spec.VirtualHost.ICAPProfile) > 1 {
-		status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: "Can only have 1 ICAP profile associated with VS"})
 		return fmt.Errorf("Can only have 1 ICAP profile associated with VS")
 	} else {
 		for _, icapprofile := range hostrule.Spec.VirtualHost.ICAPProfile {
@@ -210,44 +232,34 @@ func (l *leader) ValidateHostRuleObj(key string, hostrule *akov1beta1.HostRule)
 	tenant := lib.GetTenantInNamespace(hostrule.Namespace)
 
 	if err := checkRefsOnController(key, refData, tenant); err != nil {
-		status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 		return err
 	}
 
 	if hostrule.Spec.VirtualHost.L7Rule != "" {
-		objects.SharedCRDLister().UpdateL7RuleToHostRuleMapping(hostrule.Namespace+"/"+hostrule.Spec.VirtualHost.L7Rule, hostrule.Name)
 		_, err := lib.AKOControlConfig().CRDInformers().L7RuleInformer.Lister().L7Rules(hostrule.Namespace).Get(hostrule.Spec.VirtualHost.L7Rule)
 		if err != nil {
-			status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 			return err
 		}
 	}
 
 	if strings.Contains(fqdn, lib.ShardVSSubstring) && hostrule.Spec.VirtualHost.UseRegex {
 		err = fmt.Errorf("hostrule useRegex with fqdn %s cannot be applied to shared virtualservices", fqdn)
-		status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 		return err
 	}
 
 	if strings.Contains(fqdn, lib.ShardVSSubstring) && hostrule.Spec.VirtualHost.ApplicationRootPath != "" {
 		err = fmt.Errorf("hostrule applicationRootPath with fqdn %s cannot be applied to shared virtualservices", fqdn)
-		status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 		return err
 	}
 
-	// No need to update status of hostrule object as accepted since it was accepted before.
-	if hostrule.Status.Status == lib.StatusAccepted {
-		return nil
-	}
-
-	status.UpdateHostRuleStatus(key, hostrule, status.UpdateCRDStatusOptions{Status: lib.StatusAccepted, Error: ""})
 	return nil
 }
 
-func validateSecretReferenceInHostrule(namespace, secretName string) error {
+func validateSecretReferenceInHostrule(hostrule *akov1beta1.HostRule, secretName string) error {
 
 	// reject the hostrule if the secret handling is restricted to the namespace where
 	// AKO is installed.
+	namespace := hostrule.Namespace
 	if utils.GetInformers().RouteInformer != nil &&
 		namespace != utils.GetAKONamespace() &&
 		utils.IsSecretsHandlingRestrictedToAKONS() {
@@ -255,10 +267,39 @@ func validateSecretReferenceInHostrule(namespace, secretName string) error {
 		return err
 	}
 
-	_, err := utils.GetInformers().SecretInformer.Lister().Secrets(namespace).Get(secretName)
+	secret, err := utils.GetInformers().SecretInformer.Lister().Secrets(namespace).Get(secretName)
+	if k8serrors.IsNotFound(err) && lib.IsCertManagerManaged(hostrule.Annotations) {
+		return &lib.CertificatePendingError{Namespace: namespace, Name: secretName}
+	}
+	if err != nil {
+		return err
+	}
+	_, err = lib.ValidateSecretCertificate(secret)
 	return err
 }
 
+// reportHostRuleCertificateState reports the invalid and the expiring certificates of the Secrets used by the
+// HostRule, in the events of the HostRule.
+func reportHostRuleCertificateState(key string, hostrule *akov1beta1.HostRule) {
+	sslKeyCert := hostrule.Spec.VirtualHost.TLS.SSLKeyCertificate
+	var secretNames []string
+	if sslKeyCert.Type == akov1beta1.HostRuleSecretTypeSecretReference {
+		secretNames = append(secretNames, sslKeyCert.Name)
+	}
+	if sslKeyCert.AlternateCertificate.Type == akov1beta1.HostRuleSecretTypeSecretReference {
+		secretNames = append(secretNames, sslKeyCert.AlternateCertificate.Name)
+	}
+	for _, secretName := range secretNames {
+		secret, err := utils.GetInformers().SecretInformer.Lister().Secrets(hostrule.Namespace).Get(secretName)
+		if err != nil {
+			continue
+		}
+		leaf, err := lib.ValidateSecretCertificate(secret)
+		lib.ReportCertificateState(key, lib.AKOControlConfig().EventRecorder(), hostrule, lib.HostRule,
+			hostrule.Namespace, hostrule.Name, secretName, leaf, err)
+	}
+}
+
 func validateSecretReferenceInSSORule(namespace, secretName string) (*v1.Secret, error) {
 
 	// reject the SSORule if the secret handling is restricted to the namespace where
@@ -278,15 +319,35 @@ func validateSecretReferenceInSSORule(namespace, secretName string) (*v1.Secret,
 // update internal CRD caches, and push relevant ingresses to ingestion
 func (l *leader) ValidateHTTPRuleObj(key string, httprule *akov1beta1.HTTPRule) error {
 
+	if err := ValidateHTTPRuleSpec(key, httprule); err != nil {
+		status.UpdateHTTPRuleStatus(key, httprule, status.UpdateCRDStatusOptions{
+			Status: lib.StatusRejected,
+			Error:  err.Error(),
+		})
+		return err
+	}
+
+	// No need to update status of httprule object as accepted since it was accepted before.
+	if httprule.Status.Status == lib.StatusAccepted {
+		return nil
+	}
+
+	status.UpdateHTTPRuleStatus(key, httprule, status.UpdateCRDStatusOptions{
+		Status: lib.StatusAccepted,
+		Error:  "",
+	})
+	return nil
+}
+
+// ValidateHTTPRuleSpec runs the structural and the controller ref checks on the HTTPRule, without
+// updating its status.
+func ValidateHTTPRuleSpec(key string, httprule *akov1beta1.HTTPRule) error {
+
 	refData := make(map[string]string)
 	for _, path := range httprule.Spec.Paths {
 		if path.TLS.PKIProfile != "" && path.TLS.DestinationCA != "" {
 			//if both pkiProfile and destCA set, reject httprule
-			status.UpdateHTTPRuleStatus(key, httprule, status.UpdateCRDStatusOptions{
-				Status: lib.StatusRejected,
-				Error:  lib.HttpRulePkiAndDestCASetErr,
-			})
-			return fmt.Errorf("key: %s, msg: %s", key, lib.HttpRulePkiAndDestCASetErr)
+			return errors.New(lib.HttpRulePkiAndDestCASetErr)
 		}
 		refData[path.TLS.SSLProfile] = "SslProfile"
 		refData[path.ApplicationPersistence] = "ApplicationPersistence"
@@ -300,37 +361,72 @@ func (l *leader) ValidateHTTPRuleObj(key string, httprule *akov1beta1.HTTPRule)
 	}
 	tenant := lib.GetTenantInNamespace(httprule.Namespace)
 
-	if err := checkRefsOnController(key, refData, tenant); err != nil {
-		status.UpdateHTTPRuleStatus(key, httprule, status.UpdateCRDStatusOptions{
+	return checkRefsOnController(key, refData, tenant)
+}
+
+// validateAviInfraSetting would do validaion checks on the
+// ingested AviInfraSetting objects
+func (l *leader) ValidateAviInfraSetting(key string, infraSetting *akov1beta1.AviInfraSetting) error {
+
+	if err := ValidateAviInfraSettingSpec(key, infraSetting); err != nil {
+		status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
 			Status: lib.StatusRejected,
 			Error:  err.Error(),
 		})
 		return err
 	}
 
-	// No need to update status of httprule object as accepted since it was accepted before.
-	if httprule.Status.Status == lib.StatusAccepted {
+	// This would add SEG labels only if they are not configured yet. In case there is a label mismatch
+	// to any pre-existing SEG labels, the AviInfraSettig CR will get Rejected from the checkRefsOnController
+	// step before this.
+	segMgmtNetworK := ""
+	if infraSetting.Spec.SeGroup.Name != "" {
+		addSeGroupLabel(key, infraSetting.Spec.SeGroup.Name)
+		// Not required for NO access cloud
+		if lib.GetCloudType() == lib.CLOUD_VCENTER {
+			segMgmtNetworK = GetSEGManagementNetwork(infraSetting.Spec.SeGroup.Name)
+		}
+	}
+
+	if len(infraSetting.Spec.Network.VipNetworks) > 0 {
+		SetAviInfrasettingVIPNetworks(infraSetting.Name, segMgmtNetworK, infraSetting.Spec.SeGroup.Name, infraSetting.Spec.Network.VipNetworks)
+	}
+
+	if len(infraSetting.Spec.Network.NodeNetworks) > 0 {
+		SetAviInfrasettingNodeNetworks(infraSetting.Name, segMgmtNetworK, infraSetting.Spec.SeGroup.Name, infraSetting.Spec.Network.NodeNetworks)
+	}
+
+	namespaces, err := utils.GetInformers().NSInformer.Informer().GetIndexer().ByIndex(lib.AviSettingNamespaceIndex, infraSetting.GetName())
+	if err == nil && len(namespaces) > 0 {
+		objects.InfraSettingL7Lister().UpdateInfraSettingToNamespaceMapping(infraSetting.GetName(), namespaces)
+	} else {
+		// This handles the case where an NS scoped infrasetting was deleted and later recreated without NS scope.
+		objects.InfraSettingL7Lister().DeleteInfraSettingToNamespaceMapping(infraSetting.GetName())
+	}
+
+	// No need to update status of infra setting object as accepted since it was accepted before.
+	if infraSetting.Status.Status == lib.StatusAccepted {
 		return nil
 	}
 
-	status.UpdateHTTPRuleStatus(key, httprule, status.UpdateCRDStatusOptions{
+	status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
 		Status: lib.StatusAccepted,
 		Error:  "",
 	})
 	return nil
 }
 
-// validateAviInfraSetting would do validaion checks on the
-// ingested AviInfraSetting objects
-func (l *leader) ValidateAviInfraSetting(key string, infraSetting *akov1beta1.AviInfraSetting) error {
+// ValidateAviInfraSettingSpec runs the structural and the controller ref checks on the AviInfraSetting,
+// without updating its status or the SE group and network settings.
+func ValidateAviInfraSettingSpec(key string, infraSetting *akov1beta1.AviInfraSetting) error {
+
+	if err := lib.ValidateFQDNClaims(infraSetting.Spec.FQDNClaims); err != nil {
+		return err
+	}
 
 	if ((infraSetting.Spec.Network.EnableRhi != nil && !*infraSetting.Spec.Network.EnableRhi) || infraSetting.Spec.Network.EnableRhi == nil) &&
 		len(infraSetting.Spec.Network.BgpPeerLabels) > 0 {
 		err := fmt.Errorf("BGPPeerLabels cannot be set if EnableRhi is false.")
-		status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
-			Status: lib.StatusRejected,
-			Error:  err.Error(),
-		})
 		return err
 	}
 
@@ -340,10 +436,6 @@ func (l *leader) ValidateAviInfraSetting(key string, infraSetting *akov1beta1.Av
 			re := regexp.MustCompile(lib.IPCIDRRegex)
 			if !re.MatchString(vipNetwork.Cidr) {
 				err := fmt.Errorf("invalid CIDR configuration %s detected for networkName %s in vipNetworkList", vipNetwork.Cidr, vipNetwork.NetworkName)
-				status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
-					Status: lib.StatusRejected,
-					Error:  err.Error(),
-				})
 				return err
 			}
 		}
@@ -351,10 +443,6 @@ func (l *leader) ValidateAviInfraSetting(key string, infraSetting *akov1beta1.Av
 			re := regexp.MustCompile(lib.IPV6CIDRRegex)
 			if !re.MatchString(vipNetwork.V6Cidr) {
 				err := fmt.Errorf("invalid IPv6 CIDR configuration %s detected for networkName %s in vipNetworkList", vipNetwork.V6Cidr, vipNetwork.NetworkName)
-				status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
-					Status: lib.StatusRejected,
-					Error:  err.Error(),
-				})
 				return err
 			}
 		}
@@ -386,10 +474,6 @@ func (l *leader) ValidateAviInfraSetting(key string, infraSetting *akov1beta1.Av
 			projectArr := strings.Split(vpcArr[0], "/projects/")
 			tenant, err = lib.GetTenantForProject(projectArr[len(projectArr)-1], aviClientPool.AviClient[0])
 			if err != nil {
-				status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
-					Status: lib.StatusRejected,
-					Error:  err.Error(),
-				})
 				return err
 			}
 		}
@@ -405,59 +489,10 @@ func (l *leader) ValidateAviInfraSetting(key string, infraSetting *akov1beta1.Av
 		}
 		if !sslEnabled {
 			err := fmt.Errorf("One of the port in aviInfraSetting must have SSL enabled")
-			status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
-				Status: lib.StatusRejected,
-				Error:  err.Error(),
-			})
 			return err
 		}
 	}
-	if err := checkRefsOnController(key, refData, tenant); err != nil {
-		status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
-			Status: lib.StatusRejected,
-			Error:  err.Error(),
-		})
-		return err
-	}
-
-	// This would add SEG labels only if they are not configured yet. In case there is a label mismatch
-	// to any pre-existing SEG labels, the AviInfraSettig CR will get Rejected from the checkRefsOnController
-	// step before this.
-	segMgmtNetworK := ""
-	if infraSetting.Spec.SeGroup.Name != "" {
-		addSeGroupLabel(key, infraSetting.Spec.SeGroup.Name)
-		// Not required for NO access cloud
-		if lib.GetCloudType() == lib.CLOUD_VCENTER {
-			segMgmtNetworK = GetSEGManagementNetwork(infraSetting.Spec.SeGroup.Name)
-		}
-	}
-
-	if len(infraSetting.Spec.Network.VipNetworks) > 0 {
-		SetAviInfrasettingVIPNetworks(infraSetting.Name, segMgmtNetworK, infraSetting.Spec.SeGroup.Name, infraSetting.Spec.Network.VipNetworks)
-	}
-
-	if len(infraSetting.Spec.Network.NodeNetworks) > 0 {
-		SetAviInfrasettingNodeNetworks(infraSetting.Name, segMgmtNetworK, infraSetting.Spec.SeGroup.Name, infraSetting.Spec.Network.NodeNetworks)
-	}
-
-	namespaces, err := utils.GetInformers().NSInformer.Informer().GetIndexer().ByIndex(lib.AviSettingNamespaceIndex, infraSetting.GetName())
-	if err == nil && len(namespaces) > 0 {
-		objects.InfraSettingL7Lister().UpdateInfraSettingToNamespaceMapping(infraSetting.GetName(), namespaces)
-	} else {
-		// This handles the case where an NS scoped infrasetting was deleted and later recreated without NS scope.
-		objects.InfraSettingL7Lister().DeleteInfraSettingToNamespaceMapping(infraSetting.GetName())
-	}
-
-	// No need to update status of infra setting object as accepted since it was accepted before.
-	if infraSetting.Status.Status == lib.StatusAccepted {
-		return nil
-	}
-
-	status.UpdateAviInfraSettingStatus(key, infraSetting, status.UpdateCRDStatusOptions{
-		Status: lib.StatusAccepted,
-		Error:  "",
-	})
-	return nil
+	return checkRefsOnController(key, refData, tenant)
 }
 
 // validateMultiClusterIngressObj validates the MCI CRD changes before pushing it to ingestion
@@ -476,8 +511,9 @@ func (l *leader) ValidateMultiClusterIngressObj(key string, multiClusterIngress
 		status.UpdateMultiClusterIngressStatus(key, multiClusterIngress, statusToUpdate)
 	}()
 
-	// Currently, we support only NodePort ServiceType.
-	if !lib.IsNodePortMode() {
+	// Currently, we support only NodePort ServiceType, unless the upstream ServiceImports are used, which have
+	// the endpoints of the pods.
+	if !lib.IsNodePortMode() && !utils.UseMCSServiceImport() {
 		err = fmt.Errorf("ServiceType must be of type NodePort")
 		return err
 	}
@@ -508,12 +544,28 @@ func (l *leader) ValidateServiceImportObj(key string, serviceImport *akov1alpha1
 // ValidateSSORuleObj would do validation checks
 // update internal CRD caches, and push relevant ingresses to ingestion
 func (l *leader) ValidateSSORuleObj(key string, ssoRule *akov1alpha2.SSORule) error {
+	if err := ValidateSSORuleSpec(key, ssoRule); err != nil {
+		status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
+		return err
+	}
+
+	// No need to update status of ssoRule object as accepted since it was accepted before.
+	if ssoRule.Status.Status == lib.StatusAccepted {
+		return nil
+	}
+
+	status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusAccepted, Error: ""})
+	return nil
+}
+
+// ValidateSSORuleSpec runs the structural and the controller ref checks on the SSORule, without
+// updating its status.
+func ValidateSSORuleSpec(key string, ssoRule *akov1alpha2.SSORule) error {
 	var err error
 	fqdn := *ssoRule.Spec.Fqdn
 	foundHost, foundSR := objects.SharedCRDLister().GetFQDNToSSORuleMapping(fqdn)
 	if foundHost && foundSR != ssoRule.Namespace+"/"+ssoRule.Name {
 		err = fmt.Errorf("duplicate fqdn %s found in %s", fqdn, foundSR)
-		status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 		return err
 	}
 
@@ -521,7 +573,6 @@ func (l *leader) ValidateSSORuleObj(key string, ssoRule *akov1alpha2.SSORule) er
 
 	if ssoRule.Spec.SsoPolicyRef == nil {
 		err = fmt.Errorf("SsoPolicyRef is not specified")
-		status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 		return err
 	}
 	refData[*ssoRule.Spec.SsoPolicyRef] = "SSOPolicy"
@@ -538,18 +589,15 @@ func (l *leader) ValidateSSORuleObj(key string, ssoRule *akov1alpha2.SSORule) er
 					clientSecretObj, err := validateSecretReferenceInSSORule(ssoRule.Namespace, clientSecret)
 					if err != nil {
 						err = fmt.Errorf("Got error while fetching %s secret : %s", clientSecret, err.Error())
-						status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 						return err
 					}
 					if clientSecretObj == nil {
 						err = fmt.Errorf("specified client secret is empty : %s", clientSecret)
-						status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 						return err
 					}
 					clientSecretString := string(clientSecretObj.Data["clientSecret"])
 					if clientSecretString == "" {
 						err = fmt.Errorf("clientSecret field not found in %s secret", clientSecret)
-						status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 						return err
 					}
 				}
@@ -557,12 +605,10 @@ func (l *leader) ValidateSSORuleObj(key string, ssoRule *akov1alpha2.SSORule) er
 				if profile.ResourceServer != nil {
 					if *profile.ResourceServer.AccessType == lib.ACCESS_TOKEN_TYPE_JWT && profile.ResourceServer.JwtParams == nil {
 						err = fmt.Errorf("Access Type is %s, but Jwt Params have not been specified", *profile.ResourceServer.AccessType)
-						status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 						return err
 					}
 					if *profile.ResourceServer.AccessType == lib.ACCESS_TOKEN_TYPE_OPAQUE && profile.ResourceServer.OpaqueTokenParams == nil {
 						err = fmt.Errorf("Access Type is %s, but Opaque Token Params have not been specified", *profile.ResourceServer.AccessType)
-						status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 						return err
 					}
 
@@ -571,18 +617,15 @@ func (l *leader) ValidateSSORuleObj(key string, ssoRule *akov1alpha2.SSORule) er
 						serverSecretObj, err := utils.GetInformers().ClientSet.CoreV1().Secrets(ssoRule.Namespace).Get(context.TODO(), serverSecret, metav1.GetOptions{})
 						if err != nil {
 							err = fmt.Errorf("Got error while fetching %s secret : %s", serverSecret, err.Error())
-							status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 							return err
 						}
 						if serverSecretObj == nil {
 							err = fmt.Errorf("specified server secret is empty : %s", serverSecret)
-							status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 							return err
 						}
 						serverSecretString := string(serverSecretObj.Data["serverSecret"])
 						if serverSecretString == "" {
 							err = fmt.Errorf("serverSecret field not found in %s secret", serverSecret)
-							status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
 							return err
 						}
 					}
@@ -599,30 +642,51 @@ func (l *leader) ValidateSSORuleObj(key string, ssoRule *akov1alpha2.SSORule) er
 	}
 	tenant := lib.GetTenantInNamespace(ssoRule.Namespace)
 
-	if err := checkRefsOnController(key, refData, tenant); err != nil {
-		status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusRejected, Error: err.Error()})
+	return checkRefsOnController(key, refData, tenant)
+}
+
+// ValidateL4RuleObj would do validation checks and updates the status before
+// pushing to ingestion
+func (l *leader) ValidateL4RuleObj(key string, l4Rule *akov1alpha2.L4Rule) error {
+
+	// Create HealthMonitor to L4Rule mapping (regardless of validation result)
+	// This ensures that even rejected L4Rules can be re-evaluated when HealthMonitors change
+	l4RuleNsName := l4Rule.Namespace + "/" + l4Rule.Name
+	for _, backendProperties := range l4Rule.Spec.BackendProperties {
+		for _, healthMonitorName := range backendProperties.HealthMonitorCrdRefs {
+			if healthMonitorName != "" {
+				objects.SharedCRDLister().UpdateHealthMonitorToL4RuleMapping(l4Rule.Namespace+"/"+healthMonitorName, l4RuleNsName)
+			}
+		}
+	}
+
+	if err := ValidateL4RuleSpec(key, l4Rule); err != nil {
+		rejectL4Rule(key, l4Rule, err)
 		return err
 	}
 
-	// No need to update status of ssoRule object as accepted since it was accepted before.
-	if ssoRule.Status.Status == lib.StatusAccepted {
+	// No need to update status of l4rule object as accepted since it was accepted before.
+	if l4Rule.Status.Status == lib.StatusAccepted {
 		return nil
 	}
 
-	status.UpdateSSORuleStatus(key, ssoRule, status.UpdateCRDStatusOptions{Status: lib.StatusAccepted, Error: ""})
+	status.UpdateL4RuleStatus(key, l4Rule, status.UpdateCRDStatusOptions{
+		Status: lib.StatusAccepted,
+		Error:  "",
+	})
+
 	return nil
 }
 
-// ValidateL4RuleObj would do validation checks and updates the status before
-// pushing to ingestion
-func (l *leader) ValidateL4RuleObj(key string, l4Rule *akov1alpha2.L4Rule) error {
+// ValidateL4RuleSpec runs the structural and the controller ref checks on the L4Rule, without
+// updating its status.
+func ValidateL4RuleSpec(key string, l4Rule *akov1alpha2.L4Rule) error {
 
 	l4RuleSpec := l4Rule.Spec
 
 	if l4RuleSpec.LoadBalancerIP != nil &&
 		net.ParseIP(*l4RuleSpec.LoadBalancerIP) == nil {
 		err := fmt.Errorf("loadBalancerIP %s is not valid", *l4RuleSpec.LoadBalancerIP)
-		rejectL4Rule(key, l4Rule, err)
 		return err
 	}
 
@@ -643,13 +707,11 @@ func (l *leader) ValidateL4RuleObj(key string, l4Rule *akov1alpha2.L4Rule) error
 		}
 		isL4SSL, err := checkForL4SSLAppProfile(key, *l4RuleSpec.ApplicationProfileRef, tenant)
 		if err != nil {
-			rejectL4Rule(key, l4Rule, err)
 			return err
 		}
 		if isL4SSL {
 			if !isSSLEnabled {
 				sslErr := fmt.Errorf("SSL is not enabled in l4rule listener Spec but App Profile %s is of type SSL", *l4RuleSpec.ApplicationProfileRef)
-				rejectL4Rule(key, l4Rule, sslErr)
 				return sslErr
 			}
 			if l4RuleSpec.SslProfileRef != nil {
@@ -661,7 +723,6 @@ func (l *leader) ValidateL4RuleObj(key string, l4Rule *akov1alpha2.L4Rule) error
 			if l4RuleSpec.NetworkProfileRef != nil {
 				isNetworkProfileTypeTCP, err = checkForNetworkProfileTypeTCP(key, *l4RuleSpec.NetworkProfileRef, tenant)
 				if err != nil {
-					rejectL4Rule(key, l4Rule, err)
 					return err
 				}
 			}
@@ -669,18 +730,15 @@ func (l *leader) ValidateL4RuleObj(key string, l4Rule *akov1alpha2.L4Rule) error
 			if *l4RuleSpec.ApplicationProfileRef != utils.DEFAULT_L4_APP_PROFILE {
 				if isSSLEnabled {
 					sslErr := fmt.Errorf("SSL is enabled in l4rule listener Spec but App Profile %s is not of type SSL", *l4RuleSpec.ApplicationProfileRef)
-					rejectL4Rule(key, l4Rule, sslErr)
 					return sslErr
 				}
 			}
 			if l4RuleSpec.SslProfileRef != nil {
 				sslProfileErr := fmt.Errorf("App Profile %s is not of type SSL but SslProfileRef is set", *l4RuleSpec.ApplicationProfileRef)
-				rejectL4Rule(key, l4Rule, sslProfileErr)
 				return sslProfileErr
 			}
 			if len(l4RuleSpec.SslKeyAndCertificateRefs) != 0 {
 				sslKeyCertErr := fmt.Errorf("App Profile %s is not of type SSL but SslKeyAndCertificateRefs are set", *l4RuleSpec.ApplicationProfileRef)
-				rejectL4Rule(key, l4Rule, sslKeyCertErr)
 				return sslKeyCertErr
 			}
 		}
@@ -725,7 +783,6 @@ func (l *leader) ValidateL4RuleObj(key string, l4Rule *akov1alpha2.L4Rule) error
 		}
 
 		if err := validateLBAlgorithm(backendProperties); err != nil {
-			rejectL4Rule(key, l4Rule, err)
 			return err
 		}
 
@@ -733,59 +790,55 @@ func (l *leader) ValidateL4RuleObj(key string, l4Rule *akov1alpha2.L4Rule) error
 		for _, healthMonitorName := range backendProperties.HealthMonitorCrdRefs {
 			if healthMonitorName == "" {
 				err := fmt.Errorf("Empty HealthMonitor name in healthMonitorCrdRefs")
-				rejectL4Rule(key, l4Rule, err)
 				return err
 			}
 
 			// Check if AKO CRD Operator is enabled when HealthMonitor CRDs are referenced
 			if !lib.IsAKOCRDOperatorEnabled() {
 				err := fmt.Errorf("HealthMonitor CRD %s/%s referenced but AKO CRD Operator is not enabled", l4Rule.Namespace, healthMonitorName)
-				rejectL4Rule(key, l4Rule, err)
 				return err
 			}
 
-			// Create HealthMonitor to L4Rule mapping (regardless of validation result)
-			// This ensures that even rejected L4Rules can be re-evaluated when HealthMonitors change
-			healthMonitorNsName := l4Rule.Namespace + "/" + healthMonitorName
-			l4RuleNsName := l4Rule.Namespace + "/" + l4Rule.Name
-			objects.SharedCRDLister().UpdateHealthMonitorToL4RuleMapping(healthMonitorNsName, l4RuleNsName)
-
 			// Validate HealthMonitor CRD exists, is processed, and type is compatible with backend protocol
 			if err := validateHealthMonitorForL4Rule(key, l4Rule.Namespace, healthMonitorName, *backendProperties.Protocol); err != nil {
-				rejectL4Rule(key, l4Rule, err)
 				return err
 			}
 		}
 	}
 
 	if err := checkRefsOnController(key, refData, tenant); err != nil {
-		rejectL4Rule(key, l4Rule, err)
 		return err
 	}
 
 	revokeVipRoute := l4Rule.Spec.RevokeVipRoute
 	if lib.GetCloudType() != lib.CLOUD_NSXT && revokeVipRoute != nil && *revokeVipRoute {
 		revokeVipRouteErr := fmt.Errorf("RevokeVipRoute is only supported in NSX-T Cloud")
-		rejectL4Rule(key, l4Rule, revokeVipRouteErr)
 		return revokeVipRouteErr
 	}
 
-	// No need to update status of l4rule object as accepted since it was accepted before.
-	if l4Rule.Status.Status == lib.StatusAccepted {
-		return nil
-	}
-
-	status.UpdateL4RuleStatus(key, l4Rule, status.UpdateCRDStatusOptions{
-		Status: lib.StatusAccepted,
-		Error:  "",
-	})
-
 	return nil
 }
 
 // ValidateL7RuleObj would do validation checks and updates the status before
 // pushing to ingestion
 func (l *leader) ValidateL7RuleObj(key string, l7Rule *akov1alpha2.L7Rule) error {
+	if err := ValidateL7RuleSpec(key, l7Rule); err != nil {
+		status.UpdateL7RuleStatus(key, l7Rule, status.UpdateCRDStatusOptions{
+			Status: lib.StatusRejected,
+			Error:  err.Error(),
+		})
+		return err
+	}
+	// No need to update status of l7rule object as accepted since it was accepted before.
+	if l7Rule.Status.Status == lib.StatusAccepted {
+		return nil
+	}
+	status.UpdateL7RuleStatus(key, l7Rule, status.UpdateCRDStatusOptions{Status: lib.StatusAccepted, Error: ""})
+	return nil
+}
+
+// ValidateL7RuleSpec runs the controller ref checks on the L7Rule, without updating its status.
+func ValidateL7RuleSpec(key string, l7Rule *akov1alpha2.L7Rule) error {
 	l7RuleSpec := l7Rule.Spec
 
 	refData := make(map[string]string)
@@ -838,19 +891,7 @@ func (l *leader) ValidateL7RuleObj(key string, l7Rule *akov1alpha2.L7Rule) error
 	}
 	tenant := lib.GetTenantInNamespace(l7Rule.Namespace)
 
-	if err := checkRefsOnController(key, refData, tenant); err != nil {
-		status.UpdateL7RuleStatus(key, l7Rule, status.UpdateCRDStatusOptions{
-			Status: lib.StatusRejected,
-			Error:  err.Error(),
-		})
-		return err
-	}
-	// No need to update status of l7rule object as accepted since it was accepted before.
-	if l7Rule.Status.Status == lib.StatusAccepted {
-		return nil
-	}
-	status.UpdateL7RuleStatus(key, l7Rule, status.UpdateCRDStatusOptions{Status: lib.StatusAccepted, Error: ""})
-	return nil
+	return checkRefsOnController(key, refData, tenant)
 }
 
 func validateLBAlgorithm(backendProperties *akov1alpha2.BackendProperties) error {
@@ -0,0 +1,74 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// CertificatePendingError is returned when the Secret referred for TLS by an object managed by cert-manager,
+// is yet to be issued.
+type CertificatePendingError struct {
+	Namespace string
+	Name      string
+}
+
+func (e *CertificatePendingError) Error() string {
+	return fmt.Sprintf("secret %s/%s is pending issuance by cert-manager", e.Namespace, e.Name)
+}
+
+// IsCertificatePending returns true if the error is a CertificatePendingError.
+func IsCertificatePending(err error) bool {
+	var pendingErr *CertificatePendingError
+	return errors.As(err, &pendingErr)
+}
+
+// IsCertManagerManaged returns true if the annotations of an Ingress, HostRule or Gateway request a certificate
+// from cert-manager, through an issuer, a cluster issuer or the kubernetes.io/tls-acme annotation.
+func IsCertManagerManaged(annotations map[string]string) bool {
+	if annotations[CertManagerIssuer] != "" || annotations[CertManagerClusterIssuer] != "" {
+		return true
+	}
+	return strings.EqualFold(annotations[TLSACMEAnnotation], "true")
+}
+
+// IsACMEChallengePath returns true if the path serves the HTTP-01 challenges of an ACME issuer.
+func IsACMEChallengePath(path string) bool {
+	return strings.HasPrefix(path, ACMEChallengePathPrefix)
+}
+
+// certificatePendingObjects holds the objects waiting for cert-manager to issue a Secret.
+var certificatePendingObjects = make(map[certificateStateKey]bool)
+var certificatePendingLock sync.Mutex
+
+// UpdateCertificatePendingState records if the Secret of the object is pending issuance by cert-manager, and
+// returns true if the state is changed, so that the pending and the issued events are emitted only once.
+func UpdateCertificatePendingState(kind, namespace, name, secretName string, pending bool) bool {
+	pendingKey := certificateStateKey{kind: kind, namespace: namespace, name: name, secret: secretName}
+	certificatePendingLock.Lock()
+	defer certificatePendingLock.Unlock()
+	if certificatePendingObjects[pendingKey] == pending {
+		return false
+	}
+	if pending {
+		certificatePendingObjects[pendingKey] = true
+	} else {
+		delete(certificatePendingObjects, pendingKey)
+	}
+	return true
+}
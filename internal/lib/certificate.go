@@ -0,0 +1,323 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package lib
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/alb-sdk/go/models"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// DefaultCertExpiryWarningDays is the number of days before the expiry of a certificate, from which the
+// CertificateExpiring events are emitted, if certExpiryWarningDays is not set.
+const DefaultCertExpiryWarningDays = 30
+
+// InvalidCertificateError is returned when the certificate of a Secret can not be programmed in the controller,
+// because it has expired or does not match the private key of the Secret.
+type InvalidCertificateError struct {
+	Namespace string
+	Name      string
+	Reason    string
+}
+
+// invalidCertificatePrefix is the prefix of the message of an InvalidCertificateError.
+const invalidCertificatePrefix = "certificate of secret "
+
+func (e *InvalidCertificateError) Error() string {
+	return fmt.Sprintf(invalidCertificatePrefix+"%s/%s is invalid, %s", e.Namespace, e.Name, e.Reason)
+}
+
+// IsInvalidCertificate returns true if the error is an InvalidCertificateError.
+func IsInvalidCertificate(err error) bool {
+	var invalidErr *InvalidCertificateError
+	return errors.As(err, &invalidErr)
+}
+
+// IsInvalidCertificateMessage returns true if the error set in the status of an object, e.g. a HostRule, is the
+// message of an InvalidCertificateError.
+func IsInvalidCertificateMessage(message string) bool {
+	return strings.HasPrefix(message, invalidCertificatePrefix)
+}
+
+// GetCertExpiryWarningDays returns the number of days before the expiry of a certificate, from which AKO emits
+// the CertificateExpiring events on the objects using the certificate. 0 disables the events.
+func GetCertExpiryWarningDays() int {
+	days, err := strconv.Atoi(os.Getenv("CERT_EXPIRY_WARNING_DAYS"))
+	if err != nil || days < 0 {
+		return DefaultCertExpiryWarningDays
+	}
+	return days
+}
+
+// ParseCertificate returns the leaf certificate of a PEM encoded certificate chain. A nil certificate is
+// returned, without an error, if the data is not PEM encoded, and is left to the controller to validate.
+func ParseCertificate(cert []byte) (*x509.Certificate, error) {
+	for block, rest := pem.Decode(cert); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		return x509.ParseCertificate(block.Bytes)
+	}
+	return nil, nil
+}
+
+func parsePrivateKey(key []byte) crypto.Signer {
+	block, _ := pem.Decode(key)
+	if block == nil {
+		return nil
+	}
+	if rsaKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return rsaKey
+	}
+	if ecKey, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return ecKey
+	}
+	if pkcs8Key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := pkcs8Key.(crypto.Signer); ok {
+			return signer
+		}
+	}
+	return nil
+}
+
+// ValidateCertificate returns the leaf certificate of the Secret, and an InvalidCertificateError if the certificate
+// has expired, or the public key of the certificate does not match the private key.
+func ValidateCertificate(namespace, secretName string, cert, key []byte) (*x509.Certificate, error) {
+	leaf, err := ParseCertificate(cert)
+	if err != nil {
+		return nil, &InvalidCertificateError{Namespace: namespace, Name: secretName, Reason: err.Error()}
+	}
+	if leaf == nil {
+		return nil, nil
+	}
+	if time.Now().After(leaf.NotAfter) {
+		return leaf, &InvalidCertificateError{
+			Namespace: namespace,
+			Name:      secretName,
+			Reason:    fmt.Sprintf("expired on %s", leaf.NotAfter.UTC().Format(time.RFC3339)),
+		}
+	}
+	if signer := parsePrivateKey(key); signer != nil {
+		publicKey, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+		if ok && !publicKey.Equal(leaf.PublicKey) {
+			return leaf, &InvalidCertificateError{
+				Namespace: namespace,
+				Name:      secretName,
+				Reason:    "public key of the certificate does not match the private key",
+			}
+		}
+	}
+	return leaf, nil
+}
+
+// ValidateSecretCertificate validates the certificate and the private key of a TLS Secret.
+func ValidateSecretCertificate(secret *corev1.Secret) (*x509.Certificate, error) {
+	return ValidateCertificate(secret.Namespace, secret.Name, secret.Data[utils.K8S_TLS_SECRET_CERT], secret.Data[utils.K8S_TLS_SECRET_KEY])
+}
+
+// CertificateDaysToExpiry returns the number of days left before the certificate expires.
+func CertificateDaysToExpiry(leaf *x509.Certificate) float64 {
+	return time.Until(leaf.NotAfter).Hours() / 24
+}
+
+// IsCertificateExpiring returns true if the certificate expires within certExpiryWarningDays.
+func IsCertificateExpiring(leaf *x509.Certificate) bool {
+	warningDays := GetCertExpiryWarningDays()
+	return leaf != nil && warningDays > 0 && CertificateDaysToExpiry(leaf) <= float64(warningDays)
+}
+
+// certificateStateKey is the key of the state of the certificate of a Secret used by an object. The secret is the
+// name of the Secret in the namespace of the object, or the namespace/name of the Secret.
+type certificateStateKey struct {
+	kind      string
+	namespace string
+	name      string
+	secret    string
+}
+
+func (k certificateStateKey) usesSecret(namespace, name string) bool {
+	return (k.namespace == namespace && k.secret == name) || k.secret == namespace+"/"+name
+}
+
+// certificateStates holds the last reported state of the certificates used by the objects, CertificateExpiring or
+// InvalidCertificate. Valid certificates are not present in the map.
+var certificateStates = make(map[certificateStateKey]string)
+var certificateStatesLock sync.Mutex
+
+// UpdateCertificateState records the state of the certificate of the Secret used by the object, and returns true
+// if the state is changed, so that the events of the object are emitted only once per change.
+func UpdateCertificateState(kind, namespace, name, secretName, state string) bool {
+	stateKey := certificateStateKey{kind: kind, namespace: namespace, name: name, secret: secretName}
+	certificateStatesLock.Lock()
+	defer certificateStatesLock.Unlock()
+	if certificateStates[stateKey] == state {
+		return false
+	}
+	if state == "" {
+		delete(certificateStates, stateKey)
+	} else {
+		certificateStates[stateKey] = state
+	}
+	return true
+}
+
+// DeleteCertificateStates removes the certificate states of a deleted object, including the Secrets pending
+// issuance by cert-manager, so that an object recreated with the same name reports its certificates again.
+func DeleteCertificateStates(kind, namespace, name string) {
+	matches := func(k certificateStateKey) bool {
+		return k.kind == kind && k.namespace == namespace && k.name == name
+	}
+	deleteCertificateStates(matches)
+}
+
+// DeleteSecretCertificateStates removes the certificate states of the objects using a deleted Secret.
+func DeleteSecretCertificateStates(namespace, name string) {
+	matches := func(k certificateStateKey) bool {
+		return k.usesSecret(namespace, name) || (k.kind == utils.Secret && k.namespace == namespace && k.name == name)
+	}
+	deleteCertificateStates(matches)
+}
+
+func deleteCertificateStates(matches func(certificateStateKey) bool) {
+	certificateStatesLock.Lock()
+	for stateKey := range certificateStates {
+		if matches(stateKey) {
+			delete(certificateStates, stateKey)
+		}
+	}
+	certificateStatesLock.Unlock()
+	certificatePendingLock.Lock()
+	for pendingKey := range certificatePendingObjects {
+		if matches(pendingKey) {
+			delete(certificatePendingObjects, pendingKey)
+		}
+	}
+	certificatePendingLock.Unlock()
+}
+
+// ReportCertificateState reports the result of the validation of the certificate of a Secret, used by the object of
+// the kind, in the events of the object. An event is emitted only when the certificate becomes invalid, starts
+// expiring within certExpiryWarningDays, or becomes valid again.
+func ReportCertificateState(key string, recorder *utils.EventRecorder, obj runtime.Object, kind, namespace, name, secretName string, leaf *x509.Certificate, err error) {
+	state := ""
+	if err != nil {
+		state = InvalidCertificate
+	} else if IsCertificateExpiring(leaf) {
+		state = CertificateExpiring
+	}
+	if !UpdateCertificateState(kind, namespace, name, secretName, state) {
+		return
+	}
+	switch state {
+	case InvalidCertificate:
+		utils.AviLog.Warnf("key: %s, msg: %v, not programming the certificate", key, err)
+		recorder.Eventf(obj, corev1.EventTypeWarning, InvalidCertificate, "Certificate is not programmed, %v", err)
+	case CertificateExpiring:
+		expiry := leaf.NotAfter.UTC().Format(time.RFC3339)
+		utils.AviLog.Warnf("key: %s, msg: certificate of secret %s expires on %s", key, secretName, expiry)
+		recorder.Eventf(obj, corev1.EventTypeWarning, CertificateExpiring,
+			"Certificate of secret %s expires on %s, in %d days, and must be renewed", secretName, expiry, int(CertificateDaysToExpiry(leaf)))
+	default:
+		utils.AviLog.Infof("key: %s, msg: certificate of secret %s is valid", key, secretName)
+		recorder.Eventf(obj, corev1.EventTypeNormal, CertificateValid, "Certificate of secret %s is valid", secretName)
+	}
+}
+
+type programmedCertificate struct {
+	tenant          string
+	name            string
+	host            string
+	secretNamespace string
+	secretName      string
+	notAfter        time.Time
+}
+
+// programmedCertificates holds the expiry of the certificates in the controller, for the certificate_expiry_days
+// metric. It is filled from the sslkeyandcertificates read while populating the cache, and from the ones created
+// or updated by AKO.
+// key: tenant/name of the sslkeyandcertificate
+var programmedCertificates = make(map[string]programmedCertificate)
+
+// certificateSecrets holds the kubernetes Secret of the certificates, which is not present in the controller.
+// key: tenant/name of the sslkeyandcertificate, value: namespace/name of the Secret
+var certificateSecrets = make(map[string]string)
+var programmedCertificatesLock sync.RWMutex
+
+// TrackCertificateExpiry records the expiry of a certificate in the controller, with the hosts of its markers.
+func TrackCertificateExpiry(tenant, name string, markers []*models.RoleFilterMatchLabel, cert string) {
+	leaf, err := ParseCertificate([]byte(cert))
+	if err != nil || leaf == nil {
+		UntrackCertificateExpiry(tenant, name)
+		return
+	}
+	var hosts []string
+	for _, marker := range markers {
+		if marker.Key != nil && *marker.Key == "Host" {
+			hosts = marker.Values
+		}
+	}
+	programmedCertificatesLock.Lock()
+	defer programmedCertificatesLock.Unlock()
+	programmedCertificates[tenant+"/"+name] = programmedCertificate{
+		tenant:   tenant,
+		name:     name,
+		host:     strings.Join(hosts, ","),
+		notAfter: leaf.NotAfter,
+	}
+}
+
+// SetCertificateSecret records the kubernetes Secret of a certificate, from the model of the certificate.
+func SetCertificateSecret(tenant, name, secretNamespace, secretName string) {
+	if secretName == "" {
+		return
+	}
+	programmedCertificatesLock.Lock()
+	defer programmedCertificatesLock.Unlock()
+	certificateSecrets[tenant+"/"+name] = secretNamespace + "/" + secretName
+}
+
+// UntrackCertificateExpiry removes a certificate deleted from the controller.
+func UntrackCertificateExpiry(tenant, name string) {
+	programmedCertificatesLock.Lock()
+	defer programmedCertificatesLock.Unlock()
+	delete(programmedCertificates, tenant+"/"+name)
+	delete(certificateSecrets, tenant+"/"+name)
+}
+
+func getProgrammedCertificates() []programmedCertificate {
+	programmedCertificatesLock.RLock()
+	defer programmedCertificatesLock.RUnlock()
+	certs := make([]programmedCertificate, 0, len(programmedCertificates))
+	for certKey, cert := range programmedCertificates {
+		if secret, ok := certificateSecrets[certKey]; ok {
+			cert.secretNamespace, cert.secretName, _ = strings.Cut(secret, "/")
+		}
+		certs = append(certs, cert)
+	}
+	return certs
+}
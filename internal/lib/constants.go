@@ -103,10 +103,16 @@ const (
 	IstioGateway                               = "IstioGateway"
 	MultiClusterIngress                        = "MultiClusterIngress"
 	ServiceImport                              = "ServiceImport"
+	MCSServiceImport                           = "MCSServiceImport"
+	MCSServiceNameLabel                        = "multicluster.kubernetes.io/service-name"
+	MCSSourceClusterLabel                      = "multicluster.kubernetes.io/source-cluster"
 	DummySecret                                = "@avisslkeycertrefdummy"
 	DummySecretK8s                             = "@k8ssecretdummy"
 	StatusRejected                             = "Rejected"
 	StatusAccepted                             = "Accepted"
+	StatusPending                              = "Pending"
+	ProgrammedCondition                        = "ako.vmware.com/Programmed"
+	MaintenanceCondition                       = "ako.vmware.com/Maintenance"
 	AllowedL7ApplicationProfile                = "APPLICATION_PROFILE_TYPE_HTTP"
 	AllowedL4ApplicationProfile                = "APPLICATION_PROFILE_TYPE_L4"
 	AllowedL4SSLApplicationProfile             = "APPLICATION_PROFILE_TYPE_SSL"
@@ -118,9 +124,12 @@ const (
 	Gateway                                    = "Gateway"
 	GatewayClass                               = "GatewayClass"
 	HTTPRoute                                  = "HTTPRoute"
+	GRPCRoute                                  = "GRPCRoute"
 	TCPRoute                                   = "TCPRoute"
 	TLSRoute                                   = "TLSRoute"
 	UDPRoute                                   = "UDPRoute"
+	ReferenceGrant                             = "ReferenceGrant"
+	BackendTLSPolicy                           = "BackendTLSPolicy"
 	DuplicateBackends                          = "MultipleBackendsWithSameServiceError"
 	HostAlreadyClaimed                         = "Host already Claimed"
 	DummyVSForStaleData                        = "DummyVSForStaleData"
@@ -140,6 +149,7 @@ const (
 	UpdateStatus                               = "UpdateStatus"
 	DeleteStatus                               = "DeleteStatus"
 	NPLService                                 = "NPLService"
+	PoolMaintenance                            = "PoolMaintenance"
 	SyncStatusKey                              = "syncstatus"
 	NoFreeIPError                              = "No available free IPs"
 	ConfigDisallowedDuringUpgradeError         = "Configuration is disallowed during upgrade"
@@ -169,6 +179,8 @@ const (
 	PG                                         = "Poolgroup"
 	ApplicationPersistenceProfile              = "PersistenceProfile"
 	ApplicationPersistenceProfileNode          = "ApplicationPersistenceProfileNode"
+	TrafficCloneProfile                        = "TrafficCloneProfile"
+	TrafficCloneProfileNode                    = "TrafficCloneProfileNode"
 	PriorityLabel                              = "PriorityLabel"
 	SSLKeyCert                                 = "SSLKeyandCertificate"
 	PKIProfile                                 = "PKI Profile"
@@ -232,11 +244,25 @@ const (
 	StatusSync               = "StatusSync"
 	AKOReady                 = "AKOReady"
 	AKOPause                 = "AKOPause"
+	AKOResume                = "AKOResume"
+	ControllerFailover       = "ControllerFailover"
+	Draining                 = "Draining"
+	Restored                 = "Restored"
 	DuplicateHostPath        = "DuplicateHostPath"
 	DuplicateHost            = "DuplicateHost"
+	HostNotClaimed           = "HostNotClaimed"
+	CanaryNotSupported       = "CanaryNotSupported"
+	CertificatePending       = "CertificatePending"
+	CertificateIssued        = "CertificateIssued"
+	CertificateExpiring      = "CertificateExpiring"
+	InvalidCertificate       = "InvalidCertificate"
+	CertificateValid         = "CertificateValid"
 	Removed                  = "Removed"
 	Synced                   = "Synced"
 	Attached                 = "Attached"
+	Accepted                 = "Accepted"
+	Rejected                 = "Rejected"
+	Programmed               = "Programmed"
 	Detached                 = "Detached"
 	PatchFailed              = "PatchFailed"
 	InvalidConfiguration     = "InvalidConfiguration"
@@ -263,6 +289,15 @@ const (
 	AKOConditionType                 = "ako.vmware.com/ObjectDeletionInProgress"
 	DefaultSecretEnabled             = "ako.vmware.com/enable-tls"
 	VSTrafficDisabled                = "ako.vmware.com/disable-traffic"
+	MaintenanceModeAnnotation        = "ako.vmware.com/maintenance-mode"
+	DrainServersAnnotation           = "ako.vmware.com/drain-servers"
+	DrainTimeoutAnnotation           = "ako.vmware.com/drain-timeout"
+	MaintenanceStatusAnnotation      = "ako.vmware.com/maintenance"
+	IngressStatusAnnotation          = "ako.vmware.com/status"
+	CertManagerIssuer                = "cert-manager.io/issuer"
+	CertManagerClusterIssuer         = "cert-manager.io/cluster-issuer"
+	TLSACMEAnnotation                = "kubernetes.io/tls-acme"
+	ACMEChallengePathPrefix          = "/.well-known/acme-challenge/"
 	GatewayNameLabelKey              = "service.route.lbapi.run.tanzu.vmware.com/gateway-name"
 	GatewayNamespaceLabelKey         = "service.route.lbapi.run.tanzu.vmware.com/gateway-namespace"
 	GatewayTypeLabelKey              = "service.route.lbapi.run.tanzu.vmware.com/type"
@@ -275,6 +310,7 @@ const (
 	InfraSettingNameAnnotation       = "aviinfrasetting.ako.vmware.com/name"
 	SkipNodePortAnnotation           = "skipnodeport.ako.vmware.com/enabled"
 	PassthroughAnnotation            = "passthrough.ako.vmware.com/enabled"
+	CanaryBackendsAnnotation         = "ako.vmware.com/canary-backends"
 	StaticRouteAnnotation            = "ako.vmware.com/pod-cidrs"
 	OVNNodeSubnetAnnotation          = "k8s.ovn.org/node-subnets"
 	WCPSEGroup                       = "ako.vmware.com/wcp-se-group"
@@ -348,6 +384,10 @@ const (
 	// Namespace objects. This helps in fettching a Namespace with a given
 	// AviInfraSetting.
 	AviSettingNamespaceIndex = "aviSettingNamespaces"
+
+	// MCSServiceImportIndex maintains a map of the upstream ServiceImport
+	// Namespace/Name to the EndpointSlices imported from the member clusters.
+	MCSServiceImportIndex = "mcsServiceImport"
 )
 
 // Passthrough deployment same in EVH and SNI. Not changing log messages.
@@ -0,0 +1,151 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package lib
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/alb-sdk/go/session"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// controllerSyncPause is the window in which the sync of the models with the Avi Controller is paused,
+// because the controller is under maintenance or being upgraded. The models which are dequeued by the
+// rest layer in this window are buffered, and synced once the controller is back.
+type controllerSyncPause struct {
+	lock   sync.Mutex
+	paused bool
+	reason string
+	since  time.Time
+	keys   []string
+	keySet map[string]struct{}
+	// unavailableErrors is the number of requests failed by the controller as unavailable since
+	// lastUnavailableError, a lone failure is retried without pausing the sync.
+	unavailableErrors    int
+	lastUnavailableError time.Time
+}
+
+const (
+	controllerUnavailableErrorThreshold = 3
+	controllerUnavailableErrorWindow    = time.Minute
+)
+
+var ctrlSyncPause = &controllerSyncPause{}
+
+// PauseControllerSync pauses the sync of the models with the Avi Controller. It returns false if the
+// sync is already paused.
+func PauseControllerSync(reason string) bool {
+	ctrlSyncPause.lock.Lock()
+	defer ctrlSyncPause.lock.Unlock()
+	if ctrlSyncPause.paused {
+		return false
+	}
+	ctrlSyncPause.paused = true
+	ctrlSyncPause.reason = reason
+	ctrlSyncPause.since = time.Now()
+	ctrlSyncPause.keys = nil
+	ctrlSyncPause.keySet = make(map[string]struct{})
+	utils.AviLog.Warnf("Pausing the sync with the Avi Controller: %s", reason)
+	AKOControlConfig().PodEventf(corev1.EventTypeWarning, AKOPause, "Sync with the Avi Controller is paused: %s", reason)
+	return true
+}
+
+func IsControllerSyncPaused() bool {
+	ctrlSyncPause.lock.Lock()
+	defer ctrlSyncPause.lock.Unlock()
+	return ctrlSyncPause.paused
+}
+
+// BufferKeyIfControllerSyncPaused buffers the model key, if the sync with the Avi Controller is paused.
+// It returns false if the sync is not paused, in which case the key has to be processed by the caller.
+func BufferKeyIfControllerSyncPaused(key string) bool {
+	ctrlSyncPause.lock.Lock()
+	defer ctrlSyncPause.lock.Unlock()
+	if !ctrlSyncPause.paused {
+		return false
+	}
+	if _, ok := ctrlSyncPause.keySet[key]; !ok {
+		ctrlSyncPause.keySet[key] = struct{}{}
+		ctrlSyncPause.keys = append(ctrlSyncPause.keys, key)
+	}
+	return true
+}
+
+// GetControllerSyncPauseState returns the reason and the start time of the pause, and the number of
+// buffered model keys.
+func GetControllerSyncPauseState() (bool, string, time.Time, int) {
+	ctrlSyncPause.lock.Lock()
+	defer ctrlSyncPause.lock.Unlock()
+	return ctrlSyncPause.paused, ctrlSyncPause.reason, ctrlSyncPause.since, len(ctrlSyncPause.keys)
+}
+
+// ResumeControllerSync resumes the sync with the Avi Controller, and returns the model keys which were
+// buffered during the pause, in the order in which they were buffered, to be synced by the caller.
+func ResumeControllerSync() []string {
+	ctrlSyncPause.lock.Lock()
+	defer ctrlSyncPause.lock.Unlock()
+	if !ctrlSyncPause.paused {
+		return nil
+	}
+	pausedFor := time.Since(ctrlSyncPause.since)
+	keys := ctrlSyncPause.keys
+	ctrlSyncPause.paused = false
+	ctrlSyncPause.reason = ""
+	ctrlSyncPause.keys = nil
+	ctrlSyncPause.keySet = nil
+	ctrlSyncPause.unavailableErrors = 0
+	ObserveControllerSyncPauseDuration(pausedFor)
+	utils.AviLog.Infof("Resuming the sync with the Avi Controller after %v, %d models to be synced", pausedFor.Round(time.Second), len(keys))
+	AKOControlConfig().PodEventf(corev1.EventTypeNormal, AKOResume, "Sync with the Avi Controller is resumed after %s, %s models to be synced",
+		pausedFor.Round(time.Second).String(), utils.Stringify(len(keys)))
+	return keys
+}
+
+// IsControllerUnavailableError returns true if the error is returned by the Avi Controller while it is under
+// maintenance or being upgraded.
+func IsControllerUnavailableError(err error) bool {
+	if webSyncErr, ok := err.(*utils.WebSyncError); ok {
+		err = webSyncErr.GetWebAPIError()
+	}
+	aviErr, ok := err.(session.AviError)
+	if !ok {
+		return false
+	}
+	if aviErr.HttpStatusCode == http.StatusServiceUnavailable {
+		return true
+	}
+	return aviErr.HttpStatusCode == http.StatusForbidden && aviErr.Message != nil &&
+		strings.Contains(*aviErr.Message, ConfigDisallowedDuringUpgradeError)
+}
+
+// ObserveControllerUnavailableError records a request failed by the Avi Controller as unavailable, and returns
+// true if the controller has failed the requests repeatedly within a minute, in which case the sync has to be
+// paused. A lone failure may be transient, and is retried as any other failure.
+func ObserveControllerUnavailableError() bool {
+	ctrlSyncPause.lock.Lock()
+	defer ctrlSyncPause.lock.Unlock()
+	now := time.Now()
+	if now.Sub(ctrlSyncPause.lastUnavailableError) > controllerUnavailableErrorWindow {
+		ctrlSyncPause.unavailableErrors = 0
+	}
+	ctrlSyncPause.unavailableErrors++
+	ctrlSyncPause.lastUnavailableError = now
+	return ctrlSyncPause.unavailableErrors >= controllerUnavailableErrorThreshold
+}
@@ -105,6 +105,13 @@ var (
 		Resource: "addoninstalls",
 	}
 
+	// MCSServiceImportGVR : Multi-Cluster Services API ServiceImport resource identifier
+	MCSServiceImportGVR = schema.GroupVersionResource{
+		Group:    "multicluster.x-k8s.io",
+		Version:  "v1alpha1",
+		Resource: "serviceimports",
+	}
+
 	// ClusterGVR defines the cluster.x-k8s.io/v1beta2 Cluster resource
 	ClusterGVR = schema.GroupVersionResource{
 		Group:    "cluster.x-k8s.io",
@@ -122,8 +129,9 @@ func NewDynamicClientSet(config *rest.Config) (dynamic.Interface, error) {
 	// Always instantiate the dynamic client set if:
 	// 1. CNI being used is calico or OpenShift or Cilium, OR
 	// 2. it is VCF cluster, OR
-	// 3. AKO CRD Operator is enabled and L4Rules are enabled (for HealthMonitor support)
-	if !utils.IsVCFCluster() && GetCNIPlugin() != CALICO_CNI && GetCNIPlugin() != OPENSHIFT_CNI && GetCNIPlugin() != CILIUM_CNI && !(IsAKOCRDOperatorEnabled() && AKOControlConfig().L4RuleEnabled()) {
+	// 3. AKO CRD Operator is enabled and L4Rules are enabled (for HealthMonitor support), OR
+	// 4. multi-cluster ingress backends are resolved from the upstream ServiceImports
+	if !utils.IsVCFCluster() && GetCNIPlugin() != CALICO_CNI && GetCNIPlugin() != OPENSHIFT_CNI && GetCNIPlugin() != CILIUM_CNI && !(IsAKOCRDOperatorEnabled() && AKOControlConfig().L4RuleEnabled()) && !utils.UseMCSServiceImport() {
 		return nil, nil
 	}
 
@@ -168,6 +176,9 @@ type DynamicInformers struct {
 	// AKO CRD informers
 	HealthMonitorInformer informers.GenericInformer
 
+	// Multi-Cluster Services API informers
+	MCSServiceImportInformer informers.GenericInformer
+
 	SupervisorCapabilityInformer informers.GenericInformer
 
 	AddonInstallInformer informers.GenericInformer
@@ -212,6 +223,10 @@ func NewDynamicInformers(client dynamic.Interface, akoInfra bool) *DynamicInform
 		informers.HealthMonitorInformer = f.ForResource(HealthMonitorGVR)
 	}
 
+	if utils.UseMCSServiceImport() {
+		informers.MCSServiceImportInformer = f.ForResource(MCSServiceImportGVR)
+	}
+
 	dynamicInformerInstance = informers
 	return dynamicInformerInstance
 }
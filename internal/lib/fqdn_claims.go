@@ -0,0 +1,183 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package lib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// ValidateFQDNClaims checks that the FQDN claims of an AviInfraSetting have domains and namespaces, and that a
+// wildcard is used only as the first label of a domain.
+func ValidateFQDNClaims(claims []akov1beta1.AviInfraFQDNClaim) error {
+	for _, claim := range claims {
+		if len(claim.Domains) == 0 || len(claim.Namespaces) == 0 {
+			return fmt.Errorf("fqdnClaims must have both domains and namespaces")
+		}
+		for _, domain := range claim.Domains {
+			if domain == "" || strings.Contains(strings.TrimPrefix(domain, "*."), "*") {
+				return fmt.Errorf("invalid domain %q in fqdnClaims, a wildcard is allowed only as the prefix *.", domain)
+			}
+		}
+		for _, namespace := range claim.Namespaces {
+			if namespace == "" {
+				return fmt.Errorf("empty namespace in fqdnClaims")
+			}
+		}
+	}
+	return nil
+}
+
+// IsFQDNClaimedByDomain returns true if the host matches the claimed domain. A wildcard domain *.example.com
+// matches the subdomains of example.com, including the wildcard hosts like *.foo.example.com.
+func IsFQDNClaimedByDomain(domain, host string) bool {
+	domain = strings.ToLower(domain)
+	host = strings.ToLower(host)
+	if !strings.HasPrefix(domain, "*.") {
+		return host == domain
+	}
+	return host == domain || strings.HasSuffix(host, domain[1:])
+}
+
+// fqdnClaimIndex indexes the FQDN claims of the Accepted AviInfraSettings by the claimed domain, so that the
+// claims of a host are looked up without listing the AviInfraSettings. It is updated by the AviInfraSetting
+// event handlers.
+type fqdnClaimIndex struct {
+	lock sync.RWMutex
+	// domains maps a claimed domain to the AviInfraSettings claiming it, and the namespaces each of them
+	// claims the domain for.
+	domains map[string]map[string][]string
+	// infraSettingDomains maps an AviInfraSetting to the domains it claims.
+	infraSettingDomains map[string][]string
+}
+
+var fqdnClaims = &fqdnClaimIndex{
+	domains:             make(map[string]map[string][]string),
+	infraSettingDomains: make(map[string][]string),
+}
+
+// UpdateFQDNClaims indexes the FQDN claims of an AviInfraSetting, replacing its previous claims. The claims of
+// an AviInfraSetting which is not Accepted are removed from the index.
+func UpdateFQDNClaims(infraSetting *akov1beta1.AviInfraSetting) {
+	fqdnClaims.lock.Lock()
+	defer fqdnClaims.lock.Unlock()
+	fqdnClaims.delete(infraSetting.Name)
+	if infraSetting.Status.Status != StatusAccepted {
+		return
+	}
+	for _, claim := range infraSetting.Spec.FQDNClaims {
+		for _, domain := range claim.Domains {
+			domain = strings.ToLower(domain)
+			if _, ok := fqdnClaims.domains[domain]; !ok {
+				fqdnClaims.domains[domain] = make(map[string][]string)
+			}
+			if _, ok := fqdnClaims.domains[domain][infraSetting.Name]; !ok {
+				fqdnClaims.infraSettingDomains[infraSetting.Name] = append(fqdnClaims.infraSettingDomains[infraSetting.Name], domain)
+			}
+			fqdnClaims.domains[domain][infraSetting.Name] = append(fqdnClaims.domains[domain][infraSetting.Name], claim.Namespaces...)
+		}
+	}
+}
+
+// DeleteFQDNClaims removes the FQDN claims of a deleted AviInfraSetting from the index.
+func DeleteFQDNClaims(infraSettingName string) {
+	fqdnClaims.lock.Lock()
+	defer fqdnClaims.lock.Unlock()
+	fqdnClaims.delete(infraSettingName)
+}
+
+func (c *fqdnClaimIndex) delete(infraSettingName string) {
+	for _, domain := range c.infraSettingDomains[infraSettingName] {
+		delete(c.domains[domain], infraSettingName)
+		if len(c.domains[domain]) == 0 {
+			delete(c.domains, domain)
+		}
+	}
+	delete(c.infraSettingDomains, infraSettingName)
+}
+
+// CheckFQDNClaim returns an error if the host is claimed by the FQDN claims of the Accepted AviInfraSettings,
+// and the namespace is not one of the namespaces the host is claimed for. A host which is not claimed is
+// allowed, and is subject to the FQDN reuse policy of AKO.
+func CheckFQDNClaim(host, namespace string) error {
+	claimedBy, claimNamespaces := getFQDNClaims(host)
+	if len(claimedBy) == 0 || claimNamespaces[namespace] {
+		return nil
+	}
+	return fmt.Errorf("host %s is claimed for the namespaces [%s] by AviInfraSetting %s, and is not allowed in the namespace %s",
+		host, strings.Join(sortedKeys(claimNamespaces), ", "), strings.Join(sortedKeys(claimedBy), ", "), namespace)
+}
+
+// IsFQDNClaimed returns true if the host is claimed by the FQDN claims of the Accepted AviInfraSettings. The
+// ownership of a claimed host is decided by the claims, instead of the FQDN reuse policy of AKO.
+func IsFQDNClaimed(host string) bool {
+	claimedBy, _ := getFQDNClaims(host)
+	return len(claimedBy) > 0
+}
+
+// getFQDNClaims returns the AviInfraSettings claiming the host, and the namespaces the host is claimed for. The
+// domains which can claim a host are the host itself, and the wildcard domains of each of its parent domains.
+func getFQDNClaims(host string) (map[string]bool, map[string]bool) {
+	claimedBy := make(map[string]bool)
+	claimNamespaces := make(map[string]bool)
+	if host == "" {
+		return claimedBy, claimNamespaces
+	}
+	host = strings.ToLower(host)
+	domains := []string{host}
+	for suffix := host; strings.Contains(suffix, "."); {
+		suffix = suffix[strings.Index(suffix, ".")+1:]
+		domains = append(domains, "*."+suffix)
+	}
+
+	fqdnClaims.lock.RLock()
+	defer fqdnClaims.lock.RUnlock()
+	for _, domain := range domains {
+		for infraSettingName, namespaces := range fqdnClaims.domains[domain] {
+			claimedBy[infraSettingName] = true
+			for _, claimNamespace := range namespaces {
+				claimNamespaces[claimNamespace] = true
+			}
+		}
+	}
+	return claimedBy, claimNamespaces
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// FQDNClaimsUpdated returns true if the FQDN claims differ between two AviInfraSettings, or the claims are
+// applied or withdrawn by a change in the status of the AviInfraSetting.
+func FQDNClaimsUpdated(oldInfraSetting, newInfraSetting *akov1beta1.AviInfraSetting) bool {
+	oldClaims, newClaims := "", ""
+	if oldInfraSetting != nil && oldInfraSetting.Status.Status == StatusAccepted && len(oldInfraSetting.Spec.FQDNClaims) > 0 {
+		oldClaims = utils.Stringify(oldInfraSetting.Spec.FQDNClaims)
+	}
+	if newInfraSetting != nil && newInfraSetting.Status.Status == StatusAccepted && len(newInfraSetting.Spec.FQDNClaims) > 0 {
+		newClaims = utils.Stringify(newInfraSetting.Spec.FQDNClaims)
+	}
+	return oldClaims != newClaims
+}
@@ -97,6 +97,7 @@ type ServiceMetadataObj struct {
 	Gateway                    string              `json:"gateway"`   // ns/name
 	HTTPRoute                  string              `json:"httproute"` // ns/name
 	HTTPRouteRuleName          string              `json:"httproute_rule_name"`
+	RouteType                  string              `json:"route_type,omitempty"` // set when the HTTPRoute field refers to another route kind, e.g. GRPCRoute
 	InsecureEdgeTermAllow      bool                `json:"insecureedgetermallow"`
 	IsMCIIngress               bool                `json:"is_mci_ingress"`
 	FQDNReusePolicy            string              `json:"fqdn_reuse_policy"`
@@ -204,6 +205,7 @@ func RegisterPromMetrics() *prometheus.Registry {
 		},
 	)
 	reg.MustRegister(ObjectsInQueue)
+	registerSyncMetrics(subSystem)
 	return reg
 }
 
@@ -459,6 +461,42 @@ func GetShardSizeFromAviInfraSetting(infraSetting *akov1beta1.AviInfraSetting) u
 	return GetshardSize()
 }
 
+// ShardMove is a hostname that changes VS on a shard size change. From and To are
+// the shared VS numbers, or DEDICATED for the dedicated VS of the hostname.
+type ShardMove struct {
+	Hostname string `json:"hostname"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+}
+
+// GetShardMoves returns the hostnames which would move to a different VS if the
+// shard size is changed, sorted by hostname. As the shard sizes are powers of two,
+// only the hostnames of the added or removed shared VSs move, while a change to or
+// from DEDICATED moves all the hostnames. With the sticky shard placement, the
+// hostnames keep their shared VS if it is within the new shard size. infraPrefixes
+// has the AviInfraSetting used in the VS names of the hostnames, if any.
+func GetShardMoves(hostnames []string, infraPrefixes map[string]string, oldShardSize, newShardSize uint32) []ShardMove {
+	moves := []ShardMove{}
+	for _, hostname := range hostnames {
+		infraPrefix := infraPrefixes[hostname]
+		from, to := getShardVSForHost(hostname, infraPrefix, oldShardSize), getShardVSForHost(hostname, infraPrefix, newShardSize)
+		if from != to {
+			moves = append(moves, ShardMove{Hostname: hostname, From: from, To: to})
+		}
+	}
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].Hostname < moves[j].Hostname
+	})
+	return moves
+}
+
+func getShardVSForHost(hostname, infraPrefix string, shardSize uint32) string {
+	if shardSize == 0 {
+		return "DEDICATED"
+	}
+	return strconv.Itoa(int(getPlacedShardVSNum(hostname, infraPrefix, shardSize)))
+}
+
 func GetL4FqdnFormat() string {
 	if utils.IsWCP() {
 		// disable for advancedL4
@@ -854,6 +892,36 @@ func GetAkoApiServerPort() string {
 	return "8080"
 }
 
+// IsValidatingWebhookEnabled returns true if AKO serves the validating admission webhook for the AKO CRDs.
+func IsValidatingWebhookEnabled() bool {
+	ok, _ := strconv.ParseBool(os.Getenv("VALIDATING_WEBHOOK_ENABLED"))
+	return ok
+}
+
+// The port to run the validating admission webhook on
+func GetValidatingWebhookPort() string {
+	port := os.Getenv("VALIDATING_WEBHOOK_PORT")
+	if port != "" {
+		return port
+	}
+	return "9443"
+}
+
+// GetValidatingWebhookCertDir returns the directory with the tls.crt and tls.key of the validating admission webhook.
+func GetValidatingWebhookCertDir() string {
+	certDir := os.Getenv("VALIDATING_WEBHOOK_CERT_DIR")
+	if certDir != "" {
+		return certDir
+	}
+	return "/etc/ako/webhook-certs"
+}
+
+// IsPlanAPIEnabled returns true if the AKO API server serves the plan of the pending rest operations.
+func IsPlanAPIEnabled() bool {
+	ok, _ := strconv.ParseBool(os.Getenv("PLAN_API_ENABLED"))
+	return ok
+}
+
 func IsPrometheusEnabled() bool {
 	if ok, _ := strconv.ParseBool(os.Getenv("PROMETHEUS_ENABLED")); ok {
 		utils.AviLog.Infof("Prometheus is enabled")
@@ -1529,7 +1597,10 @@ func InformersToRegister(kclient *kubernetes.Clientset, oclient *oshiftclient.Cl
 		// Add MultiClusterIngress and ServiceImport informers if enabled.
 		if utils.IsMultiClusterIngressEnabled() {
 			allInformers = append(allInformers, utils.MultiClusterIngressInformer)
-			allInformers = append(allInformers, utils.ServiceImportInformer)
+			// The upstream ServiceImports are watched over by a dynamic informer.
+			if !utils.UseMCSServiceImport() {
+				allInformers = append(allInformers, utils.ServiceImportInformer)
+			}
 		}
 	}
 
@@ -1611,6 +1682,21 @@ func PersistenceProfileChecksum(name, persistenceType string, ingestionMarkers u
 	return checksum
 }
 
+func TrafficCloneProfileChecksum(name string, cloneServers []string, ingestionMarkers utils.AviObjectMarkers, markers []*models.RoleFilterMatchLabel, populateCache bool) uint32 {
+	var checksum uint32 = 0
+	checksum += utils.Hash(name)
+	sort.Strings(cloneServers)
+	checksum += utils.Hash(utils.Stringify(cloneServers))
+	if populateCache {
+		if markers != nil {
+			checksum += ObjectLabelChecksum(markers)
+		}
+		return checksum
+	}
+	checksum += GetMarkersChecksum(ingestionMarkers)
+	return checksum
+}
+
 func IsNodePortMode() bool {
 	nodePortType := os.Getenv(SERVICE_TYPE)
 	if nodePortType == NODE_PORT {
@@ -2178,15 +2264,54 @@ func VIPPerNamespace() bool {
 }
 
 var controllerIP string
+var controllerEndpoints []string
+var controllerIPLock sync.RWMutex
 
+// GetControllerIP returns the active endpoint of the Avi Controller.
 func GetControllerIP() string {
-	if controllerIP == "" {
+	controllerIPLock.RLock()
+	ctrlIP := controllerIP
+	controllerIPLock.RUnlock()
+	if ctrlIP == "" {
 		SetControllerIP(os.Getenv(utils.ENV_CTRL_IPADDRESS))
+		controllerIPLock.RLock()
+		ctrlIP = controllerIP
+		controllerIPLock.RUnlock()
 	}
-	return controllerIP
+	return ctrlIP
 }
 
+// SetControllerIP sets the endpoints of the Avi Controller. ctrlIP can be a comma separated list of
+// endpoints, e.g. the cluster VIP followed by the controller nodes, in the order of preference. The first
+// endpoint is the active endpoint.
 func SetControllerIP(ctrlIP string) {
+	var endpoints []string
+	for _, endpoint := range strings.Split(ctrlIP, ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	controllerIPLock.Lock()
+	defer controllerIPLock.Unlock()
+	controllerEndpoints = endpoints
+	controllerIP = ""
+	if len(endpoints) > 0 {
+		controllerIP = endpoints[0]
+	}
+}
+
+// GetControllerEndpoints returns the endpoints of the Avi Controller, in the order of preference.
+func GetControllerEndpoints() []string {
+	GetControllerIP()
+	controllerIPLock.RLock()
+	defer controllerIPLock.RUnlock()
+	return append([]string{}, controllerEndpoints...)
+}
+
+// SetActiveControllerIP switches the active endpoint of the Avi Controller to one of the configured endpoints.
+func SetActiveControllerIP(ctrlIP string) {
+	controllerIPLock.Lock()
+	defer controllerIPLock.Unlock()
 	controllerIP = ctrlIP
 }
 
@@ -0,0 +1,267 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package lib
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vmware/alb-sdk/go/session"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	apimodels "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api/models"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// Stages of the sync pipeline, for the stage duration metric and the traces.
+const (
+	// IngestionStage is the wait of a key in the ingestion queue, from the informer event to the graph stage.
+	IngestionStage = "ingestion"
+	// GraphStage is the processing of a key from the ingestion queue, which builds the models.
+	GraphStage = "graph"
+	// RestStage is the processing of a model from the graph queue, which syncs the model with the controller.
+	RestStage = "rest"
+	// RetryStage is the processing of a model from the fast or slow retry queue.
+	RetryStage = "retry"
+	// StatusStage is the update of the status of a kubernetes object.
+	StatusStage = "status"
+)
+
+var SyncStageDuration *prometheus.HistogramVec
+var RestOpErrors *prometheus.CounterVec
+var FullSyncDuration prometheus.Histogram
+var ControllerSyncPauseDuration prometheus.Histogram
+
+var cacheSizeFunc func() map[string]int
+var cacheSizeLock sync.RWMutex
+
+// SetCacheSizeFunc sets the function which returns the number of cached Avi objects per object type.
+func SetCacheSizeFunc(sizeFunc func() map[string]int) {
+	cacheSizeLock.Lock()
+	defer cacheSizeLock.Unlock()
+	cacheSizeFunc = sizeFunc
+}
+
+func getCacheSizes() map[string]int {
+	cacheSizeLock.RLock()
+	defer cacheSizeLock.RUnlock()
+	if cacheSizeFunc == nil {
+		return nil
+	}
+	return cacheSizeFunc()
+}
+
+func registerSyncMetrics(subSystem string) {
+	SyncStageDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ako",
+			Subsystem: subSystem,
+			Name:      "sync_stage_duration_seconds",
+			Help:      "Time taken by a key in each stage of the sync pipeline, and its wait in the ingestion queue.",
+			Buckets:   []float64{0.005, 0.01, 0.05, 0.1, 0.5, 1, 2.5, 5, 10, 30, 60},
+		},
+		[]string{
+			// ingestion, graph, rest or status
+			"stage",
+		},
+	)
+	reg.MustRegister(SyncStageDuration)
+
+	RestOpErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ako",
+			Subsystem: subSystem,
+			Name:      "rest_api_errors",
+			Help:      "Number of failed rest operations sent to controller from AKO per object type per HTTP status code.",
+		},
+		[]string{
+			// Avi object type, e.g. Pool
+			"object_type",
+			// HTTP status code returned by the controller, 0 if the request did not get a response
+			"status_code",
+		},
+	)
+	reg.MustRegister(RestOpErrors)
+
+	FullSyncDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "ako",
+			Subsystem: subSystem,
+			Name:      "full_sync_duration_seconds",
+			Help:      "Time taken by the full sync of the kubernetes objects.",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200},
+		},
+	)
+	reg.MustRegister(FullSyncDuration)
+
+	ControllerSyncPauseDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "ako",
+			Subsystem: subSystem,
+			Name:      "controller_sync_pause_duration_seconds",
+			Help:      "Time for which the sync with the Avi controller was paused, during a maintenance or an upgrade of the controller.",
+			Buckets:   []float64{30, 60, 300, 600, 1200, 1800, 3600, 7200},
+		},
+	)
+	reg.MustRegister(ControllerSyncPauseDuration)
+
+	reg.MustRegister(newSyncStateCollector(subSystem))
+}
+
+// ObserveSyncStageDuration records the time taken by a stage, since the start time. It is meant to be
+// deferred at the start of the stage: defer lib.ObserveSyncStageDuration(lib.RestStage, time.Now())
+func ObserveSyncStageDuration(stage string, start time.Time) {
+	if AKOControlConfig().GetAKOAKOPrometheusFlag() {
+		SyncStageDuration.With(prometheus.Labels{"stage": stage}).Observe(time.Since(start).Seconds())
+	}
+}
+
+// ObserveIngestionQueueWait records the time for which the key waited in the ingestion queue, since the informer
+// event which added it. It is called when the key is dequeued by the graph stage.
+func ObserveIngestionQueueWait(key string) {
+	enqueueTime, ok := utils.SharedWorkQueue().GetQueueByName(utils.ObjectIngestionLayer).PopEnqueueTime(key)
+	if ok {
+		ObserveSyncStageDuration(IngestionStage, enqueueTime)
+	}
+}
+
+func ObserveFullSyncDuration(start time.Time) {
+	if AKOControlConfig().GetAKOAKOPrometheusFlag() {
+		FullSyncDuration.Observe(time.Since(start).Seconds())
+	}
+}
+
+func ObserveControllerSyncPauseDuration(pausedFor time.Duration) {
+	if AKOControlConfig().GetAKOAKOPrometheusFlag() {
+		ControllerSyncPauseDuration.Observe(pausedFor.Seconds())
+	}
+}
+
+func IncrementRestOpErrorCounter(objectType string, err error) {
+	if AKOControlConfig().GetAKOAKOPrometheusFlag() {
+		statusCode := 0
+		if aviErr, ok := err.(session.AviError); ok {
+			statusCode = aviErr.HttpStatusCode
+		}
+		RestOpErrors.With(prometheus.Labels{"object_type": objectType, "status_code": strconv.Itoa(statusCode)}).Inc()
+	}
+}
+
+// syncStateCollector reports the state which is read at the time of the scrape, instead of being
+// updated from the sync pipeline: retry queue depth, cache sizes, leader, controller connection state,
+// the pause of the sync with the controller and the expiry of the programmed certificates.
+type syncStateCollector struct {
+	retryQueueDepth     *prometheus.Desc
+	cachedObjects       *prometheus.Desc
+	leader              *prometheus.Desc
+	controllerConnected *prometheus.Desc
+	syncPaused          *prometheus.Desc
+	pausedModels        *prometheus.Desc
+	certificateExpiry   *prometheus.Desc
+}
+
+func newSyncStateCollector(subSystem string) *syncStateCollector {
+	return &syncStateCollector{
+		retryQueueDepth: prometheus.NewDesc(
+			prometheus.BuildFQName("ako", subSystem, "retry_queue_depth"),
+			"Number of models in the fast and slow retry queues.",
+			[]string{"queue"}, nil,
+		),
+		cachedObjects: prometheus.NewDesc(
+			prometheus.BuildFQName("ako", subSystem, "cached_avi_objects"),
+			"Number of Avi objects in the AKO cache per object type.",
+			[]string{"object_type"}, nil,
+		),
+		leader: prometheus.NewDesc(
+			prometheus.BuildFQName("ako", subSystem, "is_leader"),
+			"1 if AKO is the leader, 0 otherwise.",
+			nil, nil,
+		),
+		controllerConnected: prometheus.NewDesc(
+			prometheus.BuildFQName("ako", subSystem, "avi_controller_connection_status"),
+			"1 for the current state of the connection with the Avi controller, 0 for the other states.",
+			[]string{"status"}, nil,
+		),
+		syncPaused: prometheus.NewDesc(
+			prometheus.BuildFQName("ako", subSystem, "controller_sync_paused"),
+			"1 if the sync with the Avi controller is paused during a maintenance or an upgrade of the controller, 0 otherwise.",
+			nil, nil,
+		),
+		pausedModels: prometheus.NewDesc(
+			prometheus.BuildFQName("ako", subSystem, "controller_sync_paused_models"),
+			"Number of models buffered while the sync with the Avi controller is paused.",
+			nil, nil,
+		),
+		certificateExpiry: prometheus.NewDesc(
+			prometheus.BuildFQName("ako", subSystem, "certificate_expiry_days"),
+			"Number of days left before the expiry of a certificate programmed in the Avi controller, negative if expired.",
+			[]string{"tenant", "certificate", "host", "secret_namespace", "secret_name"}, nil,
+		),
+	}
+}
+
+func (c *syncStateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.retryQueueDepth
+	ch <- c.cachedObjects
+	ch <- c.leader
+	ch <- c.controllerConnected
+	ch <- c.syncPaused
+	ch <- c.pausedModels
+	ch <- c.certificateExpiry
+}
+
+func (c *syncStateCollector) Collect(ch chan<- prometheus.Metric) {
+	for queue, depth := range objects.SharedSyncStatusLister().GetRetryCount() {
+		ch <- prometheus.MustNewConstMetric(c.retryQueueDepth, prometheus.GaugeValue, float64(depth), queue)
+	}
+
+	for objectType, size := range getCacheSizes() {
+		ch <- prometheus.MustNewConstMetric(c.cachedObjects, prometheus.GaugeValue, float64(size), objectType)
+	}
+
+	leader := 0.0
+	if AKOControlConfig().IsLeader() {
+		leader = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.leader, prometheus.GaugeValue, leader)
+
+	connectionStatus := utils.AVIAPI_INITIATING
+	if apimodels.RestStatus != nil {
+		connectionStatus = apimodels.RestStatus.GetAviApiConnectionStatus()
+	}
+	for _, status := range []string{utils.AVIAPI_INITIATING, utils.AVIAPI_CONNECTED, utils.AVIAPI_DISCONNECTED} {
+		value := 0.0
+		if status == connectionStatus {
+			value = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.controllerConnected, prometheus.GaugeValue, value, status)
+	}
+
+	paused, _, _, pausedModels := GetControllerSyncPauseState()
+	syncPaused := 0.0
+	if paused {
+		syncPaused = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.syncPaused, prometheus.GaugeValue, syncPaused)
+	ch <- prometheus.MustNewConstMetric(c.pausedModels, prometheus.GaugeValue, float64(pausedModels))
+
+	for _, cert := range getProgrammedCertificates() {
+		daysToExpiry := time.Until(cert.notAfter).Hours() / 24
+		ch <- prometheus.MustNewConstMetric(c.certificateExpiry, prometheus.GaugeValue, daysToExpiry,
+			cert.tenant, cert.name, cert.host, cert.secretNamespace, cert.secretName)
+	}
+}
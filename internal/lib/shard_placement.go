@@ -0,0 +1,170 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+const (
+	// ShardPlacementConfigMap persists the shared VS numbers of the hostnames, with the sticky shard placement.
+	ShardPlacementConfigMap = "avi-k8s-shard-placement"
+	shardPlacementDataKey   = "placements"
+)
+
+// IsStickyShardPlacement returns true if the hostnames keep their shared VS when the shard size is changed,
+// as long as the VS is within the new shard size.
+func IsStickyShardPlacement() bool {
+	sticky, _ := strconv.ParseBool(os.Getenv("STICKY_SHARD_PLACEMENT"))
+	return sticky
+}
+
+// shardPlacementStore holds the shared VS numbers of the hostnames.
+// key: infraPrefix/hostname, value: shared VS number
+type shardPlacementStore struct {
+	lock       sync.RWMutex
+	placements map[string]uint32
+	changed    bool
+}
+
+var shardPlacements = &shardPlacementStore{placements: make(map[string]uint32)}
+
+func shardPlacementKey(hostname, infraPrefix string) string {
+	return infraPrefix + "/" + hostname
+}
+
+// GetShardVSNum returns the shared VS number of the hostname, among the shared VSs of the AviInfraSetting used
+// as infraPrefix in the VS names. With the sticky shard placement, the number recorded for the hostname is
+// used as long as it is within the shard size, and the hostnames are hashed only when they are placed first,
+// or when their VS is removed by a smaller shard size.
+func GetShardVSNum(hostname, infraPrefix string, shardSize uint32) uint32 {
+	if !IsStickyShardPlacement() {
+		return utils.Bkt(hostname, shardSize)
+	}
+	key := shardPlacementKey(hostname, infraPrefix)
+	shardPlacements.lock.Lock()
+	defer shardPlacements.lock.Unlock()
+	if vsNum, ok := shardPlacements.placements[key]; ok && vsNum < shardSize {
+		return vsNum
+	}
+	vsNum := utils.Bkt(hostname, shardSize)
+	shardPlacements.placements[key] = vsNum
+	shardPlacements.changed = true
+	return vsNum
+}
+
+// getPlacedShardVSNum returns the shared VS number which GetShardVSNum would return for the shard size, without
+// recording it.
+func getPlacedShardVSNum(hostname, infraPrefix string, shardSize uint32) uint32 {
+	if IsStickyShardPlacement() {
+		shardPlacements.lock.RLock()
+		vsNum, ok := shardPlacements.placements[shardPlacementKey(hostname, infraPrefix)]
+		shardPlacements.lock.RUnlock()
+		if ok && vsNum < shardSize {
+			return vsNum
+		}
+	}
+	return utils.Bkt(hostname, shardSize)
+}
+
+// LoadShardPlacements reads the shared VS numbers of the hostnames persisted by the previous runs of AKO. It is
+// called during bootup, before the hostnames are placed on the shared VSs.
+func LoadShardPlacements(cs kubernetes.Interface) error {
+	placements := make(map[string]uint32)
+	cm, err := cs.CoreV1().ConfigMaps(utils.GetAKONamespace()).Get(context.TODO(), ShardPlacementConfigMap, metav1.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && cm.Data[shardPlacementDataKey] != "" {
+		if err := json.Unmarshal([]byte(cm.Data[shardPlacementDataKey]), &placements); err != nil {
+			return err
+		}
+	}
+	shardPlacements.lock.Lock()
+	defer shardPlacements.lock.Unlock()
+	shardPlacements.placements = placements
+	shardPlacements.changed = false
+	utils.AviLog.Infof("Loaded the shard placements of %d hostnames from configmap %s", len(placements), ShardPlacementConfigMap)
+	return nil
+}
+
+// SaveShardPlacements persists the shared VS numbers of the hostnames, if they are changed. The hostnames which
+// are not in activeHosts are removed, so it is called only once the hostnames are synced.
+func SaveShardPlacements(cs kubernetes.Interface, activeHosts []string) error {
+	active := make(map[string]bool, len(activeHosts))
+	for _, host := range activeHosts {
+		active[host] = true
+	}
+	shardPlacements.lock.Lock()
+	for key := range shardPlacements.placements {
+		if !active[key[strings.Index(key, "/")+1:]] {
+			delete(shardPlacements.placements, key)
+			shardPlacements.changed = true
+		}
+	}
+	if !shardPlacements.changed {
+		shardPlacements.lock.Unlock()
+		return nil
+	}
+	data, err := json.Marshal(shardPlacements.placements)
+	shardPlacements.changed = false
+	shardPlacements.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	err = saveShardPlacementConfigMap(cs, string(data))
+	if err != nil {
+		shardPlacements.lock.Lock()
+		shardPlacements.changed = true
+		shardPlacements.lock.Unlock()
+	}
+	return err
+}
+
+func saveShardPlacementConfigMap(cs kubernetes.Interface, data string) error {
+	namespace := utils.GetAKONamespace()
+	cm, err := cs.CoreV1().ConfigMaps(namespace).Get(context.TODO(), ShardPlacementConfigMap, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ShardPlacementConfigMap, Namespace: namespace},
+			Data:       map[string]string{shardPlacementDataKey: data},
+		}
+		_, err = cs.CoreV1().ConfigMaps(namespace).Create(context.TODO(), cm, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	cm = cm.DeepCopy()
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[shardPlacementDataKey] = data
+	_, err = cs.CoreV1().ConfigMaps(namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	return err
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -104,6 +105,59 @@ func HasLoadBalancerIPAnnotation(svcObj *corev1.Service) bool {
 	return false
 }
 
+// GetServiceMaintenance returns the pool servers of the Service to be drained, set with the maintenance-mode
+// and the drain-servers annotations. drainAll is true if all the servers are to be drained, otherwise the
+// servers are identified by their IP or the name of their node.
+func GetServiceMaintenance(svcObj *corev1.Service) (bool, []string) {
+	if svcObj == nil {
+		return false, nil
+	}
+	if strings.ToLower(svcObj.Annotations[MaintenanceModeAnnotation]) == "true" {
+		return true, nil
+	}
+	var drainServers []string
+	for _, server := range strings.Split(svcObj.Annotations[DrainServersAnnotation], ",") {
+		if server = strings.TrimSpace(server); server != "" {
+			drainServers = append(drainServers, server)
+		}
+	}
+	return false, drainServers
+}
+
+// GetDrainTimeout returns the time in minutes, for which the existing connections to the drained pool servers
+// of the Service are retained, set with the drain-timeout annotation. 0 terminates the connections immediately
+// and -1 retains them till they are closed. It returns nil if the annotation is not set or is invalid.
+func GetDrainTimeout(svcObj *corev1.Service) *int32 {
+	if svcObj == nil {
+		return nil
+	}
+	value, ok := svcObj.Annotations[DrainTimeoutAnnotation]
+	if !ok {
+		return nil
+	}
+	timeout, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+	if err != nil || timeout < -1 || timeout > 7200 {
+		utils.AviLog.Warnf("Invalid value %s of the annotation %s in Service %s/%s, should be between -1 and 7200",
+			value, DrainTimeoutAnnotation, svcObj.Namespace, svcObj.Name)
+		return nil
+	}
+	drainTimeout := int32(timeout)
+	return &drainTimeout
+}
+
+// GetServiceMaintenanceMessage returns a message describing the pool servers of the Service being drained,
+// or an empty string if the Service is not under maintenance.
+func GetServiceMaintenanceMessage(svcObj *corev1.Service) string {
+	drainAll, drainServers := GetServiceMaintenance(svcObj)
+	if drainAll {
+		return "All the pool servers are drained"
+	}
+	if len(drainServers) > 0 {
+		return fmt.Sprintf("Pool servers %s are drained", strings.Join(drainServers, ","))
+	}
+	return ""
+}
+
 func GetSvcKeysForNodeCRUD() (svcl4Keys []string, svcl7Keys []string) {
 	// For NodePort if the node matches the  selector update all L4 services.
 
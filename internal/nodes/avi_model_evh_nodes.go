@@ -141,6 +141,7 @@ type AviEvhVsNode struct {
 	CACertRefs          []*AviTLSKeyCertNode
 	SSLKeyCertRefs      []*AviTLSKeyCertNode
 	HttpPolicyRefs      []*AviHttpPolicySetNode
+	L4PolicyRefs        []*AviL4PolicyNode
 	VSVIPRefs           []*AviVSVIPNode
 	TLSType             string
 	ServiceMetadata     lib.ServiceMetadataObj
@@ -156,6 +157,10 @@ type AviEvhVsNode struct {
 	Caller              string
 	StringGroupRefs     []*AviStringGroupNode
 	TrafficEnabled      *bool
+	TrafficCloneProfile *AviTrafficCloneProfileNode
+	// PassthroughChildNodes has the L4 VS of the TLS passthrough listeners of a Gateway, which shares the VsVip
+	// of the EVH parent VS.
+	PassthroughChildNodes []*AviVsNode
 
 	AviVsNodeCommonFields
 
@@ -662,6 +667,10 @@ func (v *AviEvhVsNode) CalculateCheckSum() {
 	for _, vsvipref := range v.VSVIPRefs {
 		checksumStringSlice = append(checksumStringSlice, "VSVIP"+vsvipref.Name)
 	}
+
+	for _, l4policy := range v.L4PolicyRefs {
+		checksumStringSlice = append(checksumStringSlice, "L4Policy"+l4policy.Name)
+	}
 	for _, vhdomain := range v.VHDomainNames {
 		checksumStringSlice = append(checksumStringSlice, "VHDomain"+vhdomain)
 	}
@@ -674,6 +683,10 @@ func (v *AviEvhVsNode) CalculateCheckSum() {
 		}
 	}
 
+	for _, passthroughChild := range v.PassthroughChildNodes {
+		checksumStringSlice = append(checksumStringSlice, "PassthroughChild"+passthroughChild.Name)
+	}
+
 	if lib.AKOControlConfig().GetAKOFQDNReusePolicy() == lib.FQDNReusePolicyStrict {
 		// Why do we need to change checksum of VS? As we are appending hostname--> list of ingresses mapping
 		// so we need to have updated list at vs metadata so that during AKO bootup we will have updated list
@@ -932,6 +945,7 @@ func (o *AviObjectGraph) BuildPolicyPGPoolsForEVH(vsNode []*AviEvhVsNode, childN
 	}
 
 	var allFqdns []string
+	var canaryRules []string
 	allFqdns = append(allFqdns, hosts...)
 	for _, path := range paths {
 		var httpPGPath AviHostPathPortPoolPG
@@ -1005,7 +1019,8 @@ func (o *AviObjectGraph) BuildPolicyPGPoolsForEVH(vsNode []*AviEvhVsNode, childN
 				poolNode.Servers = servers
 			}
 		} else if modelType == lib.MultiClusterIngress {
-			if serviceType == lib.NodePort {
+			// The upstream ServiceImports have the endpoints of the pods, and can be used with either serviceType.
+			if serviceType == lib.NodePort || utils.UseMCSServiceImport() {
 				poolNode.ServiceMetadata.IsMCIIngress = true
 				// incase of multi-cluster ingress, the servers are created using service import CRD
 				if servers := PopulateServersForMultiClusterIngress(poolNode, namespace, path.clusterContext, path.svcNamespace, path.ServiceName, key); servers != nil {
@@ -1031,7 +1046,10 @@ func (o *AviObjectGraph) BuildPolicyPGPoolsForEVH(vsNode []*AviEvhVsNode, childN
 
 		pool_ref := fmt.Sprintf("/api/pool?name=%s", poolNode.Name)
 		ratio := path.weight
-		pgNode.Members = append(pgNode.Members, &avimodels.PoolGroupMember{PoolRef: &pool_ref, Ratio: &ratio})
+		// the pool of a canary backend selected by a header or cookie is selected by its own rule
+		if !path.isCanaryMatch() {
+			pgNode.Members = append(pgNode.Members, &avimodels.PoolGroupMember{PoolRef: &pool_ref, Ratio: &ratio})
+		}
 
 		if childNode.CheckPGNameNChecksum(pgNode.Name, pgNode.GetCheckSum()) {
 			childNode.ReplaceEvhPGInEVHNode(pgNode, key)
@@ -1052,7 +1070,14 @@ func (o *AviObjectGraph) BuildPolicyPGPoolsForEVH(vsNode []*AviEvhVsNode, childN
 				childNode.ReplaceHTTPRefInNodeForEvh(httpPGPath, httppolname, key)
 			}
 		}
+		if path.isCanaryMatch() {
+			hppMapName := lib.GetSniHppMapName(ingName, namespace, hosts[0], path.Path, infraSettingName, vsNode[0].Dedicated)
+			canaryRule := buildCanaryHTTPRule(httpPGPath, hppMapName, ingName, poolNode.Name, path)
+			canaryRules = append(canaryRules, canaryRule.Name)
+			childNode.ReplaceHTTPRefInNodeForEvh(canaryRule, httppolname, key)
+		}
 	}
+	removeStaleCanaryHTTPRules(policyNode, ingName, paths, canaryRules, key)
 	childNode.Paths = pathSet.List()
 	childNode.IngressNames = ingressNameSet.List()
 	for _, path := range paths {
@@ -1356,6 +1381,8 @@ func (o *AviObjectGraph) BuildTlsCertNodeForEvh(svcLister *objects.SvcLister, tl
 			return false
 		}
 		keycertMap := secretObj.Data
+		certNode.SecretNamespace = secretNS
+		certNode.SecretName = secretName
 		cert, ok := keycertMap[utils.K8S_TLS_SECRET_CERT]
 		if ok {
 			certNode.Cert = cert
@@ -1370,6 +1397,9 @@ func (o *AviObjectGraph) BuildTlsCertNodeForEvh(svcLister *objects.SvcLister, tl
 			utils.AviLog.Infof("key: %s, msg: key not found for secret: %s", key, secretObj.Name)
 			return false
 		}
+		if isCertificateRefused(key, certNode) {
+			return false
+		}
 		altCert, ok := keycertMap[utils.K8S_TLS_SECRET_ALT_CERT]
 		if ok {
 			altKey, ok := keycertMap[utils.K8S_TLS_SECRET_ALT_KEY]
@@ -1395,6 +1425,10 @@ func (o *AviObjectGraph) BuildTlsCertNodeForEvh(svcLister *objects.SvcLister, tl
 				}
 			}
 		}
+		if altCertNode != nil {
+			altCertNode.SecretNamespace = secretNS
+			altCertNode.SecretName = secretName
+		}
 		utils.AviLog.Infof("key: %s, msg: Added the secret object to tlsnode: %s", key, secretObj.Name)
 	}
 	// If this SSLCertRef is already present don't add it.
@@ -1900,14 +1934,14 @@ func DeriveShardVSForEvh(hostname, key string, routeIgrObj RouteIngressModel) (l
 		newVsName += "NS-" + routeIgrObj.GetNamespace()
 	} else {
 		if oldShardSize != 0 {
-			oldVsName += strconv.Itoa(int(utils.Bkt(hostname, oldShardSize)))
+			oldVsName += strconv.Itoa(int(lib.GetShardVSNum(hostname, oldInfraPrefix, oldShardSize)))
 		} else {
 			//Dedicated VS
 			oldVsName = GetDedicatedVSName(hostname, oldInfraPrefix)
 			oldVSNameMeta.Dedicated = true
 		}
 		if newShardSize != 0 {
-			newVsName += strconv.Itoa(int(utils.Bkt(hostname, newShardSize)))
+			newVsName += strconv.Itoa(int(lib.GetShardVSNum(hostname, newInfraPrefix, newShardSize)))
 		} else {
 			//Dedicated VS
 			newVsName = GetDedicatedVSName(hostname, newInfraPrefix)
@@ -2008,6 +2042,9 @@ func (o *AviObjectGraph) manipulateEVHVsNode(vsNode *AviEvhVsNode, ingName, name
 			evhPool := lib.GetEvhPoolName(ingName, namespace, hostname, path, infraSettingName, svc, vsNode.Dedicated)
 			o.RemovePoolNodeRefsFromEvh(evhPool, vsNode)
 			o.RemovePoolRefsFromPG(evhPool, pgNode)
+			httppolname := lib.GetSniHttpPolName(namespace, hostname, infraSettingName)
+			hppmapname := lib.GetSniHppMapName(ingName, namespace, hostname, path, infraSettingName, vsNode.Dedicated)
+			o.RemoveHTTPRefsStringGroupsFromEvh(httppolname, getCanaryHppMapName(hppmapname, svc), vsNode)
 
 			// Remove the EVH PG if it has no member
 			if pgNode != nil {
@@ -219,29 +219,7 @@ func (o *AviObjectGraph) ConstructAviL4PolPoolNodes(svcObj *corev1.Service, vsNo
 			poolNode.VrfContext = ""
 		}
 
-		serviceType := lib.GetServiceType()
-		if serviceType == lib.NodePortLocal {
-			if svcObj.Spec.Type == "NodePort" {
-				utils.AviLog.Warnf("key: %s, msg: Service of type NodePort is not supported when `serviceType` is NodePortLocal.", key)
-			} else {
-				if servers := PopulateServersForNPL(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key); servers != nil {
-					poolNode.Servers = servers
-				}
-			}
-		} else if _, ok := svcObj.GetAnnotations()[lib.SkipNodePortAnnotation]; ok {
-			// This annotation's presence on the svc object means that the node ports should be skipped.
-			if servers := PopulateServers(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key); servers != nil {
-				poolNode.Servers = servers
-			}
-		} else if serviceType == lib.NodePort {
-			if servers := PopulateServersForNodePort(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key); servers != nil {
-				poolNode.Servers = servers
-			}
-		} else {
-			if servers := PopulateServers(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key); servers != nil {
-				poolNode.Servers = servers
-			}
-		}
+		PopulateL4PoolServers(poolNode, svcObj, key)
 
 		poolNode.AviMarkers = lib.PopulateL4PoolNodeMarkers(svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, strconv.Itoa(int(filterPort)))
 		pool_ref := fmt.Sprintf("/api/pool?name=%s", poolNode.Name)
@@ -273,6 +251,34 @@ func (o *AviObjectGraph) ConstructAviL4PolPoolNodes(svcObj *corev1.Service, vsNo
 	}
 }
 
+// PopulateL4PoolServers populates the servers of a L4 pool, built for a service port, based on the
+// serviceType AKO is running with and the type of the service.
+func PopulateL4PoolServers(poolNode *AviPoolNode, svcObj *corev1.Service, key string) {
+	serviceType := lib.GetServiceType()
+	if serviceType == lib.NodePortLocal {
+		if svcObj.Spec.Type == "NodePort" {
+			utils.AviLog.Warnf("key: %s, msg: Service of type NodePort is not supported when `serviceType` is NodePortLocal.", key)
+		} else {
+			if servers := PopulateServersForNPL(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key); servers != nil {
+				poolNode.Servers = servers
+			}
+		}
+	} else if _, ok := svcObj.GetAnnotations()[lib.SkipNodePortAnnotation]; ok {
+		// This annotation's presence on the svc object means that the node ports should be skipped.
+		if servers := PopulateServers(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key); servers != nil {
+			poolNode.Servers = servers
+		}
+	} else if serviceType == lib.NodePort {
+		if servers := PopulateServersForNodePort(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key); servers != nil {
+			poolNode.Servers = servers
+		}
+	} else {
+		if servers := PopulateServers(poolNode, svcObj.ObjectMeta.Namespace, svcObj.ObjectMeta.Name, false, key); servers != nil {
+			poolNode.Servers = servers
+		}
+	}
+}
+
 func PopulateServersForNPL(poolNode *AviPoolNode, ns string, serviceName string, ingress bool, key string) []AviPoolMetaServer {
 	if ingress {
 		found, _ := objects.SharedClusterIpLister().Get(ns + "/" + serviceName)
@@ -303,6 +309,8 @@ func PopulateServersForNPL(poolNode *AviPoolNode, ns string, serviceName string,
 	v4ServerCount := 0
 	v6ServerCount := 0
 	var poolMeta []AviPoolMetaServer
+	setPoolMaintenance(poolNode, svcObj)
+	drain := newPoolServerDrain(svcObj)
 
 	// create a mapping from pod name to its endpoint condition
 	conditionMap := map[string]*bool{}
@@ -376,6 +384,13 @@ func PopulateServersForNPL(poolNode *AviPoolNode, ns string, serviceName string,
 						Type: &atype,
 					},
 					Enabled: enabled}
+				var nodeName string
+				if len(drain.servers) > 0 {
+					if podObj, err := utils.GetInformers().PodInformer.Lister().Pods(ns).Get(pod.Name); err == nil {
+						nodeName = podObj.Spec.NodeName
+					}
+				}
+				drain.drain(&server, nodeName)
 				poolMeta = append(poolMeta, server)
 			}
 		}
@@ -429,6 +444,8 @@ func PopulateServersForNodePort(poolNode *AviPoolNode, ns string, serviceName st
 		v4Family = true
 	}
 	// Populate pool servers
+	setPoolMaintenance(poolNode, svcObj)
+	drain := newPoolServerDrain(svcObj)
 	if lib.IsServiceClusterIPType(svcObj) {
 		utils.AviLog.Debugf("key: %s, msg: ClusterIP is not processed in NodePort: %s", key, serviceName)
 		return poolMeta
@@ -481,6 +498,7 @@ func PopulateServersForNodePort(poolNode *AviPoolNode, ns string, serviceName st
 				continue
 			}
 			server := AviPoolMetaServer{Ip: serverIP}
+			drain.drain(&server, node.Name)
 			poolMeta = append(poolMeta, server)
 		}
 	}
@@ -590,6 +608,8 @@ func PopulateServers(poolNode *AviPoolNode, ns string, serviceName string, ingre
 		v4Family = true
 	}
 	var pool_meta []AviPoolMetaServer
+	setPoolMaintenance(poolNode, svcObj)
+	drain := newPoolServerDrain(svcObj)
 	epSliceIntList, err := utils.GetInformers().EpSlicesInformer.Informer().GetIndexer().ByIndex(discovery.LabelServiceName, ns+"/"+serviceName)
 	if err != nil {
 		utils.AviLog.Warnf("key: %s, msg: error while retrieving endpointsice: %s", key, err)
@@ -655,6 +675,7 @@ func PopulateServers(poolNode *AviPoolNode, ns string, serviceName string, ingre
 			if addr.NodeName != nil {
 				server.ServerNode = *addr.NodeName
 			}
+			drain.drain(&server, server.ServerNode)
 			pool_meta = append(pool_meta, server)
 		}
 	}
@@ -691,6 +712,9 @@ func enableServer(condition discovery.EndpointConditions) *bool {
 }
 
 func PopulateServersForMultiClusterIngress(poolNode *AviPoolNode, ns, cluster, serviceNamespace, serviceName string, key string) []AviPoolMetaServer {
+	if utils.UseMCSServiceImport() {
+		return populateServersForMCSServiceImport(poolNode, cluster, serviceNamespace, serviceName, key)
+	}
 
 	var servers []AviPoolMetaServer
 	svcName := generateMultiClusterKey(cluster, serviceNamespace, serviceName)
@@ -728,6 +752,98 @@ func PopulateServersForMultiClusterIngress(poolNode *AviPoolNode, ns, cluster, s
 	return servers
 }
 
+// populateServersForMCSServiceImport populates the servers of a multi-cluster ingress pool from the EndpointSlices
+// of the upstream ServiceImport, which are imported from the cluster of the backend. The name of the port of the
+// ServiceImport, which matches the port of the backend, selects the port of the EndpointSlices.
+func populateServersForMCSServiceImport(poolNode *AviPoolNode, cluster, serviceNamespace, serviceName string, key string) []AviPoolMetaServer {
+	var servers []AviPoolMetaServer
+	dynamicInformers := lib.GetDynamicInformers()
+	if dynamicInformers == nil || dynamicInformers.MCSServiceImportInformer == nil {
+		return servers
+	}
+	siObj, err := dynamicInformers.MCSServiceImportInformer.Lister().ByNamespace(serviceNamespace).Get(serviceName)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: failed to get the upstream service import %s/%s: %v", key, serviceNamespace, serviceName, err)
+		return servers
+	}
+	serviceImport, ok := siObj.(*unstructured.Unstructured)
+	if !ok {
+		utils.AviLog.Warnf("key: %s, msg: invalid upstream service import object %s/%s", key, serviceNamespace, serviceName)
+		return servers
+	}
+	portName, found := getMCSServiceImportPortName(serviceImport, poolNode.Port)
+	if !found {
+		utils.AviLog.Warnf("key: %s, msg: port %d not found in the upstream service import %s/%s", key, poolNode.Port, serviceNamespace, serviceName)
+		return servers
+	}
+
+	epSliceIntList, err := utils.GetInformers().EpSlicesInformer.Informer().GetIndexer().ByIndex(lib.MCSServiceImportIndex, serviceNamespace+"/"+serviceName)
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: error while retrieving endpointslices of the upstream service import: %s", key, err)
+		return servers
+	}
+	for _, epSliceInt := range epSliceIntList {
+		epSlice, isEpSliceClass := epSliceInt.(*discovery.EndpointSlice)
+		if !isEpSliceClass {
+			utils.AviLog.Warnf("key: %s, msg: invalid endpointslice object", key)
+			continue
+		}
+		if cluster != "" && epSlice.Labels[lib.MCSSourceClusterLabel] != cluster {
+			continue
+		}
+		var port *int32
+		for _, epp := range epSlice.Ports {
+			if epp.Port != nil && ((epp.Name == nil && portName == "") || (epp.Name != nil && *epp.Name == portName)) {
+				port = epp.Port
+				break
+			}
+		}
+		if port == nil {
+			continue
+		}
+		for _, ep := range epSlice.Endpoints {
+			if len(ep.Addresses) == 0 {
+				continue
+			}
+			addr := ep.Addresses[0]
+			var addrType string
+			if utils.IsV4(addr) {
+				addrType = "V4"
+			} else if k8net.IsIPv6String(addr) {
+				addrType = "V6"
+			} else {
+				continue
+			}
+			server := AviPoolMetaServer{
+				Ip:      avimodels.IPAddr{Addr: &addr, Type: &addrType},
+				Port:    *port,
+				Enabled: enableServer(ep.Conditions),
+			}
+			servers = append(servers, server)
+		}
+	}
+	utils.AviLog.Infof("key: %s, msg: servers from the upstream service import %s/%s for cluster %s: %v", key, serviceNamespace, serviceName, cluster, utils.Stringify(servers))
+	return servers
+}
+
+// getMCSServiceImportPortName returns the name of the port of the upstream ServiceImport, which is used to select
+// the port of its EndpointSlices.
+func getMCSServiceImportPortName(serviceImport *unstructured.Unstructured, port int32) (string, bool) {
+	ports, _, _ := unstructured.NestedSlice(serviceImport.Object, "spec", "ports")
+	for _, portIntf := range ports {
+		portObj, ok := portIntf.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if siPort, _, _ := unstructured.NestedInt64(portObj, "port"); int32(siPort) != port {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(portObj, "name")
+		return name, true
+	}
+	return "", false
+}
+
 func (o *AviObjectGraph) BuildL4LBGraph(namespace string, svcName string, key string) {
 	o.Lock.Lock()
 	defer o.Lock.Unlock()
@@ -949,6 +1065,7 @@ func buildPoolWithL4Rule(key string, pool *AviPoolNode, l4Rule *akov1alpha2.L4Ru
 	if l4Rule == nil {
 		return
 	}
+	drainPoolWithL4Rule(pool, l4Rule, key)
 
 	index := -1
 	for i, poolProperty := range l4Rule.Spec.BackendProperties {
@@ -25,6 +25,7 @@ import (
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 
 	avimodels "github.com/vmware/alb-sdk/go/models"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
@@ -149,6 +150,7 @@ func (o *AviObjectGraph) BuildPoolPGPolicyForDedicatedVS(vsNode []*AviVsNode, na
 	}
 
 	utils.AviLog.Infof("key: %s, msg: The pathsvc mapping: %v", key, paths)
+	var poolNames, canaryRules []string
 	for _, obj := range paths {
 		isPoolNameLenExceedAviLimit := false
 		isPGNameLenExceedAviLimit := false
@@ -170,7 +172,7 @@ func (o *AviObjectGraph) BuildPoolPGPolicyForDedicatedVS(vsNode []*AviVsNode, na
 		} else {
 			priorityLabel = hostname
 		}
-		if isIngr {
+		if isIngr && !obj.canary {
 			poolName = lib.GetSniPoolName(ingName, namespace, hostname, obj.Path, infraSettingName, vsNode[0].Dedicated)
 		} else {
 			poolName = lib.GetSniPoolName(ingName, namespace, hostname, obj.Path, infraSettingName, vsNode[0].Dedicated, obj.ServiceName)
@@ -206,6 +208,7 @@ func (o *AviObjectGraph) BuildPoolPGPolicyForDedicatedVS(vsNode []*AviVsNode, na
 		}
 		var storedHosts []string
 		storedHosts = append(storedHosts, hostname)
+		poolNames = append(poolNames, poolName)
 		poolNode := buildPoolNode(key, poolName, ingName, namespace, priorityLabel, hostname, infraSetting, obj.ServiceName, storedHosts, insecureEdgeTermAllow, obj)
 		isPoolNameLenExceedAviLimit = false
 		if lib.CheckObjectNameLength(poolNode.Name, lib.Pool) {
@@ -215,7 +218,8 @@ func (o *AviObjectGraph) BuildPoolPGPolicyForDedicatedVS(vsNode []*AviVsNode, na
 			pool_ref := fmt.Sprintf("/api/pool?name=%s", poolNode.Name)
 
 			ratio := obj.weight
-			if !isPoolNameLenExceedAviLimit {
+			// the pool of a canary backend selected by a header or cookie is selected by its own rule
+			if !isPoolNameLenExceedAviLimit && !obj.isCanaryMatch() {
 				pgNode.Members = append(pgNode.Members, &avimodels.PoolGroupMember{PoolRef: &pool_ref, Ratio: &ratio})
 			}
 			// if PG name exceeds limit, do not add it to vs node
@@ -244,8 +248,18 @@ func (o *AviObjectGraph) BuildPoolPGPolicyForDedicatedVS(vsNode []*AviVsNode, na
 				vsNode[0].ReplaceSniHTTPRefInSNINode(httpPGPath, httpPolName, key, isHPPNameLengthExceedAviLimit)
 			}
 		}
+		if obj.isCanaryMatch() && !isHttpPolNameLengthExceedAviLimit && !isPoolNameLenExceedAviLimit {
+			hppMapName := lib.GetSniHppMapName(ingName, namespace, hostname, obj.Path, infraSettingName, vsNode[0].Dedicated)
+			canaryRule := buildCanaryHTTPRule(httpPGPath, hppMapName, ingName, poolNode.Name, obj)
+			canaryRules = append(canaryRules, canaryRule.Name)
+			vsNode[0].ReplaceSniHTTPRefInSNINode(canaryRule, httpPolName, key, false)
+		}
 		BuildPoolHTTPRule(hostname, obj.Path, ingName, namespace, infraSettingName, key, vsNode[0], true, vsNode[0].Dedicated)
 	}
+	if isIngr {
+		removeStaleCanaryPools(vsNode[0], namespace, ingName, hostname, paths, poolNames, key)
+		removeStaleCanaryHTTPRules(policyNode, ingName, paths, canaryRules, key)
+	}
 	vsNode[0].Paths = pathSet.List()
 	vsNode[0].IngressNames = ingressNameSet.List()
 	utils.AviLog.Infof("key: %s, msg: added pools and poolgroups. NodeChecksum for Insecure Dedicated Vs :%s is :%v", key, vsNode[0].Name, vsNode[0].GetCheckSum())
@@ -280,6 +294,7 @@ func (o *AviObjectGraph) BuildL7VSGraphHostNameShard(vsName, hostname string, ro
 	}
 
 	utils.AviLog.Infof("key: %s, msg: The pathsvc mapping: %v", key, pathsvc)
+	var poolNames []string
 	for _, obj := range pathsvc {
 		if obj.Path != "" {
 			priorityLabel = hostname + obj.Path
@@ -287,15 +302,25 @@ func (o *AviObjectGraph) BuildL7VSGraphHostNameShard(vsName, hostname string, ro
 			priorityLabel = hostname
 		}
 
+		if obj.isCanaryMatch() {
+			// the pools of the shared virtual service are selected by the priority label of the host and path
+			utils.AviLog.Warnf("key: %s, msg: canary backend %s selected by a header or cookie is not supported for host: %s without TLS, ignoring it", key, obj.ServiceName, hostname)
+			if ingObj, err := utils.GetInformers().IngressInformer.Lister().Ingresses(namespace).Get(ingName); err == nil {
+				lib.AKOControlConfig().EventRecorder().Eventf(ingObj, corev1.EventTypeWarning, lib.CanaryNotSupported,
+					"Canary backend %s selected by %s is not supported for host %s without TLS", obj.ServiceName, obj.canaryMatchString(), hostname)
+			}
+			continue
+		}
 		// Using servicename in poolname for routes, but not in ingress for consistency with existing naming convention.
 		// If possible, we would make this uniform
-		if routeIgrObj.GetType() == utils.Ingress {
+		if routeIgrObj.GetType() == utils.Ingress && !obj.canary {
 			poolName = lib.GetL7PoolName(priorityLabel, namespace, ingName, infraSettingName)
 			serviceName = ""
 		} else {
 			poolName = lib.GetL7PoolName(priorityLabel, namespace, ingName, infraSettingName, obj.ServiceName)
 			serviceName = obj.ServiceName
 		}
+		poolNames = append(poolNames, poolName)
 
 		// First check if there are pools related to this ingress present in the model already
 		poolNodes := o.GetAviPoolNodesByIngress(namespace, ingName)
@@ -327,6 +352,9 @@ func (o *AviObjectGraph) BuildL7VSGraphHostNameShard(vsName, hostname string, ro
 		}
 
 	}
+	if routeIgrObj.GetType() == utils.Ingress {
+		removeStaleCanaryPools(vsNode[0], namespace, ingName, hostname, pathsvc, poolNames, key)
+	}
 	for _, obj := range pathsvc {
 		BuildPoolHTTPRule(hostname, obj.Path, ingName, namespace, infraSettingName, key, vsNode[0], false, vsNode[0].Dedicated)
 	}
@@ -341,6 +369,28 @@ func (o *AviObjectGraph) BuildL7VSGraphHostNameShard(vsName, hostname string, ro
 	}
 }
 
+// removeStaleCanaryPools removes the pools of the ingress for the host and paths that are not in poolNames. Pools of
+// the canary backends removed from an ingress path are not removed with the path, as the path is still present.
+func removeStaleCanaryPools(vsNode *AviVsNode, namespace, ingName, hostname string, paths []IngressHostPathSvc, poolNames []string, key string) {
+	priorityLabels := sets.NewString()
+	for _, obj := range paths {
+		priorityLabels.Insert(strings.ToLower(hostname + obj.Path))
+	}
+	currentPools := sets.NewString()
+	for _, poolName := range poolNames {
+		currentPools.Insert(poolName, lib.GetEncodedSniPGPoolNameforRegex(poolName))
+	}
+	for i := len(vsNode.PoolRefs) - 1; i >= 0; i-- {
+		pool := vsNode.PoolRefs[i]
+		if pool.IngressName != ingName || pool.ServiceMetadata.Namespace != namespace ||
+			!priorityLabels.Has(strings.ToLower(pool.PriorityLabel)) || currentPools.Has(pool.Name) {
+			continue
+		}
+		utils.AviLog.Infof("key: %s, msg: removing pool %s of a canary backend removed from the ingress", key, pool.Name)
+		vsNode.PoolRefs = append(vsNode.PoolRefs[:i], vsNode.PoolRefs[i+1:]...)
+	}
+}
+
 func buildPoolNode(key, poolName, ingName, namespace, priorityLabel, hostname string, infraSetting *akov1beta1.AviInfraSetting, serviceName string, storedHosts []string, insecureEdgeTermAllow bool, obj IngressHostPathSvc) *AviPoolNode {
 	tenant := lib.GetTenantInNamespace(namespace)
 	poolNode := &AviPoolNode{
@@ -430,8 +480,10 @@ func (o *AviObjectGraph) DeletePoolForHostname(vsName, hostname string, routeIgr
 					} else {
 						poolName = lib.GetL7PoolName(priorityLabel, namespace, ingName, infraSettingName, svcName)
 					}
-					if poolName == pool.Name {
-						o.RemovePoolNodeRefs(poolName)
+					// pools of the canary backends of an ingress use the service name
+					canaryPoolName := lib.GetL7PoolName(priorityLabel, namespace, ingName, infraSettingName, svcName)
+					if poolName == pool.Name || canaryPoolName == pool.Name {
+						o.RemovePoolNodeRefs(pool.Name)
 					}
 				}
 			}
@@ -506,20 +558,30 @@ func (o *AviObjectGraph) manipulateVsNode(vsNode *AviVsNode, ingName, namespace,
 		}
 		for _, svc := range services {
 			var sniPool string
+			sniPools := []string{lib.GetSniPoolName(ingName, namespace, hostname, path, infraSettingName, vsNode.Dedicated, svc)}
 			if isIngr {
 				sniPool = lib.GetSniPoolName(ingName, namespace, hostname, path, infraSettingName, vsNode.Dedicated)
-			} else {
-				sniPool = lib.GetSniPoolName(ingName, namespace, hostname, path, infraSettingName, vsNode.Dedicated, svc)
+				// pools of the canary backends of an ingress use the service name
+				sniPools = append(sniPools, sniPool)
 			}
 			// Pls decprecate when PGs have http caching
 			if lib.GetNoPGForSNI() && isIngr {
-				sniPool = sniPool + "--" + lib.PoolNameSuffixForHttpPolToPool
+				sniPools = []string{sniPool + "--" + lib.PoolNameSuffixForHttpPolToPool}
+			}
+			for _, sniPool := range sniPools {
+				if lib.IsNameEncoded(pgName) {
+					sniPool = lib.GetEncodedSniPGPoolNameforRegex(sniPool)
+				}
+				o.RemovePoolNodeRefsFromSni(sniPool, vsNode)
+				if pgNode != nil {
+					o.RemovePoolRefsFromPG(sniPool, pgNode)
+				}
 			}
-			if lib.IsNameEncoded(pgName) {
-				sniPool = lib.GetEncodedSniPGPoolNameforRegex(sniPool)
+			if isIngr {
+				hppmapname := lib.GetSniHppMapName(ingName, namespace, hostname, path, infraSettingName, vsNode.Dedicated)
+				httppolname := lib.GetSniHttpPolName(namespace, hostname, infraSettingName)
+				o.RemoveHTTPRefsStringGroupsFromSni(httppolname, getCanaryHppMapName(hppmapname, svc), vsNode)
 			}
-			o.RemovePoolNodeRefsFromSni(sniPool, vsNode)
-			o.RemovePoolRefsFromPG(sniPool, pgNode)
 		}
 		// Remove the SNI PG if it has no member
 		if pgNode != nil {
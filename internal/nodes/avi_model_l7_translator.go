@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
 	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
@@ -195,6 +196,23 @@ func (o *AviObjectGraph) BuildCACertNode(tlsNode *AviVsNode, cacert, infraSettin
 	return cacertNode.Name
 }
 
+// isCertificateRefused returns true if the certificate of the secret has expired or does not match its key, and no
+// certificate is programmed for the host yet, so that the TLS configuration of the host is skipped. An invalid
+// certificate which replaces a programmed certificate is refused by the rest layer, which keeps the programmed one.
+func isCertificateRefused(key string, certNode *AviTLSKeyCertNode) bool {
+	_, err := lib.ValidateCertificate(certNode.SecretNamespace, certNode.SecretName, certNode.Cert, certNode.Key)
+	if err == nil {
+		return false
+	}
+	sslKey := avicache.NamespaceName{Namespace: certNode.Tenant, Name: certNode.Name}
+	if _, found := avicache.SharedAviObjCache().SSLKeyCache.AviCacheGet(sslKey); found {
+		utils.AviLog.Warnf("key: %s, msg: %v, keeping the programmed certificate %s", key, err, certNode.Name)
+		return false
+	}
+	utils.AviLog.Warnf("key: %s, msg: %v, skipping the TLS configuration of the host", key, err)
+	return true
+}
+
 func (o *AviObjectGraph) BuildTlsCertNode(svcLister *objects.SvcLister, tlsNode *AviVsNode, namespace string, tlsData TlsSettings, key, infraSettingName, sniHost string) bool {
 	secretName := tlsData.SecretName
 	secretNS := tlsData.SecretNS
@@ -263,6 +281,8 @@ func (o *AviObjectGraph) BuildTlsCertNode(svcLister *objects.SvcLister, tlsNode
 			return false
 		}
 		keycertMap := secretObj.Data
+		certNode.SecretNamespace = secretNS
+		certNode.SecretName = secretName
 		cert, ok := keycertMap[utils.K8S_TLS_SECRET_CERT]
 		if ok {
 			certNode.Cert = cert
@@ -277,6 +297,9 @@ func (o *AviObjectGraph) BuildTlsCertNode(svcLister *objects.SvcLister, tlsNode
 			utils.AviLog.Infof("key: %s, msg: key not found for secret: %s", key, secretObj.Name)
 			return false
 		}
+		if isCertificateRefused(key, certNode) {
+			return false
+		}
 		altCert, ok := keycertMap[utils.K8S_TLS_SECRET_ALT_CERT]
 		if ok {
 			altKey, ok := keycertMap[utils.K8S_TLS_SECRET_ALT_KEY]
@@ -302,6 +325,10 @@ func (o *AviObjectGraph) BuildTlsCertNode(svcLister *objects.SvcLister, tlsNode
 				}
 			}
 		}
+		if altCertNode != nil {
+			altCertNode.SecretNamespace = secretNS
+			altCertNode.SecretName = secretName
+		}
 		utils.AviLog.Infof("key: %s, msg: Added the secret object to tlsnode: %s", key, secretObj.Name)
 	}
 	// If this SSLCertRef is already present don't add it.
@@ -374,6 +401,7 @@ func (o *AviObjectGraph) BuildPolicyPGPoolsForSNI(vsNode []*AviVsNode, tlsNode *
 			tlsNode.HttpPolicyRefs = append(tlsNode.HttpPolicyRefs, policyNode)
 		}
 
+		var poolNames, canaryRules []string
 		for _, path := range paths.ingressHPSvc {
 			isPoolNameLenExceedAviLimit := false
 			isPGNameLenExceedAviLimit := false
@@ -401,12 +429,13 @@ func (o *AviObjectGraph) BuildPolicyPGPoolsForSNI(vsNode []*AviVsNode, tlsNode *
 			var poolName string
 			var pgfound bool
 			var pgNode *AviPoolGroupNode
-			// Do not use serviceName in SNI Pool Name for ingress for backward compatibility
-			if isIngr {
+			// Do not use serviceName in SNI Pool Name for ingress for backward compatibility, except for canary backends
+			if isIngr && !path.canary {
 				poolName = lib.GetSniPoolName(ingName, namespace, host, path.Path, infraSettingName, vsNode[0].Dedicated)
 			} else {
 				poolName = lib.GetSniPoolName(ingName, namespace, host, path.Path, infraSettingName, vsNode[0].Dedicated, path.ServiceName)
 			}
+			poolNames = append(poolNames, poolName)
 			httpPGPath.Host = pathFQDNs
 			// There can be multiple services for the same path in case of alternate backend.
 			// In that case, make sure we are creating only one PG per path
@@ -499,8 +528,9 @@ func (o *AviObjectGraph) BuildPolicyPGPoolsForSNI(vsNode []*AviVsNode, tlsNode *
 			if !lib.GetNoPGForSNI() || !isIngr {
 				pool_ref := fmt.Sprintf("/api/pool?name=%s", poolNode.Name)
 				ratio := path.weight
-				// add pool to pg member if len does not exceed limit
-				if !isPoolNameLenExceedAviLimit {
+				// add pool to pg member if len does not exceed limit, the pool of a canary backend selected by a
+				// header or cookie is selected by its own rule
+				if !isPoolNameLenExceedAviLimit && !path.isCanaryMatch() {
 					pgNode.Members = append(pgNode.Members, &avimodels.PoolGroupMember{PoolRef: &pool_ref, Ratio: &ratio})
 				}
 				// do not add PG to VS node if len exceeds.
@@ -534,11 +564,21 @@ func (o *AviObjectGraph) BuildPolicyPGPoolsForSNI(vsNode []*AviVsNode, tlsNode *
 					tlsNode.ReplaceSniHTTPRefInSNINode(httpPGPath, httpPolName, key, isHTTPPGPathNameExceedsAviLimit)
 				}
 			}
+			if path.isCanaryMatch() && !isHttpPolNameLengthExceedAviLimit && !isPoolNameLenExceedAviLimit {
+				hppMapName := lib.GetSniHppMapName(ingName, namespace, host, path.Path, infraSettingName, vsNode[0].Dedicated)
+				canaryRule := buildCanaryHTTPRule(httpPGPath, hppMapName, ingName, poolNode.Name, path)
+				canaryRules = append(canaryRules, canaryRule.Name)
+				tlsNode.ReplaceSniHTTPRefInSNINode(canaryRule, httpPolName, key, false)
+			}
 			BuildPoolHTTPRule(host, path.Path, ingName, namespace, infraSettingName, key, tlsNode, true, vsNode[0].Dedicated)
 			if lib.IsIstioEnabled() {
 				poolNode.UpdatePoolNodeForIstio()
 			}
 		}
+		if isIngr {
+			removeStaleCanaryPools(tlsNode, namespace, ingName, host, paths.ingressHPSvc, poolNames, key)
+			removeStaleCanaryHTTPRules(policyNode, ingName, paths.ingressHPSvc, canaryRules, key)
+		}
 		sniFQDNs = append(sniFQDNs, pathFQDNs...)
 	}
 	tlsNode.Paths = pathSet.List()
@@ -213,6 +213,9 @@ func (v *AviEvhVsNode) CalculateForGraphChecksum() uint32 {
 	for _, vsvip := range v.VSVIPRefs {
 		checksumStringSlice = append(checksumStringSlice, fmt.Sprint(vsvip.GetCheckSum()))
 	}
+	for _, l4pol := range v.L4PolicyRefs {
+		checksumStringSlice = append(checksumStringSlice, fmt.Sprint(l4pol.GetCheckSum()))
+	}
 	for _, stringGroup := range v.StringGroupRefs {
 		checksumStringSlice = append(checksumStringSlice, fmt.Sprint(stringGroup.GetCheckSum()))
 	}
@@ -1133,6 +1136,10 @@ func (v *AviL4PolicyNode) CalculateCheckSum() {
 		ports = append(ports, int64(hpp.Port))
 		protocols = append(protocols, hpp.Protocol)
 		// Include Pool name in checksum logic
+		if hpp.PoolGroup != "" {
+			pools = append(pools, strings.TrimPrefix(hpp.PoolGroup, "/api/poolgroup?name="))
+			continue
+		}
 		pool := strings.TrimPrefix(hpp.Pool, "/api/pool?name=")
 		pools = append(pools, pool)
 
@@ -1244,6 +1251,49 @@ type AviHostPathPortPoolPG struct {
 	MatchCase       string
 	StringGroupRefs []string
 	SvcPort         int
+	// CanaryHeader and CanaryCookie restrict the rule to the requests with the header or cookie, for the pool
+	// of a canary backend of an ingress path
+	CanaryHeader *CanaryMatch
+	CanaryCookie *CanaryMatch
+}
+
+// buildCanaryHTTPRule returns the rule selecting the pool of the canary backend of the path, for the requests of
+// the path rule with the header or cookie of the canary backend.
+func buildCanaryHTTPRule(pathRule AviHostPathPortPoolPG, hppMapName, ingName, poolName string, path IngressHostPathSvc) AviHostPathPortPoolPG {
+	rule := pathRule
+	rule.Name = getCanaryHppMapName(hppMapName, path.ServiceName)
+	rule.IngName = ingName
+	rule.Pool = poolName
+	rule.PoolGroup = ""
+	rule.CanaryHeader = path.canaryHeader
+	rule.CanaryCookie = path.canaryCookie
+	rule.CalculateCheckSum()
+	return rule
+}
+
+func getCanaryHppMapName(hppMapName, serviceName string) string {
+	return hppMapName + "-" + serviceName
+}
+
+// removeStaleCanaryHTTPRules removes the rules of the ingress selecting the pools of canary backends for the paths,
+// which are not in canaryRules.
+func removeStaleCanaryHTTPRules(policyNode *AviHttpPolicySetNode, ingName string, paths []IngressHostPathSvc, canaryRules []string, key string) {
+	if policyNode == nil {
+		return
+	}
+	ingPaths := sets.NewString()
+	for _, path := range paths {
+		ingPaths.Insert(path.Path)
+	}
+	for i := len(policyNode.HppMap) - 1; i >= 0; i-- {
+		rule := policyNode.HppMap[i]
+		if rule.IngName != ingName || (rule.CanaryHeader == nil && rule.CanaryCookie == nil) ||
+			len(rule.Path) == 0 || !ingPaths.Has(rule.Path[0]) || utils.HasElem(canaryRules, rule.Name) {
+			continue
+		}
+		utils.AviLog.Infof("key: %s, msg: removing rule %s of a canary backend removed from the ingress", key, rule.Name)
+		policyNode.HppMap = append(policyNode.HppMap[:i], policyNode.HppMap[i+1:]...)
+	}
 }
 
 func (v *AviHostPathPortPoolPG) GetCheckSum() uint32 {
@@ -1297,6 +1347,9 @@ type AviTLSKeyCertNode struct {
 	Port             int32
 	Type             string
 	AviMarkers       utils.AviObjectMarkers
+	// SecretNamespace and SecretName are the kubernetes Secret of the certificate, if any.
+	SecretNamespace string
+	SecretName      string
 }
 
 func (v *AviTLSKeyCertNode) CalculateCheckSum() {
@@ -1635,6 +1688,46 @@ func (v *AviApplicationPersistenceProfileNode) CalculateCheckSum() {
 	v.CloudConfigCksum = checksum
 }
 
+type AviTrafficCloneProfileNode struct {
+	Name             string
+	Tenant           string
+	CloudConfigCksum uint32
+	AviMarkers       utils.AviObjectMarkers
+	CloneServers     []avimodels.IPAddr
+}
+
+func (v *AviTrafficCloneProfileNode) GetNodeType() string {
+	return lib.TrafficCloneProfileNode
+}
+
+func (v *AviTrafficCloneProfileNode) CopyNode() AviModelNode {
+	newNode := AviTrafficCloneProfileNode{}
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		utils.AviLog.Warnf("Unable to marshal AviTrafficCloneProfileNode: %s", err)
+	}
+	err = json.Unmarshal(bytes, &newNode)
+	if err != nil {
+		utils.AviLog.Warnf("Unable to unmarshal AviTrafficCloneProfileNode: %s", err)
+	}
+	return &newNode
+}
+
+func (v *AviTrafficCloneProfileNode) GetCheckSum() uint32 {
+	v.CalculateCheckSum()
+	return v.CloudConfigCksum
+}
+
+func (v *AviTrafficCloneProfileNode) CalculateCheckSum() {
+	cloneServers := make([]string, 0, len(v.CloneServers))
+	for _, server := range v.CloneServers {
+		if server.Addr != nil {
+			cloneServers = append(cloneServers, *server.Addr)
+		}
+	}
+	v.CloudConfigCksum = lib.TrafficCloneProfileChecksum(v.Name, cloneServers, v.AviMarkers, nil, false)
+}
+
 type AviPoolNode struct {
 	Name                          string
 	Tenant                        string
@@ -1655,6 +1748,13 @@ type AviPoolNode struct {
 	T1Lr                          string // Only applicable to NSX-T cloud, if this value is set, we automatically should unset the VRF context value.
 	AviMarkers                    utils.AviObjectMarkers
 	AttachedWithSharedVS          bool
+	// drains all the pool servers, set with the maintenance of a HostRule or an L4Rule
+	DrainAllServers        bool
+	DrainAllServersTimeout *int32
+	// Service of the pool servers (namespace/name) and its maintenance, to be reported in the status of the
+	// Service and the Ingress or Route once the pool is synced
+	MaintenanceService string
+	MaintenanceMessage string
 
 	AviPoolCommonFields
 
@@ -1679,6 +1779,10 @@ type AviPoolCommonFields struct {
 	EnableHttp2                      *bool
 	HostCheckEnabled                 *bool
 	DomainName                       []string
+	ServerName                       *string
+	ServerTimeout                    *uint32
+	ServerReselect                   *avimodels.HttpserverReselect
+	GracefulDisableTimeout           *int32
 }
 
 func (v *AviPoolNode) GetCheckSum() uint32 {
@@ -1736,6 +1840,9 @@ func (v *AviPoolNode) CalculateCheckSum() {
 	if v.DomainName != nil {
 		checksumStringSlice = append(checksumStringSlice, utils.Stringify(v.DomainName))
 	}
+	if v.ServerName != nil {
+		checksumStringSlice = append(checksumStringSlice, *v.ServerName)
+	}
 
 	if len(v.ServiceMetadata.NamespaceServiceName) > 0 {
 		sort.Strings(v.ServiceMetadata.NamespaceServiceName)
@@ -1750,6 +1857,21 @@ func (v *AviPoolNode) CalculateCheckSum() {
 	if v.EnableHttp2 != nil {
 		checksumStringSlice = append(checksumStringSlice, utils.Stringify(*v.EnableHttp2))
 	}
+	if v.ServerTimeout != nil {
+		checksumStringSlice = append(checksumStringSlice, strconv.Itoa(int(*v.ServerTimeout)))
+	}
+	if v.ServerReselect != nil {
+		checksumStringSlice = append(checksumStringSlice, utils.Stringify(v.ServerReselect))
+	}
+	if v.GracefulDisableTimeout != nil {
+		checksumStringSlice = append(checksumStringSlice, strconv.Itoa(int(*v.GracefulDisableTimeout)))
+	}
+	if v.DrainAllServers {
+		checksumStringSlice = append(checksumStringSlice, utils.Stringify(v.DrainAllServers))
+		if v.DrainAllServersTimeout != nil {
+			checksumStringSlice = append(checksumStringSlice, strconv.Itoa(int(*v.DrainAllServersTimeout)))
+		}
+	}
 	chksumStr := fmt.Sprint(strings.Join(checksumStringSlice, delim))
 
 	checksum := utils.Hash(chksumStr)
@@ -1848,6 +1970,20 @@ type IngressHostPathSvc struct {
 	TargetPort     intstr.IntOrString
 	clusterContext string // required for Multi-cluster ingress
 	svcNamespace   string // required for Multi-cluster ingress
+	canary         bool   // set for canary backends of an ingress path
+	// set for canary backends of an ingress path selected by a request header or cookie
+	canaryHeader *CanaryMatch
+	canaryCookie *CanaryMatch
+}
+
+// isCanaryMatch returns true for the canary backends of an ingress path selected by a request header or cookie.
+func (p IngressHostPathSvc) isCanaryMatch() bool {
+	return p.canaryHeader != nil || p.canaryCookie != nil
+}
+
+// canaryMatchString returns the request header and cookie selecting a canary backend of an ingress path.
+func (p IngressHostPathSvc) canaryMatchString() string {
+	return CanaryBackend{Header: p.canaryHeader, Cookie: p.canaryCookie}.matchString()
 }
 
 type IngressHostMap map[string]HostMetadata
@@ -179,7 +179,7 @@ func (o *AviVsNodeCommonFields) ConvertToRef() {
 		}
 		SslKeyAndCertificateRefs := make([]string, 0, len(o.SslKeyAndCertificateRefs))
 		for i := range o.SslKeyAndCertificateRefs {
-			ref := fmt.Sprintf("/api/sslkeyandcertificate?name=" + o.SslKeyAndCertificateRefs[i])
+			ref := fmt.Sprintf("/api/sslkeyandcertificate?name=%s", o.SslKeyAndCertificateRefs[i])
 			if !utils.HasElem(SslKeyAndCertificateRefs, ref) {
 				SslKeyAndCertificateRefs = append(SslKeyAndCertificateRefs, ref)
 			}
@@ -190,7 +190,7 @@ func (o *AviVsNodeCommonFields) ConvertToRef() {
 		}
 		VsDatascriptRefs := make([]string, 0, len(o.VsDatascriptRefs))
 		for i := range o.VsDatascriptRefs {
-			ref := fmt.Sprintf("/api/vsdatascriptset?name=" + o.VsDatascriptRefs[i])
+			ref := fmt.Sprintf("/api/vsdatascriptset?name=%s", o.VsDatascriptRefs[i])
 			if !utils.HasElem(VsDatascriptRefs, ref) {
 				VsDatascriptRefs = append(VsDatascriptRefs, ref)
 			}
@@ -262,7 +262,7 @@ func (o *AviPoolCommonFields) ConvertToRef() {
 		}
 		HealthMonitorRefs := make([]string, 0, len(o.HealthMonitorRefs))
 		for i := range o.HealthMonitorRefs {
-			ref := fmt.Sprintf("/api/healthmonitor?name=" + o.HealthMonitorRefs[i])
+			ref := fmt.Sprintf("/api/healthmonitor?name=%s", o.HealthMonitorRefs[i])
 			if !utils.HasElem(HealthMonitorRefs, ref) {
 				HealthMonitorRefs = append(HealthMonitorRefs, ref)
 			}
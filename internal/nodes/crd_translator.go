@@ -63,8 +63,8 @@ func BuildL7HostRule(host, key string, vsNode AviVsEvhSniModel) {
 		if err != nil {
 			utils.AviLog.Debugf("key: %s, msg: No HostRule found for virtualhost: %s msg: %v", key, host, err)
 			deleteCase = true
-		} else if hostrule.Status.Status == lib.StatusRejected {
-			// do not apply a rejected hostrule, this way the VS would retain
+		} else if hostrule.Status.Status == lib.StatusRejected || hostrule.Status.Status == lib.StatusPending {
+			// do not apply a rejected or pending hostrule, this way the VS would retain
 			return
 		} else {
 			if lib.GetTenantInNamespace(hostrule.Namespace) != vsNode.GetTenant() {
@@ -256,6 +256,9 @@ func BuildL7HostRule(host, key string, vsNode AviVsEvhSniModel) {
 	vsNode.SetSSLProfileRef(vsSslProfile)
 	vsNode.SetVsDatascriptRefs(vsDatascripts)
 	vsNode.SetEnabled(vsEnabled)
+	if !vsNode.IsSharedVS() {
+		drainPoolsWithHostRule(vsNode, hostrule, key)
+	}
 	vsNode.SetAnalyticsPolicy(commonFieldObj.analyticsPolicy)
 	if len(portProtocols) != 0 {
 		vsNode.SetPortProtocols(portProtocols)
@@ -292,9 +295,9 @@ func BuildOnlyRegexAppRoot(host, key string, vsNode AviVsEvhSniModel) {
 	if err != nil {
 		utils.AviLog.Debugf("key: %s, msg: No HostRule found for virtualhost: %s msg: %v", key, host, err)
 		return
-	} else if hostrule.Status.Status == lib.StatusRejected {
-		// do not apply a rejected hostrule, this way the VS would retain
-		utils.AviLog.Debugf("key: %s, msg: hostrule %s is in rejected state", key, hrNSName)
+	} else if hostrule.Status.Status == lib.StatusRejected || hostrule.Status.Status == lib.StatusPending {
+		// do not apply a rejected or pending hostrule, this way the VS would retain
+		utils.AviLog.Debugf("key: %s, msg: hostrule %s is in %s state", key, hrNSName, hostrule.Status.Status)
 		return
 	} else {
 		if lib.GetTenantInNamespace(hostrule.Namespace) != vsNode.GetTenant() {
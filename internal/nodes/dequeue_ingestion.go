@@ -20,6 +20,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1alpha1 "sigs.k8s.io/service-apis/apis/v1alpha1"
@@ -30,6 +31,7 @@ import (
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/status"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/tracing"
 	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
 	akoErrors "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/errors"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
@@ -42,9 +44,15 @@ func DequeueIngestion(key string, fullsync bool) {
 	var ingressNames, routeNames, mciNames []string
 	utils.AviLog.Infof("key: %s, msg: starting graph Sync", key)
 	lib.DecrementQueueCounter(utils.ObjectIngestionLayer)
+	lib.ObserveIngestionQueueWait(key)
+	defer lib.ObserveSyncStageDuration(lib.GraphStage, time.Now())
+	span := tracing.StartSpan(lib.GraphStage, key)
+	defer span.End()
 	sharedQueue := utils.SharedWorkQueue().GetQueueByName(utils.GraphLayer)
+	objects.SharedSyncStatusLister().ResetObjectModels(key)
 
 	objType, namespace, name := lib.ExtractTypeNameNamespace(key)
+	defer pruneDeletedObjectSyncStatus(key, objType, namespace, name)
 
 	if objType == lib.L7Rule {
 		return
@@ -59,6 +67,12 @@ func DequeueIngestion(key string, fullsync bool) {
 		rootCA := secret.Data["root-cert"]
 		sslKey := secret.Data["key"]
 		sslCert := secret.Data["cert-chain"]
+		// The istio workload certificate has no owning object, its expiry is reported in the events of the secret.
+		leaf, err := lib.ValidateCertificate(namespace, name, sslCert, sslKey)
+		lib.ReportCertificateState(key, lib.AKOControlConfig().EventRecorder(), secret, utils.Secret, namespace, name, name, leaf, err)
+		if err != nil {
+			return
+		}
 		newAviModel := NewAviObjectGraph()
 		newAviModel.IsVrf = false
 		pkinode := &AviPkiProfileNode{
@@ -68,11 +82,13 @@ func DequeueIngestion(key string, fullsync bool) {
 		}
 		newAviModel.AddModelNode(pkinode)
 		sslNode := &AviTLSKeyCertNode{
-			Name:   lib.GetIstioWorkloadCertificateName(),
-			Tenant: lib.GetTenant(),
-			Type:   lib.CertTypeVS,
-			Cert:   sslCert,
-			Key:    sslKey,
+			Name:            lib.GetIstioWorkloadCertificateName(),
+			Tenant:          lib.GetTenant(),
+			Type:            lib.CertTypeVS,
+			Cert:            sslCert,
+			Key:             sslKey,
+			SecretNamespace: namespace,
+			SecretName:      name,
 		}
 		newAviModel.AddModelNode(sslNode)
 		ok := saveAviModel(lib.IstioModel, newAviModel, key)
@@ -824,7 +840,7 @@ func getIngressNSNameForIngestion(objType, namespace, nsname string) (string, st
 		return arr[0], arr[1]
 	}
 
-	if objType == utils.IngressClass || objType == lib.AviInfraSetting {
+	if objType == utils.IngressClass || objType == lib.AviInfraSetting || objType == lib.MCSServiceImport {
 		arr := strings.Split(nsname, "/")
 		return arr[0], arr[1]
 	}
@@ -832,6 +848,35 @@ func getIngressNSNameForIngestion(objType, namespace, nsname string) (string, st
 	return namespace, nsname
 }
 
+// pruneDeletedObjectSyncStatus removes the sync status of an Ingress, Route or Service which is deleted from the informer,
+// as saveAviModel adds the key back while the delete is processed.
+func pruneDeletedObjectSyncStatus(key, objType, namespace, name string) {
+	var err error
+	informers := utils.GetInformers()
+	switch objType {
+	case utils.Ingress:
+		if informers.IngressInformer == nil {
+			return
+		}
+		_, err = informers.IngressInformer.Lister().Ingresses(namespace).Get(name)
+	case utils.OshiftRoute:
+		if informers.RouteInformer == nil {
+			return
+		}
+		_, err = informers.RouteInformer.Lister().Routes(namespace).Get(name)
+	case utils.Service, utils.L4LBService:
+		if informers.ServiceInformer == nil {
+			return
+		}
+		_, err = informers.ServiceInformer.Lister().Services(namespace).Get(name)
+	default:
+		return
+	}
+	if k8serrors.IsNotFound(err) {
+		objects.SharedSyncStatusLister().DeleteObjectModels(key)
+	}
+}
+
 func saveAviModel(modelName string, aviGraph *AviObjectGraph, key string) bool {
 	utils.AviLog.Debugf("key: %s, msg: Evaluating model :%s", key, modelName)
 	if lib.DisableSync {
@@ -839,6 +884,7 @@ func saveAviModel(modelName string, aviGraph *AviObjectGraph, key string) bool {
 		utils.AviLog.Infof("key: %s, msg: Disable Sync is True, model %s can not be saved", key, modelName)
 		return false
 	}
+	objects.SharedSyncStatusLister().AddObjectModel(key, modelName)
 	found, aviModel := objects.SharedAviGraphLister().Get(modelName)
 	if found && aviModel != nil {
 		prevChecksum := aviModel.(*AviObjectGraph).GraphChecksum
@@ -918,6 +964,7 @@ func (aviModelGraph *AviObjectGraph) processVrfGraphForNode(key string, nodename
 
 func PublishKeyToRestLayer(modelName string, key string, sharedQueue *utils.WorkerQueue) {
 	bkt := utils.Bkt(modelName, sharedQueue.NumWorkers)
+	tracing.Publish(lib.GraphStage, key, lib.RestStage, modelName)
 	sharedQueue.Workqueue[bkt].AddRateLimited(modelName)
 	lib.IncrementQueueCounter(utils.GraphLayer)
 	utils.AviLog.Infof("key: %s, msg: Published key with modelName: %s", key, modelName)
@@ -973,7 +1020,7 @@ func GetShardVSName(s string, key string, shardSize uint32, tenant string, prefi
 	}
 
 	if shardSize != 0 {
-		vsNum = utils.Bkt(s, shardSize)
+		vsNum = lib.GetShardVSNum(s, extraPrefix, shardSize)
 		utils.AviLog.Debugf("key: %s, msg: VS number: %v", key, vsNum)
 	} else {
 		utils.AviLog.Debugf("key: %s, msg: Processing dedicated VS", key)
@@ -169,6 +169,10 @@ func (h *HostNamePathStore) RemoveHostPathStore(host, path string, ing string) {
 	}
 }
 
+func (h *HostNamePathStore) GetAllHosts() []string {
+	return h.hostNamePathStore.GetAllKeys()
+}
+
 func (h *HostNamePathStore) DeleteHostPathStore(host string) {
 	h.hostNamePathStore.Delete(host)
 }
@@ -191,6 +195,10 @@ func EnqueueIng(key, namespace, hostName, ingName string) bool {
 	if lib.AKOControlConfig().GetAKOFQDNReusePolicy() != lib.FQDNReusePolicyStrict {
 		return true
 	}
+	if lib.IsFQDNClaimed(hostName) {
+		// the namespaces allowed to use a claimed host are validated while parsing the ingress
+		return true
+	}
 	return !hostnameExistInDifferentNamespace(key, hostName, namespace)
 }
 
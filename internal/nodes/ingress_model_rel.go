@@ -126,6 +126,10 @@ var (
 		Type:                           lib.ServiceImport,
 		GetParentMultiClusterIngresses: ServiceImportToMultiClusterIng,
 	}
+	MCSServiceImport = GraphSchema{
+		Type:                           lib.MCSServiceImport,
+		GetParentMultiClusterIngresses: MCSServiceImportToMultiClusterIng,
+	}
 	SSORule = GraphSchema{
 		Type:               lib.SSORule,
 		GetParentIngresses: SSORuleToIng,
@@ -150,6 +154,7 @@ var (
 		AviInfraSetting,
 		MultiClusterIngress,
 		ServiceImport,
+		MCSServiceImport,
 		SSORule,
 		L4Rule,
 	}
@@ -385,7 +390,7 @@ func IngressChanges(ingName string, namespace string, key string) ([]string, boo
 		}
 
 		_, oldSvcs := objects.SharedSvcLister().IngressMappings(namespace).GetIngToSvc(ingName)
-		currSvcs := parseServicesForIngress(ingObj.Spec, key)
+		currSvcs := parseServicesForIngress(ingObj.Spec, ingObj.Annotations, key)
 
 		svcToDel := lib.Difference(oldSvcs, currSvcs)
 		for _, svc := range svcToDel {
@@ -969,7 +974,7 @@ func ServiceChanges(serviceName, namespace, key string) ([]string, bool) {
 	return vipKeys, true
 }
 
-func parseServicesForIngress(ingSpec networkingv1.IngressSpec, key string) []string {
+func parseServicesForIngress(ingSpec networkingv1.IngressSpec, annotations map[string]string, key string) []string {
 	// Figure out the service names that are part of this ingress
 	var services []string
 	for _, rule := range ingSpec.Rules {
@@ -979,6 +984,15 @@ func parseServicesForIngress(ingSpec networkingv1.IngressSpec, key string) []str
 			}
 		}
 	}
+	if ingSpec.DefaultBackend != nil && ingSpec.DefaultBackend.Service != nil &&
+		!utils.HasElem(services, ingSpec.DefaultBackend.Service.Name) {
+		services = append(services, ingSpec.DefaultBackend.Service.Name)
+	}
+	for _, canary := range ParseCanaryBackends(annotations, key) {
+		if !utils.HasElem(services, canary.ServiceName) {
+			services = append(services, canary.ServiceName)
+		}
+	}
 	utils.AviLog.Debugf("key: %s, msg: total services retrieved from corev1: %s", key, services)
 	return services
 }
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
@@ -141,6 +142,28 @@ func ServiceImportToMultiClusterIng(siName string, namespace string, key string)
 	return mciNames, true
 }
 
+// MCSServiceImportToMultiClusterIng returns the multi-cluster ingresses, in the namespace/name format, which have the
+// service of the upstream ServiceImport as a backend. The upstream ServiceImport has the same name and namespace as
+// the service exported from the member clusters.
+func MCSServiceImportToMultiClusterIng(siName string, namespace string, key string) ([]string, bool) {
+	mciObjs, err := utils.GetInformers().MultiClusterIngressInformer.Lister().List(labels.Everything())
+	if err != nil {
+		utils.AviLog.Warnf("key: %s, msg: error in listing the multi-cluster ingresses: %v", key, err)
+		return []string{}, false
+	}
+	var mciNames []string
+	for _, mciObj := range mciObjs {
+		for _, config := range mciObj.Spec.Config {
+			if config.Service.Namespace == namespace && config.Service.Name == siName {
+				mciNames = append(mciNames, mciObj.Namespace+"/"+mciObj.Name)
+				break
+			}
+		}
+	}
+	utils.AviLog.Debugf("key: %s, msg: Multi-cluster ingresses retrieved %s", key, mciNames)
+	return mciNames, len(mciNames) > 0
+}
+
 func generateMultiClusterKey(cluster, namespace, objName string) string {
 	return fmt.Sprintf("%s/%s/%s", cluster, namespace, objName)
 }
@@ -0,0 +1,122 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package nodes
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	akov1alpha2 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1alpha2"
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// poolServerDrain is the set of pool servers of a Service to be drained, identified by their IP or the name
+// of their node.
+type poolServerDrain struct {
+	drainAll bool
+	servers  map[string]bool
+}
+
+func newPoolServerDrain(svcObj *v1.Service) poolServerDrain {
+	drainAll, drainServers := lib.GetServiceMaintenance(svcObj)
+	drain := poolServerDrain{drainAll: drainAll, servers: make(map[string]bool, len(drainServers))}
+	for _, server := range drainServers {
+		drain.servers[server] = true
+	}
+	return drain
+}
+
+// drain disables the pool server, if it is to be drained. A disabled server does not receive new connections,
+// and its existing connections are retained for the graceful disable timeout of the pool.
+func (d poolServerDrain) drain(server *AviPoolMetaServer, nodeName string) {
+	if !d.drainAll && !d.servers[*server.Ip.Addr] && (nodeName == "" || !d.servers[nodeName]) {
+		return
+	}
+	server.Enabled = proto.Bool(false)
+}
+
+// setPoolMaintenance sets the graceful disable timeout of the pool from the drain-timeout annotation of the
+// Service, and the maintenance of the Service to be reported in its status once the pool is synced.
+func setPoolMaintenance(poolNode *AviPoolNode, svcObj *v1.Service) {
+	if svcObj == nil {
+		return
+	}
+	message := lib.GetServiceMaintenanceMessage(svcObj)
+	if message != "" {
+		poolNode.GracefulDisableTimeout = lib.GetDrainTimeout(svcObj)
+	}
+	poolNode.MaintenanceService = svcObj.Namespace + "/" + svcObj.Name
+	if !poolNode.DrainAllServers {
+		poolNode.MaintenanceMessage = message
+	}
+}
+
+// drainPoolWithRule drains all the pool servers, while the maintenance is enabled in the HostRule or the L4Rule,
+// and enables them again once it is disabled or the rule is removed. The drain-servers annotation of the Service
+// still applies to the servers while the rule does not drain them.
+func drainPoolWithRule(poolNode *AviPoolNode, enabled bool, drainTimeout *int32, ruleType, ruleName, key string) {
+	if enabled {
+		if drainTimeout != nil && (*drainTimeout < -1 || *drainTimeout > 7200) {
+			utils.AviLog.Warnf("key: %s, msg: invalid drainTimeout %d in %s %s, should be between -1 and 7200", key, *drainTimeout, ruleType, ruleName)
+			drainTimeout = nil
+		}
+		poolNode.DrainAllServers = true
+		poolNode.DrainAllServersTimeout = drainTimeout
+		poolNode.MaintenanceMessage = fmt.Sprintf("All the pool servers are drained by %s %s", ruleType, ruleName)
+		return
+	}
+	if !poolNode.DrainAllServers {
+		return
+	}
+	poolNode.DrainAllServers = false
+	poolNode.DrainAllServersTimeout = nil
+	poolNode.MaintenanceMessage = ""
+	if poolNode.MaintenanceService == "" {
+		return
+	}
+	svcNSName := strings.Split(poolNode.MaintenanceService, "/")
+	svcObj, err := utils.GetInformers().ServiceInformer.Lister().Services(svcNSName[0]).Get(svcNSName[1])
+	if err == nil {
+		poolNode.MaintenanceMessage = lib.GetServiceMaintenanceMessage(svcObj)
+	}
+}
+
+// drainPoolsWithHostRule drains all the pool servers of the virtualhost, with the maintenance of the HostRule.
+func drainPoolsWithHostRule(vsNode AviVsEvhSniModel, hostrule *akov1beta1.HostRule, key string) {
+	enabled, ruleName := false, ""
+	var drainTimeout *int32
+	if hostrule != nil && hostrule.Spec.VirtualHost.Maintenance != nil {
+		enabled = hostrule.Spec.VirtualHost.Maintenance.Enabled
+		drainTimeout = hostrule.Spec.VirtualHost.Maintenance.DrainTimeout
+		ruleName = hostrule.Namespace + "/" + hostrule.Name
+	}
+	for _, poolNode := range vsNode.GetPoolRefs() {
+		drainPoolWithRule(poolNode, enabled, drainTimeout, lib.HostRule, ruleName, key)
+	}
+}
+
+// drainPoolWithL4Rule drains all the pool servers of the Service, with the maintenance of the L4Rule.
+func drainPoolWithL4Rule(poolNode *AviPoolNode, l4Rule *akov1alpha2.L4Rule, key string) {
+	if l4Rule == nil || l4Rule.Spec.Maintenance == nil {
+		drainPoolWithRule(poolNode, false, nil, lib.L4Rule, "", key)
+		return
+	}
+	drainPoolWithRule(poolNode, l4Rule.Spec.Maintenance.Enabled, l4Rule.Spec.Maintenance.DrainTimeout, lib.L4Rule, l4Rule.Namespace+"/"+l4Rule.Name, key)
+}
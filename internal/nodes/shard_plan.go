@@ -0,0 +1,147 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package nodes
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api/models"
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// ShardPlan reports the hostnames which would move between the VSs for a shard size
+// change, before the change is applied.
+type ShardPlan struct {
+	AviInfraSetting  string          `json:"avi_infra_setting,omitempty"`
+	CurrentShardSize uint32          `json:"current_shard_size"`
+	ShardSize        uint32          `json:"shard_size"`
+	TotalHosts       int             `json:"total_hosts"`
+	Moves            []lib.ShardMove `json:"moves"`
+}
+
+// ShardPlanModel implements ApiModel
+type ShardPlanModel struct{}
+
+func (a *ShardPlanModel) InitModel() {}
+
+func (a *ShardPlanModel) ApiOperationMap(prometheusEnabled bool, reg *prometheus.Registry) []models.OperationMap {
+	get := models.OperationMap{
+		Route:  "/api/shards/plan",
+		Method: "GET",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var infraSetting *akov1beta1.AviInfraSetting
+			if name := r.URL.Query().Get("aviInfraSetting"); name != "" {
+				if !isAviInfraSettingInformerSet() {
+					http.Error(w, "AviInfraSetting is not enabled", http.StatusBadRequest)
+					return
+				}
+				var err error
+				if infraSetting, err = lib.AKOControlConfig().CRDInformers().AviInfraSettingInformer.Lister().Get(name); err != nil {
+					http.Error(w, fmt.Sprintf("AviInfraSetting %s not found", name), http.StatusNotFound)
+					return
+				}
+			}
+			shardSize := lib.GetShardSizeFromAviInfraSetting(infraSetting)
+			if size := r.URL.Query().Get("shardSize"); size != "" {
+				var ok bool
+				if shardSize, ok = lib.ShardSizeMap[size]; !ok {
+					http.Error(w, "shardSize must be one of LARGE, MEDIUM, SMALL or DEDICATED", http.StatusBadRequest)
+					return
+				}
+			}
+			utils.Respond(w, GetShardPlan(SharedHostNameLister().GetAllHosts(), infraSetting, shardSize))
+		},
+	}
+	return []models.OperationMap{get}
+}
+
+// GetShardPlan computes the VS moves of the hostnames from the current shard size to the
+// given one. Without an AviInfraSetting, the plan is for the global shard size and leaves
+// out the hostnames using an AviInfraSetting with its own shard size. With an
+// AviInfraSetting, the plan is for its shard size and covers only the hostnames using it.
+func GetShardPlan(hostnames []string, infraSetting *akov1beta1.AviInfraSetting, shardSize uint32) ShardPlan {
+	plan := ShardPlan{
+		CurrentShardSize: lib.GetShardSizeFromAviInfraSetting(infraSetting),
+		ShardSize:        shardSize,
+	}
+	if infraSetting != nil {
+		plan.AviInfraSetting = infraSetting.Name
+	}
+	planHostnames := make([]string, 0, len(hostnames))
+	infraPrefixes := make(map[string]string)
+	for _, hostname := range hostnames {
+		hostInfraSetting, infraPrefix := getHostAviInfraSetting(hostname)
+		if infraSetting != nil {
+			if hostInfraSetting == nil || hostInfraSetting.Name != infraSetting.Name {
+				continue
+			}
+		} else if hostInfraSetting != nil && hostInfraSetting.Spec.L7Settings.ShardSize != "" {
+			continue
+		}
+		planHostnames = append(planHostnames, hostname)
+		if infraPrefix != "" {
+			infraPrefixes[hostname] = infraPrefix
+		}
+	}
+	plan.TotalHosts = len(planHostnames)
+	plan.Moves = lib.GetShardMoves(planHostnames, infraPrefixes, plan.CurrentShardSize, shardSize)
+	return plan
+}
+
+func isAviInfraSettingInformerSet() bool {
+	return lib.AKOControlConfig().CRDInformers() != nil &&
+		lib.AKOControlConfig().CRDInformers().AviInfraSettingInformer != nil
+}
+
+// getHostAviInfraSetting returns the AviInfraSetting of the Ingresses or Routes using the hostname, and with
+// the sticky shard placement, the AviInfraSetting used in the names of the shared VSs of the hostname.
+func getHostAviInfraSetting(hostname string) (*akov1beta1.AviInfraSetting, string) {
+	if !isAviInfraSettingInformerSet() {
+		return nil, ""
+	}
+	_, pathObjs := SharedHostNameLister().GetHostPathStore(hostname)
+	for _, objs := range pathObjs {
+		for _, obj := range objs {
+			namespace, name := utils.ExtractNamespaceObjectName(obj)
+			var infraSetting *akov1beta1.AviInfraSetting
+			var err error
+			if utils.GetInformers().RouteInformer != nil {
+				route, routeErr := utils.GetInformers().RouteInformer.Lister().Routes(namespace).Get(name)
+				if routeErr != nil {
+					continue
+				}
+				infraSetting, err = getL7RouteInfraSetting(utils.OshiftRoute+"/"+obj, route.GetAnnotations(), namespace)
+			} else if utils.GetInformers().IngressInformer != nil {
+				ingress, ingErr := utils.GetInformers().IngressInformer.Lister().Ingresses(namespace).Get(name)
+				if ingErr != nil {
+					continue
+				}
+				infraSetting, err = getL7IngressInfraSetting(utils.Ingress+"/"+obj, utils.String(ingress.Spec.IngressClassName), namespace)
+			}
+			if err == nil && infraSetting != nil {
+				if lib.IsStickyShardPlacement() && !lib.IsInfraSettingNSScoped(infraSetting.Name, namespace) {
+					return infraSetting, infraSetting.Name
+				}
+				return infraSetting, ""
+			}
+		}
+	}
+	return nil, ""
+}
@@ -15,10 +15,13 @@
 package nodes
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/status"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1alpha1"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
 	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
@@ -28,7 +31,9 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 type Validator struct {
@@ -80,7 +85,8 @@ func validateSpecFromHostnameCache(key string, ingress *networkingv1.Ingress) bo
 					}
 				}
 			}
-		} else {
+		} else if ingress.Spec.DefaultBackend == nil {
+			// a rule without paths is served by the default backend of the ingress, if it is set
 			utils.AviLog.Warnf("key: %s, msg: Found Ingress: %s without service backends. Not going to process.", key, ingress.Name)
 			return false
 		}
@@ -113,6 +119,9 @@ func findHostRuleMappingForFqdn(key, host string) (bool, *v1beta1.HostRule) {
 	} else if hostRuleObj.Status.Status == lib.StatusRejected {
 		utils.AviLog.Warnf("key: %s, msg: rejected hostrule %s", key, hrNSNameStr)
 		return false, nil
+	} else if hostRuleObj.Status.Status == lib.StatusPending {
+		utils.AviLog.Warnf("key: %s, msg: hostrule %s is pending issuance of the certificate", key, hrNSNameStr)
+		return false, nil
 	} else {
 		return true, hostRuleObj
 	}
@@ -210,8 +219,36 @@ func (v *Validator) ParseHostPathForIngress(ns string, ingName string, ingSpec n
 		passthroughEnabled = strings.EqualFold(val, "true")
 	}
 
+	var canaryBackends []CanaryBackend
+	if !passthroughEnabled {
+		canaryBackends = ParseCanaryBackends(annotations, key)
+	}
+
+	defaultBackend := validateIngressDefaultBackend(ingSpec, key)
+	rules := ingSpec.Rules
+	rootPathHosts := sets.NewString()
+	defaultBackendHosts := sets.NewString()
+	if defaultBackend != nil {
+		if len(rules) == 0 {
+			// an ingress with only the default backend is handled as a host-less ingress
+			rules = []networkingv1.IngressRule{{}}
+		}
+		for _, rule := range rules {
+			if rule.IngressRuleValue.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.IngressRuleValue.HTTP.Paths {
+				if isCatchAllIngressPath(path) {
+					rootPathHosts.Insert(rule.Host)
+				}
+			}
+		}
+	}
+
 	var tlsConfigs []TlsSettings
-	for _, rule := range ingSpec.Rules {
+	// the hosts of the ingress which are not programmed, reported in the status annotation of the ingress
+	hostMessages := make(map[string]string)
+	for _, rule := range rules {
 		var hostPathMapSvcList HostMetadata
 		var hostName string
 		if rule.Host == "" {
@@ -232,6 +269,14 @@ func (v *Validator) ParseHostPathForIngress(ns string, ingName string, ingSpec n
 			}
 			hostName = rule.Host
 		}
+		if err := lib.CheckFQDNClaim(hostName, ns); err != nil {
+			utils.AviLog.Warnf("key: %s, msg: skipping the rules of host %s, err: %v", key, hostName, err)
+			if ingObj, err2 := utils.GetInformers().IngressInformer.Lister().Ingresses(ns).Get(ingName); err2 == nil {
+				lib.AKOControlConfig().EventRecorder().Event(ingObj, corev1.EventTypeWarning, lib.HostNotClaimed, err.Error())
+			}
+			hostMessages[hostName] = err.Error()
+			continue
+		}
 
 		if len(hostMap[hostName].ingressHPSvc) > 0 {
 			hostPathMapSvcList = hostMap[hostName]
@@ -266,27 +311,42 @@ func (v *Validator) ParseHostPathForIngress(ns string, ingName string, ingSpec n
 				if path.PathType != nil {
 					pathType = *path.PathType
 				}
-				hostPathMapSvc := IngressHostPathSvc{
-					Path:        path.Path,
-					PathType:    pathType,
-					ServiceName: path.Backend.Service.Name,
-					Port:        path.Backend.Service.Port.Number,
-					PortName:    path.Backend.Service.Port.Name,
-					TargetPort:  v.findTargetPort(path.Backend.Service.Name, ns, &path.Backend.Service.Port, key),
-				}
-				if hostPathMapSvc.PortName == "" {
-					// fill the port name as the port name is not given in the ingress
-					hostPathMapSvc.PortName = v.findPortName(path.Backend.Service.Name, ns, path.Backend.Service.Port.Number, key)
-				}
-				if hostPathMapSvc.Port == 0 {
-					// Default to port 80 if not set in the ingress object
-					hostPathMapSvc.Port = 80
+				hostPathMapSvc := v.ingressBackendPathSvc(ns, path.Path, pathType, path.Backend.Service, key)
+				pathCanaryBackends := getCanaryBackendsForPath(canaryBackends, hostName, hostPathMapSvc, key)
+				for _, canary := range pathCanaryBackends {
+					hostPathMapSvc.weight -= canary.Weight
 				}
-				// for ingress use 100 as default weight
-				hostPathMapSvc.weight = 100
 				hostPathMapSvcList.ingressHPSvc = append(hostPathMapSvcList.ingressHPSvc, hostPathMapSvc)
+				for _, canary := range pathCanaryBackends {
+					canaryBackend := &networkingv1.IngressServiceBackend{
+						Name: canary.ServiceName,
+						Port: networkingv1.ServiceBackendPort{Number: canary.ServicePort},
+					}
+					if canary.ServicePort == 0 {
+						// the canary service exposes the port, by number or name, of the backend of the path
+						canaryBackend.Port = path.Backend.Service.Port
+					}
+					canaryPathMapSvc := v.ingressBackendPathSvc(ns, hostPathMapSvc.Path, hostPathMapSvc.PathType, canaryBackend, key)
+					canaryPathMapSvc.weight = canary.Weight
+					canaryPathMapSvc.canary = true
+					canaryPathMapSvc.canaryHeader = canary.Header
+					canaryPathMapSvc.canaryCookie = canary.Cookie
+					if canary.Header != nil || canary.Cookie != nil {
+						utils.AviLog.Infof("key: %s, msg: sending the traffic of host: %s, path: %s matching %s to canary service: %s", key, hostName, hostPathMapSvc.Path, canary.matchString(), canary.ServiceName)
+					} else {
+						utils.AviLog.Infof("key: %s, msg: sending %d%% of the traffic of host: %s, path: %s to canary service: %s", key, canary.Weight, hostName, hostPathMapSvc.Path, canary.ServiceName)
+					}
+					hostPathMapSvcList.ingressHPSvc = append(hostPathMapSvcList.ingressHPSvc, canaryPathMapSvc)
+				}
 			}
 		}
+		if defaultBackend != nil && !rootPathHosts.Has(rule.Host) && !defaultBackendHosts.Has(hostName) {
+			// the default backend is added without a path, so that it is the lowest priority match of the host,
+			// after the paths of all the ingresses of the host, including their / paths
+			defaultBackendHosts.Insert(hostName)
+			hostPathMapSvcList.ingressHPSvc = append(hostPathMapSvcList.ingressHPSvc,
+				v.ingressBackendPathSvc(ns, "", networkingv1.PathTypeImplementationSpecific, defaultBackend, key))
+		}
 
 		if passthroughEnabled {
 			pass.host = hostName
@@ -316,6 +376,7 @@ func (v *Validator) ParseHostPathForIngress(ns string, ingName string, ingSpec n
 			hostMap[hostName] = hostPathMapSvcList
 		}
 	}
+	status.UpdateIngressHostMessages(key, ns+"/"+ingName, hostMessages)
 
 	if passthroughEnabled {
 		ingressConfig.PassthroughCollection = passConfig
@@ -328,6 +389,10 @@ func (v *Validator) ParseHostPathForIngress(ns string, ingName string, ingSpec n
 		tls := TlsSettings{}
 		tls.SecretName = tlsSettings.SecretName
 		tls.SecretNS = ns
+		certificatePending := isCertificatePending(key, ns, ingName, tlsSettings.SecretName, annotations)
+		if !certificatePending {
+			reportIngressCertificateState(key, ns, ingName, tlsSettings.SecretName)
+		}
 		for _, host := range tlsSettings.Hosts {
 			if _, ok := additionalSecureHostMap[host]; ok {
 				continue
@@ -336,11 +401,24 @@ func (v *Validator) ParseHostPathForIngress(ns string, ingName string, ingSpec n
 				continue
 			}
 			hostSvcMap, ok := hostMap[host]
-			if ok {
+			if ok && certificatePending {
+				// Until the certificate is issued, only the HTTP-01 challenge paths of the host are served over HTTP
+				if challengeSvcMap, found := acmeChallengePaths(hostSvcMap); found {
+					hostMap[host] = challengeSvcMap
+				} else {
+					delete(hostMap, host)
+				}
+			} else if ok {
 				tlsHostSvcMap[host] = hostSvcMap
 				delete(hostMap, host)
 			}
 		}
+		if certificatePending {
+			// The secret mapping re-processes the ingress once cert-manager issues the secret.
+			objects.SharedSvcLister().IngressMappings(ns).AddIngressToSecretsMappings(ns, ingName, tlsSettings.SecretName)
+			objects.SharedSvcLister().IngressMappings(ns).AddSecretsToIngressMappings(ns, ingName, tlsSettings.SecretName)
+			continue
+		}
 		tls.Hosts = tlsHostSvcMap
 		// Always add http -> https redirect rule for secure ingress
 		tls.redirect = true
@@ -401,6 +479,123 @@ func (v *Validator) ParseHostPathForIngress(ns string, ingName string, ingSpec n
 	return ingressConfig
 }
 
+// isCertificatePending returns true if the TLS secret of an ingress managed by cert-manager is yet to be issued.
+// The pending state, and the issue of the secret, are reported in the events of the ingress.
+func isCertificatePending(key, ns, ingName, secretName string, annotations map[string]string) bool {
+	if secretName == "" || !lib.IsCertManagerManaged(annotations) {
+		return false
+	}
+	_, err := utils.GetInformers().SecretInformer.Lister().Secrets(ns).Get(secretName)
+	pending := k8serrors.IsNotFound(err)
+	pendingErr := &lib.CertificatePendingError{Namespace: ns, Name: secretName}
+	if pending {
+		status.UpdateIngressMessage(key, ns+"/"+ingName, "secret/"+secretName, pendingErr.Error())
+	}
+	if !lib.UpdateCertificatePendingState(utils.Ingress, ns, ingName, secretName, pending) {
+		return pending
+	}
+	ingObj, err := utils.GetInformers().IngressInformer.Lister().Ingresses(ns).Get(ingName)
+	if err != nil {
+		return pending
+	}
+	if pending {
+		utils.AviLog.Infof("key: %s, msg: %v, serving only the HTTP-01 challenge paths of the TLS hosts", key, pendingErr)
+		lib.AKOControlConfig().EventRecorder().Eventf(ingObj, corev1.EventTypeWarning, lib.CertificatePending,
+			"Certificate is not programmed, %v", pendingErr)
+	} else {
+		utils.AviLog.Infof("key: %s, msg: secret %s/%s is issued by cert-manager", key, ns, secretName)
+		lib.AKOControlConfig().EventRecorder().Eventf(ingObj, corev1.EventTypeNormal, lib.CertificateIssued,
+			"Secret %s/%s is issued by cert-manager, programming the certificate", ns, secretName)
+	}
+	return pending
+}
+
+// reportIngressCertificateState reports the invalid and the expiring certificates of the TLS secret of an ingress,
+// in the events of the ingress, and the invalid certificates in the ako.vmware.com/status annotation. The invalid
+// certificates are refused while building the TLS certificate of the host.
+func reportIngressCertificateState(key, ns, ingName, secretName string) {
+	if secretName == "" {
+		return
+	}
+	secret, err := utils.GetInformers().SecretInformer.Lister().Secrets(ns).Get(secretName)
+	if err != nil {
+		// A missing secret is handled while building the TLS certificate of the host.
+		status.UpdateIngressMessage(key, ns+"/"+ingName, "secret/"+secretName, "")
+		return
+	}
+	leaf, err := lib.ValidateSecretCertificate(secret)
+	ingObj, ingErr := utils.GetInformers().IngressInformer.Lister().Ingresses(ns).Get(ingName)
+	if ingErr == nil {
+		lib.ReportCertificateState(key, lib.AKOControlConfig().EventRecorder(), ingObj, utils.Ingress, ns, ingName, secretName, leaf, err)
+	}
+	var message string
+	if err != nil {
+		message = err.Error()
+	}
+	status.UpdateIngressMessage(key, ns+"/"+ingName, "secret/"+secretName, message)
+}
+
+// acmeChallengePaths returns the paths of the host which serve the HTTP-01 challenges of cert-manager, added to
+// the ingress when the solver edits the ingress in place.
+func acmeChallengePaths(hostSvcMap HostMetadata) (HostMetadata, bool) {
+	challengeSvcMap := HostMetadata{gslbHostHeader: hostSvcMap.gslbHostHeader}
+	for _, pathSvc := range hostSvcMap.ingressHPSvc {
+		if lib.IsACMEChallengePath(pathSvc.Path) {
+			challengeSvcMap.ingressHPSvc = append(challengeSvcMap.ingressHPSvc, pathSvc)
+		}
+	}
+	return challengeSvcMap, len(challengeSvcMap.ingressHPSvc) > 0
+}
+
+// validateIngressDefaultBackend returns the Service backend of the default backend of the ingress, if it is set
+// and is supported.
+func validateIngressDefaultBackend(ingSpec networkingv1.IngressSpec, key string) *networkingv1.IngressServiceBackend {
+	if ingSpec.DefaultBackend == nil {
+		return nil
+	}
+	if ingSpec.DefaultBackend.Service == nil {
+		utils.AviLog.Warnf("key: %s, msg: only a Service is supported as the default backend of an ingress, ignoring the default backend", key)
+		return nil
+	}
+	if ingSpec.DefaultBackend.Service.Name == "" {
+		utils.AviLog.Warnf("key: %s, msg: Service name is not set in the default backend of the ingress, ignoring the default backend", key)
+		return nil
+	}
+	return ingSpec.DefaultBackend.Service
+}
+
+// isCatchAllIngressPath returns true if the ingress path matches all the requests of the host, i.e. the / path of
+// Prefix or ImplementationSpecific type, or a path without a value, in which case the default backend is not used.
+func isCatchAllIngressPath(path networkingv1.HTTPIngressPath) bool {
+	if path.Path != "" && path.Path != "/" {
+		return false
+	}
+	return path.PathType == nil || *path.PathType != networkingv1.PathTypeExact
+}
+
+// ingressBackendPathSvc returns the path to Service mapping for the Service backend of an ingress path.
+func (v *Validator) ingressBackendPathSvc(ns, path string, pathType networkingv1.PathType, backend *networkingv1.IngressServiceBackend, key string) IngressHostPathSvc {
+	hostPathMapSvc := IngressHostPathSvc{
+		Path:        path,
+		PathType:    pathType,
+		ServiceName: backend.Name,
+		Port:        backend.Port.Number,
+		PortName:    backend.Port.Name,
+		TargetPort:  v.findTargetPort(backend.Name, ns, &backend.Port, key),
+	}
+	if hostPathMapSvc.PortName == "" {
+		// fill the port name as the port name is not given in the ingress
+		hostPathMapSvc.PortName = v.findPortName(backend.Name, ns, backend.Port.Number, key)
+	}
+	if hostPathMapSvc.Port == 0 {
+		// Default to port 80 if not set in the ingress object
+		hostPathMapSvc.Port = 80
+	}
+	// for ingress use 100 as default weight
+	hostPathMapSvc.weight = 100
+	return hostPathMapSvc
+}
+
 func (v *Validator) findTargetPort(serviceName, ns string, serviceBackendPort *networkingv1.ServiceBackendPort, key string) intstr.IntOrString {
 	// Query the service and obtain the targetPort
 	svcObj, err := utils.GetInformers().ServiceInformer.Lister().Services(ns).Get(serviceName)
@@ -441,6 +636,113 @@ func (v *Validator) findPortName(serviceName, ns string, servicePort int32, key
 	return ""
 }
 
+// CanaryBackend is a backend of an ingress host and path which receives a percentage of the traffic of the path,
+// or the requests of the path with the header or the cookie, the remaining traffic is sent to the backend of the
+// path in the ingress spec.
+type CanaryBackend struct {
+	Host        string       `json:"host,omitempty"`
+	Path        string       `json:"path"`
+	ServiceName string       `json:"serviceName"`
+	ServicePort int32        `json:"servicePort,omitempty"`
+	Weight      uint32       `json:"weight"`
+	Header      *CanaryMatch `json:"header,omitempty"`
+	Cookie      *CanaryMatch `json:"cookie,omitempty"`
+}
+
+// CanaryMatch is the name and the value of the request header or cookie selecting a canary backend.
+type CanaryMatch struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (c CanaryBackend) matchString() string {
+	var match []string
+	if c.Header != nil {
+		match = append(match, fmt.Sprintf("header %s: %s", c.Header.Name, c.Header.Value))
+	}
+	if c.Cookie != nil {
+		match = append(match, fmt.Sprintf("cookie %s=%s", c.Cookie.Name, c.Cookie.Value))
+	}
+	return strings.Join(match, " and ")
+}
+
+// ParseCanaryBackends returns the canary backends in the canary backends annotation of an ingress. The annotation
+// is ignored if any of the canary backends is invalid, or the weights of a host and path add up to more than 100.
+func ParseCanaryBackends(annotations map[string]string, key string) []CanaryBackend {
+	val, found := annotations[lib.CanaryBackendsAnnotation]
+	if !found {
+		return nil
+	}
+	if lib.GetNoPGForSNI() {
+		utils.AviLog.Warnf("key: %s, msg: canary backends are not supported when noPGForSNI is enabled, ignoring annotation %s", key, lib.CanaryBackendsAnnotation)
+		return nil
+	}
+	var canaryBackends []CanaryBackend
+	if err := json.Unmarshal([]byte(val), &canaryBackends); err != nil {
+		utils.AviLog.Warnf("key: %s, msg: invalid value for annotation %s, err: %v", key, lib.CanaryBackendsAnnotation, err)
+		return nil
+	}
+	pathWeights := make(map[string]uint32)
+	pathServices := make(map[string]bool)
+	for _, canary := range canaryBackends {
+		if canary.ServiceName == "" || canary.Weight > 100 {
+			utils.AviLog.Warnf("key: %s, msg: invalid canary backend %s with weight %d for host: %s, path: %s, ignoring annotation %s",
+				key, canary.ServiceName, canary.Weight, canary.Host, canary.Path, lib.CanaryBackendsAnnotation)
+			return nil
+		}
+		if (canary.Header != nil && (canary.Header.Name == "" || canary.Header.Value == "")) ||
+			(canary.Cookie != nil && (canary.Cookie.Name == "" || canary.Cookie.Value == "")) {
+			utils.AviLog.Warnf("key: %s, msg: header and cookie of canary backend %s for host: %s, path: %s must have a name and a value, ignoring annotation %s",
+				key, canary.ServiceName, canary.Host, canary.Path, lib.CanaryBackendsAnnotation)
+			return nil
+		}
+		if (canary.Header != nil || canary.Cookie != nil) && canary.Weight != 0 {
+			// the pool of a canary backend selected by the header or cookie is not a member of the poolgroup of the path
+			utils.AviLog.Warnf("key: %s, msg: canary backend %s for host: %s, path: %s can not have both a weight and a header or cookie, ignoring annotation %s",
+				key, canary.ServiceName, canary.Host, canary.Path, lib.CanaryBackendsAnnotation)
+			return nil
+		}
+		hostPath := canary.Host + canary.Path
+		if pathServices[hostPath+"/"+canary.ServiceName] {
+			utils.AviLog.Warnf("key: %s, msg: duplicate canary backend %s for host: %s, path: %s, ignoring annotation %s",
+				key, canary.ServiceName, canary.Host, canary.Path, lib.CanaryBackendsAnnotation)
+			return nil
+		}
+		pathServices[hostPath+"/"+canary.ServiceName] = true
+		pathWeights[hostPath] += canary.Weight
+		if pathWeights[hostPath] > 100 {
+			utils.AviLog.Warnf("key: %s, msg: weights of the canary backends for host: %s, path: %s are more than 100, ignoring annotation %s",
+				key, canary.Host, canary.Path, lib.CanaryBackendsAnnotation)
+			return nil
+		}
+	}
+	return canaryBackends
+}
+
+// getCanaryBackendsForPath returns the canary backends of the host and path, a canary backend without a host
+// applies to the path in all the hosts of the ingress.
+func getCanaryBackendsForPath(canaryBackends []CanaryBackend, hostName string, pathSvc IngressHostPathSvc, key string) []CanaryBackend {
+	var pathCanaryBackends []CanaryBackend
+	var weight uint32
+	for _, canary := range canaryBackends {
+		if (canary.Host != "" && canary.Host != hostName) || canary.Path != pathSvc.Path {
+			continue
+		}
+		if canary.ServiceName == pathSvc.ServiceName {
+			utils.AviLog.Warnf("key: %s, msg: canary backend %s is the backend of host: %s, path: %s, ignoring it", key, canary.ServiceName, hostName, pathSvc.Path)
+			continue
+		}
+		// the weights of a canary backend for the host and another for all the hosts may add up to more than 100
+		if weight+canary.Weight > 100 {
+			utils.AviLog.Warnf("key: %s, msg: weights of the canary backends for host: %s, path: %s are more than 100, ignoring canary backend %s", key, hostName, pathSvc.Path, canary.ServiceName)
+			continue
+		}
+		weight += canary.Weight
+		pathCanaryBackends = append(pathCanaryBackends, canary)
+	}
+	return pathCanaryBackends
+}
+
 func (v *Validator) ParseHostPathForRoute(ns string, routeName string, routeSpec routev1.RouteSpec, key string) IngressConfig {
 	ingressConfig := IngressConfig{}
 	hostMap := make(IngressHostMap)
@@ -453,6 +755,14 @@ func (v *Validator) ParseHostPathForRoute(ns string, routeName string, routeSpec
 	if !v.IsValidHostName(hostName) {
 		return ingressConfig
 	}
+	if err := lib.CheckFQDNClaim(hostName, ns); err != nil {
+		utils.AviLog.Warnf("key: %s, msg: rejecting the route %s, err: %v", key, routeName, err)
+		status.UpdateRouteStatusWithErrMsg(key, routeName, ns, err.Error())
+		if routeObj, err2 := utils.GetInformers().RouteInformer.Lister().Routes(ns).Get(routeName); err2 == nil {
+			lib.AKOControlConfig().EventRecorder().Event(routeObj, corev1.EventTypeWarning, lib.HostNotClaimed, err.Error())
+		}
+		return ingressConfig
+	}
 
 	defaultWeight := uint32(100)
 	var hostPathMapSvcList HostMetadata
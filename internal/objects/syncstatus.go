@@ -0,0 +1,163 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+// APIs to track the sync state of the Kubernetes objects and Avi models, for troubleshooting.
+
+package objects
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	FastRetryQueue = "fast"
+	SlowRetryQueue = "slow"
+)
+
+// RestResult is the result of the last rest operations executed for a model.
+type RestResult struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Success    bool      `json:"success"`
+	Operations []string  `json:"operations"`
+	Errors     []string  `json:"errors,omitempty"`
+}
+
+// RetryStatus is set for a model while it is in a retry queue.
+type RetryStatus struct {
+	Queue     string    `json:"queue"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+var syncStatusInstance *SyncStatusLister
+var syncStatusOnce sync.Once
+
+func SharedSyncStatusLister() *SyncStatusLister {
+	syncStatusOnce.Do(func() {
+		syncStatusInstance = &SyncStatusLister{
+			objectModelStore: NewObjectMapStore(),
+			restResultStore:  NewObjectMapStore(),
+			retryStore:       NewObjectMapStore(),
+		}
+	})
+	return syncStatusInstance
+}
+
+type SyncStatusLister struct {
+	objectModelLock sync.Mutex
+	// key: objType/namespace/name of the ingestion layer, value: model names
+	objectModelStore *ObjectMapStore
+	// key: model name, value: RestResult
+	restResultStore *ObjectMapStore
+	// key: model name, value: RetryStatus
+	retryStore *ObjectMapStore
+}
+
+// ResetObjectModels clears the models of the key, before the key is processed by the graph layer.
+func (s *SyncStatusLister) ResetObjectModels(key string) {
+	s.objectModelStore.Delete(key)
+}
+
+// DeleteObjectModels removes the key, once the object is deleted and its delete is processed by the graph layer.
+func (s *SyncStatusLister) DeleteObjectModels(key string) {
+	s.objectModelLock.Lock()
+	defer s.objectModelLock.Unlock()
+	s.objectModelStore.Delete(key)
+}
+
+func (s *SyncStatusLister) AddObjectModel(key, modelName string) {
+	s.objectModelLock.Lock()
+	defer s.objectModelLock.Unlock()
+	var modelNames []string
+	if found, obj := s.objectModelStore.Get(key); found {
+		modelNames = obj.([]string)
+	}
+	for _, name := range modelNames {
+		if name == modelName {
+			return
+		}
+	}
+	modelNames = append(modelNames, modelName)
+	sort.Strings(modelNames)
+	s.objectModelStore.AddOrUpdate(key, modelNames)
+}
+
+func (s *SyncStatusLister) GetObjectModels(key string) (bool, []string) {
+	found, obj := s.objectModelStore.Get(key)
+	if !found {
+		return false, nil
+	}
+	return true, obj.([]string)
+}
+
+// GetModelObjects returns the keys of the objects which were last processed into the model.
+func (s *SyncStatusLister) GetModelObjects(modelName string) []string {
+	keys := []string{}
+	for key, obj := range s.objectModelStore.GetAllObjectNames() {
+		for _, name := range obj.([]string) {
+			if name == modelName {
+				keys = append(keys, key)
+				break
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *SyncStatusLister) GetAllObjectKeys() []string {
+	return s.objectModelStore.GetAllKeys()
+}
+
+func (s *SyncStatusLister) SaveRestResult(modelName string, result RestResult) {
+	s.restResultStore.AddOrUpdate(modelName, result)
+}
+
+func (s *SyncStatusLister) DeleteRestResult(modelName string) {
+	s.restResultStore.Delete(modelName)
+}
+
+func (s *SyncStatusLister) GetRestResult(modelName string) (bool, RestResult) {
+	found, obj := s.restResultStore.Get(modelName)
+	if !found {
+		return false, RestResult{}
+	}
+	return true, obj.(RestResult)
+}
+
+func (s *SyncStatusLister) SaveRetry(modelName, queue string) {
+	s.retryStore.AddOrUpdate(modelName, RetryStatus{Queue: queue, Timestamp: time.Now()})
+}
+
+func (s *SyncStatusLister) DeleteRetry(modelName string) {
+	s.retryStore.Delete(modelName)
+}
+
+func (s *SyncStatusLister) GetRetry(modelName string) (bool, RetryStatus) {
+	found, obj := s.retryStore.Get(modelName)
+	if !found {
+		return false, RetryStatus{}
+	}
+	return true, obj.(RetryStatus)
+}
+
+// GetRetryCount returns the number of models in each retry queue.
+func (s *SyncStatusLister) GetRetryCount() map[string]int {
+	retryCount := map[string]int{FastRetryQueue: 0, SlowRetryQueue: 0}
+	for _, obj := range s.retryStore.GetAllObjectNames() {
+		retryCount[obj.(RetryStatus).Queue]++
+	}
+	return retryCount
+}
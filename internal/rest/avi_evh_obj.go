@@ -31,16 +31,8 @@ import (
 )
 
 func (rest *RestOperations) RestOperationForEvh(vsName string, namespace string, avimodel *nodes.AviObjectGraph, sniNode bool, vs_cache_obj *avicache.AviVsCache, key string) {
-	var pools_to_delete []avicache.NamespaceName
-	var pgs_to_delete []avicache.NamespaceName
-	var vsvip_to_delete []avicache.NamespaceName
-	var sni_to_delete []avicache.NamespaceName
-	var httppol_to_delete []avicache.NamespaceName
-	var l4pol_to_delete []avicache.NamespaceName
-	var sslkey_cert_delete []avicache.NamespaceName
-	var string_groups_to_delete []avicache.NamespaceName
-	var vsvipErr error
 	var publishKey string
+	var passthroughChild string
 
 	vsKey := avicache.NamespaceName{Namespace: namespace, Name: vsName}
 	aviVsNode := avimodel.GetAviEvhVS()[0]
@@ -55,86 +47,29 @@ func (rest *RestOperations) RestOperationForEvh(vsName string, namespace string,
 		}
 	}
 	nsPublishKey := avicache.NamespaceName{Namespace: namespace, Name: publishKey}
-	// Order would be this: 1. Pools 2. PGs  3. DS. 4. SSLKeyCert 5. VS
 	if vs_cache_obj != nil {
-		var rest_ops []*utils.RestOp
-		vsvip_to_delete, rest_ops, vsvipErr = rest.VSVipCU(aviVsNode.VSVIPRefs, vs_cache_obj, namespace, rest_ops, key)
-		if vsvipErr != nil {
-			if rest.CheckAndPublishForRetry(vsvipErr, nsPublishKey, key, avimodel) {
-				return
-			}
-		}
-		sslkey_cert_delete, rest_ops = rest.CACertCU(aviVsNode.CACertRefs, vs_cache_obj.SSLKeyCertCollection, namespace, rest_ops, key)
-		// SSLKeyCertCollection which did not match cacerts are present in the list sslkey_cert_delete,
-		// which shuld be the new SSLKeyCertCollection
-		sslkey_cert_delete, rest_ops = rest.SSLKeyCertCU(aviVsNode.SSLKeyCertRefs, sslkey_cert_delete, namespace, rest_ops, key)
-		pools_to_delete, rest_ops = rest.PoolCU(aviVsNode.PoolRefs, vs_cache_obj, namespace, rest_ops, key)
-		pgs_to_delete, rest_ops = rest.PoolGroupCU(aviVsNode.PoolGroupRefs, vs_cache_obj, namespace, rest_ops, key)
-		string_groups_to_delete, rest_ops = rest.StringGroupVsCU(aviVsNode.StringGroupRefs, vs_cache_obj, namespace, rest_ops, key)
-		httppol_to_delete, rest_ops = rest.HTTPPolicyCU(aviVsNode.HttpPolicyRefs, vs_cache_obj, namespace, rest_ops, key)
-		utils.AviLog.Debugf("key: %s, msg: stored checksum for VS: %s, model checksum: %s", key, vs_cache_obj.CloudConfigCksum, strconv.Itoa(int(aviVsNode.GetCheckSum())))
-		if vs_cache_obj.CloudConfigCksum == strconv.Itoa(int(aviVsNode.GetCheckSum())) {
-			utils.AviLog.Debugf("key: %s, msg: the checksums are same for vs %s, not doing anything", key, vs_cache_obj.Name)
-		} else {
-			utils.AviLog.Debugf("key: %s, msg: the stored checksum for vs is %v, and the obtained checksum for VS is: %v", key, vs_cache_obj.CloudConfigCksum, strconv.Itoa(int(aviVsNode.GetCheckSum())))
-			// The checksums are different, so it should be a PUT call.
-			restOp := rest.AviVsBuildForEvh(aviVsNode, utils.RestPut, vs_cache_obj, key)
-			if restOp != nil {
-				rest_ops = append(rest_ops, restOp...)
-			}
-
-		}
-		if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, true); !success {
+		// the passthrough child is read before the update of the parent, which updates the cached service metadata
+		passthroughChild = vs_cache_obj.ServiceMetadataObj.PassthroughChildRef
+	}
+	toDelete, rest_ops, vsvipErr := rest.evhCURestOps(aviVsNode, vs_cache_obj, namespace, key)
+	if vsvipErr != nil {
+		if rest.CheckAndPublishForRetry(vsvipErr, nsPublishKey, key, avimodel) {
 			return
 		}
-	} else {
-		var rest_ops []*utils.RestOp
-		_, rest_ops, vsvipErr = rest.VSVipCU(aviVsNode.VSVIPRefs, nil, namespace, rest_ops, key)
-		if vsvipErr != nil {
-			if rest.CheckAndPublishForRetry(vsvipErr, nsPublishKey, key, avimodel) {
-				return
-			}
-		}
-		_, rest_ops = rest.CACertCU(aviVsNode.CACertRefs, []avicache.NamespaceName{}, namespace, rest_ops, key)
-		_, rest_ops = rest.SSLKeyCertCU(aviVsNode.SSLKeyCertRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.PoolCU(aviVsNode.PoolRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.PoolGroupCU(aviVsNode.PoolGroupRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.StringGroupVsCU(aviVsNode.StringGroupRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.HTTPPolicyCU(aviVsNode.HttpPolicyRefs, nil, namespace, rest_ops, key)
-
-		// The cache was not found - it's a POST call.
-		restOp := rest.AviVsBuildForEvh(aviVsNode, utils.RestPost, nil, key)
-		if restOp != nil {
-			rest_ops = append(rest_ops, restOp...)
-		}
+	}
+	if vs_cache_obj == nil {
 		utils.AviLog.Debugf("POST key: %s, vsKey: %s", key, vsKey)
 		utils.AviLog.Debugf("POST restops %s", utils.Stringify(rest_ops))
-		if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, true); !success {
-			return
-		}
 	}
-	if vs_cache_obj != nil {
-		for _, sni_uuid := range vs_cache_obj.SNIChildCollection {
-			sni_vs_key, ok := rest.cache.VsCacheMeta.AviCacheGetKeyByUuid(sni_uuid)
-			if ok {
-				sni_to_delete = append(sni_to_delete, sni_vs_key.(avicache.NamespaceName))
-			} else {
-				utils.AviLog.Debugf("key: %s, msg: Couldn't get SNI key for uuid: %v", key, sni_uuid)
-			}
-		}
+	if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, true); !success {
+		return
 	}
-	var rest_ops []*utils.RestOp
-	vsKey = avicache.NamespaceName{Namespace: namespace, Name: vsName}
-	rest_ops = rest.SSLKeyCertDelete(sslkey_cert_delete, namespace, rest_ops, key)
-	rest_ops = rest.VSVipDelete(vsvip_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.HTTPPolicyDelete(httppol_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.StringGroupDelete(string_groups_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.L4PolicyDelete(l4pol_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.PoolGroupDelete(pgs_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.PoolDelete(pools_to_delete, namespace, rest_ops, nil, key)
+	sni_to_delete := rest.childVsKeys(vs_cache_obj, key)
+	rest_ops = rest.evhObjectsDeleteRestOps(toDelete, namespace, key)
 	if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, true); !success {
 		return
 	}
+	rest.publishPoolMaintenanceStatus(aviVsNode.PoolRefs, key)
 
 	for _, evhNode := range aviVsNode.EvhNodes {
 		utils.AviLog.Debugf("key: %s, msg: processing EVH node: %s", key, evhNode.Name)
@@ -151,7 +86,9 @@ func (rest *RestOperations) RestOperationForEvh(vsName string, namespace string,
 				utils.AviLog.Infof("key: %s, msg: Failure in processing EVH node: %s. Not processing other child nodes.", key, evhNode.Name)
 				return
 			}
+			continue
 		}
+		rest.publishPoolMaintenanceStatus(evhNode.PoolRefs, key)
 	}
 
 	// Let's populate all the DELETE entries
@@ -167,6 +104,125 @@ func (rest *RestOperations) RestOperationForEvh(vsName string, namespace string,
 
 	}
 
+	for _, passChildNode := range aviVsNode.PassthroughChildNodes {
+		utils.AviLog.Debugf("key: %s, msg: processing passthrough node: %s", key, passChildNode.Name)
+		vsKey = avicache.NamespaceName{Namespace: namespace, Name: passChildNode.Name}
+		rest_ops := rest.EvhPassthroughChildCU(passChildNode, rest.getVsCacheObj(vsKey, key), namespace, key)
+		if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, true); !success {
+			return
+		}
+		if passChildNode.Name == passthroughChild {
+			passthroughChild = ""
+		}
+	}
+
+	// The passthrough child is deleted once the Gateway has no TLS passthrough listener, the VsVip is retained
+	// as it is shared with the parent VS.
+	if passthroughChild != "" {
+		utils.AviLog.Infof("key: %s, msg: passthrough child delete candidate is : %s", key, passthroughChild)
+		passChildVSKey := avicache.NamespaceName{Namespace: namespace, Name: passthroughChild}
+		rest.DeleteVSOper(passChildVSKey, rest.getVsCacheObj(passChildVSKey, key), namespace, key, false, true)
+	}
+}
+
+// evhCURestOps returns the rest operations which create or update the EVH parent virtualservice along with the
+// objects it refers to, and the objects of the virtualservice in the cache which are to be deleted by
+// evhObjectsDeleteRestOps once it is updated. The operations are built by RestOperationForEvh, and by
+// BuildRestOpsForModel for a plan. An error of the VsVip is returned along with the operations, for the caller to retry.
+func (rest *RestOperations) evhCURestOps(aviVsNode *nodes.AviEvhVsNode, vs_cache_obj *avicache.AviVsCache, namespace, key string) (*vsObjectsToDelete, []*utils.RestOp, error) {
+	var rest_ops []*utils.RestOp
+	var vsvipErr error
+	toDelete := &vsObjectsToDelete{}
+	// Order would be this: 1. Pools 2. PGs  3. DS. 4. SSLKeyCert 5. VS
+	if vs_cache_obj != nil {
+		toDelete.vsvips, rest_ops, vsvipErr = rest.VSVipCU(aviVsNode.VSVIPRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.sslKeyCerts, rest_ops = rest.CACertCU(aviVsNode.CACertRefs, vs_cache_obj.SSLKeyCertCollection, namespace, rest_ops, key)
+		// SSLKeyCertCollection which did not match cacerts are present in the list sslKeyCerts,
+		// which shuld be the new SSLKeyCertCollection
+		toDelete.sslKeyCerts, rest_ops = rest.SSLKeyCertCU(aviVsNode.SSLKeyCertRefs, toDelete.sslKeyCerts, namespace, rest_ops, key)
+		toDelete.pools, rest_ops = rest.PoolCU(aviVsNode.PoolRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.poolGroups, rest_ops = rest.PoolGroupCU(aviVsNode.PoolGroupRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.stringGroups, rest_ops = rest.StringGroupVsCU(aviVsNode.StringGroupRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.httpPolicies, rest_ops = rest.HTTPPolicyCU(aviVsNode.HttpPolicyRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.l4Policies, rest_ops = rest.L4PolicyCU(aviVsNode.L4PolicyRefs, vs_cache_obj, namespace, rest_ops, key)
+		utils.AviLog.Debugf("key: %s, msg: stored checksum for VS: %s, model checksum: %s", key, vs_cache_obj.CloudConfigCksum, strconv.Itoa(int(aviVsNode.GetCheckSum())))
+		if vs_cache_obj.CloudConfigCksum == strconv.Itoa(int(aviVsNode.GetCheckSum())) {
+			utils.AviLog.Debugf("key: %s, msg: the checksums are same for vs %s, not doing anything", key, vs_cache_obj.Name)
+		} else {
+			utils.AviLog.Debugf("key: %s, msg: the stored checksum for vs is %v, and the obtained checksum for VS is: %v", key, vs_cache_obj.CloudConfigCksum, strconv.Itoa(int(aviVsNode.GetCheckSum())))
+			// The checksums are different, so it should be a PUT call.
+			restOp := rest.AviVsBuildForEvh(aviVsNode, utils.RestPut, vs_cache_obj, key)
+			if restOp != nil {
+				rest_ops = append(rest_ops, restOp...)
+			}
+		}
+		return toDelete, rest_ops, vsvipErr
+	}
+	_, rest_ops, vsvipErr = rest.VSVipCU(aviVsNode.VSVIPRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.CACertCU(aviVsNode.CACertRefs, []avicache.NamespaceName{}, namespace, rest_ops, key)
+	_, rest_ops = rest.SSLKeyCertCU(aviVsNode.SSLKeyCertRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.PoolCU(aviVsNode.PoolRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.PoolGroupCU(aviVsNode.PoolGroupRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.StringGroupVsCU(aviVsNode.StringGroupRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.HTTPPolicyCU(aviVsNode.HttpPolicyRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.L4PolicyCU(aviVsNode.L4PolicyRefs, nil, namespace, rest_ops, key)
+
+	// The cache was not found - it's a POST call.
+	restOp := rest.AviVsBuildForEvh(aviVsNode, utils.RestPost, nil, key)
+	if restOp != nil {
+		rest_ops = append(rest_ops, restOp...)
+	}
+	return toDelete, rest_ops, vsvipErr
+}
+
+// evhObjectsDeleteRestOps returns the rest operations which delete the objects of an EVH parent virtualservice,
+// which are not in the model anymore.
+func (rest *RestOperations) evhObjectsDeleteRestOps(toDelete *vsObjectsToDelete, namespace, key string) []*utils.RestOp {
+	var rest_ops []*utils.RestOp
+	rest_ops = rest.SSLKeyCertDelete(toDelete.sslKeyCerts, namespace, rest_ops, key)
+	rest_ops = rest.VSVipDelete(toDelete.vsvips, namespace, rest_ops, key)
+	rest_ops = rest.HTTPPolicyDelete(toDelete.httpPolicies, namespace, rest_ops, key)
+	rest_ops = rest.StringGroupDelete(toDelete.stringGroups, namespace, rest_ops, key)
+	rest_ops = rest.L4PolicyDelete(toDelete.l4Policies, namespace, rest_ops, key)
+	rest_ops = rest.PoolGroupDelete(toDelete.poolGroups, namespace, rest_ops, key)
+	rest_ops = rest.PoolDelete(toDelete.pools, namespace, rest_ops, nil, key)
+	return rest_ops
+}
+
+// EvhPassthroughChildCU creates or updates the L4 VS of the TLS passthrough listeners of a Gateway, along with its
+// pools, poolgroups and the SNI datascript, and deletes the ones which are no longer present in the model.
+func (rest *RestOperations) EvhPassthroughChildCU(passChildNode *nodes.AviVsNode, vsCacheObj *avicache.AviVsCache, namespace, key string) []*utils.RestOp {
+	var restOps []*utils.RestOp
+	if vsCacheObj != nil {
+		var poolsToDelete, pgsToDelete, dsToDelete []avicache.NamespaceName
+		poolsToDelete, restOps = rest.PoolCU(passChildNode.PoolRefs, vsCacheObj, namespace, restOps, key)
+		pgsToDelete, restOps = rest.PoolGroupCU(passChildNode.PoolGroupRefs, vsCacheObj, namespace, restOps, key)
+		dsToDelete, restOps = rest.DatascriptCU(passChildNode.HTTPDSrefs, vsCacheObj, namespace, restOps, key)
+
+		// The checksums are different, so it should be a PUT call.
+		if vsCacheObj.CloudConfigCksum != strconv.Itoa(int(passChildNode.GetCheckSum())) {
+			restOp := rest.AviVsBuild(passChildNode, utils.RestPut, vsCacheObj, key)
+			if restOp != nil {
+				restOps = append(restOps, restOp...)
+			}
+			utils.AviLog.Debugf("key: %s, msg: the checksums are different for passthrough child %s, operation: PUT", key, passChildNode.Name)
+		}
+		restOps = rest.DSDelete(dsToDelete, namespace, restOps, key)
+		restOps = rest.PoolGroupDelete(pgsToDelete, namespace, restOps, key)
+		restOps = rest.PoolDelete(poolsToDelete, namespace, restOps, vsCacheObj, key)
+	} else {
+		utils.AviLog.Infof("key: %s, msg: passthrough Child %s not found in cache", key, passChildNode.Name)
+		_, restOps = rest.PoolCU(passChildNode.PoolRefs, nil, namespace, restOps, key)
+		_, restOps = rest.PoolGroupCU(passChildNode.PoolGroupRefs, nil, namespace, restOps, key)
+		_, restOps = rest.DatascriptCU(passChildNode.HTTPDSrefs, nil, namespace, restOps, key)
+
+		// Not found - it should be a POST call.
+		restOp := rest.AviVsBuild(passChildNode, utils.RestPost, nil, key)
+		if restOp != nil {
+			restOps = append(restOps, restOp...)
+		}
+	}
+	return restOps
 }
 
 func (rest *RestOperations) EvhNodeCU(sni_node *nodes.AviEvhVsNode, vs_cache_obj *avicache.AviVsCache, namespace string, cache_sni_nodes []avicache.NamespaceName, rest_ops []*utils.RestOp, key string) ([]avicache.NamespaceName, []*utils.RestOp) {
@@ -175,6 +231,7 @@ func (rest *RestOperations) EvhNodeCU(sni_node *nodes.AviEvhVsNode, vs_cache_obj
 	var http_policies_to_delete []avicache.NamespaceName
 	var sslkey_cert_delete []avicache.NamespaceName
 	var string_groups_to_delete []avicache.NamespaceName
+	var traffic_clone_to_delete avicache.NamespaceName
 	var sni_cache_obj *avicache.AviVsCache
 	if vs_cache_obj != nil {
 		sni_key := avicache.NamespaceName{Namespace: namespace, Name: sni_node.Name}
@@ -195,6 +252,7 @@ func (rest *RestOperations) EvhNodeCU(sni_node *nodes.AviEvhVsNode, vs_cache_obj
 				sni_pgs_to_delete, rest_ops = rest.PoolGroupCU(sni_node.PoolGroupRefs, sni_cache_obj, namespace, rest_ops, key)
 				string_groups_to_delete, rest_ops = rest.StringGroupVsCU(sni_node.StringGroupRefs, sni_cache_obj, namespace, rest_ops, key)
 				http_policies_to_delete, rest_ops = rest.HTTPPolicyCU(sni_node.HttpPolicyRefs, sni_cache_obj, namespace, rest_ops, key)
+				traffic_clone_to_delete, rest_ops = rest.TrafficCloneProfileCU(sni_node.TrafficCloneProfile, sni_cache_obj, namespace, rest_ops, key)
 
 				// The checksums are different, so it should be a PUT call.
 				if sni_cache_obj.CloudConfigCksum != strconv.Itoa(int(sni_node.GetCheckSum())) {
@@ -214,6 +272,7 @@ func (rest *RestOperations) EvhNodeCU(sni_node *nodes.AviEvhVsNode, vs_cache_obj
 			_, rest_ops = rest.PoolGroupCU(sni_node.PoolGroupRefs, nil, namespace, rest_ops, key)
 			_, rest_ops = rest.StringGroupVsCU(sni_node.StringGroupRefs, nil, namespace, rest_ops, key)
 			_, rest_ops = rest.HTTPPolicyCU(sni_node.HttpPolicyRefs, nil, namespace, rest_ops, key)
+			_, rest_ops = rest.TrafficCloneProfileCU(sni_node.TrafficCloneProfile, nil, namespace, rest_ops, key)
 
 			// Not found - it should be a POST call.
 			restOp := rest.AviVsBuildForEvh(sni_node, utils.RestPost, nil, key)
@@ -224,6 +283,7 @@ func (rest *RestOperations) EvhNodeCU(sni_node *nodes.AviEvhVsNode, vs_cache_obj
 		rest_ops = rest.SSLKeyCertDelete(sslkey_cert_delete, namespace, rest_ops, key)
 		rest_ops = rest.HTTPPolicyDelete(http_policies_to_delete, namespace, rest_ops, key)
 		rest_ops = rest.StringGroupDelete(string_groups_to_delete, namespace, rest_ops, key)
+		rest_ops = rest.TrafficCloneProfileDelete(traffic_clone_to_delete, namespace, rest_ops, key)
 		rest_ops = rest.PoolGroupDelete(sni_pgs_to_delete, namespace, rest_ops, key)
 		rest_ops = rest.PoolDelete(sni_pools_to_delete, namespace, rest_ops, sni_cache_obj, key)
 		utils.AviLog.Debugf("key: %s, msg: the EVH VSes to be deleted are: %s", key, cache_sni_nodes)
@@ -235,6 +295,7 @@ func (rest *RestOperations) EvhNodeCU(sni_node *nodes.AviEvhVsNode, vs_cache_obj
 		_, rest_ops = rest.PoolGroupCU(sni_node.PoolGroupRefs, nil, namespace, rest_ops, key)
 		_, rest_ops = rest.StringGroupVsCU(sni_node.StringGroupRefs, nil, namespace, rest_ops, key)
 		_, rest_ops = rest.HTTPPolicyCU(sni_node.HttpPolicyRefs, nil, namespace, rest_ops, key)
+		_, rest_ops = rest.TrafficCloneProfileCU(sni_node.TrafficCloneProfile, nil, namespace, rest_ops, key)
 
 		// Not found - it should be a POST call.
 		restOp := rest.AviVsBuildForEvh(sni_node, utils.RestPost, nil, key)
@@ -381,9 +442,26 @@ func (rest *RestOperations) AviVsBuildForEvh(vs_meta *nodes.AviEvhVsNode, rest_m
 		for i, pp := range vs_meta.PortProto {
 			port := uint32(pp.Port)
 			svc := avimodels.Service{Port: &port, EnableSsl: &vs_meta.PortProto[i].EnableSSL, EnableHttp2: &vs_meta.PortProto[i].EnableHTTP2}
+			if pp.Protocol == utils.TCP || pp.Protocol == utils.UDP {
+				// TCP and UDP ports are served as L4, the pool is selected by the L4 policyset.
+				svc.OverrideApplicationProfileRef = proto.String("/api/applicationprofile/?name=" + utils.DEFAULT_L4_APP_PROFILE)
+				if pp.Protocol == utils.UDP {
+					svc.OverrideNetworkProfileRef = proto.String("/api/networkprofile/?name=" + utils.SYSTEM_UDP_FAST_PATH)
+				}
+			}
 			vs.Services = append(vs.Services, &svc)
 		}
 
+		var l4Policies []*avimodels.L4Policies
+		for i, l4pol := range vs_meta.L4PolicyRefs {
+			j := int32(i)
+			l4PolicyRef := fmt.Sprintf("/api/l4policyset/?name=%s", l4pol.Name)
+			l4Policies = append(l4Policies, &avimodels.L4Policies{L4PolicySetRef: &l4PolicyRef, Index: &j})
+		}
+		if len(l4Policies) > 0 {
+			vs.L4Policies = l4Policies
+		}
+
 		var httpPolicyCollection []*avimodels.HTTPPolicies
 		internalPolicyIndexBuffer := int32(11)
 		if len(vs_meta.HttpPolicyRefs) > 0 {
@@ -57,14 +57,21 @@ func (rest *RestOperations) AviHttpPSBuild(hps_meta *nodes.AviHttpPolicySetNode,
 	for _, hppmap := range hps_meta.HppMap {
 		if hppmap.Path != nil {
 			hppmapWithPath = append(hppmapWithPath, hppmap)
-			httpPresentPaths = append(httpPresentPaths, hppmap.Path[0])
+			// the rules of the canary backends of a path have the path of the rule of the path
+			if !utils.HasElem(httpPresentPaths, hppmap.Path[0]) {
+				httpPresentPaths = append(httpPresentPaths, hppmap.Path[0])
+			}
 		} else {
 			hppmapWithoutPath = append(hppmapWithoutPath, hppmap)
 		}
 		httpPresentIng.Insert(hppmap.IngName)
 	}
 	sort.Slice(hppmapWithPath, func(i, j int) bool {
-		return len(hppmapWithPath[i].Path[0]) > len(hppmapWithPath[j].Path[0])
+		if len(hppmapWithPath[i].Path[0]) != len(hppmapWithPath[j].Path[0]) {
+			return len(hppmapWithPath[i].Path[0]) > len(hppmapWithPath[j].Path[0])
+		}
+		// the rules of the canary backends selected by a header or cookie precede the rule of the path
+		return isCanaryHTTPRule(hppmapWithPath[i]) && !isCanaryHTTPRule(hppmapWithPath[j])
 	})
 	hppmapAllPaths = append(hppmapAllPaths, hppmapWithPath...)
 	hppmapAllPaths = append(hppmapAllPaths, hppmapWithoutPath...)
@@ -146,6 +153,23 @@ func (rest *RestOperations) AviHttpPSBuild(hps_meta *nodes.AviHttpPolicySetNode,
 			match_target.Path = &path_match
 		}
 
+		if hppmap.CanaryHeader != nil {
+			match_crit := "HDR_EQUALS"
+			match_target.Hdrs = []*avimodels.HdrMatch{{
+				Hdr:           &hppmap.CanaryHeader.Name,
+				MatchCriteria: &match_crit,
+				Value:         []string{hppmap.CanaryHeader.Value},
+			}}
+		}
+		if hppmap.CanaryCookie != nil {
+			match_crit := "HDR_EQUALS"
+			match_target.Cookie = &avimodels.CookieMatch{
+				Name:          &hppmap.CanaryCookie.Name,
+				MatchCriteria: &match_crit,
+				Value:         &hppmap.CanaryCookie.Value,
+			}
+		}
+
 		if hppmap.Port != 0 {
 			match_crit := "IS_IN"
 			vsport_match := avimodels.PortMatch{
@@ -406,10 +430,13 @@ func (rest *RestOperations) AviHTTPPolicyCacheAdd(rest_op *utils.RestOp, vsKey a
 						}
 						if rulemap["match"] != nil {
 							matchMap, _ := rulemap["match"].(map[string]interface{})
-							if matchMap["path"] != nil {
-								pathMap, _ := matchMap["path"].(map[string]interface{})
-								if pathMap["string_group_refs"] != nil {
-									sgRefs, _ := pathMap["string_group_refs"].([]interface{})
+							for _, matchType := range []string{"path", "query"} {
+								if matchMap[matchType] == nil {
+									continue
+								}
+								matchTypeMap, _ := matchMap[matchType].(map[string]interface{})
+								if matchTypeMap["string_group_refs"] != nil {
+									sgRefs, _ := matchTypeMap["string_group_refs"].([]interface{})
 									for _, sg := range sgRefs {
 										sgUuid := avicache.ExtractUUID(sg.(string), "stringgroup-.*.#")
 										// Search the string group name using this Uuid in the string group cache.
@@ -418,7 +445,6 @@ func (rest *RestOperations) AviHTTPPolicyCacheAdd(rest_op *utils.RestOp, vsKey a
 											stringGroupRefs = append(stringGroupRefs, sgName.(string))
 										}
 									}
-
 								}
 							}
 						}
@@ -474,3 +500,7 @@ func (rest *RestOperations) AviHTTPPolicyCacheDel(rest_op *utils.RestOp, vsKey a
 
 	return nil
 }
+
+func isCanaryHTTPRule(hppmap nodes.AviHostPathPortPoolPG) bool {
+	return hppmap.CanaryHeader != nil || hppmap.CanaryCookie != nil
+}
@@ -159,6 +159,22 @@ func (rest *RestOperations) AviPoolBuild(pool_meta *nodes.AviPoolNode, cache_obj
 		pool.EnableHttp2 = pool_meta.EnableHttp2
 	}
 
+	if pool_meta.ServerName != nil {
+		pool.ServerName = pool_meta.ServerName
+	}
+	if pool_meta.ServerTimeout != nil {
+		pool.ServerTimeout = pool_meta.ServerTimeout
+	}
+	if pool_meta.ServerReselect != nil {
+		pool.ServerReselect = pool_meta.ServerReselect
+	}
+	if pool_meta.GracefulDisableTimeout != nil {
+		pool.GracefulDisableTimeout = pool_meta.GracefulDisableTimeout
+	}
+	if pool_meta.DrainAllServers && pool_meta.DrainAllServersTimeout != nil {
+		pool.GracefulDisableTimeout = pool_meta.DrainAllServersTimeout
+	}
+
 	for i, server := range pool_meta.Servers {
 		port := pool_meta.Port
 		sip := server.Ip
@@ -168,6 +184,9 @@ func (rest *RestOperations) AviPoolBuild(pool_meta *nodes.AviPoolNode, cache_obj
 		uuid := fmt.Sprintf("%s:%d", *sip.Addr, port)
 
 		s := avimodels.Server{IP: &sip, Port: &port, ExternalUUID: &uuid, Enabled: server.Enabled}
+		if pool_meta.DrainAllServers {
+			s.Enabled = proto.Bool(false)
+		}
 		if server.ServerNode != "" {
 			sn := server.ServerNode
 			s.ServerNode = &sn
@@ -482,3 +501,37 @@ func (rest *RestOperations) DeletePoolIngressStatus(poolKey avicache.NamespaceNa
 		}
 	}
 }
+
+// publishPoolMaintenanceStatus publishes the maintenance of the pool servers of the synced pools to the status
+// layer, to be updated in the status of the backend Services and the Ingresses or Routes using them.
+func (rest *RestOperations) publishPoolMaintenanceStatus(poolNodes []*nodes.AviPoolNode, key string) {
+	for _, poolNode := range poolNodes {
+		if poolNode.MaintenanceService == "" {
+			continue
+		}
+		var ingKey string
+		if poolNode.ServiceMetadata.IngressName != "" && !poolNode.ServiceMetadata.IsMCIIngress {
+			ingKey = poolNode.ServiceMetadata.Namespace + "/" + poolNode.ServiceMetadata.IngressName
+		}
+		if poolNode.MaintenanceMessage == "" && !status.IsPoolMaintenanceTracked(poolNode.MaintenanceService, ingKey) {
+			continue
+		}
+		serviceMetadata := lib.ServiceMetadataObj{
+			NamespaceServiceName: []string{poolNode.MaintenanceService},
+		}
+		if ingKey != "" {
+			serviceMetadata.NamespaceIngressName = []string{ingKey}
+		}
+		statusOption := status.StatusOptions{
+			ObjType: lib.PoolMaintenance,
+			Op:      lib.UpdateStatus,
+			Key:     key,
+			Options: &status.UpdateOptions{
+				ServiceMetadata: serviceMetadata,
+				Key:             key,
+				Message:         poolNode.MaintenanceMessage,
+			},
+		}
+		status.PublishToStatusQueue(poolNode.MaintenanceService, statusOption)
+	}
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+
+	avimodels "github.com/vmware/alb-sdk/go/models"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+func (rest *RestOperations) AviTrafficCloneProfileBuild(trafficCloneNode *nodes.AviTrafficCloneProfileNode, cacheObj *avicache.AviTrafficCloneProfileCache) *utils.RestOp {
+	if trafficCloneNode == nil {
+		utils.AviLog.Debugf("TrafficCloneProfileNode is nil")
+		return nil
+	}
+
+	if lib.CheckObjectNameLength(trafficCloneNode.Name, "") {
+		utils.AviLog.Warnf("Not processing TrafficCloneProfile object %s due to name length limit", trafficCloneNode.Name)
+		return nil
+	}
+
+	name := trafficCloneNode.Name
+	tenant := fmt.Sprintf("/api/tenant/?name=%s", lib.GetEscapedValue(trafficCloneNode.Tenant))
+	cloudRef := fmt.Sprintf("/api/cloud?name=%s", utils.CloudName)
+
+	trafficClone := avimodels.TrafficCloneProfile{
+		Name:      &name,
+		TenantRef: &tenant,
+		CloudRef:  &cloudRef,
+		Markers:   lib.GetAllMarkers(trafficCloneNode.AviMarkers),
+	}
+	for i := range trafficCloneNode.CloneServers {
+		trafficClone.CloneServers = append(trafficClone.CloneServers, &avimodels.CloneServer{
+			IPAddress: &trafficCloneNode.CloneServers[i],
+		})
+	}
+
+	var restOp utils.RestOp
+	if cacheObj != nil {
+		restOp = utils.RestOp{
+			ObjName: name,
+			Path:    "/api/trafficcloneprofile/" + cacheObj.Uuid,
+			Method:  utils.RestPut,
+			Obj:     trafficClone,
+			Tenant:  trafficCloneNode.Tenant,
+			Model:   "TrafficCloneProfile",
+		}
+	} else {
+		restOp = utils.RestOp{
+			ObjName: name,
+			Path:    "/api/trafficcloneprofile",
+			Method:  utils.RestPost,
+			Obj:     trafficClone,
+			Tenant:  trafficCloneNode.Tenant,
+			Model:   "TrafficCloneProfile",
+		}
+	}
+
+	utils.AviLog.Debugf(spew.Sprintf("TrafficCloneProfile RestOp: %v, Object: %v", utils.Stringify(restOp), utils.Stringify(trafficClone)))
+	return &restOp
+}
+
+func (rest *RestOperations) AviTrafficCloneProfileDel(uuid string, tenant string) *utils.RestOp {
+	path := "/api/trafficcloneprofile/" + uuid
+	restOp := utils.RestOp{
+		Path:   path,
+		Method: "DELETE",
+		Tenant: tenant,
+		Model:  "TrafficCloneProfile",
+	}
+	utils.AviLog.Infof(spew.Sprintf("TrafficCloneProfile DELETE RestOp: %v", utils.Stringify(restOp)))
+	return &restOp
+}
+
+func (rest *RestOperations) AviTrafficCloneProfileCacheAdd(restOp *utils.RestOp, key string) error {
+	if restOp.Err != nil || restOp.Response == nil {
+		utils.AviLog.Warnf("key: %s, rest_op has err or no response for TrafficCloneProfile, err: %v, response: %v", key, restOp.Err, restOp.Response)
+		return errors.New("errored rest_op")
+	}
+
+	respElems := rest.restOperator.RestRespArrToObjByType(restOp, "trafficcloneprofile", key)
+	if respElems == nil {
+		utils.AviLog.Warnf("key: %s, msg: unable to find TrafficCloneProfile obj in resp %v", key, restOp.Response)
+		return errors.New("TrafficCloneProfile not found")
+	}
+
+	for _, resp := range respElems {
+		name, ok := resp["name"].(string)
+		if !ok {
+			utils.AviLog.Warnf("key: %s, msg: Name not present in response %v for TrafficCloneProfile", key, resp)
+			continue
+		}
+
+		uuid, ok := resp["uuid"].(string)
+		if !ok {
+			utils.AviLog.Warnf("key: %s, msg: Uuid not present in response %v for TrafficCloneProfile", key, resp)
+			continue
+		}
+
+		var lastModifiedStr string
+		if lastModifiedIntf, ok := resp["_last_modified"]; ok {
+			lastModifiedStr, _ = lastModifiedIntf.(string)
+		} else {
+			utils.AviLog.Warnf("key: %s, msg: _last_modified not present in response %v for TrafficCloneProfile %s", key, resp, name)
+		}
+
+		var trafficCloneModel avimodels.TrafficCloneProfile
+		switch restOp.Obj.(type) {
+		case utils.AviRestObjMacro:
+			trafficCloneModel = restOp.Obj.(utils.AviRestObjMacro).Data.(avimodels.TrafficCloneProfile)
+		case avimodels.TrafficCloneProfile:
+			trafficCloneModel = restOp.Obj.(avimodels.TrafficCloneProfile)
+		default:
+			utils.AviLog.Warnf("key: %s, msg: Unknown object type for TrafficCloneProfile %v", key, restOp.Obj)
+			continue
+		}
+
+		trafficCloneCacheObj := avicache.AviTrafficCloneProfileCache{
+			Name:             name,
+			Tenant:           restOp.Tenant,
+			Uuid:             uuid,
+			LastModified:     lastModifiedStr,
+			CloudConfigCksum: avicache.CalculateTrafficCloneProfileChecksum(trafficCloneModel),
+		}
+		if lastModifiedStr == "" {
+			trafficCloneCacheObj.InvalidData = true
+		}
+
+		k := avicache.NamespaceName{Namespace: restOp.Tenant, Name: name}
+		rest.cache.TrafficCloneCache.AviCacheAdd(k, &trafficCloneCacheObj)
+		utils.AviLog.Infof("key: %s, msg: Added TrafficCloneProfile cache k %v val %v", key, k, utils.Stringify(trafficCloneCacheObj))
+	}
+	return nil
+}
+
+func (rest *RestOperations) AviTrafficCloneProfileCacheDel(restOp *utils.RestOp, key string) error {
+	trafficCloneKey := avicache.NamespaceName{Namespace: restOp.Tenant, Name: restOp.ObjName}
+	rest.cache.TrafficCloneCache.AviCacheDelete(trafficCloneKey)
+	utils.AviLog.Infof("key: %s, msg: Deleted TrafficCloneProfile cache k %v", key, trafficCloneKey)
+	return nil
+}
@@ -443,11 +443,16 @@ func AviVsHttpPSAdd(vs_meta interface{}, isEVH bool) []*avimodels.HTTPPolicies {
 
 	var httpsWithHppMap []*nodes.AviHttpPolicySetNode
 	var httpsNoHppMap []*nodes.AviHttpPolicySetNode
+	// the policies with only the rules of a host without a path, e.g. of the default backend of an ingress, are
+	// matched after the policies with the paths of the other ingresses of the host
+	var httpsNoPathHppMap []*nodes.AviHttpPolicySetNode
 	for _, http := range httpPolicyRef {
-		if http.HppMap != nil && http.HppMap[0].Path != nil {
+		if len(http.HppMap) == 0 {
+			httpsNoHppMap = append(httpsNoHppMap, http)
+		} else if http.HppMap[0].Path != nil {
 			httpsWithHppMap = append(httpsWithHppMap, http)
 		} else {
-			httpsNoHppMap = append(httpsNoHppMap, http)
+			httpsNoPathHppMap = append(httpsNoPathHppMap, http)
 		}
 	}
 
@@ -468,7 +473,7 @@ func AviVsHttpPSAdd(vs_meta interface{}, isEVH bool) []*avimodels.HTTPPolicies {
 	} else {
 		j = j + 1
 	}
-	for _, http := range httpsWithHppMap {
+	for _, http := range append(httpsWithHppMap, httpsNoPathHppMap...) {
 		k := j
 		j = j + 1
 		httpPolicy := fmt.Sprintf("/api/httppolicyset/?name=%s", http.Name)
@@ -714,6 +719,8 @@ func (rest *RestOperations) AviVsCacheAdd(rest_op *utils.RestOp, key string) err
 			}
 		}
 
+		trafficCloneKey := rest.cache.GetTrafficCloneProfileKeyFromRef(resp["traffic_clone_profile_ref"], rest_op.Tenant)
+
 		k := avicache.NamespaceName{Namespace: rest_op.Tenant, Name: name}
 		vs_cache, ok := rest.cache.VsCacheMeta.AviCacheGet(k)
 		var vs_cache_obj *avicache.AviVsCache
@@ -733,6 +740,7 @@ func (rest *RestOperations) AviVsCacheAdd(rest_op *utils.RestOp, key string) err
 				if vhParentKey != nil {
 					vs_cache_obj.ParentVSRef = vhParentKey.(avicache.NamespaceName)
 				}
+				vs_cache_obj.TrafficCloneProfile = trafficCloneKey
 
 				vs_cache_obj.LastModified = lastModifiedStr
 				if lastModifiedStr == "" {
@@ -750,12 +758,13 @@ func (rest *RestOperations) AviVsCacheAdd(rest_op *utils.RestOp, key string) err
 
 		} else {
 			vs_cache_obj = &avicache.AviVsCache{
-				Name:               name,
-				Tenant:             rest_op.Tenant,
-				Uuid:               uuid,
-				CloudConfigCksum:   cksum,
-				ServiceMetadataObj: svc_mdata_obj,
-				LastModified:       lastModifiedStr,
+				Name:                name,
+				Tenant:              rest_op.Tenant,
+				Uuid:                uuid,
+				CloudConfigCksum:    cksum,
+				ServiceMetadataObj:  svc_mdata_obj,
+				LastModified:        lastModifiedStr,
+				TrafficCloneProfile: trafficCloneKey,
 			}
 			if val, ok := resp["enable_rhi"].(bool); ok {
 				vs_cache_obj.EnableRhi = val
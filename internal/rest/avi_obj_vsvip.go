@@ -306,9 +306,11 @@ func (rest *RestOperations) AviVsVipBuild(vsvip_meta *nodes.AviVSVIPNode, vsCach
 			vsvip_avi, err := rest.AviVsVipGet(key, vsvip_cache_obj.Uuid, name, vsvip_meta.Tenant)
 			if err != nil {
 				if strings.Contains(err.Error(), lib.VSVIPNotFoundError) {
-					// Clear the cache for this key
-					rest.cache.VSVIPCache.AviCacheDelete(vsvip_key)
-					utils.AviLog.Warnf("key: %s, Removed the vsvip object from the cache", key)
+					// Clear the cache for this key, unless the rest operations are built for a plan
+					if rest.planFetcher == nil {
+						rest.cache.VSVIPCache.AviCacheDelete(vsvip_key)
+						utils.AviLog.Warnf("key: %s, Removed the vsvip object from the cache", key)
+					}
 					rest_op = utils.RestOp{
 						ObjName: name,
 						Path:    path,
@@ -372,6 +374,21 @@ func (rest *RestOperations) AviVsVipBuild(vsvip_meta *nodes.AviVSVIPNode, vsCach
 }
 
 func (rest *RestOperations) AviVsVipGet(key, uuid, name, tenant string) (*avimodels.VsVip, error) {
+	if rest.planFetcher != nil {
+		// the vsvip of a plan is fetched once, within the limit of the objects fetched for the plans
+		current, err := rest.planFetcher.get(&utils.RestOp{Path: "/api/vsvip/" + uuid, Tenant: tenant})
+		if err != nil {
+			utils.AviLog.Warnf("key: %s, msg: VsVip %s not fetched for the plan, err: %v", key, name, err)
+			return nil, err
+		}
+		rawData, err := json.Marshal(current)
+		if err != nil {
+			return nil, err
+		}
+		vsvip := avimodels.VsVip{}
+		json.Unmarshal(rawData, &vsvip)
+		return &vsvip, nil
+	}
 	aviRestPoolClient := avicache.SharedAVIClients(tenant)
 	if aviRestPoolClient == nil {
 		utils.AviLog.Warnf("key: %s, msg: aviRestPoolClient during vsvip not initialized", key)
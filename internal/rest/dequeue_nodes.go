@@ -33,6 +33,7 @@ import (
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/status"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/tracing"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api/models"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 )
@@ -40,6 +41,10 @@ import (
 type RestOperations struct {
 	cache        *avicache.AviObjCache
 	restOperator RestOperator
+	// planFetcher is set for the plans, which are built on the goroutines of the API server, while
+	// the caches are updated by the rest layer. The VS cache objects are copied, the other caches are
+	// not updated, and the current objects are fetched from the Avi controller through planFetcher.
+	planFetcher *planObjectFetcher
 }
 
 func NewRestOperations(cache *avicache.AviObjCache, overrideLeaderFlag ...bool) RestOperations {
@@ -61,6 +66,9 @@ func (rest *RestOperations) CleanupVS(key string, skipVS bool) {
 func (rest *RestOperations) DequeueNodes(key string) {
 	utils.AviLog.Infof("key: %s, msg: start rest layer sync.", key)
 	lib.DecrementQueueCounter(utils.GraphLayer)
+	defer lib.ObserveSyncStageDuration(lib.RestStage, time.Now())
+	span := tracing.StartSpan(lib.RestStage, key)
+	defer span.End()
 	// Got the key from the Graph Layer - let's fetch the model
 	ok, avimodelIntf := objects.SharedAviGraphLister().Get(key)
 	if !ok {
@@ -88,9 +96,14 @@ func (rest *RestOperations) DequeueNodes(key string) {
 			close(lib.StaticRouteSyncChan)
 			lib.StaticRouteSyncChan = nil
 		}
+		deleted := true
 		if vs_cache_obj != nil {
 			utils.AviLog.Infof("key: %s, msg: nil model found, this is a vs deletion case", key)
-			rest.DeleteVSOper(vsKey, vs_cache_obj, namespace, key, false, false)
+			deleted = rest.DeleteVSOper(vsKey, vs_cache_obj, namespace, key, false, false)
+		}
+		if deleted {
+			// the sync status of a deleted model is not tracked anymore
+			objects.SharedSyncStatusLister().DeleteRestResult(key)
 		}
 	} else if ok && avimodelIntf != nil {
 		avimodel := avimodelIntf.(*nodes.AviObjectGraph)
@@ -154,17 +167,20 @@ func (rest *RestOperations) IstioCU(key string, avimodel *nodes.AviObjectGraph)
 		}
 	}
 
+	lib.SetCertificateSecret(sslKey.Namespace, sslKey.Name, sslNode.SecretNamespace, sslNode.SecretName)
 	sslCacheObj, ok := rest.cache.SSLKeyCache.AviCacheGet(sslKey)
 	if !ok {
-		restOp := rest.AviSSLBuild(sslNode, nil)
-		restOps = []*utils.RestOp{restOp}
-		sslSuccess, _ = rest.ExecuteRestAndPopulateCache(restOps, sslKey, avimodel, key, false)
+		if restOp := rest.AviSSLBuild(sslNode, nil); restOp != nil {
+			restOps = []*utils.RestOp{restOp}
+			sslSuccess, _ = rest.ExecuteRestAndPopulateCache(restOps, sslKey, avimodel, key, false)
+		}
 	} else {
 		sslCache := sslCacheObj.(*avicache.AviSSLCache)
 		if sslCache.CloudConfigCksum != sslNode.GetCheckSum() {
-			restOp := rest.AviSSLBuild(sslNode, sslCache)
-			restOps = []*utils.RestOp{restOp}
-			sslSuccess, _ = rest.ExecuteRestAndPopulateCache(restOps, sslKey, avimodel, key, false)
+			if restOp := rest.AviSSLBuild(sslNode, sslCache); restOp != nil {
+				restOps = []*utils.RestOp{restOp}
+				sslSuccess, _ = rest.ExecuteRestAndPopulateCache(restOps, sslKey, avimodel, key, false)
+			}
 		}
 	}
 	return pkiSuccess, sslSuccess
@@ -242,6 +258,21 @@ func (rest *RestOperations) CheckAndPublishForRetry(err error, publishKey avicac
 	if err == nil {
 		return false
 	}
+	// Retrying during a maintenance or an upgrade of the controller would fail till the controller is back,
+	// so once the controller fails the requests repeatedly, the sync is paused and the key is buffered, to be
+	// synced once the controller is back. A lone failure is retried.
+	if lib.IsControllerUnavailableError(err) {
+		if lib.ObserveControllerUnavailableError() {
+			lib.PauseControllerSync("Avi Controller is under maintenance or being upgraded")
+		}
+		if lib.BufferKeyIfControllerSyncPaused(publishKey.Namespace + "/" + publishKey.Name) {
+			utils.AviLog.Warnf("key: %s, msg: controller is unavailable, buffering the key till the controller is back", key)
+			return true
+		}
+		utils.AviLog.Warnf("key: %s, msg: controller is unavailable, adding to slow retry queue", key)
+		rest.PublishKeyToSlowRetryLayer(publishKey, key)
+		return true
+	}
 	if webSyncErr, ok := err.(*utils.WebSyncError); ok {
 		if aviError, ok := webSyncErr.GetWebAPIError().(session.AviError); ok {
 			switch aviError.HttpStatusCode {
@@ -278,11 +309,6 @@ func (rest *RestOperations) CheckAndPublishForRetry(err error, publishKey avicac
 					return true
 				}
 			case 403:
-				if strings.Contains(*aviError.Message, lib.ConfigDisallowedDuringUpgradeError) {
-					utils.AviLog.Warnf("key: %s, msg: controller upgrade in progress, adding to slow retry queue", key)
-					rest.PublishKeyToSlowRetryLayer(publishKey, key)
-					return true
-				}
 				if strings.Contains(*aviError.Message, fmt.Sprintf(lib.TenantDoesNotExist, publishKey.Namespace)) {
 					utils.AviLog.Warnf("key: %s, msg: Tenant not found, adding to slow retry queue", key)
 					rest.PublishKeyToSlowRetryLayer(publishKey, key)
@@ -319,16 +345,6 @@ func (rest *RestOperations) CheckAndPublishForRetry(err error, publishKey avicac
 }
 
 func (rest *RestOperations) RestOperation(vsName string, namespace string, avimodel *nodes.AviObjectGraph, vs_cache_obj *avicache.AviVsCache, key string) {
-	var pools_to_delete []avicache.NamespaceName
-	var pgs_to_delete []avicache.NamespaceName
-	var ds_to_delete []avicache.NamespaceName
-	var vsvip_to_delete []avicache.NamespaceName
-	var sni_to_delete []avicache.NamespaceName
-	var httppol_to_delete []avicache.NamespaceName
-	var l4pol_to_delete []avicache.NamespaceName
-	var sslkey_cert_delete []avicache.NamespaceName
-	var string_groups_to_delete []avicache.NamespaceName
-	var vsvipErr error
 	var publishKey string
 
 	vsKey := avicache.NamespaceName{Namespace: namespace, Name: vsName}
@@ -344,97 +360,25 @@ func (rest *RestOperations) RestOperation(vsName string, namespace string, avimo
 		}
 	}
 	nsPublishKey := avicache.NamespaceName{Namespace: namespace, Name: publishKey}
-	// Order would be this: 1. Pools 2. PGs  3. DS. 4. SSLKeyCert 5. VS
-	if vs_cache_obj != nil {
-		var rest_ops []*utils.RestOp
-		vsvip_to_delete, rest_ops, vsvipErr = rest.VSVipCU(aviVsNode.VSVIPRefs, vs_cache_obj, namespace, rest_ops, key)
-		if vsvipErr != nil {
-			if rest.CheckAndPublishForRetry(vsvipErr, nsPublishKey, key, avimodel) {
-				return
-			}
-		}
-		if aviVsNode.Dedicated {
-			// CAcerts have to be created first, as they are referred by the keycerts
-			sslkey_cert_delete, rest_ops = rest.CACertCU(aviVsNode.CACertRefs, vs_cache_obj.SSLKeyCertCollection, namespace, rest_ops, key)
-			// SSLKeyCertCollection which did not match cacerts are present in the list sslkey_cert_delete,
-			// which shuld be the new SSLKeyCertCollection
-			sslkey_cert_delete, rest_ops = rest.SSLKeyCertCU(aviVsNode.SSLKeyCertRefs, sslkey_cert_delete, namespace, rest_ops, key)
-		}
-		pools_to_delete, rest_ops = rest.PoolCU(aviVsNode.PoolRefs, vs_cache_obj, namespace, rest_ops, key)
-		pgs_to_delete, rest_ops = rest.PoolGroupCU(aviVsNode.PoolGroupRefs, vs_cache_obj, namespace, rest_ops, key)
-		string_groups_to_delete, rest_ops = rest.StringGroupVsCU(aviVsNode.StringGroupRefs, vs_cache_obj, namespace, rest_ops, key)
-		httppol_to_delete, rest_ops = rest.HTTPPolicyCU(aviVsNode.HttpPolicyRefs, vs_cache_obj, namespace, rest_ops, key)
-		ds_to_delete, rest_ops = rest.DatascriptCU(aviVsNode.HTTPDSrefs, vs_cache_obj, namespace, rest_ops, key)
-		l4pol_to_delete, rest_ops = rest.L4PolicyCU(aviVsNode.L4PolicyRefs, vs_cache_obj, namespace, rest_ops, key)
-		utils.AviLog.Debugf("key: %s, msg: stored checksum for VS: %s, model checksum: %s", key, vs_cache_obj.CloudConfigCksum, strconv.Itoa(int(aviVsNode.GetCheckSum())))
-		if vs_cache_obj.CloudConfigCksum == strconv.Itoa(int(aviVsNode.GetCheckSum())) {
-			utils.AviLog.Debugf("key: %s, msg: the checksums are same for vs %s, not doing anything", key, vs_cache_obj.Name)
-		} else {
-			utils.AviLog.Debugf("key: %s, msg: the stored checksum for vs is %v, and the obtained checksum for VS is: %v", key, vs_cache_obj.CloudConfigCksum, strconv.Itoa(int(aviVsNode.GetCheckSum())))
-			// The checksums are different, so it should be a PUT call.
-			restOp := rest.AviVsBuild(aviVsNode, utils.RestPut, vs_cache_obj, key)
-			if restOp != nil {
-				rest_ops = append(rest_ops, restOp...)
-			}
-
-		}
-		if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, false); !success {
+	toDelete, rest_ops, vsvipErr := rest.vsCURestOps(aviVsNode, vs_cache_obj, namespace, key)
+	if vsvipErr != nil {
+		if rest.CheckAndPublishForRetry(vsvipErr, nsPublishKey, key, avimodel) {
 			return
 		}
-	} else {
-		var rest_ops []*utils.RestOp
-		_, rest_ops, vsvipErr = rest.VSVipCU(aviVsNode.VSVIPRefs, nil, namespace, rest_ops, key)
-		if vsvipErr != nil {
-			if rest.CheckAndPublishForRetry(vsvipErr, nsPublishKey, key, avimodel) {
-				return
-			}
-		}
-		if aviVsNode.Dedicated {
-			_, rest_ops = rest.CACertCU(aviVsNode.CACertRefs, []avicache.NamespaceName{}, namespace, rest_ops, key)
-			_, rest_ops = rest.SSLKeyCertCU(aviVsNode.SSLKeyCertRefs, nil, namespace, rest_ops, key)
-		}
-		_, rest_ops = rest.PoolCU(aviVsNode.PoolRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.PoolGroupCU(aviVsNode.PoolGroupRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.StringGroupVsCU(aviVsNode.StringGroupRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.HTTPPolicyCU(aviVsNode.HttpPolicyRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.L4PolicyCU(aviVsNode.L4PolicyRefs, nil, namespace, rest_ops, key)
-		_, rest_ops = rest.DatascriptCU(aviVsNode.HTTPDSrefs, nil, namespace, rest_ops, key)
-		// The cache was not found - it's a POST call.
-		restOp := rest.AviVsBuild(aviVsNode, utils.RestPost, nil, key)
-		if restOp != nil {
-			rest_ops = append(rest_ops, restOp...)
-		}
+	}
+	if vs_cache_obj == nil {
 		utils.AviLog.Debugf("POST key: %s, vsKey: %s", key, vsKey)
 		utils.AviLog.Debugf("POST restops %s", utils.Stringify(rest_ops))
-		if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, false); !success {
-			return
-		}
 	}
-	if vs_cache_obj != nil {
-		for _, sni_uuid := range vs_cache_obj.SNIChildCollection {
-			sni_vs_key, ok := rest.cache.VsCacheMeta.AviCacheGetKeyByUuid(sni_uuid)
-			if ok {
-				sni_to_delete = append(sni_to_delete, sni_vs_key.(avicache.NamespaceName))
-			} else {
-				utils.AviLog.Debugf("key: %s, msg: Couldn't get SNI key for uuid: %v", key, sni_uuid)
-			}
-		}
-	}
-	var rest_ops []*utils.RestOp
-	vsKey = avicache.NamespaceName{Namespace: namespace, Name: vsName}
-	rest_ops = rest.VSVipDelete(vsvip_to_delete, namespace, rest_ops, key)
-	if aviVsNode.Dedicated {
-		rest_ops = rest.SSLKeyCertDelete(sslkey_cert_delete, namespace, rest_ops, key)
+	if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, false); !success {
+		return
 	}
-	rest_ops = rest.HTTPPolicyDelete(httppol_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.StringGroupDelete(string_groups_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.L4PolicyDelete(l4pol_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.DSDelete(ds_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.PoolGroupDelete(pgs_to_delete, namespace, rest_ops, key)
-	rest_ops = rest.PoolDelete(pools_to_delete, namespace, rest_ops, nil, key)
+	sni_to_delete := rest.childVsKeys(vs_cache_obj, key)
+	rest_ops = rest.vsObjectsDeleteRestOps(toDelete, aviVsNode.Dedicated, namespace, key)
 	if success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, false); !success {
 		return
 	}
+	rest.publishPoolMaintenanceStatus(aviVsNode.PoolRefs, key)
 
 	for _, sni_node := range aviVsNode.SniNodes {
 		utils.AviLog.Debugf("key: %s, msg: processing sni node: %s", key, sni_node.Name)
@@ -451,7 +395,9 @@ func (rest *RestOperations) RestOperation(vsName string, namespace string, avimo
 				utils.AviLog.Infof("key: %s, msg: Failure in processing SNI node: %s. Not processing other child nodes.", key, sni_node.Name)
 				return
 			}
+			continue
 		}
+		rest.publishPoolMaintenanceStatus(sni_node.PoolRefs, key)
 	}
 
 	// Let's populate all the DELETE entries
@@ -483,6 +429,108 @@ func (rest *RestOperations) RestOperation(vsName string, namespace string, avimo
 	}
 }
 
+// vsObjectsToDelete are the objects of a virtualservice in the cache, which are not in the model anymore.
+type vsObjectsToDelete struct {
+	pools        []avicache.NamespaceName
+	poolGroups   []avicache.NamespaceName
+	datascripts  []avicache.NamespaceName
+	vsvips       []avicache.NamespaceName
+	httpPolicies []avicache.NamespaceName
+	l4Policies   []avicache.NamespaceName
+	sslKeyCerts  []avicache.NamespaceName
+	stringGroups []avicache.NamespaceName
+}
+
+// vsCURestOps returns the rest operations which create or update the virtualservice along with the objects it
+// refers to, and the objects of the virtualservice in the cache which are to be deleted by vsObjectsDeleteRestOps
+// once it is updated. The operations are built by RestOperation, and by BuildRestOpsForModel for a plan. An error
+// of the VsVip is returned along with the operations, for the caller to retry.
+func (rest *RestOperations) vsCURestOps(aviVsNode *nodes.AviVsNode, vs_cache_obj *avicache.AviVsCache, namespace, key string) (*vsObjectsToDelete, []*utils.RestOp, error) {
+	var rest_ops []*utils.RestOp
+	var vsvipErr error
+	toDelete := &vsObjectsToDelete{}
+	// Order would be this: 1. Pools 2. PGs  3. DS. 4. SSLKeyCert 5. VS
+	if vs_cache_obj != nil {
+		toDelete.vsvips, rest_ops, vsvipErr = rest.VSVipCU(aviVsNode.VSVIPRefs, vs_cache_obj, namespace, rest_ops, key)
+		if aviVsNode.Dedicated {
+			// CAcerts have to be created first, as they are referred by the keycerts
+			toDelete.sslKeyCerts, rest_ops = rest.CACertCU(aviVsNode.CACertRefs, vs_cache_obj.SSLKeyCertCollection, namespace, rest_ops, key)
+			// SSLKeyCertCollection which did not match cacerts are present in the list sslKeyCerts,
+			// which shuld be the new SSLKeyCertCollection
+			toDelete.sslKeyCerts, rest_ops = rest.SSLKeyCertCU(aviVsNode.SSLKeyCertRefs, toDelete.sslKeyCerts, namespace, rest_ops, key)
+		}
+		toDelete.pools, rest_ops = rest.PoolCU(aviVsNode.PoolRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.poolGroups, rest_ops = rest.PoolGroupCU(aviVsNode.PoolGroupRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.stringGroups, rest_ops = rest.StringGroupVsCU(aviVsNode.StringGroupRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.httpPolicies, rest_ops = rest.HTTPPolicyCU(aviVsNode.HttpPolicyRefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.datascripts, rest_ops = rest.DatascriptCU(aviVsNode.HTTPDSrefs, vs_cache_obj, namespace, rest_ops, key)
+		toDelete.l4Policies, rest_ops = rest.L4PolicyCU(aviVsNode.L4PolicyRefs, vs_cache_obj, namespace, rest_ops, key)
+		utils.AviLog.Debugf("key: %s, msg: stored checksum for VS: %s, model checksum: %s", key, vs_cache_obj.CloudConfigCksum, strconv.Itoa(int(aviVsNode.GetCheckSum())))
+		if vs_cache_obj.CloudConfigCksum == strconv.Itoa(int(aviVsNode.GetCheckSum())) {
+			utils.AviLog.Debugf("key: %s, msg: the checksums are same for vs %s, not doing anything", key, vs_cache_obj.Name)
+		} else {
+			utils.AviLog.Debugf("key: %s, msg: the stored checksum for vs is %v, and the obtained checksum for VS is: %v", key, vs_cache_obj.CloudConfigCksum, strconv.Itoa(int(aviVsNode.GetCheckSum())))
+			// The checksums are different, so it should be a PUT call.
+			restOp := rest.AviVsBuild(aviVsNode, utils.RestPut, vs_cache_obj, key)
+			if restOp != nil {
+				rest_ops = append(rest_ops, restOp...)
+			}
+		}
+		return toDelete, rest_ops, vsvipErr
+	}
+	_, rest_ops, vsvipErr = rest.VSVipCU(aviVsNode.VSVIPRefs, nil, namespace, rest_ops, key)
+	if aviVsNode.Dedicated {
+		_, rest_ops = rest.CACertCU(aviVsNode.CACertRefs, []avicache.NamespaceName{}, namespace, rest_ops, key)
+		_, rest_ops = rest.SSLKeyCertCU(aviVsNode.SSLKeyCertRefs, nil, namespace, rest_ops, key)
+	}
+	_, rest_ops = rest.PoolCU(aviVsNode.PoolRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.PoolGroupCU(aviVsNode.PoolGroupRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.StringGroupVsCU(aviVsNode.StringGroupRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.HTTPPolicyCU(aviVsNode.HttpPolicyRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.L4PolicyCU(aviVsNode.L4PolicyRefs, nil, namespace, rest_ops, key)
+	_, rest_ops = rest.DatascriptCU(aviVsNode.HTTPDSrefs, nil, namespace, rest_ops, key)
+	// The cache was not found - it's a POST call.
+	restOp := rest.AviVsBuild(aviVsNode, utils.RestPost, nil, key)
+	if restOp != nil {
+		rest_ops = append(rest_ops, restOp...)
+	}
+	return toDelete, rest_ops, vsvipErr
+}
+
+// vsObjectsDeleteRestOps returns the rest operations which delete the objects of a virtualservice, which are not
+// in the model anymore.
+func (rest *RestOperations) vsObjectsDeleteRestOps(toDelete *vsObjectsToDelete, dedicated bool, namespace, key string) []*utils.RestOp {
+	var rest_ops []*utils.RestOp
+	rest_ops = rest.VSVipDelete(toDelete.vsvips, namespace, rest_ops, key)
+	if dedicated {
+		rest_ops = rest.SSLKeyCertDelete(toDelete.sslKeyCerts, namespace, rest_ops, key)
+	}
+	rest_ops = rest.HTTPPolicyDelete(toDelete.httpPolicies, namespace, rest_ops, key)
+	rest_ops = rest.StringGroupDelete(toDelete.stringGroups, namespace, rest_ops, key)
+	rest_ops = rest.L4PolicyDelete(toDelete.l4Policies, namespace, rest_ops, key)
+	rest_ops = rest.DSDelete(toDelete.datascripts, namespace, rest_ops, key)
+	rest_ops = rest.PoolGroupDelete(toDelete.poolGroups, namespace, rest_ops, key)
+	rest_ops = rest.PoolDelete(toDelete.pools, namespace, rest_ops, nil, key)
+	return rest_ops
+}
+
+// childVsKeys returns the keys of the SNI or EVH child virtualservices of a virtualservice in the cache.
+func (rest *RestOperations) childVsKeys(vs_cache_obj *avicache.AviVsCache, key string) []avicache.NamespaceName {
+	var childKeys []avicache.NamespaceName
+	if vs_cache_obj == nil {
+		return childKeys
+	}
+	for _, sni_uuid := range vs_cache_obj.SNIChildCollection {
+		sni_vs_key, ok := rest.cache.VsCacheMeta.AviCacheGetKeyByUuid(sni_uuid)
+		if ok {
+			childKeys = append(childKeys, sni_vs_key.(avicache.NamespaceName))
+		} else {
+			utils.AviLog.Debugf("key: %s, msg: Couldn't get SNI key for uuid: %v", key, sni_uuid)
+		}
+	}
+	return childKeys
+}
+
 func (rest *RestOperations) PassthroughChildCU(passChildNode *nodes.AviVsNode, vsCacheObj *avicache.AviVsCache, namespace string, restOps []*utils.RestOp, key string) []*utils.RestOp {
 	var httpPoliciesToDelete []avicache.NamespaceName
 	if vsCacheObj != nil {
@@ -520,6 +568,11 @@ func (rest *RestOperations) getVsCacheObj(vsKey avicache.NamespaceName, key stri
 			utils.AviLog.Warnf("key: %s, msg: invalid vs object found, cannot cast. Not doing anything", key)
 			return nil
 		}
+		if rest.planFetcher != nil {
+			if vs_cache_obj, ok = vs_cache_obj.GetVSCopy(); !ok {
+				return nil
+			}
+		}
 		return vs_cache_obj
 	}
 	utils.AviLog.Infof("key: %s, msg: vs cache object NOT found for vskey: %s", key, vsKey)
@@ -527,11 +580,7 @@ func (rest *RestOperations) getVsCacheObj(vsKey avicache.NamespaceName, key stri
 }
 
 func (rest *RestOperations) DeleteVSOper(vsKey avicache.NamespaceName, vs_cache_obj *avicache.AviVsCache, namespace string, key string, skipVS, skipVSVip bool) bool {
-	var rest_ops []*utils.RestOp
 	if vs_cache_obj != nil {
-		sni_vs_keys := make([]string, len(vs_cache_obj.SNIChildCollection))
-		copy(sni_vs_keys, vs_cache_obj.SNIChildCollection)
-
 		// VS delete should delete everything together.
 		passthroughChild := vs_cache_obj.ServiceMetadataObj.PassthroughChildRef
 		if passthroughChild != "" {
@@ -544,31 +593,12 @@ func (rest *RestOperations) DeleteVSOper(vsKey avicache.NamespaceName, vs_cache_
 				return false
 			}
 		}
-		for _, sni_uuid := range sni_vs_keys {
-			sniVsKey, ok := rest.cache.VsCacheMeta.AviCacheGetKeyByUuid(sni_uuid)
-			if ok {
-				delSNI := sniVsKey.(avicache.NamespaceName)
-				if !rest.SNINodeDelete(delSNI, namespace, rest_ops, nil, key) {
-					return false
-				}
-			}
-		}
-		if !skipVS {
-			rest_op, ok := rest.AviVSDel(vs_cache_obj.Uuid, namespace, key)
-			if ok {
-				rest_ops = append(rest_ops, rest_op)
+		for _, delSNI := range rest.childVsKeys(vs_cache_obj, key) {
+			if !rest.SNINodeDelete(delSNI, namespace, nil, nil, key) {
+				return false
 			}
 		}
-		if !skipVSVip {
-			rest_ops = rest.VSVipDelete(vs_cache_obj.VSVipKeyCollection, namespace, rest_ops, key)
-		}
-		rest_ops = rest.DSDelete(vs_cache_obj.DSKeyCollection, namespace, rest_ops, key)
-		rest_ops = rest.SSLKeyCertDelete(vs_cache_obj.SSLKeyCertCollection, namespace, rest_ops, key)
-		rest_ops = rest.HTTPPolicyDelete(vs_cache_obj.HTTPKeyCollection, namespace, rest_ops, key)
-		rest_ops = rest.StringGroupDelete(vs_cache_obj.StringGroupKeyCollection, namespace, rest_ops, key)
-		rest_ops = rest.L4PolicyDelete(vs_cache_obj.L4PolicyCollection, namespace, rest_ops, key)
-		rest_ops = rest.PoolGroupDelete(vs_cache_obj.PGKeyCollection, namespace, rest_ops, key)
-		rest_ops = rest.PoolDelete(vs_cache_obj.PoolKeyCollection, namespace, rest_ops, nil, key)
+		rest_ops := rest.vsDeleteRestOps(vs_cache_obj, namespace, key, skipVS, skipVSVip)
 		success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, nil, key, false)
 		if success {
 			vsKeysPending := rest.cache.VsCacheMeta.AviGetAllKeys()
@@ -595,26 +625,58 @@ func (rest *RestOperations) DeleteVSOper(vsKey avicache.NamespaceName, vs_cache_
 	return true
 }
 
-func (rest *RestOperations) deleteSniVs(vsKey avicache.NamespaceName, vs_cache_obj *avicache.AviVsCache, avimodel *nodes.AviObjectGraph, namespace, key string) bool {
+// vsDeleteRestOps returns the rest operations which delete a virtualservice along with the objects it refers to,
+// its SNI or EVH children and passthrough child are deleted separately.
+func (rest *RestOperations) vsDeleteRestOps(vs_cache_obj *avicache.AviVsCache, namespace, key string, skipVS, skipVSVip bool) []*utils.RestOp {
 	var rest_ops []*utils.RestOp
-
-	if vs_cache_obj != nil {
+	if !skipVS {
 		rest_op, ok := rest.AviVSDel(vs_cache_obj.Uuid, namespace, key)
 		if ok {
+			rest_op.ObjName = vs_cache_obj.Name
 			rest_ops = append(rest_ops, rest_op)
 		}
-		rest_ops = rest.DSDelete(vs_cache_obj.DSKeyCollection, namespace, rest_ops, key)
-		rest_ops = rest.SSLKeyCertDelete(vs_cache_obj.SSLKeyCertCollection, namespace, rest_ops, key)
-		rest_ops = rest.HTTPPolicyDelete(vs_cache_obj.HTTPKeyCollection, namespace, rest_ops, key)
-		rest_ops = rest.PoolGroupDelete(vs_cache_obj.PGKeyCollection, namespace, rest_ops, key)
-		rest_ops = rest.PoolDelete(vs_cache_obj.PoolKeyCollection, namespace, rest_ops, nil, key)
-		rest_ops = rest.StringGroupDelete(vs_cache_obj.StringGroupKeyCollection, namespace, rest_ops, key)
-		success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, vsKey, avimodel, key, false)
+	}
+	if !skipVSVip {
+		rest_ops = rest.VSVipDelete(vs_cache_obj.VSVipKeyCollection, namespace, rest_ops, key)
+	}
+	rest_ops = rest.DSDelete(vs_cache_obj.DSKeyCollection, namespace, rest_ops, key)
+	rest_ops = rest.SSLKeyCertDelete(vs_cache_obj.SSLKeyCertCollection, namespace, rest_ops, key)
+	rest_ops = rest.HTTPPolicyDelete(vs_cache_obj.HTTPKeyCollection, namespace, rest_ops, key)
+	rest_ops = rest.StringGroupDelete(vs_cache_obj.StringGroupKeyCollection, namespace, rest_ops, key)
+	rest_ops = rest.L4PolicyDelete(vs_cache_obj.L4PolicyCollection, namespace, rest_ops, key)
+	rest_ops = rest.PoolGroupDelete(vs_cache_obj.PGKeyCollection, namespace, rest_ops, key)
+	rest_ops = rest.PoolDelete(vs_cache_obj.PoolKeyCollection, namespace, rest_ops, nil, key)
+	rest_ops = rest.TrafficCloneProfileDelete(vs_cache_obj.TrafficCloneProfile, namespace, rest_ops, key)
+	return rest_ops
+}
+
+func (rest *RestOperations) deleteSniVs(vsKey avicache.NamespaceName, vs_cache_obj *avicache.AviVsCache, avimodel *nodes.AviObjectGraph, namespace, key string) bool {
+	if vs_cache_obj != nil {
+		success, _ := rest.ExecuteRestAndPopulateCache(rest.sniVsDeleteRestOps(vs_cache_obj, namespace, key), vsKey, avimodel, key, false)
 		return success
 	}
 	return true
 }
 
+// sniVsDeleteRestOps returns the rest operations which delete an SNI or EVH child virtualservice along with the
+// objects it refers to.
+func (rest *RestOperations) sniVsDeleteRestOps(vs_cache_obj *avicache.AviVsCache, namespace, key string) []*utils.RestOp {
+	var rest_ops []*utils.RestOp
+	rest_op, ok := rest.AviVSDel(vs_cache_obj.Uuid, namespace, key)
+	if ok {
+		rest_op.ObjName = vs_cache_obj.Name
+		rest_ops = append(rest_ops, rest_op)
+	}
+	rest_ops = rest.DSDelete(vs_cache_obj.DSKeyCollection, namespace, rest_ops, key)
+	rest_ops = rest.SSLKeyCertDelete(vs_cache_obj.SSLKeyCertCollection, namespace, rest_ops, key)
+	rest_ops = rest.HTTPPolicyDelete(vs_cache_obj.HTTPKeyCollection, namespace, rest_ops, key)
+	rest_ops = rest.PoolGroupDelete(vs_cache_obj.PGKeyCollection, namespace, rest_ops, key)
+	rest_ops = rest.PoolDelete(vs_cache_obj.PoolKeyCollection, namespace, rest_ops, nil, key)
+	rest_ops = rest.StringGroupDelete(vs_cache_obj.StringGroupKeyCollection, namespace, rest_ops, key)
+	rest_ops = rest.TrafficCloneProfileDelete(vs_cache_obj.TrafficCloneProfile, namespace, rest_ops, key)
+	return rest_ops
+}
+
 func (rest *RestOperations) ExecuteRestAndPopulateCache(rest_ops []*utils.RestOp, aviObjKey avicache.NamespaceName, avimodel *nodes.AviObjectGraph, key string, isEvh bool, sslKey ...utils.NamespaceName) (bool, bool) {
 	// Choose a avi client based on the model name hash. This would ensure that the same worker queue processes updates for a given VS all the time.
 	shardSize := lib.GetshardSize()
@@ -629,6 +691,7 @@ func (rest *RestOperations) ExecuteRestAndPopulateCache(rest_ops []*utils.RestOp
 		utils.AviLog.Infof("key: %s, msg: processing in rest queue number: %v", key, bkt)
 		aviclient := aviRestPoolClient.AviClient[bkt]
 		err := rest.AviRestOperateWrapper(aviclient, rest_ops, key)
+		saveRestResult(key, rest_ops, err)
 		if err == nil {
 			models.RestStatus.UpdateAviApiRestStatus(utils.AVIAPI_CONNECTED, nil)
 			utils.AviLog.Debugf("key: %s, msg: rest call executed successfully, will update cache", key)
@@ -657,6 +720,7 @@ func (rest *RestOperations) ExecuteRestAndPopulateCache(rest_ops []*utils.RestOp
 				}
 			}
 			nsPublishKey := avicache.NamespaceName{Namespace: aviObjKey.Namespace, Name: publishKey}
+			updateStatusWithSyncError(key, publishKey, rest_ops, err)
 
 			if rest.restOperator.isRetryRequired(key, err) {
 				rest.PublishKeyToRetryLayer(nsPublishKey, key)
@@ -842,6 +906,8 @@ func (rest *RestOperations) PopulateOneCache(rest_op *utils.RestOp, aviObjKey av
 			rest.AviStringGroupCacheAdd(rest_op, aviObjKey, key)
 		} else if rest_op.Model == "ApplicationPersistenceProfile" {
 			rest.AviPersistenceProfileCacheAdd(rest_op, aviObjKey, key)
+		} else if rest_op.Model == "TrafficCloneProfile" {
+			rest.AviTrafficCloneProfileCacheAdd(rest_op, key)
 		}
 
 	} else if (rest_op.Err == nil || aviErr.HttpStatusCode == 404) &&
@@ -869,20 +935,26 @@ func (rest *RestOperations) PopulateOneCache(rest_op *utils.RestOp, aviObjKey av
 			rest.AviStringGroupCacheDel(rest_op, aviObjKey, key)
 		} else if rest_op.Model == "ApplicationPersistenceProfile" {
 			rest.AviPersistenceProfileCacheDel(rest_op, aviObjKey, key)
+		} else if rest_op.Model == "TrafficCloneProfile" {
+			rest.AviTrafficCloneProfileCacheDel(rest_op, key)
 		}
 	}
 }
 
 func (rest *RestOperations) PublishKeyToRetryLayer(parentVsKey avicache.NamespaceName, key string) {
 	fastRetryQueue := utils.SharedWorkQueue().GetQueueByName(lib.FAST_RETRY_LAYER)
+	tracing.Publish(lib.RestStage, key, lib.RetryStage, fmt.Sprintf("%s/%s", parentVsKey.Namespace, parentVsKey.Name))
 	fastRetryQueue.Workqueue[0].AddRateLimited(fmt.Sprintf("%s/%s", parentVsKey.Namespace, parentVsKey.Name))
+	objects.SharedSyncStatusLister().SaveRetry(fmt.Sprintf("%s/%s", parentVsKey.Namespace, parentVsKey.Name), objects.FastRetryQueue)
 	lib.IncrementQueueCounter(lib.FAST_RETRY_LAYER)
 	utils.AviLog.Infof("key: %s, msg: Published key with vs_key to fast path retry queue: %s", key, parentVsKey)
 }
 
 func (rest *RestOperations) PublishKeyToSlowRetryLayer(parentVsKey avicache.NamespaceName, key string) {
 	slowRetryQueue := utils.SharedWorkQueue().GetQueueByName(lib.SLOW_RETRY_LAYER)
+	tracing.Publish(lib.RestStage, key, lib.RetryStage, fmt.Sprintf("%s/%s", parentVsKey.Namespace, parentVsKey.Name))
 	slowRetryQueue.Workqueue[0].AddRateLimited(fmt.Sprintf("%s/%s", parentVsKey.Namespace, parentVsKey.Name))
+	objects.SharedSyncStatusLister().SaveRetry(fmt.Sprintf("%s/%s", parentVsKey.Namespace, parentVsKey.Name), objects.SlowRetryQueue)
 	lib.IncrementQueueCounter(lib.SLOW_RETRY_LAYER)
 	utils.AviLog.Infof("key: %s, msg: Published key with vs_key to slow path retry queue: %s", key, parentVsKey)
 }
@@ -1077,6 +1149,18 @@ func (rest *RestOperations) RefreshCacheForRetryLayer(parentVsKey string, aviObj
 					rest_op.ObjName = ApplicationPersistenceProfile
 				}
 				rest.AviPersistenceProfileCacheDel(rest_op, aviObjKey, key)
+			case "TrafficCloneProfile":
+				var TrafficCloneProfile string
+				switch rest_op.Obj.(type) {
+				case utils.AviRestObjMacro:
+					TrafficCloneProfile = *rest_op.Obj.(utils.AviRestObjMacro).Data.(avimodels.TrafficCloneProfile).Name
+				case avimodels.TrafficCloneProfile:
+					TrafficCloneProfile = *rest_op.Obj.(avimodels.TrafficCloneProfile).Name
+				}
+				if TrafficCloneProfile != "" {
+					rest_op.ObjName = TrafficCloneProfile
+				}
+				rest.AviTrafficCloneProfileCacheDel(rest_op, key)
 			case "VirtualService":
 				rest.AviVsCacheDel(rest_op, aviObjKey, key)
 			case "VSDataScriptSet":
@@ -1180,6 +1264,15 @@ func (rest *RestOperations) RefreshCacheForRetryLayer(parentVsKey string, aviObj
 					PersistenceProfile = *rest_op.Obj.(avimodels.ApplicationPersistenceProfile).Name
 				}
 				aviObjCache.AviPopulateOnePersistenceProfileCache(c, PersistenceProfile)
+			case "TrafficCloneProfile":
+				var TrafficCloneProfile string
+				switch rest_op.Obj.(type) {
+				case utils.AviRestObjMacro:
+					TrafficCloneProfile = *rest_op.Obj.(utils.AviRestObjMacro).Data.(avimodels.TrafficCloneProfile).Name
+				case avimodels.TrafficCloneProfile:
+					TrafficCloneProfile = *rest_op.Obj.(avimodels.TrafficCloneProfile).Name
+				}
+				aviObjCache.AviPopulateOneTrafficCloneProfileCache(c, TrafficCloneProfile)
 			case "VirtualService":
 				aviObjCache.AviObjOneVSCachePopulate(c, utils.CloudName, aviObjKey.Name, aviObjKey.Namespace)
 				vsObjMeta, ok := rest.cache.VsCacheMeta.AviCacheGet(aviObjKey)
@@ -1730,9 +1823,12 @@ func (rest *RestOperations) VSVipCU(vsvip_nodes []*nodes.AviVSVIPNode, vs_cache_
 				vsvip_cache, ok := rest.cache.VSVIPCache.AviCacheGet(vsvip_key)
 				if ok {
 					vsvip_cache_obj, _ := vsvip_cache.(*avicache.AviVSVIPCache)
-					sort.Strings(vsvip_cache_obj.FQDNs)
+					// The cache object is shared with the other workers and the plans, sort a copy of the FQDNs.
+					cacheFQDNs := make([]string, len(vsvip_cache_obj.FQDNs))
+					copy(cacheFQDNs, vsvip_cache_obj.FQDNs)
+					sort.Strings(cacheFQDNs)
 					// Cache found. Let's compare the checksums
-					utils.AviLog.Debugf("key: %s, msg: the model FQDNs: %s, cache_FQDNs: %s", key, vsvip.FQDNs, vsvip_cache_obj.FQDNs)
+					utils.AviLog.Debugf("key: %s, msg: the model FQDNs: %s, cache_FQDNs: %s", key, vsvip.FQDNs, cacheFQDNs)
 
 					if vsvip_cache_obj.CloudConfigCksum == strconv.Itoa(int(vsvip.GetCheckSum())) {
 						utils.AviLog.Debugf("key: %s, msg: the checksums are same for VSVIP %s, not doing anything", key, vsvip_cache_obj.Name)
@@ -1919,6 +2015,7 @@ func (rest *RestOperations) KeyCertCU(sslkey_nodes []*nodes.AviTLSKeyCertNode, c
 		cache_ssl_nodes = make([]avicache.NamespaceName, len(certKeys))
 		copy(cache_ssl_nodes, certKeys)
 		for _, ssl := range sslkey_nodes {
+			lib.SetCertificateSecret(namespace, ssl.Name, ssl.SecretNamespace, ssl.SecretName)
 			ssl_key := avicache.NamespaceName{Namespace: namespace, Name: ssl.Name}
 			found := utils.HasElem(cache_ssl_nodes, ssl_key)
 			if found {
@@ -1948,6 +2045,7 @@ func (rest *RestOperations) KeyCertCU(sslkey_nodes []*nodes.AviTLSKeyCertNode, c
 	} else {
 		// Everything is a POST call
 		for _, ssl := range sslkey_nodes {
+			lib.SetCertificateSecret(namespace, ssl.Name, ssl.SecretNamespace, ssl.SecretName)
 			restOp := rest.AviSSLBuild(ssl, nil)
 			if restOp != nil {
 				rest_ops = append(rest_ops, restOp)
@@ -2033,6 +2131,56 @@ func (rest *RestOperations) ApplicationPersistenceProfileDelete(appPersProfileDe
 	}
 	return rest_ops
 }
+
+// TrafficCloneProfileCU handles Create/Update for the TrafficCloneProfile of a virtualservice,
+// and returns the TrafficCloneProfile which is no longer referred by the virtualservice.
+func (rest *RestOperations) TrafficCloneProfileCU(trafficCloneNode *nodes.AviTrafficCloneProfileNode, vs_cache_obj *avicache.AviVsCache, namespace string, rest_ops []*utils.RestOp, key string) (avicache.NamespaceName, []*utils.RestOp) {
+	var trafficCloneToDelete avicache.NamespaceName
+	if vs_cache_obj != nil {
+		trafficCloneToDelete = vs_cache_obj.TrafficCloneProfile
+	}
+	if trafficCloneNode == nil {
+		return trafficCloneToDelete, rest_ops
+	}
+
+	trafficCloneKey := avicache.NamespaceName{Namespace: namespace, Name: trafficCloneNode.Name}
+	if trafficCloneToDelete == trafficCloneKey {
+		trafficCloneToDelete = avicache.NamespaceName{}
+	}
+	var trafficCloneCacheObj *avicache.AviTrafficCloneProfileCache
+	if trafficCloneCache, found := rest.cache.TrafficCloneCache.AviCacheGet(trafficCloneKey); found {
+		trafficCloneCacheObj, _ = trafficCloneCache.(*avicache.AviTrafficCloneProfileCache)
+	}
+
+	if trafficCloneCacheObj != nil && trafficCloneCacheObj.CloudConfigCksum == trafficCloneNode.GetCheckSum() {
+		utils.AviLog.Debugf("key: %s, msg: checksums are same for TrafficCloneProfile %s, not doing anything", key, trafficCloneNode.Name)
+	} else {
+		restOp := rest.AviTrafficCloneProfileBuild(trafficCloneNode, trafficCloneCacheObj)
+		if restOp != nil {
+			rest_ops = append(rest_ops, restOp)
+		}
+	}
+	return trafficCloneToDelete, rest_ops
+}
+
+func (rest *RestOperations) TrafficCloneProfileDelete(trafficCloneToDelete avicache.NamespaceName, namespace string, rest_ops []*utils.RestOp, key string) []*utils.RestOp {
+	if trafficCloneToDelete.Name == "" {
+		return rest_ops
+	}
+	utils.AviLog.Debugf("key: %s, msg: about to delete TrafficCloneProfile %s", key, utils.Stringify(trafficCloneToDelete))
+	trafficCloneKey := avicache.NamespaceName{Namespace: namespace, Name: trafficCloneToDelete.Name}
+	trafficCloneCache, ok := rest.cache.TrafficCloneCache.AviCacheGet(trafficCloneKey)
+	if ok {
+		trafficCloneCacheObj, _ := trafficCloneCache.(*avicache.AviTrafficCloneProfileCache)
+		restOp := rest.AviTrafficCloneProfileDel(trafficCloneCacheObj.Uuid, namespace)
+		restOp.ObjName = trafficCloneToDelete.Name
+		rest_ops = append(rest_ops, restOp)
+	} else {
+		utils.AviLog.Debugf("key: %s, msg: TrafficCloneProfile not found in cache during delete %s", key, trafficCloneToDelete)
+	}
+	return rest_ops
+}
+
 func (rest *RestOperations) PkiProfileCU(pki_node *nodes.AviPkiProfileNode, pool_cache_obj *avicache.AviPoolCache, namespace string, rest_ops []*utils.RestOp, key string) ([]avicache.NamespaceName, []*utils.RestOp) {
 	// Default is POST
 	var cache_pki_nodes []avicache.NamespaceName
@@ -2102,47 +2250,54 @@ func (rest *RestOperations) PkiProfileDelete(pkiProfileDelete []avicache.Namespa
 }
 
 func (rest *RestOperations) stringGroupCU(key, stringGroupName string, avimodel *nodes.AviObjectGraph) {
-	var cache_sg_node avicache.NamespaceName
-	var rest_ops []*utils.RestOp
 	sg_node := avimodel.GetAviStringGroupNodeByName(stringGroupName)
 	if sg_node != nil {
-		// Default is POST
-		// check in the sg cache to see if this exists in AVI
 		sg_key := avicache.NamespaceName{Namespace: lib.GetTenant(), Name: *sg_node.Name}
-		found := utils.HasElem(cache_sg_node, sg_key)
-		if found {
-			sg_cache, ok := rest.cache.StringGroupCache.AviCacheGet(sg_key)
-			if !ok {
-				// If the StringGroup Is not found - let's do a POST call.
-				restOp := rest.AviStringGroupBuild(sg_node, nil, key)
-				if restOp != nil {
-					rest_ops = append(rest_ops, restOp)
-				}
-			} else {
-				sgCacheObj := sg_cache.(*avicache.AviStringGroupCache)
-				if sgCacheObj.CloudConfigCksum != sg_node.GetCheckSum() {
-					utils.AviLog.Debugf("key: %s, msg: stringgroup checksum changed, updating - %s", key, sg_node.Name)
-					restOp := rest.AviStringGroupBuild(sg_node, sgCacheObj, key)
-					if restOp != nil {
-						rest_ops = append(rest_ops, restOp)
-					}
-				}
-			}
-		} else {
-			// If the stringgroup Is not found - let's do a POST call.
-			restOp := rest.AviStringGroupBuild(sg_node, nil, key)
-			if restOp != nil {
-				rest_ops = append(rest_ops, restOp)
-			}
-		}
+		rest_ops := rest.stringGroupRestOps(sg_node, key)
 		utils.AviLog.Debugf("key: %s, msg: the StringGroup rest_op is %s", key, utils.StringifyWithSanitization(rest_ops))
 		utils.AviLog.Debugf("key: %s, msg: Executing rest for stringgroup %s", key, stringGroupName)
 		utils.AviLog.Debugf("key: %s, msg: restops %v", key, rest_ops)
 		success, _ := rest.ExecuteRestAndPopulateCache(rest_ops, sg_key, avimodel, key, false)
 		if success {
-			utils.AviLog.Debugf("key: %s, msg: the StringGroup added successfully: %s", key, cache_sg_node)
+			utils.AviLog.Debugf("key: %s, msg: the StringGroup added successfully: %s", key, sg_key)
+		}
+	}
+}
+
+// stringGroupRestOps returns the rest operations which create or update a shared StringGroup of the model.
+func (rest *RestOperations) stringGroupRestOps(sg_node *nodes.AviStringGroupNode, key string) []*utils.RestOp {
+	var cache_sg_node avicache.NamespaceName
+	var rest_ops []*utils.RestOp
+	// Default is POST
+	// check in the sg cache to see if this exists in AVI
+	sg_key := avicache.NamespaceName{Namespace: lib.GetTenant(), Name: *sg_node.Name}
+	found := utils.HasElem(cache_sg_node, sg_key)
+	if found {
+		sg_cache, ok := rest.cache.StringGroupCache.AviCacheGet(sg_key)
+		if !ok {
+			// If the StringGroup Is not found - let's do a POST call.
+			restOp := rest.AviStringGroupBuild(sg_node, nil, key)
+			if restOp != nil {
+				rest_ops = append(rest_ops, restOp)
+			}
+		} else {
+			sgCacheObj := sg_cache.(*avicache.AviStringGroupCache)
+			if sgCacheObj.CloudConfigCksum != sg_node.GetCheckSum() {
+				utils.AviLog.Debugf("key: %s, msg: stringgroup checksum changed, updating - %s", key, sg_node.Name)
+				restOp := rest.AviStringGroupBuild(sg_node, sgCacheObj, key)
+				if restOp != nil {
+					rest_ops = append(rest_ops, restOp)
+				}
+			}
+		}
+	} else {
+		// If the stringgroup Is not found - let's do a POST call.
+		restOp := rest.AviStringGroupBuild(sg_node, nil, key)
+		if restOp != nil {
+			rest_ops = append(rest_ops, restOp)
 		}
 	}
+	return rest_ops
 }
 
 func (rest *RestOperations) StringGroupVsCU(stringGroupNodes []*nodes.AviStringGroupNode, vs_cache_obj *avicache.AviVsCache, namespace string, rest_ops []*utils.RestOp, key string) ([]avicache.NamespaceName, []*utils.RestOp) {
@@ -2211,3 +2366,89 @@ func (rest *RestOperations) StringGroupDelete(sg_to_delete []avicache.NamespaceN
 	}
 	return rest_ops
 }
+
+// updateStatusWithSyncError publishes the error returned by the Avi Controller to the status layer, for the
+// Services, Ingresses and Routes of the virtualservices and pools in the failed rest operations.
+func updateStatusWithSyncError(key, vsName string, rest_ops []*utils.RestOp, err error) {
+	if !lib.AKOControlConfig().IsLeader() {
+		return
+	}
+	message := syncErrorMessage(rest_ops, err)
+	published := make(map[string]bool)
+	for _, rest_op := range rest_ops {
+		if rest_op.Method == utils.RestDelete || rest_op.Obj == nil {
+			continue
+		}
+		var svcMetadata *string
+		var objType string
+		switch obj := rest_op.Obj.(type) {
+		case avimodels.VirtualService:
+			svcMetadata, objType = obj.ServiceMetadata, "VS"
+		case *avimodels.VirtualService:
+			svcMetadata, objType = obj.ServiceMetadata, "VS"
+		case avimodels.Pool:
+			svcMetadata, objType = obj.ServiceMetadata, "Pool"
+		case *avimodels.Pool:
+			svcMetadata, objType = obj.ServiceMetadata, "Pool"
+		default:
+			continue
+		}
+		if svcMetadata == nil {
+			continue
+		}
+		var svc_mdata_obj lib.ServiceMetadataObj
+		if err := json.Unmarshal([]byte(*svcMetadata), &svc_mdata_obj); err != nil {
+			utils.AviLog.Warnf("key: %s, msg: error parsing service metadata: %v", key, err)
+			continue
+		}
+		updateOptions := status.UpdateOptions{
+			ServiceMetadata: svc_mdata_obj,
+			Key:             key,
+			VSName:          vsName,
+			Message:         message,
+			Tenant:          rest_op.Tenant,
+		}
+		if objType == "VS" {
+			updateOptions.VSName = rest_op.ObjName
+		}
+		statusOption := status.StatusOptions{
+			Op:      lib.UpdateStatus,
+			Key:     key,
+			Options: &updateOptions,
+		}
+		var publishKey string
+		switch svc_mdata_obj.ServiceMetadataMapping(objType) {
+		case lib.ServiceTypeLBVS:
+			statusOption.ObjType = utils.L4LBService
+			publishKey = svc_mdata_obj.NamespaceServiceName[0]
+		case lib.ChildVS, lib.SNIInsecureOrEVHPool:
+			if svc_mdata_obj.IsMCIIngress || len(svc_mdata_obj.HostNames) == 0 {
+				continue
+			}
+			statusOption.ObjType = utils.Ingress
+			if utils.GetInformers().RouteInformer != nil {
+				statusOption.ObjType = utils.OshiftRoute
+			}
+			publishKey = svc_mdata_obj.HostNames[0]
+		default:
+			continue
+		}
+		if published[statusOption.ObjType+"/"+publishKey+"/"+updateOptions.VSName] {
+			continue
+		}
+		published[statusOption.ObjType+"/"+publishKey+"/"+updateOptions.VSName] = true
+		utils.AviLog.Infof("key: %s, msg: publishing the sync error of virtualservice %s to status queue for %s %s", key, updateOptions.VSName, statusOption.ObjType, publishKey)
+		status.PublishToStatusQueue(publishKey, statusOption)
+	}
+}
+
+// syncErrorMessage returns the first error returned by the Avi Controller for the rest operations, the rest
+// operations after it are aborted.
+func syncErrorMessage(rest_ops []*utils.RestOp, err error) string {
+	for _, rest_op := range rest_ops {
+		if rest_op.Err != nil && rest_op.Err.Error() != "Aborted due to prev error" {
+			return fmt.Sprintf("%s %s: %v", rest_op.Model, rest_op.ObjName, rest_op.Err)
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package rest
+
+import (
+	"fmt"
+	"strings"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// BuildRestOpsForModel returns the rest operations which would sync the Avi objects of the model
+// with the Avi objects in the cache, in the order in which they are executed. A nil model returns
+// the operations which would delete the virtualservice of the model. The operations are built by
+// the same functions as in RestOperation, RestOperationForEvh and DeleteVSOper, but they are not
+// executed and the cache is not updated.
+func (rest *RestOperations) BuildRestOpsForModel(modelName string, avimodel *nodes.AviObjectGraph) ([]*utils.RestOp, error) {
+	namespace, name := utils.ExtractNamespaceObjectName(modelName)
+	key := "dryrun/" + modelName
+	vsKey := avicache.NamespaceName{Namespace: namespace, Name: name}
+	if avimodel == nil {
+		vsCacheObj := rest.getVsCacheObj(vsKey, key)
+		if vsCacheObj == nil {
+			return nil, nil
+		}
+		return rest.buildDeleteVsRestOps(namespace, vsCacheObj, key, false), nil
+	}
+	if avimodel.IsVrf {
+		return nil, fmt.Errorf("vrf context model %s is not supported", modelName)
+	}
+	if strings.Contains(name, "StringGroup") {
+		sgNode := avimodel.GetAviStringGroupNodeByName(name)
+		if sgNode == nil {
+			return nil, nil
+		}
+		return rest.stringGroupRestOps(sgNode, key), nil
+	}
+	vsCacheObj := rest.getVsCacheObj(vsKey, key)
+	if strings.Contains(name, "-EVH") && lib.IsEvhEnabled() {
+		if len(avimodel.GetAviEvhVS()) != 1 {
+			return nil, fmt.Errorf("virtualservice in the model %s is not equal to 1", modelName)
+		}
+		return rest.buildEvhRestOps(namespace, avimodel.GetAviEvhVS()[0], vsCacheObj, key)
+	}
+	if len(avimodel.GetAviVS()) != 1 {
+		return nil, fmt.Errorf("virtualservice in the model %s is not equal to 1", modelName)
+	}
+	return rest.buildVsRestOps(namespace, avimodel.GetAviVS()[0], vsCacheObj, key)
+}
+
+// buildVsRestOps returns the rest operations of RestOperation, in the order in which they are executed.
+func (rest *RestOperations) buildVsRestOps(namespace string, aviVsNode *nodes.AviVsNode, vsCacheObj *avicache.AviVsCache, key string) ([]*utils.RestOp, error) {
+	toDelete, restOps, err := rest.vsCURestOps(aviVsNode, vsCacheObj, namespace, key)
+	if err != nil {
+		return nil, err
+	}
+	sniToDelete := rest.childVsKeys(vsCacheObj, key)
+	restOps = append(restOps, rest.vsObjectsDeleteRestOps(toDelete, aviVsNode.Dedicated, namespace, key)...)
+	for _, sniNode := range aviVsNode.SniNodes {
+		var sniRestOps []*utils.RestOp
+		if vsCacheObj != nil {
+			sniToDelete, sniRestOps = rest.SNINodeCU(sniNode, vsCacheObj, namespace, sniToDelete, sniRestOps, key)
+		} else {
+			_, sniRestOps = rest.SNINodeCU(sniNode, nil, namespace, sniToDelete, sniRestOps, key)
+		}
+		restOps = append(restOps, sniRestOps...)
+	}
+	restOps = append(restOps, rest.buildDeleteSniRestOps(namespace, sniToDelete, key)...)
+
+	for _, passChildNode := range aviVsNode.PassthroughChildNodes {
+		passChildVSKey := avicache.NamespaceName{Namespace: namespace, Name: passChildNode.Name}
+		restOps = rest.PassthroughChildCU(passChildNode, rest.getVsCacheObj(passChildVSKey, key), namespace, restOps, key)
+	}
+	return restOps, nil
+}
+
+// buildEvhRestOps returns the rest operations of RestOperationForEvh, in the order in which they are executed.
+func (rest *RestOperations) buildEvhRestOps(namespace string, aviVsNode *nodes.AviEvhVsNode, vsCacheObj *avicache.AviVsCache, key string) ([]*utils.RestOp, error) {
+	var passthroughChild string
+	if vsCacheObj != nil {
+		passthroughChild = vsCacheObj.ServiceMetadataObj.PassthroughChildRef
+	}
+	toDelete, restOps, err := rest.evhCURestOps(aviVsNode, vsCacheObj, namespace, key)
+	if err != nil {
+		return nil, err
+	}
+	evhToDelete := rest.childVsKeys(vsCacheObj, key)
+	restOps = append(restOps, rest.evhObjectsDeleteRestOps(toDelete, namespace, key)...)
+	for _, evhNode := range aviVsNode.EvhNodes {
+		var evhRestOps []*utils.RestOp
+		if vsCacheObj != nil {
+			evhToDelete, evhRestOps = rest.EvhNodeCU(evhNode, vsCacheObj, namespace, evhToDelete, evhRestOps, key)
+		} else {
+			_, evhRestOps = rest.EvhNodeCU(evhNode, nil, namespace, evhToDelete, evhRestOps, key)
+		}
+		restOps = append(restOps, evhRestOps...)
+	}
+	restOps = append(restOps, rest.buildDeleteSniRestOps(namespace, evhToDelete, key)...)
+
+	for _, passChildNode := range aviVsNode.PassthroughChildNodes {
+		passChildVSKey := avicache.NamespaceName{Namespace: namespace, Name: passChildNode.Name}
+		restOps = append(restOps, rest.EvhPassthroughChildCU(passChildNode, rest.getVsCacheObj(passChildVSKey, key), namespace, key)...)
+		if passChildNode.Name == passthroughChild {
+			passthroughChild = ""
+		}
+	}
+	if passthroughChild != "" {
+		passChildVSKey := avicache.NamespaceName{Namespace: namespace, Name: passthroughChild}
+		if passChildVSCacheObj := rest.getVsCacheObj(passChildVSKey, key); passChildVSCacheObj != nil {
+			restOps = append(restOps, rest.buildDeleteVsRestOps(namespace, passChildVSCacheObj, key, true)...)
+		}
+	}
+	return restOps, nil
+}
+
+// buildDeleteSniRestOps returns the rest operations of SNINodeDelete, for SNI and EVH child virtualservices.
+func (rest *RestOperations) buildDeleteSniRestOps(namespace string, sniToDelete []avicache.NamespaceName, key string) []*utils.RestOp {
+	var restOps []*utils.RestOp
+	for _, delSni := range sniToDelete {
+		sniKey := avicache.NamespaceName{Namespace: namespace, Name: delSni.Name}
+		if sniCacheObj := rest.getVsCacheObj(sniKey, key); sniCacheObj != nil {
+			restOps = append(restOps, rest.sniVsDeleteRestOps(sniCacheObj, namespace, key)...)
+		}
+	}
+	return restOps
+}
+
+// buildDeleteVsRestOps returns the rest operations of DeleteVSOper, in the order in which they are executed.
+func (rest *RestOperations) buildDeleteVsRestOps(namespace string, vsCacheObj *avicache.AviVsCache, key string, skipVSVip bool) []*utils.RestOp {
+	var restOps []*utils.RestOp
+	if passthroughChild := vsCacheObj.ServiceMetadataObj.PassthroughChildRef; passthroughChild != "" {
+		passthroughChildKey := avicache.NamespaceName{Namespace: namespace, Name: passthroughChild}
+		if passthroughChildCache := rest.getVsCacheObj(passthroughChildKey, key); passthroughChildCache != nil {
+			restOps = append(restOps, rest.buildDeleteVsRestOps(namespace, passthroughChildCache, key, true)...)
+		}
+	}
+	restOps = append(restOps, rest.buildDeleteSniRestOps(namespace, rest.childVsKeys(vsCacheObj, key), key)...)
+	return append(restOps, rest.vsDeleteRestOps(vsCacheObj, namespace, key, false, skipVSVip)...)
+}
@@ -0,0 +1,393 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api/models"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// fields set by the controller, which are not part of the objects built by AKO.
+var planIgnoredFields = map[string]bool{
+	"uuid":           true,
+	"url":            true,
+	"_last_modified": true,
+}
+
+// top level fields with secrets, e.g. the private key of a SSLKeyAndCertificate, whose values
+// are not reported. The passphrase and password fields are redacted at any level.
+var planSecretFields = map[string]bool{
+	"key":            true,
+	"key_passphrase": true,
+	"passphrase":     true,
+	"password":       true,
+	"private_key":    true,
+}
+
+const planRedactedValue = "<redacted>"
+
+// planMaxControllerGets caps the number of objects fetched from the Avi controller for a plan.
+const planMaxControllerGets = 50
+
+// FieldChange is the change of a field of an Avi object. Nested fields are separated by dots,
+// and list items are referred by their index, e.g. servers[0].ip.addr.
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old,omitempty"`
+	New   interface{} `json:"new,omitempty"`
+}
+
+// PlannedOperation is a rest operation which AKO would execute for a model.
+type PlannedOperation struct {
+	Method  string        `json:"method"`
+	Model   string        `json:"model"`
+	Name    string        `json:"name,omitempty"`
+	Path    string        `json:"path"`
+	Tenant  string        `json:"tenant"`
+	Changes []FieldChange `json:"changes,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// ModelPlan has the rest operations which AKO would execute to sync a model with the Avi controller.
+type ModelPlan struct {
+	Model      string             `json:"model"`
+	Operations []PlannedOperation `json:"operations"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// PlanModel implements ApiModel
+type PlanModel struct{}
+
+func (a *PlanModel) InitModel() {}
+
+func (a *PlanModel) ApiOperationMap(prometheusEnabled bool, reg *prometheus.Registry) []models.OperationMap {
+	get := models.OperationMap{
+		Route:  "/api/plan",
+		Method: "GET",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var plans []ModelPlan
+			if modelName := r.URL.Query().Get("model"); modelName != "" {
+				plans = []ModelPlan{GetModelPlan(modelName)}
+			} else {
+				plans = GetAllModelPlans()
+			}
+			if r.URL.Query().Get("format") == "text" {
+				w.Header().Add("Content-Type", "text/plain")
+				w.Write([]byte(FormatPlans(plans)))
+				return
+			}
+			utils.Respond(w, plans)
+		},
+	}
+	return []models.OperationMap{get}
+}
+
+// GetModelPlan computes the rest operations which AKO would execute for the model, against copies
+// of the Avi VS cache objects, without executing them. For the updates, the current object is fetched from
+// the Avi controller, and the changed fields are reported.
+func GetModelPlan(modelName string) ModelPlan {
+	return getModelPlan(modelName, newPlanObjectFetcher())
+}
+
+func getModelPlan(modelName string, fetcher *planObjectFetcher) ModelPlan {
+	plan := ModelPlan{Model: modelName, Operations: []PlannedOperation{}}
+	var avimodel *nodes.AviObjectGraph
+	if found, avimodelIntf := objects.SharedAviGraphLister().Get(modelName); found && avimodelIntf != nil {
+		if graph, ok := avimodelIntf.(*nodes.AviObjectGraph); ok && graph != nil {
+			if avimodel, ok = graph.GetCopy(modelName); !ok {
+				plan.Error = "failed to get a copy of the model"
+				return plan
+			}
+		}
+	}
+	restOperations := NewRestOperations(avicache.SharedAviObjCache())
+	restOperations.planFetcher = fetcher
+	restOps, err := restOperations.BuildRestOpsForModel(modelName, avimodel)
+	if err != nil {
+		plan.Error = err.Error()
+	}
+	for _, restOp := range restOps {
+		plan.Operations = append(plan.Operations, planRestOp(restOp, fetcher))
+	}
+	return plan
+}
+
+// GetAllModelPlans returns the plans of the models which have pending rest operations. The
+// current objects fetched from the Avi controller are shared by the models.
+func GetAllModelPlans() []ModelPlan {
+	allModels := objects.SharedAviGraphLister().GetAll().(map[string]interface{})
+	modelNames := make([]string, 0, len(allModels))
+	for modelName := range allModels {
+		modelNames = append(modelNames, modelName)
+	}
+	sort.Strings(modelNames)
+	plans := []ModelPlan{}
+	fetcher := newPlanObjectFetcher()
+	for _, modelName := range modelNames {
+		plan := getModelPlan(modelName, fetcher)
+		if len(plan.Operations) > 0 || plan.Error != "" {
+			plans = append(plans, plan)
+		}
+	}
+	return plans
+}
+
+func planRestOp(restOp *utils.RestOp, fetcher *planObjectFetcher) PlannedOperation {
+	op := PlannedOperation{
+		Method: string(restOp.Method),
+		Model:  restOp.Model,
+		Name:   restOp.ObjName,
+		Path:   restOp.Path,
+		Tenant: restOp.Tenant,
+	}
+	if restOp.Obj == nil {
+		return op
+	}
+	desired, err := toAviObjectMap(restOp.Obj)
+	if err != nil {
+		op.Error = err.Error()
+		return op
+	}
+	if name, ok := desired["name"].(string); ok {
+		op.Name = name
+	}
+	var current map[string]interface{}
+	if restOp.Method == utils.RestPut {
+		if current, err = fetcher.get(restOp); err != nil {
+			op.Error = err.Error()
+			return op
+		}
+	}
+	op.Changes = DiffAviObject(current, desired)
+	return op
+}
+
+// planObjectFetcher fetches the current objects of the updates of a plan from the Avi
+// controller, once per object and up to planMaxControllerGets objects.
+type planObjectFetcher struct {
+	objects map[string]map[string]interface{}
+	gets    int
+}
+
+func newPlanObjectFetcher() *planObjectFetcher {
+	return &planObjectFetcher{objects: make(map[string]map[string]interface{})}
+}
+
+func (f *planObjectFetcher) get(restOp *utils.RestOp) (map[string]interface{}, error) {
+	key := restOp.Tenant + "/" + restOp.Path
+	if current, ok := f.objects[key]; ok {
+		return current, nil
+	}
+	if f.gets >= planMaxControllerGets {
+		return nil, fmt.Errorf("current object not fetched, a plan fetches at most %d objects from the Avi controller", planMaxControllerGets)
+	}
+	f.gets++
+	current, err := getCurrentAviObject(restOp)
+	if err != nil {
+		return nil, err
+	}
+	f.objects[key] = current
+	return current, nil
+}
+
+func getCurrentAviObject(restOp *utils.RestOp) (map[string]interface{}, error) {
+	aviRestPoolClient := avicache.SharedAVIClients(restOp.Tenant)
+	if aviRestPoolClient == nil || len(aviRestPoolClient.AviClient) < 1 {
+		return nil, fmt.Errorf("avi client for tenant %s not initialized", restOp.Tenant)
+	}
+	rawData, err := lib.AviGetRaw(aviRestPoolClient.AviClient[0], restOp.Path+"?include_name")
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]interface{})
+	if err := json.Unmarshal(rawData, &current); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func toAviObjectMap(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	aviObject := make(map[string]interface{})
+	if err := json.Unmarshal(data, &aviObject); err != nil {
+		return nil, err
+	}
+	return aviObject, nil
+}
+
+// DiffAviObject returns the changes of the fields set by AKO in the desired object, from the
+// current object. The fields which are only in the current object are defaulted by the Avi
+// controller and not reported, except for the extra items of the lists. A nil current object
+// reports all the fields of the desired object as new. The values of the secrets are redacted.
+func DiffAviObject(current, desired map[string]interface{}) []FieldChange {
+	var changes []FieldChange
+	diffAviField("", current, desired, &changes)
+	return changes
+}
+
+func diffAviField(field string, current, desired interface{}, changes *[]FieldChange) {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		currentMap, _ := current.(map[string]interface{})
+		keys := make([]string, 0, len(desiredValue))
+		for k := range desiredValue {
+			if !planIgnoredFields[k] {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			subField := k
+			if field != "" {
+				subField = field + "." + k
+			}
+			currentValue, ok := currentMap[k]
+			if isSecretAviField(subField) {
+				if !ok || !reflect.DeepEqual(currentValue, desiredValue[k]) {
+					change := FieldChange{Field: subField, New: planRedactedValue}
+					if ok {
+						change.Old = planRedactedValue
+					}
+					*changes = append(*changes, change)
+				}
+				continue
+			}
+			if !ok {
+				addFieldChanges(subField, nil, desiredValue[k], changes)
+				continue
+			}
+			diffAviField(subField, currentValue, desiredValue[k], changes)
+		}
+	case []interface{}:
+		currentList, ok := current.([]interface{})
+		if !ok && current != nil {
+			*changes = append(*changes, FieldChange{Field: field, Old: current, New: desired})
+			return
+		}
+		for i := range desiredValue {
+			subField := field + "[" + strconv.Itoa(i) + "]"
+			if i >= len(currentList) {
+				addFieldChanges(subField, nil, desiredValue[i], changes)
+				continue
+			}
+			diffAviField(subField, currentList[i], desiredValue[i], changes)
+		}
+		for i := len(desiredValue); i < len(currentList); i++ {
+			*changes = append(*changes, FieldChange{Field: field + "[" + strconv.Itoa(i) + "]", Old: currentList[i]})
+		}
+	default:
+		if !isSameAviValue(field, current, desired) {
+			*changes = append(*changes, FieldChange{Field: field, Old: current, New: desired})
+		}
+	}
+}
+
+func isSecretAviField(field string) bool {
+	if planSecretFields[field] {
+		return true
+	}
+	name := field[strings.LastIndex(field, ".")+1:]
+	return strings.Contains(name, "passphrase") || strings.Contains(name, "password")
+}
+
+// addFieldChanges reports a new value per leaf field, to keep the diff readable for objects.
+func addFieldChanges(field string, current, desired interface{}, changes *[]FieldChange) {
+	if current == nil {
+		switch desired.(type) {
+		case map[string]interface{}, []interface{}:
+			diffAviField(field, nil, desired, changes)
+			return
+		}
+	}
+	*changes = append(*changes, FieldChange{Field: field, Old: current, New: desired})
+}
+
+// isSameAviValue compares the values of a field, where the refs in AKO objects are in the
+// /api/<object>?name=<name> format, and the refs fetched with include_name end with #<name>.
+func isSameAviValue(field string, current, desired interface{}) bool {
+	if reflect.DeepEqual(current, desired) {
+		return true
+	}
+	currentStr, ok1 := current.(string)
+	desiredStr, ok2 := desired.(string)
+	if !ok1 || !ok2 {
+		if current != nil && desired != nil {
+			return fmt.Sprint(current) == fmt.Sprint(desired)
+		}
+		return false
+	}
+	if !strings.Contains(field, "_ref") {
+		return false
+	}
+	return aviRefName(currentStr) == aviRefName(desiredStr)
+}
+
+func aviRefName(ref string) string {
+	if i := strings.LastIndex(ref, "#"); i != -1 {
+		return ref[i+1:]
+	}
+	if i := strings.LastIndex(ref, "name="); i != -1 {
+		return ref[i+len("name="):]
+	}
+	return ref
+}
+
+// FormatPlans renders the plans as a diff, with one line per operation and per field change.
+func FormatPlans(plans []ModelPlan) string {
+	var sb strings.Builder
+	for _, plan := range plans {
+		sb.WriteString("model: " + plan.Model + "\n")
+		if plan.Error != "" {
+			sb.WriteString("  error: " + plan.Error + "\n")
+		}
+		if len(plan.Operations) == 0 {
+			sb.WriteString("  no changes\n")
+		}
+		for _, op := range plan.Operations {
+			sb.WriteString(fmt.Sprintf("  %s %s %s (tenant: %s, path: %s)\n", op.Method, op.Model, op.Name, op.Tenant, op.Path))
+			if op.Error != "" {
+				sb.WriteString("    error: " + op.Error + "\n")
+			}
+			for _, change := range op.Changes {
+				switch {
+				case change.Old == nil:
+					sb.WriteString(fmt.Sprintf("    + %s: %s\n", change.Field, utils.Stringify(change.New)))
+				case change.New == nil:
+					sb.WriteString(fmt.Sprintf("    - %s: %s\n", change.Field, utils.Stringify(change.Old)))
+				default:
+					sb.WriteString(fmt.Sprintf("    ~ %s: %s -> %s\n", change.Field, utils.Stringify(change.Old), utils.Stringify(change.New)))
+				}
+			}
+		}
+	}
+	return sb.String()
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/tracing"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 )
 
@@ -198,7 +199,7 @@ func (v *L4PolicySetSchema) removePoolRef(op *utils.RestOp, objRef string) bool
 	}
 
 	for i, rule := range l4PolSet.L4ConnectionPolicy.Rules {
-		if strings.EqualFold(*rule.Action.SelectPool.PoolRef, objRef) {
+		if rule.Action.SelectPool.PoolRef != nil && strings.EqualFold(*rule.Action.SelectPool.PoolRef, objRef) {
 			l4PolSet.L4ConnectionPolicy.Rules = append(l4PolSet.L4ConnectionPolicy.Rules[:i], l4PolSet.L4ConnectionPolicy.Rules[i+1:]...)
 		}
 	}
@@ -288,6 +289,7 @@ func (l *leader) AviRestOperate(c *clients.AviClient, rest_ops []*utils.RestOp,
 			SetVersion := session.SetVersion(op.Version)
 			SetVersion(c.AviSession)
 		}
+		span := tracing.StartChildSpan(lib.RestStage, key, string(op.Method)+" "+op.Model)
 		switch op.Method {
 		case utils.RestPost:
 			op.Err = c.AviSession.Post(utils.GetUriEncoded(op.Path), op.Obj, &op.Response)
@@ -304,7 +306,9 @@ func (l *leader) AviRestOperate(c *clients.AviClient, rest_ops []*utils.RestOp,
 			utils.AviLog.Errorf("Unknown RestOp %v", op.Method)
 			op.Err = fmt.Errorf("Unknown RestOp %v", op.Method)
 		}
+		endRestOpSpan(span, op)
 		if op.Err != nil {
+			lib.IncrementRestOpErrorCounter(op.Model, op.Err)
 			utils.AviLog.Warnf("key: %s, msg: RestOp method %v path %v tenant %v Obj %s returned err %s with response %s",
 				key, op.Method, op.Path, op.Tenant, utils.StringifyWithSanitization(op.Obj), utils.Stringify(op.Err), utils.Stringify(op.Response))
 			// Wrap the error into a websync error.
@@ -419,8 +423,11 @@ func (f *follower) AviRestOperate(c *clients.AviClient, rest_ops []*utils.RestOp
 		}
 
 		utils.AviLog.Debugf("key: %s, msg: Got a REST operation: %s, %s", key, op.ObjName, op.Path)
+		span := tracing.StartChildSpan(lib.RestStage, key, string(utils.RestGet)+" "+op.Model)
 		op.Err = c.AviSession.Get(utils.GetUriEncoded(op.Path), &op.Response)
+		endRestOpSpan(span, op)
 		if op.Err != nil {
+			lib.IncrementRestOpErrorCounter(op.Model, op.Err)
 			utils.AviLog.Warnf("key: %s, msg: RestOp method %v path %v tenant %v Obj %s returned err %s with response %s",
 				key, op.Method, op.Path, op.Tenant, utils.StringifyWithSanitization(op.Obj), utils.Stringify(op.Err), utils.Stringify(op.Response))
 			// Wrap the error into a websync error.
@@ -461,3 +468,21 @@ func (f *follower) AviRestOperate(c *clients.AviClient, rest_ops []*utils.RestOp
 	}
 	return nil
 }
+
+// endRestOpSpan records the rest operation on the span of the Avi rest call.
+func endRestOpSpan(span *tracing.Span, op *utils.RestOp) {
+	if span == nil {
+		return
+	}
+	span.SetAttribute("avi.method", string(op.Method))
+	span.SetAttribute("avi.object_type", op.Model)
+	span.SetAttribute("avi.path", op.Path)
+	span.SetAttribute("avi.tenant", op.Tenant)
+	if op.Err != nil {
+		span.SetError(op.Err)
+		if aviErr, ok := op.Err.(session.AviError); ok {
+			span.SetAttribute("http.status_code", aviErr.HttpStatusCode)
+		}
+	}
+	span.End()
+}
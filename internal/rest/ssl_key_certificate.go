@@ -34,6 +34,11 @@ func (rest *RestOperations) AviSSLBuild(ssl_node *nodes.AviTLSKeyCertNode, cache
 		utils.AviLog.Warnf("Not processing sslkeycert object")
 		return nil
 	}
+	if _, err := lib.ValidateCertificate(ssl_node.SecretNamespace, ssl_node.SecretName, ssl_node.Cert, ssl_node.Key); err != nil {
+		// The certificate programmed earlier, if any, is kept until the secret is renewed.
+		utils.AviLog.Warnf("Not processing sslkeycert object %s, %v", ssl_node.Name, err)
+		return nil
+	}
 	name := ssl_node.Name
 	tenant := fmt.Sprintf("/api/tenant/?name=%s", lib.GetEscapedValue(ssl_node.Tenant))
 	certificate := string(ssl_node.Cert)
@@ -174,6 +179,7 @@ func (rest *RestOperations) AviSSLKeyCertAdd(rest_op *utils.RestOp, vsKey avicac
 
 		k := avicache.NamespaceName{Namespace: rest_op.Tenant, Name: name}
 		rest.cache.SSLKeyCache.AviCacheAdd(k, &ssl_cache_obj)
+		lib.TrackCertificateExpiry(rest_op.Tenant, name, SSLKeyAndCertificate.Markers, cert)
 		// Update the VS object
 		if vsKey != (avicache.NamespaceName{}) {
 			vs_cache, ok := rest.cache.VsCacheMeta.AviCacheGet(vsKey)
@@ -201,6 +207,7 @@ func (rest *RestOperations) AviSSLKeyCertAdd(rest_op *utils.RestOp, vsKey avicac
 func (rest *RestOperations) AviSSLCacheDel(rest_op *utils.RestOp, vsKey avicache.NamespaceName, key string) error {
 	sslkey := avicache.NamespaceName{Namespace: rest_op.Tenant, Name: rest_op.ObjName}
 	rest.cache.SSLKeyCache.AviCacheDelete(sslkey)
+	lib.UntrackCertificateExpiry(rest_op.Tenant, rest_op.ObjName)
 	if vsKey != (avicache.NamespaceName{}) {
 		vs_cache, ok := rest.cache.VsCacheMeta.AviCacheGet(vsKey)
 		if ok {
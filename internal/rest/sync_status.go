@@ -0,0 +1,261 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package rest
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api/models"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// CachedAviObject is an Avi object of a model, as present in the Avi object cache.
+type CachedAviObject struct {
+	Model string `json:"model"`
+	Name  string `json:"name"`
+	Uuid  string `json:"uuid,omitempty"`
+}
+
+// ModelSyncStatus has the sync state of a model with the Avi controller.
+type ModelSyncStatus struct {
+	Model          string               `json:"model"`
+	InGraph        bool                 `json:"in_graph"`
+	Objects        []string             `json:"objects"`
+	LastRestResult *objects.RestResult  `json:"last_rest_result,omitempty"`
+	Retry          *objects.RetryStatus `json:"retry,omitempty"`
+	CachedObjects  []CachedAviObject    `json:"cached_objects"`
+}
+
+// ObjectSyncStatus has the sync state of the models built from a Kubernetes object.
+type ObjectSyncStatus struct {
+	Key    string            `json:"key"`
+	Models []ModelSyncStatus `json:"models"`
+}
+
+// SyncStatusModel implements ApiModel
+type SyncStatusModel struct{}
+
+func (a *SyncStatusModel) InitModel() {}
+
+func (a *SyncStatusModel) ApiOperationMap(prometheusEnabled bool, reg *prometheus.Registry) []models.OperationMap {
+	getModel := models.OperationMap{
+		Route:  "/api/models/{namespace}/{name}",
+		Method: "GET",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			vars := mux.Vars(r)
+			utils.Respond(w, GetModelSyncStatus(vars["namespace"]+"/"+vars["name"]))
+		},
+	}
+	objectHandler := func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		utils.Respond(w, GetObjectSyncStatus(vars["kind"], vars["namespace"], vars["name"]))
+	}
+	getObject := models.OperationMap{
+		Route:   "/api/objects/{kind}/{namespace}/{name}",
+		Method:  "GET",
+		Handler: objectHandler,
+	}
+	getClusterScopedObject := models.OperationMap{
+		Route:   "/api/objects/{kind}/{name}",
+		Method:  "GET",
+		Handler: objectHandler,
+	}
+	return []models.OperationMap{getModel, getObject, getClusterScopedObject}
+}
+
+// GetObjectSyncStatus returns the sync state of the models, which the object was last processed into,
+// by the graph layer. The kind is matched case insensitively, and namespace is empty for the cluster
+// scoped objects. For kind Service, the LoadBalancer services are matched as well.
+func GetObjectSyncStatus(kind, namespace, name string) []ObjectSyncStatus {
+	statuses := []ObjectSyncStatus{}
+	for _, key := range objects.SharedSyncStatusLister().GetAllObjectKeys() {
+		if !matchObjectKey(key, kind, namespace, name) {
+			continue
+		}
+		status := ObjectSyncStatus{Key: key, Models: []ModelSyncStatus{}}
+		_, modelNames := objects.SharedSyncStatusLister().GetObjectModels(key)
+		for _, modelName := range modelNames {
+			status.Models = append(status.Models, GetModelSyncStatus(modelName))
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Key < statuses[j].Key
+	})
+	return statuses
+}
+
+func matchObjectKey(key, kind, namespace, name string) bool {
+	keyParts := strings.Split(key, "/")
+	var keyKind, keyNamespace, keyName string
+	switch len(keyParts) {
+	case 2:
+		keyKind, keyName = keyParts[0], keyParts[1]
+	case 3:
+		keyKind, keyNamespace, keyName = keyParts[0], keyParts[1], keyParts[2]
+	default:
+		return false
+	}
+	if keyNamespace != namespace || keyName != name {
+		return false
+	}
+	if strings.EqualFold(keyKind, kind) {
+		return true
+	}
+	return strings.EqualFold(kind, utils.Service) && keyKind == utils.L4LBService
+}
+
+// GetModelSyncStatus returns the sync state of the model: the objects processed into the model,
+// the result of the last rest operations, the retry queue of the model and the cached Avi objects.
+func GetModelSyncStatus(modelName string) ModelSyncStatus {
+	status := ModelSyncStatus{
+		Model:         modelName,
+		Objects:       objects.SharedSyncStatusLister().GetModelObjects(modelName),
+		CachedObjects: []CachedAviObject{},
+	}
+	if found, avimodel := objects.SharedAviGraphLister().Get(modelName); found && avimodel != nil {
+		status.InGraph = true
+	}
+	if found, result := objects.SharedSyncStatusLister().GetRestResult(modelName); found {
+		status.LastRestResult = &result
+	}
+	if found, retry := objects.SharedSyncStatusLister().GetRetry(modelName); found {
+		status.Retry = &retry
+	}
+	tenant, vsName := splitModelName(modelName)
+	status.CachedObjects = getCachedAviObjects(avicache.SharedAviObjCache(), avicache.NamespaceName{Namespace: tenant, Name: vsName})
+	return status
+}
+
+func splitModelName(modelName string) (string, string) {
+	if tenant, name, ok := strings.Cut(modelName, "/"); ok {
+		return tenant, name
+	}
+	return "", modelName
+}
+
+func getCachedAviObjects(cache *avicache.AviObjCache, vsKey avicache.NamespaceName) []CachedAviObject {
+	cachedObjects := []CachedAviObject{}
+	vsCache, found := cache.VsCacheMeta.AviCacheGet(vsKey)
+	if !found {
+		return cachedObjects
+	}
+	vsCacheObj, ok := vsCache.(*avicache.AviVsCache)
+	if !ok || vsCacheObj == nil {
+		return cachedObjects
+	}
+	vsCacheObj.VSCacheLock.RLock()
+	defer vsCacheObj.VSCacheLock.RUnlock()
+	cachedObjects = append(cachedObjects, CachedAviObject{Model: "VirtualService", Name: vsCacheObj.Name, Uuid: vsCacheObj.Uuid})
+	cachedObjects = appendCachedAviObjects(cachedObjects, cache.VSVIPCache, "VsVip", vsCacheObj.VSVipKeyCollection)
+	cachedObjects = appendCachedAviObjects(cachedObjects, cache.PgCache, "PoolGroup", vsCacheObj.PGKeyCollection)
+	cachedObjects = appendCachedAviObjects(cachedObjects, cache.PoolCache, "Pool", vsCacheObj.PoolKeyCollection)
+	cachedObjects = appendCachedAviObjects(cachedObjects, cache.HTTPPolicyCache, "HTTPPolicySet", vsCacheObj.HTTPKeyCollection)
+	cachedObjects = appendCachedAviObjects(cachedObjects, cache.L4PolicyCache, "L4PolicySet", vsCacheObj.L4PolicyCollection)
+	cachedObjects = appendCachedAviObjects(cachedObjects, cache.SSLKeyCache, "SSLKeyAndCertificate", vsCacheObj.SSLKeyCertCollection)
+	cachedObjects = appendCachedAviObjects(cachedObjects, cache.DSCache, "VSDataScriptSet", vsCacheObj.DSKeyCollection)
+	cachedObjects = appendCachedAviObjects(cachedObjects, cache.StringGroupCache, "StringGroup", vsCacheObj.StringGroupKeyCollection)
+	if vsCacheObj.TrafficCloneProfile.Name != "" {
+		cachedObjects = appendCachedAviObjects(cachedObjects, cache.TrafficCloneCache, "TrafficCloneProfile", []avicache.NamespaceName{vsCacheObj.TrafficCloneProfile})
+	}
+	for _, childUuid := range vsCacheObj.SNIChildCollection {
+		childObj := CachedAviObject{Model: "VirtualService", Uuid: childUuid}
+		if childKey, found := cache.VsCacheMeta.AviCacheGetKeyByUuid(childUuid); found {
+			if key, ok := childKey.(avicache.NamespaceName); ok {
+				childObj.Name = key.Name
+			}
+		}
+		cachedObjects = append(cachedObjects, childObj)
+	}
+	return cachedObjects
+}
+
+func appendCachedAviObjects(cachedObjects []CachedAviObject, cache *avicache.AviCache, model string, keys []avicache.NamespaceName) []CachedAviObject {
+	for _, key := range keys {
+		cachedObj := CachedAviObject{Model: model, Name: key.Name}
+		if obj, found := cache.AviCacheGet(key); found {
+			cachedObj.Uuid = cachedObjUuid(obj)
+		}
+		cachedObjects = append(cachedObjects, cachedObj)
+	}
+	return cachedObjects
+}
+
+func cachedObjUuid(obj interface{}) string {
+	switch cacheObj := obj.(type) {
+	case *avicache.AviVSVIPCache:
+		return cacheObj.Uuid
+	case *avicache.AviPGCache:
+		return cacheObj.Uuid
+	case *avicache.AviPoolCache:
+		return cacheObj.Uuid
+	case *avicache.AviHTTPPolicyCache:
+		return cacheObj.Uuid
+	case *avicache.AviL4PolicyCache:
+		return cacheObj.Uuid
+	case *avicache.AviSSLCache:
+		return cacheObj.Uuid
+	case *avicache.AviDSCache:
+		return cacheObj.Uuid
+	case *avicache.AviStringGroupCache:
+		return cacheObj.Uuid
+	case *avicache.AviTrafficCloneProfileCache:
+		return cacheObj.Uuid
+	}
+	return ""
+}
+
+func saveRestResult(key string, restOps []*utils.RestOp, err error) {
+	result := objects.RestResult{
+		Timestamp:  time.Now(),
+		Success:    err == nil,
+		Operations: []string{},
+	}
+	for _, restOp := range restOps {
+		result.Operations = append(result.Operations, string(restOp.Method)+" "+restOp.Model+" "+restOpName(restOp))
+		if restOp.Err != nil {
+			result.Errors = append(result.Errors, restOp.Err.Error())
+		}
+	}
+	if err != nil && len(result.Errors) == 0 {
+		result.Errors = append(result.Errors, err.Error())
+	}
+	objects.SharedSyncStatusLister().SaveRestResult(key, result)
+}
+
+func restOpName(restOp *utils.RestOp) string {
+	if restOp.ObjName != "" {
+		return restOp.ObjName
+	}
+	// Avi objects have the name as a *string field
+	objValue := reflect.Indirect(reflect.ValueOf(restOp.Obj))
+	if objValue.Kind() == reflect.Struct {
+		if nameField := objValue.FieldByName("Name"); nameField.IsValid() && nameField.Kind() == reflect.Ptr && !nameField.IsNil() {
+			if name, ok := nameField.Elem().Interface().(string); ok {
+				return name
+			}
+		}
+	}
+	return restOp.Path
+}
@@ -14,23 +14,36 @@
 package retry
 
 import (
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/tracing"
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 )
 
 func DequeueFastRetry(vsKey string) {
 	utils.AviLog.Infof("Retrieved the key for fast retry: %s", vsKey)
+	objects.SharedSyncStatusLister().DeleteRetry(vsKey)
+	span := tracing.StartSpan(lib.RetryStage, vsKey)
+	span.SetAttribute("queue", objects.FastRetryQueue)
+	defer span.End()
 	sharedQueue := utils.SharedWorkQueue().GetQueueByName(utils.GraphLayer)
 	//modelName := lib.GetTenant() + "/" + vsKey
+	tracing.Publish(lib.RetryStage, vsKey, lib.RestStage, vsKey)
 	nodes.PublishKeyToRestLayer(vsKey, "retry", sharedQueue)
 
 }
 
 func DequeueSlowRetry(vsKey string) {
 	utils.AviLog.Infof("Retrieved the key for slow retry: %s", vsKey)
+	objects.SharedSyncStatusLister().DeleteRetry(vsKey)
+	span := tracing.StartSpan(lib.RetryStage, vsKey)
+	span.SetAttribute("queue", objects.SlowRetryQueue)
+	defer span.End()
 	sharedQueue := utils.SharedWorkQueue().GetQueueByName(utils.GraphLayer)
 	//modelName := lib.GetTenant() + "/" + vsKey
+	tracing.Publish(lib.RetryStage, vsKey, lib.RestStage, vsKey)
 	nodes.PublishKeyToRestLayer(vsKey, "retry", sharedQueue)
 
 }
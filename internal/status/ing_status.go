@@ -35,12 +35,14 @@ import (
 	types "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 type Status struct {
 	*gatewayv1.GatewayClassStatus
 	*gatewayv1.GatewayStatus
 	*gatewayv1.HTTPRouteStatus
+	*gatewayv1alpha2.PolicyStatus
 }
 type UpdateOptions struct {
 	// IngSvc format: namespace/name, not supposed to be provided by the caller
@@ -58,6 +60,7 @@ type UpdateOptions struct {
 // VSUuidAnnotation is maps a hostname to the UUID of the virtual service where it is placed.
 func (l *leader) UpdateIngressStatus(options []UpdateOptions, bulk bool) {
 	var err error
+	options = skipSyncErrors(options, updateIngressSyncError)
 	ingressesToUpdate, updateIngressOptions := ParseOptionsFromMetadata(options, bulk)
 	// ingressMap: {ns/ingress: ingressObj}
 	// this pre-fetches all ingresses to be candidates for status update
@@ -196,6 +199,9 @@ func updateObject(mIngress *networkingv1.Ingress, updateOption UpdateOptions, re
 	} else {
 		utils.AviLog.Debugf("key: %s, msg: no changes detected in the ingress %s/%s status", key, mIngress.Namespace, mIngress.Name)
 	}
+	if err == nil {
+		recordSyncEvent(mIngress, utils.Ingress, key, updateOption.VSName, lib.Programmed, programmedMessage(updateOption), !sameStatus)
+	}
 
 	// update the annotations for this object
 	err = updateIngAnnotations(updatedIng, hostnames, updateOption.VirtualServiceUUID, key, updateOption.Tenant, hostListIng, mIngress)
@@ -205,6 +211,13 @@ func updateObject(mIngress *networkingv1.Ingress, updateOption UpdateOptions, re
 	return nil
 }
 
+// updateIngressSyncError reports the error of the Avi Controller for the virtualservice, in the events of the Ingresses.
+func updateIngressSyncError(option UpdateOptions) {
+	for _, ingress := range getIngresses(syncErrorObjects(option.ServiceMetadata), false) {
+		recordSyncEvent(ingress, utils.Ingress, option.Key, option.VSName, lib.Rejected, rejectedMessage(option), false)
+	}
+}
+
 func updateIngAnnotations(ingObj *networkingv1.Ingress, hostnamesToBeUpdated []string,
 	vsUUID, key, tenant string, ingSpecHostnames []string, oldIng *networkingv1.Ingress, retryNum ...int) error {
 
@@ -373,6 +386,7 @@ func deleteObject(option UpdateOptions, key string, isVSDelete bool, retryNum ..
 			return errors.New("DeleteIngressStatus retried 3 times, aborting")
 		}
 	}
+	resetSyncState(utils.Ingress, option.ServiceMetadata.Namespace, option.ServiceMetadata.IngressName, option.VSName)
 	mIngress, err := utils.GetInformers().IngressInformer.Lister().Ingresses(option.ServiceMetadata.Namespace).Get(option.ServiceMetadata.IngressName)
 	if err != nil {
 		utils.AviLog.Warnf("key: %s, msg: Could not get the ingress object for DeleteStatus: %s", key, err)
@@ -0,0 +1,144 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// ingressMessages holds the messages last set in the ako.vmware.com/status annotation of the Ingresses, so that the
+// annotation is not updated again till the informer cache is updated.
+// key: namespace/name/subject of the Ingress
+var ingressMessages sync.Map
+
+// DeleteIngressMessages removes the messages of the deleted Ingress, so that an Ingress recreated with the same
+// name does not inherit them.
+func DeleteIngressMessages(ingKey string) {
+	ingressMessages.Range(func(k, _ interface{}) bool {
+		if strings.HasPrefix(k.(string), ingKey+"/") {
+			ingressMessages.Delete(k)
+		}
+		return true
+	})
+}
+
+// UpdateIngressMessage sets the message of a subject of the Ingress, e.g. secret/<name> or host/<fqdn>, in the
+// ako.vmware.com/status annotation of the Ingress, a map of the subjects which are not programmed to the reason.
+// The subject is removed from the annotation when the message is empty.
+func UpdateIngressMessage(key, ingKey, subject, message string) {
+	if !lib.AKOControlConfig().IsLeader() {
+		utils.AviLog.Debugf("key: %s, msg: AKO is not a leader, not updating the status of Ingress %s", key, ingKey)
+		return
+	}
+	stateKey := ingKey + "/" + subject
+	if prevMessage, ok := ingressMessages.Load(stateKey); ok && prevMessage.(string) == message {
+		return
+	}
+	namespace, name := utils.ExtractNamespaceObjectName(ingKey)
+	ingress, err := utils.GetInformers().IngressInformer.Lister().Ingresses(namespace).Get(name)
+	if err != nil {
+		return
+	}
+	if getIngressMessages(ingress)[subject] == message {
+		ingressMessages.Store(stateKey, message)
+		return
+	}
+	patchIngressMessages(key, ingress, map[string]string{subject: message})
+}
+
+// UpdateIngressHostMessages sets the messages of the hosts of the Ingress which are not programmed, as the
+// host/<fqdn> subjects of the ako.vmware.com/status annotation, and removes the messages of the other hosts.
+func UpdateIngressHostMessages(key, ingKey string, hostMessages map[string]string) {
+	if !lib.AKOControlConfig().IsLeader() {
+		utils.AviLog.Debugf("key: %s, msg: AKO is not a leader, not updating the status of Ingress %s", key, ingKey)
+		return
+	}
+	namespace, name := utils.ExtractNamespaceObjectName(ingKey)
+	ingress, err := utils.GetInformers().IngressInformer.Lister().Ingresses(namespace).Get(name)
+	if err != nil {
+		return
+	}
+	messages := getIngressMessages(ingress)
+	updates := make(map[string]string)
+	for subject := range messages {
+		if host, ok := strings.CutPrefix(subject, "host/"); ok && hostMessages[host] == "" {
+			updates[subject] = ""
+		}
+	}
+	for host, message := range hostMessages {
+		if messages["host/"+host] != message {
+			updates["host/"+host] = message
+		}
+	}
+	if len(updates) > 0 {
+		patchIngressMessages(key, ingress, updates)
+	}
+}
+
+// patchIngressMessages sets the messages of the subjects in the ako.vmware.com/status annotation of the Ingress,
+// removing the subjects with an empty message.
+func patchIngressMessages(key string, ingress *networkingv1.Ingress, updates map[string]string) {
+	messages := getIngressMessages(ingress)
+	for subject, message := range updates {
+		if message == "" {
+			delete(messages, subject)
+		} else {
+			messages[subject] = message
+		}
+	}
+	var annotationValue interface{}
+	if len(messages) > 0 {
+		messagesBytes, _ := json.Marshal(messages)
+		annotationValue = string(messagesBytes)
+	}
+	patchPayload, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				lib.IngressStatusAnnotation: annotationValue,
+			},
+		},
+	})
+	ingKey := ingress.Namespace + "/" + ingress.Name
+	_, err := utils.GetInformers().ClientSet.NetworkingV1().Ingresses(ingress.Namespace).Patch(context.TODO(), ingress.Name, types.MergePatchType, patchPayload, metav1.PatchOptions{})
+	if err != nil {
+		utils.AviLog.Errorf("key: %s, msg: there was an error in updating the %s annotation of %s: %v", key, lib.IngressStatusAnnotation, ingKey, err)
+		return
+	}
+	for subject, message := range updates {
+		ingressMessages.Store(ingKey+"/"+subject, message)
+	}
+}
+
+// getIngressMessages returns the subjects of the Ingress which are not programmed, from the ako.vmware.com/status
+// annotation.
+func getIngressMessages(ingress *networkingv1.Ingress) map[string]string {
+	messages := make(map[string]string)
+	if value, ok := ingress.Annotations[lib.IngressStatusAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &messages); err != nil {
+			utils.AviLog.Warnf("Invalid %s annotation in %s/%s: %v", lib.IngressStatusAnnotation, ingress.Namespace, ingress.Name, err)
+		}
+	}
+	return messages
+}
@@ -0,0 +1,229 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// maintenanceStates holds the maintenance message last set in the status of the Services, and of the Ingresses or
+// Routes for their backend Services, so that the status is not updated again till the informer cache is updated.
+// key: namespace/name of the Service, or namespace/name/service of the Ingress or Route
+var maintenanceStates sync.Map
+
+// DeleteServiceMaintenanceState removes the maintenance state of the deleted Service, so that a Service recreated
+// with the same name does not inherit it.
+func DeleteServiceMaintenanceState(svcKey string) {
+	maintenanceStates.Delete(svcKey)
+	namespace, name := utils.ExtractNamespaceObjectName(svcKey)
+	maintenanceStates.Range(func(k, _ interface{}) bool {
+		if stateKey := k.(string); strings.HasPrefix(stateKey, namespace+"/") && strings.HasSuffix(stateKey, "/"+name) &&
+			strings.Count(stateKey, "/") == 2 {
+			maintenanceStates.Delete(stateKey)
+		}
+		return true
+	})
+}
+
+// IsPoolMaintenanceTracked returns true if the maintenance of the Service is set in its status, or in the status
+// of the Ingress or Route, so that the status is updated once the pool servers are restored.
+func IsPoolMaintenanceTracked(svcKey, ingKey string) bool {
+	if message, ok := maintenanceStates.Load(svcKey); ok && message.(string) != "" {
+		return true
+	}
+	if ingKey != "" {
+		svcName := svcKey[strings.Index(svcKey, "/")+1:]
+		if message, ok := maintenanceStates.Load(ingKey + "/" + svcName); ok && message.(string) != "" {
+			return true
+		}
+		if getRouteIngressMaintenance(ingKey)[svcName] != "" {
+			return true
+		}
+	}
+	namespace, name := utils.ExtractNamespaceObjectName(svcKey)
+	svcObj, err := utils.GetInformers().ServiceInformer.Lister().Services(namespace).Get(name)
+	return err == nil && meta.FindStatusCondition(svcObj.Status.Conditions, lib.MaintenanceCondition) != nil
+}
+
+// UpdateMaintenanceStatus updates the maintenance of the pool servers of the Services in their status, and in the
+// status of the Ingresses or Routes using them, once the pools are synced.
+func (l *leader) UpdateMaintenanceStatus(options []UpdateOptions) {
+	for _, option := range options {
+		if len(option.ServiceMetadata.NamespaceServiceName) == 0 {
+			continue
+		}
+		svcKey := option.ServiceMetadata.NamespaceServiceName[0]
+		namespace, name := utils.ExtractNamespaceObjectName(svcKey)
+		svcObj, err := utils.GetInformers().ServiceInformer.Lister().Services(namespace).Get(name)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				DeleteServiceMaintenanceState(svcKey)
+			}
+			utils.AviLog.Debugf("key: %s, msg: unable to get the Service %s for the maintenance status: %v", option.Key, svcKey, err)
+			continue
+		}
+		// the pools of the Ingresses and Routes may be drained by a HostRule, hence the Service is under maintenance
+		// only with its annotations
+		serviceMessage := option.Message
+		if len(option.ServiceMetadata.NamespaceIngressName) > 0 {
+			serviceMessage = lib.GetServiceMaintenanceMessage(svcObj)
+		}
+		updateServiceMaintenanceStatus(svcObj, serviceMessage, option.Key)
+		for _, ingKey := range option.ServiceMetadata.NamespaceIngressName {
+			updateRouteIngressMaintenanceStatus(ingKey, svcObj.Name, option.Message, option.Key)
+		}
+	}
+}
+
+func (f *follower) UpdateMaintenanceStatus(options []UpdateOptions) {
+	for _, option := range options {
+		utils.AviLog.Debugf("key: %s, msg: AKO is not a leader, not updating the maintenance status", option.Key)
+	}
+}
+
+// updateServiceMaintenanceStatus sets the Maintenance condition in the status of the Service, while its pool
+// servers are drained, and removes the condition once they are restored. The transitions are reported with the
+// Draining and Restored events.
+func updateServiceMaintenanceStatus(svcObj *corev1.Service, message, key string) {
+	svcKey := svcObj.Namespace + "/" + svcObj.Name
+	if prevMessage, ok := maintenanceStates.Load(svcKey); ok && prevMessage.(string) == message {
+		return
+	}
+	service := svcObj.DeepCopy()
+	if !setMaintenanceCondition(service, message) {
+		maintenanceStates.Store(svcKey, message)
+		return
+	}
+	patchPayload, _ := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": service.Status.Conditions,
+		},
+	})
+	_, err := utils.GetInformers().ClientSet.CoreV1().Services(service.Namespace).Patch(context.TODO(), service.Name, types.MergePatchType, patchPayload, metav1.PatchOptions{}, "status")
+	if err != nil {
+		utils.AviLog.Errorf("key: %s, msg: there was an error in updating the %s condition of Service %s/%s: %v",
+			key, lib.MaintenanceCondition, service.Namespace, service.Name, err)
+		return
+	}
+	maintenanceStates.Store(svcKey, message)
+	recordMaintenanceEvent(service, message, key)
+}
+
+// updateRouteIngressMaintenanceStatus sets the maintenance of the backend Service in the ako.vmware.com/maintenance
+// annotation of the Ingress or Route, a map of the drained backend Services to their maintenance, and removes it
+// once the pool servers are restored.
+func updateRouteIngressMaintenanceStatus(ingKey, svcName, message, key string) {
+	stateKey := ingKey + "/" + svcName
+	if prevMessage, ok := maintenanceStates.Load(stateKey); ok && prevMessage.(string) == message {
+		return
+	}
+	obj := getRouteIngress(ingKey)
+	if obj == nil {
+		return
+	}
+	maintenance := getRouteIngressMaintenance(ingKey)
+	if maintenance[svcName] == message {
+		maintenanceStates.Store(stateKey, message)
+		return
+	}
+	if message == "" {
+		delete(maintenance, svcName)
+	} else {
+		maintenance[svcName] = message
+	}
+	var annotationValue interface{}
+	if len(maintenance) > 0 {
+		maintenanceBytes, _ := json.Marshal(maintenance)
+		annotationValue = string(maintenanceBytes)
+	}
+	patchPayload, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				lib.MaintenanceStatusAnnotation: annotationValue,
+			},
+		},
+	})
+	namespace, name := utils.ExtractNamespaceObjectName(ingKey)
+	var err error
+	if utils.GetInformers().RouteInformer != nil {
+		_, err = utils.GetInformers().OshiftClient.RouteV1().Routes(namespace).Patch(context.TODO(), name, types.MergePatchType, patchPayload, metav1.PatchOptions{})
+	} else {
+		_, err = utils.GetInformers().ClientSet.NetworkingV1().Ingresses(namespace).Patch(context.TODO(), name, types.MergePatchType, patchPayload, metav1.PatchOptions{})
+	}
+	if err != nil {
+		utils.AviLog.Errorf("key: %s, msg: there was an error in updating the %s annotation of %s: %v", key, lib.MaintenanceStatusAnnotation, ingKey, err)
+		return
+	}
+	maintenanceStates.Store(stateKey, message)
+	recordMaintenanceEvent(obj, message, key)
+}
+
+func recordMaintenanceEvent(obj runtime.Object, message, key string) {
+	objMeta, _ := meta.Accessor(obj)
+	if message != "" {
+		utils.AviLog.Infof("key: %s, msg: %s/%s is under maintenance: %s", key, objMeta.GetNamespace(), objMeta.GetName(), message)
+		lib.AKOControlConfig().EventRecorder().Event(obj, corev1.EventTypeNormal, lib.Draining, message)
+	} else {
+		utils.AviLog.Infof("key: %s, msg: pool servers of %s/%s are restored", key, objMeta.GetNamespace(), objMeta.GetName())
+		lib.AKOControlConfig().EventRecorder().Event(obj, corev1.EventTypeNormal, lib.Restored, "Pool servers are restored")
+	}
+}
+
+// getRouteIngress returns the Ingress, or the Route in openshift, from the informer cache.
+func getRouteIngress(ingKey string) runtime.Object {
+	namespace, name := utils.ExtractNamespaceObjectName(ingKey)
+	if utils.GetInformers().RouteInformer != nil {
+		if route, err := utils.GetInformers().RouteInformer.Lister().Routes(namespace).Get(name); err == nil {
+			return route
+		}
+		return nil
+	}
+	if utils.GetInformers().IngressInformer != nil {
+		if ingress, err := utils.GetInformers().IngressInformer.Lister().Ingresses(namespace).Get(name); err == nil {
+			return ingress
+		}
+	}
+	return nil
+}
+
+// getRouteIngressMaintenance returns the drained backend Services of the Ingress or Route, from the
+// ako.vmware.com/maintenance annotation.
+func getRouteIngressMaintenance(ingKey string) map[string]string {
+	maintenance := make(map[string]string)
+	obj := getRouteIngress(ingKey)
+	if obj == nil {
+		return maintenance
+	}
+	objMeta, _ := meta.Accessor(obj)
+	if value, ok := objMeta.GetAnnotations()[lib.MaintenanceStatusAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &maintenance); err != nil {
+			utils.AviLog.Warnf("Invalid %s annotation in %s: %v", lib.MaintenanceStatusAnnotation, ingKey, err)
+		}
+	}
+	return maintenance
+}
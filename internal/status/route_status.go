@@ -128,6 +128,7 @@ func (l *leader) DeleteRouteIngressStatus(options []UpdateOptions, isVSDelete bo
 
 func (l *leader) UpdateRouteStatus(options []UpdateOptions, bulk bool) {
 	var err error
+	options = skipSyncErrors(options, updateRouteSyncError)
 	routesToUpdate, updateRouteOptions := ParseOptionsFromMetadata(options, bulk)
 
 	// routeMap: {ns/Route: routeObj}
@@ -164,6 +165,13 @@ func (l *leader) UpdateRouteStatus(options []UpdateOptions, bulk bool) {
 	return
 }
 
+// updateRouteSyncError reports the error of the Avi Controller for the virtualservice, in the events of the Routes.
+func updateRouteSyncError(option UpdateOptions) {
+	for _, route := range getRoutes(syncErrorObjects(option.ServiceMetadata), false) {
+		recordSyncEvent(route, utils.OshiftRoute, option.Key, option.VSName, lib.Rejected, rejectedMessage(option), false)
+	}
+}
+
 func getRoutes(routeNSNames []string, bulk bool, retryNum ...int) map[string]*routev1.Route {
 	retry := 0
 	routeMap := make(map[string]*routev1.Route)
@@ -412,6 +420,9 @@ func updateRouteObject(mRoute *routev1.Route, updateOption UpdateOptions, retryN
 		utils.AviLog.Debugf("key: %s, msg: No changes detected in route status. old: %+v new: %+v",
 			key, oldRouteStatus.Ingress, mRoute.Status.Ingress)
 	}
+	if err == nil {
+		recordSyncEvent(mRoute, utils.OshiftRoute, key, updateOption.VSName, lib.Programmed, programmedMessage(updateOption), !sameStatus)
+	}
 	err = updateRouteAnnotations(updatedRoute, updateOption, mRoute, key, routeHost)
 
 	return err
@@ -579,6 +590,7 @@ func deleteRouteObject(option UpdateOptions, key string, isVSDelete bool, retryN
 			return errors.New("DeleteRouteStatus retried 3 times, aborting")
 		}
 	}
+	resetSyncState(utils.OshiftRoute, option.ServiceMetadata.Namespace, option.ServiceMetadata.IngressName, option.VSName)
 
 	mRoute, err := utils.GetInformers().RouteInformer.Lister().Routes(option.ServiceMetadata.Namespace).Get(option.ServiceMetadata.IngressName)
 	if err != nil {
@@ -42,6 +42,8 @@ type StatusPublisher interface {
 	UpdateNPLAnnotation(key, namespace, name string)
 	DeleteNPLAnnotation(key, namespace, name string)
 
+	UpdateMaintenanceStatus(options []UpdateOptions)
+
 	UpdateMultiClusterIngressStatusAndAnnotation(key string, option *UpdateOptions)
 	DeleteMultiClusterIngressStatusAndAnnotation(key string, option *UpdateOptions)
 
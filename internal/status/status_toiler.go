@@ -15,7 +15,10 @@
 package status
 
 import (
+	"time"
+
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/tracing"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 )
 
@@ -27,22 +30,35 @@ type StatusOptions struct {
 	Namespace string
 	Key       string
 	Options   *UpdateOptions
+	// span of the stage which published the status update, set when tracing is enabled
+	ParentSpan tracing.SpanContext
 }
 
 func PublishToStatusQueue(key string, statusOption StatusOptions) {
 	statusQueue := utils.SharedWorkQueue().GetQueueByName(utils.StatusQueue)
 	bkt := utils.Bkt(key, statusQueue.NumWorkers)
 	lib.IncrementQueueCounter(utils.StatusQueue)
+	if tracing.Enabled() {
+		statusOption.ParentSpan = tracing.ActiveSpanContext(lib.RestStage, statusOption.Key)
+		if !statusOption.ParentSpan.IsValid() {
+			statusOption.ParentSpan = tracing.ActiveSpanContext(lib.GraphStage, statusOption.Key)
+		}
+	}
 	statusQueue.Workqueue[bkt].AddRateLimited(statusOption)
 }
 
 func (l *leader) DequeueStatus(objIntf interface{}) error {
 	obj, ok := objIntf.(StatusOptions)
 	lib.DecrementQueueCounter(utils.StatusQueue)
+	defer lib.ObserveSyncStageDuration(lib.StatusStage, time.Now())
 	if !ok {
 		utils.AviLog.Warnf("Object is not of type StatusOptions, %T", objIntf)
 		return nil
 	}
+	span := tracing.StartSpanWithParent(obj.ParentSpan, lib.StatusStage, obj.Key)
+	span.SetAttribute("object_type", obj.ObjType)
+	span.SetAttribute("operation", obj.Op)
+	defer span.End()
 	utils.AviLog.Infof("key: %s, msg: start status layer sync.", obj.Key)
 	switch obj.ObjType {
 	case utils.L4LBService:
@@ -81,6 +97,10 @@ func (l *leader) DequeueStatus(objIntf interface{}) error {
 		} else if obj.Op == lib.DeleteStatus {
 			l.DeleteNPLAnnotation(obj.Key, obj.Namespace, obj.ObjName)
 		}
+	case lib.PoolMaintenance:
+		if obj.Op == lib.UpdateStatus {
+			l.UpdateMaintenanceStatus([]UpdateOptions{*obj.Options})
+		}
 	case lib.MultiClusterIngress:
 		if obj.Op == lib.UpdateStatus {
 			l.UpdateMultiClusterIngressStatusAndAnnotation(obj.Key, obj.Options)
@@ -25,6 +25,7 @@ import (
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -51,8 +52,17 @@ func (l *leader) UpdateL4LBStatus(options []UpdateOptions, bulk bool) {
 		key, svcMetadata := option.Key, option.ServiceMetadata
 		if service := serviceMap[option.IngSvc]; service != nil {
 			oldServiceStatus := service.Status.LoadBalancer.DeepCopy()
+			if option.Message != "" {
+				updateL4LBProgrammedCondition(service, option, lib.Rejected, rejectedMessage(option))
+				skipDelete[option.IngSvc] = true
+				continue
+			}
 			if len(option.Vip) == 0 {
-				// nothing to do here
+				// the virtualservice is accepted by the Avi Controller, but the VIP is not allocated yet
+				if len(service.Status.LoadBalancer.Ingress) == 0 {
+					updateL4LBProgrammedCondition(service, option, lib.Accepted,
+						fmt.Sprintf("Virtualservice %s is accepted by the Avi Controller, waiting for the VIP", option.VSName))
+				}
 				continue
 			}
 
@@ -68,9 +78,13 @@ func (l *leader) UpdateL4LBStatus(options []UpdateOptions, bulk bool) {
 			}
 
 			sameStatus, _, _ := compareLBStatus(oldServiceStatus, &service.Status.LoadBalancer)
+			// a Service not programmed before the upgrade of AKO does not have the Programmed condition
+			notProgrammed := !meta.IsStatusConditionTrue(service.Status.Conditions, lib.ProgrammedCondition) &&
+				meta.FindStatusCondition(service.Status.Conditions, lib.ProgrammedCondition) != nil
+			conditionChanged := setProgrammedCondition(service, lib.Programmed, programmedMessage(option))
 			var updatedSvc *corev1.Service
 			var err error
-			if !sameStatus {
+			if !sameStatus || conditionChanged {
 				patchPayload, _ := json.Marshal(map[string]interface{}{
 					"status": service.Status,
 				})
@@ -79,11 +93,14 @@ func (l *leader) UpdateL4LBStatus(options []UpdateOptions, bulk bool) {
 				if err != nil {
 					utils.AviLog.Errorf("key: %s, msg: there was an error in updating the loadbalancer status: %v", key, err)
 				} else {
-					if len(service.Status.LoadBalancer.Ingress) > 0 {
-						lib.AKOControlConfig().EventRecorder().Eventf(service, corev1.EventTypeNormal, lib.Synced, "Added virtualservice %s for %s", option.VSName, service.Name)
-					} else {
-						lib.AKOControlConfig().EventRecorder().Eventf(service, corev1.EventTypeNormal, lib.Removed, "Removed virtualservice for %s", service.Name)
+					if !sameStatus {
+						if len(service.Status.LoadBalancer.Ingress) > 0 {
+							lib.AKOControlConfig().EventRecorder().Eventf(service, corev1.EventTypeNormal, lib.Synced, "Added virtualservice %s for %s", option.VSName, service.Name)
+						} else {
+							lib.AKOControlConfig().EventRecorder().Eventf(service, corev1.EventTypeNormal, lib.Removed, "Removed virtualservice for %s", service.Name)
+						}
 					}
+					recordSyncEvent(service, utils.L4LBService, key, option.VSName, lib.Programmed, programmedMessage(option), !sameStatus || notProgrammed)
 					utils.AviLog.Infof("key: %s, msg: Successfully updated the status of serviceLB: %s old: %+v new %+v",
 						key, option.IngSvc, oldServiceStatus.Ingress, service.Status.LoadBalancer.Ingress)
 				}
@@ -118,6 +135,26 @@ func (l *leader) UpdateL4LBStatus(options []UpdateOptions, bulk bool) {
 	}
 }
 
+// updateL4LBProgrammedCondition sets the Programmed condition of the Service to False with the reason, when
+// the virtualservice is rejected by the Avi Controller or the VIP is not allocated yet.
+func updateL4LBProgrammedCondition(service *corev1.Service, option UpdateOptions, reason, message string) {
+	key := option.Key
+	if setProgrammedCondition(service, reason, message) {
+		patchPayload, _ := json.Marshal(map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": service.Status.Conditions,
+			},
+		})
+		_, err := utils.GetInformers().ClientSet.CoreV1().Services(service.Namespace).Patch(context.TODO(), service.Name, types.MergePatchType, patchPayload, metav1.PatchOptions{}, "status")
+		if err != nil {
+			utils.AviLog.Errorf("key: %s, msg: there was an error in updating the %s condition of serviceLB %s/%s: %v",
+				key, lib.ProgrammedCondition, service.Namespace, service.Name, err)
+			return
+		}
+	}
+	recordSyncEvent(service, utils.L4LBService, key, option.VSName, reason, message, true)
+}
+
 func updateSvcAnnotationsWithVSUUID(svc *corev1.Service, updateOption UpdateOptions, oldSvc *corev1.Service) error {
 	if updateOption.VirtualServiceUUID == "" {
 		utils.AviLog.Debugf("key: %s, msg: VirtualServiceUUID is empty, not updating the VS annotations for service %s/%s", updateOption.Key, svc.Namespace, svc.Name)
@@ -200,9 +237,13 @@ func (l *leader) DeleteL4LBStatus(svc_mdata_obj lib.ServiceMetadataObj, vsName,
 		patchPayload, _ := json.Marshal(map[string]interface{}{
 			"status": nil,
 		})
+		if len(serviceNSName) == 2 {
+			resetSyncState(utils.L4LBService, serviceNSName[0], serviceNSName[1], "")
+		}
 
 		if serviceObj := serviceMap[service]; serviceObj != nil && (serviceObj.Status.LoadBalancer.Ingress == nil ||
-			(serviceObj.Status.LoadBalancer.Ingress != nil && len(serviceObj.Status.LoadBalancer.Ingress) == 0)) {
+			(serviceObj.Status.LoadBalancer.Ingress != nil && len(serviceObj.Status.LoadBalancer.Ingress) == 0)) &&
+			meta.FindStatusCondition(serviceObj.Status.Conditions, lib.ProgrammedCondition) == nil {
 			continue
 		}
 
@@ -0,0 +1,156 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type syncState struct {
+	reason  string
+	message string
+}
+
+// syncStates holds the last sync state reported in the events, for the virtualservices of the Ingresses,
+// Routes and Services. key: kind/namespace/name/vsName
+var syncStates = make(map[string]syncState)
+var syncStatesLock sync.Mutex
+
+func syncStateKey(kind, namespace, name, vsName string) string {
+	return kind + "/" + namespace + "/" + name + "/" + vsName
+}
+
+// recordSyncEvent emits the Accepted, Rejected or Programmed event for the virtualservice of the object, on a
+// transition of the sync state. The Programmed state is reported only if the status of the object is changed,
+// or if the virtualservice was not programmed before, so that the status sync during bootup does not emit
+// an event for every object.
+func recordSyncEvent(obj runtime.Object, kind, key, vsName, reason, message string, statusChanged bool) {
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	stateKey := syncStateKey(kind, objMeta.GetNamespace(), objMeta.GetName(), vsName)
+	state := syncState{reason: reason, message: message}
+
+	syncStatesLock.Lock()
+	prevState, found := syncStates[stateKey]
+	syncStates[stateKey] = state
+	syncStatesLock.Unlock()
+
+	if found && prevState == state {
+		return
+	}
+	if reason == lib.Programmed && !statusChanged && (!found || prevState.reason == lib.Programmed) {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if reason == lib.Rejected {
+		eventType = corev1.EventTypeWarning
+	}
+	lib.AKOControlConfig().EventRecorder().Event(obj, eventType, reason, message)
+	utils.AviLog.Infof("key: %s, msg: %s %s/%s %s: %s", key, kind, objMeta.GetNamespace(), objMeta.GetName(), reason, message)
+}
+
+// resetSyncState removes the sync state of the virtualservice of the object, or of all the virtualservices of
+// the object if vsName is empty.
+func resetSyncState(kind, namespace, name, vsName string) {
+	syncStatesLock.Lock()
+	defer syncStatesLock.Unlock()
+	if vsName != "" {
+		delete(syncStates, syncStateKey(kind, namespace, name, vsName))
+		return
+	}
+	prefix := syncStateKey(kind, namespace, name, "")
+	for stateKey := range syncStates {
+		if strings.HasPrefix(stateKey, prefix) {
+			delete(syncStates, stateKey)
+		}
+	}
+}
+
+// skipSyncErrors reports the options carrying an error of the Avi Controller with onError, and returns the
+// rest of the options.
+func skipSyncErrors(options []UpdateOptions, onError func(UpdateOptions)) []UpdateOptions {
+	var updateOptions []UpdateOptions
+	for _, option := range options {
+		if option.Message != "" {
+			onError(option)
+			continue
+		}
+		updateOptions = append(updateOptions, option)
+	}
+	return updateOptions
+}
+
+// syncErrorObjects returns the namespace/name of the Ingresses or Routes in the service metadata of the
+// virtualservice, which failed to sync.
+func syncErrorObjects(svcMetadata lib.ServiceMetadataObj) []string {
+	if len(svcMetadata.NamespaceIngressName) > 0 {
+		return svcMetadata.NamespaceIngressName
+	}
+	if svcMetadata.Namespace != "" && svcMetadata.IngressName != "" {
+		return []string{svcMetadata.Namespace + "/" + svcMetadata.IngressName}
+	}
+	return nil
+}
+
+func rejectedMessage(option UpdateOptions) string {
+	return fmt.Sprintf("Virtualservice %s is rejected by the Avi Controller: %s", option.VSName, option.Message)
+}
+
+func programmedMessage(option UpdateOptions) string {
+	return fmt.Sprintf("Virtualservice %s is programmed with VIP %s", option.VSName, strings.Join(option.Vip, ","))
+}
+
+// setProgrammedCondition sets the ako.vmware.com/Programmed condition in the status of the Service, and returns
+// true if the condition is changed.
+func setProgrammedCondition(service *corev1.Service, reason, message string) bool {
+	conditionStatus := metav1.ConditionFalse
+	if reason == lib.Programmed {
+		conditionStatus = metav1.ConditionTrue
+	}
+	return meta.SetStatusCondition(&service.Status.Conditions, metav1.Condition{
+		Type:               lib.ProgrammedCondition,
+		Status:             conditionStatus,
+		ObservedGeneration: service.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setMaintenanceCondition sets the ako.vmware.com/Maintenance condition in the status of the Service, while its
+// pool servers are drained, and removes the condition otherwise. It returns true if the conditions are changed.
+func setMaintenanceCondition(service *corev1.Service, message string) bool {
+	if message == "" {
+		return meta.RemoveStatusCondition(&service.Status.Conditions, lib.MaintenanceCondition)
+	}
+	return meta.SetStatusCondition(&service.Status.Conditions, metav1.Condition{
+		Type:               lib.MaintenanceCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: service.Generation,
+		Reason:             lib.Draining,
+		Message:            message,
+	})
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+const (
+	defaultServiceName = "ako"
+	protocolGRPC       = "grpc"
+)
+
+// InitFromEnv enables tracing with the OTLP exporter, if the standard OpenTelemetry environment variables
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT or OTEL_EXPORTER_OTLP_ENDPOINT are set. The traces are exported over
+// OTLP/HTTP, or OTLP/gRPC if OTEL_EXPORTER_OTLP_TRACES_PROTOCOL or OTEL_EXPORTER_OTLP_PROTOCOL is grpc.
+// The other OTEL_EXPORTER_OTLP_* variables, e.g. the headers and the timeout, are read by the exporter.
+func InitFromEnv() {
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	if endpoint == "" {
+		if endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); endpoint == "" {
+			return
+		}
+	}
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	var exporter sdktrace.SpanExporter
+	var err error
+	if protocol == protocolGRPC {
+		exporter, err = otlptracegrpc.New(context.Background())
+	} else {
+		exporter, err = otlptracehttp.New(context.Background())
+	}
+	if err != nil {
+		utils.AviLog.Warnf("Error in creating the OTLP trace exporter for %s, tracing is disabled: %v", endpoint, err)
+		return
+	}
+	// the service name is overridden by OTEL_SERVICE_NAME or OTEL_RESOURCE_ATTRIBUTES
+	res, err := resource.Merge(
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(defaultServiceName)),
+		resource.Environment(),
+	)
+	if err != nil {
+		utils.AviLog.Warnf("Error in reading the OpenTelemetry resource attributes: %v", err)
+		res = resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(defaultServiceName))
+	}
+	utils.AviLog.Infof("Exporting traces to %s", endpoint)
+	SetTracerProvider(sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	))
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+// Package tracing traces a key through the stages of the sync pipeline with OpenTelemetry. The keys are
+// passed between the stages through the workqueues, without a context, hence the span of a stage is
+// tracked by the stage and the key. When a stage publishes a key to the next stage, the span of the stage
+// becomes the parent of the span of the next stage for the published key. Tracing is disabled by default,
+// and all the functions are no-op until a tracer provider is set.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+const (
+	tracerName       = "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes"
+	shutdownTimeout  = 10 * time.Second
+	pendingParentTTL = 10 * time.Minute
+)
+
+// SpanContext identifies a span, to be used as the parent of the spans of the next stage. Unlike
+// trace.SpanContext, it is comparable, hence can be carried by the objects queued in the workqueues.
+type SpanContext struct {
+	TraceID    trace.TraceID
+	SpanID     trace.SpanID
+	TraceFlags trace.TraceFlags
+}
+
+func (c SpanContext) IsValid() bool {
+	return c.TraceID.IsValid() && c.SpanID.IsValid()
+}
+
+type Span struct {
+	span      trace.Span
+	activeKey string
+}
+
+// parent of the span of a stage for a published key, dropped if the key is not processed by the stage
+// within pendingParentTTL, e.g. when the key is not synced by the stage.
+type pendingParent struct {
+	parent    SpanContext
+	published time.Time
+}
+
+var provider *sdktrace.TracerProvider
+var tracer trace.Tracer
+var tracerLock sync.RWMutex
+
+// span of a stage being processed for a key, key: stage/key
+var activeSpans = make(map[string]*Span)
+
+// key: stage/key
+var pendingParents = make(map[string]pendingParent)
+var lastPrune time.Time
+
+// SetTracerProvider enables tracing with the tracer provider. A nil tracer provider disables tracing.
+// The previous tracer provider is not shut down.
+func SetTracerProvider(tp *sdktrace.TracerProvider) {
+	tracerLock.Lock()
+	defer tracerLock.Unlock()
+	provider = tp
+	tracer = nil
+	if tp != nil {
+		tracer = tp.Tracer(tracerName)
+	}
+	activeSpans = make(map[string]*Span)
+	pendingParents = make(map[string]pendingParent)
+}
+
+func Enabled() bool {
+	tracerLock.RLock()
+	defer tracerLock.RUnlock()
+	return tracer != nil
+}
+
+// Shutdown exports the pending spans and disables tracing.
+func Shutdown() {
+	tracerLock.Lock()
+	tp := provider
+	provider, tracer = nil, nil
+	tracerLock.Unlock()
+	if tp == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := tp.Shutdown(ctx); err != nil {
+		utils.AviLog.Warnf("Error in shutting down the tracer provider: %v", err)
+	}
+}
+
+func stageKey(stage, key string) string {
+	return stage + "/" + key
+}
+
+// StartSpan starts the span of a stage for the key. The span is a child of the span of the stage which
+// published the key, else it is the root span of a new trace.
+func StartSpan(stage, key string) *Span {
+	if !Enabled() {
+		return nil
+	}
+	tracerLock.Lock()
+	pending := pendingParents[stageKey(stage, key)]
+	delete(pendingParents, stageKey(stage, key))
+	tracerLock.Unlock()
+	return StartSpanWithParent(pending.parent, stage, key)
+}
+
+// StartSpanWithParent starts the span of a stage for the key, with the parent carried by the queued object.
+func StartSpanWithParent(parent SpanContext, stage, key string) *Span {
+	span := startSpan(parent, stage, key)
+	if span == nil {
+		return nil
+	}
+	span.activeKey = stageKey(stage, key)
+	tracerLock.Lock()
+	activeSpans[span.activeKey] = span
+	tracerLock.Unlock()
+	return span
+}
+
+// StartChildSpan starts a span under the span of the stage being processed for the key, e.g. for the
+// rest calls of a model. A root span is started if the stage is not being traced for the key.
+func StartChildSpan(stage, key, name string) *Span {
+	return startSpan(ActiveSpanContext(stage, key), name, key)
+}
+
+func startSpan(parent SpanContext, name, key string) *Span {
+	tracerLock.RLock()
+	t := tracer
+	tracerLock.RUnlock()
+	if t == nil {
+		return nil
+	}
+	ctx := context.Background()
+	if parent.IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    parent.TraceID,
+			SpanID:     parent.SpanID,
+			TraceFlags: parent.TraceFlags,
+		}))
+	}
+	_, span := t.Start(ctx, name, trace.WithAttributes(attribute.String("key", key)))
+	return &Span{span: span}
+}
+
+// ActiveSpanContext returns the span of the stage being processed for the key.
+func ActiveSpanContext(stage, key string) SpanContext {
+	tracerLock.RLock()
+	defer tracerLock.RUnlock()
+	if span, ok := activeSpans[stageKey(stage, key)]; ok {
+		return span.SpanContext()
+	}
+	return SpanContext{}
+}
+
+// Publish sets the span of the stage being processed for the key, as the parent of the span of the
+// next stage for the published key.
+func Publish(fromStage, fromKey, toStage, toKey string) {
+	if !Enabled() {
+		return
+	}
+	parent := ActiveSpanContext(fromStage, fromKey)
+	if !parent.IsValid() {
+		return
+	}
+	now := time.Now()
+	tracerLock.Lock()
+	defer tracerLock.Unlock()
+	if now.Sub(lastPrune) > pendingParentTTL {
+		for k, pending := range pendingParents {
+			if now.Sub(pending.published) > pendingParentTTL {
+				delete(pendingParents, k)
+			}
+		}
+		lastPrune = now
+	}
+	pendingParents[stageKey(toStage, toKey)] = pendingParent{parent: parent, published: now}
+}
+
+// PendingParentsCount returns the number of published keys waiting to be processed by the next stage.
+func PendingParentsCount() int {
+	tracerLock.RLock()
+	defer tracerLock.RUnlock()
+	return len(pendingParents)
+}
+
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	switch v := value.(type) {
+	case string:
+		s.span.SetAttributes(attribute.String(key, v))
+	case int:
+		s.span.SetAttributes(attribute.Int(key, v))
+	case int64:
+		s.span.SetAttributes(attribute.Int64(key, v))
+	case bool:
+		s.span.SetAttributes(attribute.Bool(key, v))
+	case float64:
+		s.span.SetAttributes(attribute.Float64(key, v))
+	default:
+		s.span.SetAttributes(attribute.String(key, fmt.Sprint(v)))
+	}
+}
+
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	sc := s.span.SpanContext()
+	return SpanContext{TraceID: sc.TraceID(), SpanID: sc.SpanID(), TraceFlags: sc.TraceFlags()}
+}
+
+// End ends the span, which is exported by the span processor of the tracer provider.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	tracerLock.Lock()
+	if s.activeKey != "" && activeSpans[s.activeKey] == s {
+		delete(activeSpans, s.activeKey)
+	}
+	tracerLock.Unlock()
+	s.span.End()
+}
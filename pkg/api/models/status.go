@@ -28,8 +28,9 @@ import (
 
 // AviApiRestStatus holds status details for AKO/AMKO <-> AVI connection
 type AviApiRestStatus struct {
-	ConnectionStatus string            `json:"connection_status"`
-	Errors           []RestStatusError `json:"errors"`
+	ConnectionStatus   string            `json:"connection_status"`
+	ControllerEndpoint string            `json:"controller_endpoint,omitempty"`
+	Errors             []RestStatusError `json:"errors"`
 }
 
 type RestStatusError struct {
@@ -80,6 +81,22 @@ func (a *StatusModel) ApiOperationMap(prometheusEnavbled bool, reg *prometheus.R
 	return operationMapList
 }
 
+func (a *StatusModel) GetAviApiConnectionStatus() string {
+	a.statusLock.RLock()
+	defer a.statusLock.RUnlock()
+	return a.AviApi.ConnectionStatus
+}
+
+// UpdateAviApiControllerEndpoint updates the Avi Controller endpoint which AKO/AMKO is connected to.
+func (a *StatusModel) UpdateAviApiControllerEndpoint(endpoint string) {
+	if a == nil {
+		return
+	}
+	a.statusLock.Lock()
+	defer a.statusLock.Unlock()
+	a.AviApi.ControllerEndpoint = endpoint
+}
+
 // utility function to be used by modules to update RestStatus.AviApi
 func (a *StatusModel) UpdateAviApiRestStatus(connectionStatus string, err error) {
 	// In case of avi infra component we won't use the API server, hence the model won't be initialized.
@@ -35,6 +35,14 @@ type L4RuleSpec struct {
 	SslProfileRef            *string              `json:"sslProfileRef,omitempty"`
 	VsDatascriptRefs         []string             `json:"vsDatascriptRefs,omitempty"`
 	RevokeVipRoute           *bool                `json:"revokeVipRoute,omitempty"`
+	Maintenance              *Maintenance         `json:"maintenance,omitempty"`
+}
+
+// Maintenance drains all the pool servers of the virtual service, without deleting its configuration.
+// The existing connections to the drained servers are retained for DrainTimeout minutes.
+type Maintenance struct {
+	Enabled      bool   `json:"enabled,omitempty"`
+	DrainTimeout *int32 `json:"drainTimeout,omitempty"`
 }
 
 type L4RuleStatus struct {
@@ -38,6 +38,14 @@ type AviInfraSettingSpec struct {
 	SeGroup     AviInfraSettingSeGroup `json:"seGroup,omitempty"`
 	L7Settings  AviInfraL7Settings     `json:"l7Settings,omitempty"`
 	NSXSettings AviInfraNSXSettings    `json:"nsxSettings,omitempty"`
+	FQDNClaims  []AviInfraFQDNClaim    `json:"fqdnClaims,omitempty"`
+}
+
+// AviInfraFQDNClaim restricts the hostnames matching the domains to the namespaces. A domain is either an
+// FQDN, or a wildcard like *.team-a.example.com, which matches the subdomains of team-a.example.com.
+type AviInfraFQDNClaim struct {
+	Domains    []string `json:"domains,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 type AviInfraNSXSettings struct {
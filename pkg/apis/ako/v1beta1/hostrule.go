@@ -79,6 +79,14 @@ type HostRuleVirtualHost struct {
 	L7Rule                string                   `json:"l7Rule,omitempty"`
 	UseRegex              bool                     `json:"useRegex,omitempty"`
 	ApplicationRootPath   string                   `json:"applicationRootPath,omitempty"`
+	Maintenance           *HostRuleMaintenance     `json:"maintenance,omitempty"`
+}
+
+// HostRuleMaintenance drains all the pool servers of the virtualhost, without deleting its configuration.
+// The existing connections to the drained servers are retained for DrainTimeout minutes.
+type HostRuleMaintenance struct {
+	Enabled      bool   `json:"enabled,omitempty"`
+	DrainTimeout *int32 `json:"drainTimeout,omitempty"`
 }
 
 // HostRuleTCPSettings allows for customizing TCP settings
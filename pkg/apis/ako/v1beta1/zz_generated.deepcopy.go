@@ -25,6 +25,32 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AviInfraFQDNClaim) DeepCopyInto(out *AviInfraFQDNClaim) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AviInfraFQDNClaim.
+func (in *AviInfraFQDNClaim) DeepCopy() *AviInfraFQDNClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(AviInfraFQDNClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AviInfraL7Settings) DeepCopyInto(out *AviInfraL7Settings) {
 	*out = *in
@@ -248,6 +274,13 @@ func (in *AviInfraSettingSpec) DeepCopyInto(out *AviInfraSettingSpec) {
 	out.SeGroup = in.SeGroup
 	out.L7Settings = in.L7Settings
 	in.NSXSettings.DeepCopyInto(&out.NSXSettings)
+	if in.FQDNClaims != nil {
+		in, out := &in.FQDNClaims, &out.FQDNClaims
+		*out = make([]AviInfraFQDNClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -603,6 +636,27 @@ func (in *HostRuleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostRuleMaintenance) DeepCopyInto(out *HostRuleMaintenance) {
+	*out = *in
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostRuleMaintenance.
+func (in *HostRuleMaintenance) DeepCopy() *HostRuleMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(HostRuleMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostRuleSSLKeyCertificate) DeepCopyInto(out *HostRuleSSLKeyCertificate) {
 	*out = *in
@@ -759,6 +813,11 @@ func (in *HostRuleVirtualHost) DeepCopyInto(out *HostRuleVirtualHost) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(HostRuleMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -98,6 +98,7 @@ const (
 	VCF_CLUSTER                   = "VCF_CLUSTER"
 	VPC_MODE                      = "VPC_MODE"
 	MCI_ENABLED                   = "MCI_ENABLED"
+	USE_MCS_SERVICE_IMPORT        = "USE_MCS_SERVICE_IMPORT"
 	USE_DEFAULT_SECRETS_ONLY      = "USE_DEFAULT_SECRETS_ONLY"
 	Namespace                     = "Namespace"
 	MaxAviVersion                 = "30.2.1"
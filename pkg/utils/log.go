@@ -121,6 +121,32 @@ func getPodName() string {
 
 var AviLog *AviLogger
 
+func newEncoderConfig() zapcore.EncoderConfig {
+	encoderCfg := zap.NewProductionEncoderConfig()
+	encoderCfg.EncodeLevel = zapcore.CapitalColorLevelEncoder // colored capital case LEVEL
+	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder        // format 2020-05-08T03:26:08.943+0530
+	encoderCfg.EncodeCaller = zapcore.ShortCallerEncoder      // caller format package_name/filename.go
+	return encoderCfg
+}
+
+func newConsoleLogger(file *os.File, atom zap.AtomicLevel) *AviLogger {
+	logger := zap.New(zapcore.NewCore(
+		zapcore.NewConsoleEncoder(newEncoderConfig()),
+		zapcore.Lock(file),
+		atom,
+	))
+
+	logger = logger.WithOptions(zap.AddCaller(), zap.AddCallerSkip(1))
+	sugar := logger.Sugar()
+	return &AviLogger{sugar, logger, atom}
+}
+
+// SetConsoleOutput changes the file the console logger writes to, e.g. to os.Stderr for command
+// line tools which print their result on os.Stdout. The log level is retained.
+func SetConsoleOutput(file *os.File) {
+	*AviLog = *newConsoleLogger(file, AviLog.atom)
+}
+
 func init() {
 	atom := zap.NewAtomicLevel()
 	// default level set to Info
@@ -132,21 +158,10 @@ func init() {
 
 	usePVC := os.Getenv("USE_PVC")
 
-	encoderCfg := zap.NewProductionEncoderConfig()
-	encoderCfg.EncodeLevel = zapcore.CapitalColorLevelEncoder // colored capital case LEVEL
-	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder        // format 2020-05-08T03:26:08.943+0530
-	encoderCfg.EncodeCaller = zapcore.ShortCallerEncoder      // caller format package_name/filename.go
+	encoderCfg := newEncoderConfig()
 
 	if usePVC != "true" {
-		logger := zap.New(zapcore.NewCore(
-			zapcore.NewConsoleEncoder(encoderCfg),
-			zapcore.Lock(os.Stdout),
-			atom,
-		))
-
-		logger = logger.WithOptions(zap.AddCaller(), zap.AddCallerSkip(1))
-		sugar := logger.Sugar()
-		AviLog = &AviLogger{sugar, logger, atom}
+		AviLog = newConsoleLogger(os.Stdout, atom)
 		return
 	}
 
@@ -44,6 +44,9 @@ func SharedWorkQueue(queueParams ...*WorkerQueue) *WorkQueueWrapper {
 		if len(queueParams) != 0 {
 			for _, queue := range queueParams {
 				workqueue := NewWorkQueue(queue.NumWorkers, queue.WorkqueueName, queue.SlowSyncTime)
+				if queue.TrackEnqueueTime {
+					workqueue.EnableEnqueueTimeTracking()
+				}
 				queueInstance.queueCollection[queue.WorkqueueName] = workqueue
 			}
 		} else {
@@ -65,6 +68,54 @@ type WorkerQueue struct {
 	workerId      uint32
 	SyncFunc      func(interface{}, *sync.WaitGroup) error
 	SlowSyncTime  int
+	// TrackEnqueueTime records the time at which the keys are added to the queue, until they are popped
+	// by PopEnqueueTime.
+	TrackEnqueueTime bool
+	enqueueTimes     sync.Map
+}
+
+// timedQueue records the time at which an item is added to the queue. An item added again before it is
+// popped keeps the time at which it was first added.
+type timedQueue struct {
+	workqueue.RateLimitingInterface //nolint:staticcheck
+	enqueueTimes                    *sync.Map
+}
+
+func (q *timedQueue) Add(item interface{}) {
+	q.enqueueTimes.LoadOrStore(item, time.Now())
+	q.RateLimitingInterface.Add(item)
+}
+
+func (q *timedQueue) AddAfter(item interface{}, duration time.Duration) {
+	q.enqueueTimes.LoadOrStore(item, time.Now())
+	q.RateLimitingInterface.AddAfter(item, duration)
+}
+
+func (q *timedQueue) AddRateLimited(item interface{}) {
+	q.enqueueTimes.LoadOrStore(item, time.Now())
+	q.RateLimitingInterface.AddRateLimited(item)
+}
+
+// EnableEnqueueTimeTracking records the time at which the keys are added to the queue. It is called before the
+// keys are added to the queue.
+func (c *WorkerQueue) EnableEnqueueTimeTracking() {
+	c.TrackEnqueueTime = true
+	for i := range c.Workqueue {
+		c.Workqueue[i] = &timedQueue{RateLimitingInterface: c.Workqueue[i], enqueueTimes: &c.enqueueTimes}
+	}
+}
+
+// PopEnqueueTime returns the time at which the key was added to the queue, if the queue tracks the enqueue
+// time, and forgets it. It is called when the key is dequeued.
+func (c *WorkerQueue) PopEnqueueTime(key interface{}) (time.Time, bool) {
+	if c == nil || !c.TrackEnqueueTime {
+		return time.Time{}, false
+	}
+	enqueueTime, ok := c.enqueueTimes.LoadAndDelete(key)
+	if !ok {
+		return time.Time{}, false
+	}
+	return enqueueTime.(time.Time), true
 }
 
 func NewWorkQueue(num_workers uint32, workerQueueName string, slowSyncTime ...int) *WorkerQueue {
@@ -753,6 +753,17 @@ func IsMultiClusterIngressEnabled() bool {
 	return false
 }
 
+// UseMCSServiceImport returns true if the backends of the multi-cluster ingresses are to be resolved from
+// the upstream Multi-Cluster Services API (multicluster.x-k8s.io) ServiceImports, instead of the ServiceImports
+// of AKO which are written by AMKO.
+func UseMCSServiceImport() bool {
+	if !IsMultiClusterIngressEnabled() {
+		return false
+	}
+	ok, _ := strconv.ParseBool(os.Getenv(USE_MCS_SERVICE_IMPORT))
+	return ok
+}
+
 type Version struct {
 	subversions []int
 }
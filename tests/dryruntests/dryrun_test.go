@@ -0,0 +1,99 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package dryruntests
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/dryrun"
+)
+
+// TestDryRun runs in its own package, as dryrun.Run sets up the shared AKO instances.
+func TestDryRun(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	os.Setenv("CLUSTER_NAME", "dryrun")
+	os.Setenv("SHARD_VS_SIZE", "LARGE")
+
+	objs, err := dryrun.LoadManifests([]string{"testdata"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objs).To(gomega.HaveLen(7))
+
+	models, err := dryrun.Run(objs)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(models).To(gomega.HaveLen(2))
+
+	kinds := make(map[string][]string)
+	for _, model := range models {
+		g.Expect(model.Error).To(gomega.BeEmpty())
+		for _, obj := range model.Objects {
+			g.Expect(obj.Method).To(gomega.Equal("POST"))
+			g.Expect(obj.Tenant).To(gomega.Equal("admin"))
+			kinds[model.Name] = append(kinds[model.Name], obj.Model)
+		}
+	}
+	g.Expect(kinds).To(gomega.HaveKey("admin/dryrun--Shared-L7-6"))
+	g.Expect(kinds["admin/dryrun--Shared-L7-6"]).To(gomega.Equal([]string{"VsVip", "Pool", "PoolGroup", "VSDataScriptSet", "VirtualService"}))
+	g.Expect(kinds).To(gomega.HaveKey("admin/dryrun--Shared-L7-7"))
+	g.Expect(kinds["admin/dryrun--Shared-L7-7"]).To(gomega.ContainElements("SSLKeyAndCertificate", "HTTPPolicySet"))
+
+	// the HostRule is applied on the SNI child virtualservice.
+	sniVS := models[1].Objects[len(models[1].Objects)-1]
+	g.Expect(sniVS.Model).To(gomega.Equal("VirtualService"))
+	vsJSON, err := json.Marshal(sniVS.Object)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(vsJSON)).To(gomega.ContainSubstring(`"name":"dryrun--secure.example.com"`))
+	g.Expect(string(vsJSON)).To(gomega.ContainSubstring(`"waf_policy_ref":"/api/wafpolicy?name=some-waf"`))
+
+	// the pool servers are from the EndpointSlice.
+	poolJSON, err := json.Marshal(models[0].Objects[1].Object)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(poolJSON)).To(gomega.ContainSubstring(`"addr":"10.1.1.1"`))
+}
+
+func TestDecodeManifests(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	manifest := `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: svc
+    namespace: default
+- apiVersion: ako.vmware.com/v1beta1
+  kind: HTTPRule
+  metadata:
+    name: rule
+    namespace: default
+---
+---
+apiVersion: ako.vmware.com/v1alpha2
+kind: L7Rule
+metadata:
+  name: l7rule
+  namespace: default
+`
+	objs, err := dryrun.DecodeManifests([]byte(manifest))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objs).To(gomega.HaveLen(3))
+
+	_, err = dryrun.DecodeManifests([]byte("apiVersion: v1\nkind: Unknown\n"))
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -24,11 +24,13 @@ import (
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/rest"
 	utils "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
 
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -2324,3 +2326,215 @@ func TestMultiIngressSameHostDifferentNamespaceForEvh(t *testing.T) {
 	VerifyEvhVsCacheChildDeletion(t, g, cache.NamespaceName{Namespace: "admin", Name: modelName})
 	TearDownTestForIngress(t, svcName, modelName)
 }
+
+func TestCanaryBackendsIngressForEvh(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName, _ := GetModelName("foo.com", "default")
+	ingressName := objNameMap.GenerateName("foo-with-targets")
+	svcName := objNameMap.GenerateName("avisvc")
+	canarySvcName := objNameMap.GenerateName("avisvc-canary")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", canarySvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", canarySvcName, false, false, "1.1.2")
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingressName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"host": "foo.com", "path": "/foo", "serviceName": "` + canarySvcName + `", "weight": 25}]`,
+	})
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	var aviModel interface{}
+	g.Eventually(func() int {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes) != 1 || len(nodes[0].EvhNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].EvhNodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(2))
+
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].EvhNodes[0].PoolGroupRefs).To(gomega.HaveLen(1))
+	var ratios []int
+	for _, member := range nodes[0].EvhNodes[0].PoolGroupRefs[0].Members {
+		ratios = append(ratios, int(*member.Ratio))
+	}
+	sort.Ints(ratios)
+	g.Expect(ratios).To(gomega.Equal([]int{25, 75}))
+
+	// the canary pool is removed with the annotation
+	ingrFake.SetAnnotations(nil)
+	ingrFake.ResourceVersion = "2"
+	_, err = KubeClient.NetworkingV1().Ingresses("default").Update(context.TODO(), ingrFake, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("error in updating Ingress: %v", err)
+	}
+	g.Eventually(func() int {
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].EvhNodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(1))
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].EvhNodes[0].PoolGroupRefs[0].Members).To(gomega.HaveLen(1))
+	g.Expect(*nodes[0].EvhNodes[0].PoolGroupRefs[0].Members[0].Ratio).To(gomega.Equal(uint32(100)))
+
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingressName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+	VerifyEvhPoolDeletion(t, g, aviModel, 0)
+	VerifyEvhIngressDeletion(t, g, aviModel, 0)
+	integrationtest.DelSVC(t, "default", canarySvcName)
+	integrationtest.DelEPS(t, "default", canarySvcName)
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
+func TestDefaultBackendIngressForEvh(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName, _ := GetModelName("foo.com", "default")
+	rootIngressName := objNameMap.GenerateName("foo-with-targets")
+	ingressName := objNameMap.GenerateName("foo-with-targets")
+	svcName := objNameMap.GenerateName("avisvc")
+	defaultSvcName := objNameMap.GenerateName("avisvc-default")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", defaultSvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", defaultSvcName, false, false, "1.1.3")
+	var aviModel interface{}
+	defer func() {
+		KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), rootIngressName, metav1.DeleteOptions{})
+		if err := KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingressName, metav1.DeleteOptions{}); err != nil {
+			t.Fatalf("Couldn't DELETE the Ingress %v", err)
+		}
+		if aviModel != nil {
+			VerifyEvhPoolDeletion(t, g, aviModel, 0)
+			VerifyEvhIngressDeletion(t, g, aviModel, 0)
+		}
+		integrationtest.DelSVC(t, "default", defaultSvcName)
+		integrationtest.DelEPS(t, "default", defaultSvcName)
+		TearDownTestForIngress(t, svcName, modelName)
+	}()
+
+	// two ingresses of the same host, one with the / path, and the other with only the default backend
+	rootIngrFake := (integrationtest.FakeIngress{
+		Name:        rootIngressName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Paths:       []string{"/"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	if _, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), rootIngrFake, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingressName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	ingrFake.Spec.Rules[0].HTTP = nil
+	ingrFake.Spec.DefaultBackend = &networking.IngressBackend{Service: &networking.IngressServiceBackend{
+		Name: defaultSvcName,
+		Port: networking.ServiceBackendPort{Number: 8080},
+	}}
+	if _, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	g.Eventually(func() int {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes) != 1 || len(nodes[0].EvhNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].EvhNodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(2))
+
+	// the rules of the host are in the HTTP policy set of the namespace, where the rule without a path, of the
+	// default backend, is matched after the rules with a path
+	evhNode := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()[0].EvhNodes[0]
+	g.Expect(evhNode.HttpPolicyRefs).To(gomega.HaveLen(1))
+	g.Expect(evhNode.HttpPolicyRefs[0].HppMap).To(gomega.HaveLen(2))
+	var defaultPaths int
+	for _, hppMap := range evhNode.HttpPolicyRefs[0].HppMap {
+		if len(hppMap.Path) == 0 {
+			g.Expect(hppMap.IngName).To(gomega.Equal(ingressName))
+			defaultPaths++
+		}
+	}
+	g.Expect(defaultPaths).To(gomega.Equal(1))
+	var defaultPoolServers []string
+	for _, pool := range evhNode.PoolRefs {
+		if pool.ServiceMetadata.IngressName == ingressName {
+			for _, server := range pool.Servers {
+				defaultPoolServers = append(defaultPoolServers, *server.Ip.Addr)
+			}
+		}
+	}
+	g.Expect(defaultPoolServers).To(gomega.Equal([]string{"1.1.3.1"}))
+
+	// the default backend is kept when the other ingress of the host is deleted
+	if err := KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), rootIngressName, metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+	g.Eventually(func() int {
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].EvhNodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(1))
+	g.Expect(aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()[0].EvhNodes[0].PoolRefs[0].ServiceMetadata.IngressName).To(gomega.Equal(ingressName))
+}
+
+func TestDefaultBackendHTTPPolicySetOrderForEvh(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// the HTTP policy sets of a host in different namespaces, where the policy set with only the rule without a
+	// path, of the default backend, is ordered after the policy set with the paths
+	evhNode := &avinodes.AviEvhVsNode{
+		HttpPolicyRefs: []*avinodes.AviHttpPolicySetNode{{
+			Name:   "default-backend",
+			HppMap: []avinodes.AviHostPathPortPoolPG{{Host: []string{"foo.com"}}},
+		}, {
+			Name:   "paths",
+			HppMap: []avinodes.AviHostPathPortPoolPG{{Host: []string{"foo.com"}, Path: []string{"/"}}},
+		}, {
+			Name: "redirect",
+		}},
+	}
+	var policySets []string
+	for _, policy := range rest.AviVsHttpPSAdd(evhNode, true) {
+		policySets = append(policySets, *policy.HTTPPolicySetRef)
+	}
+	g.Expect(policySets).To(gomega.Equal([]string{
+		"/api/httppolicyset/?name=redirect",
+		"/api/httppolicyset/?name=paths",
+		"/api/httppolicyset/?name=default-backend",
+	}))
+}
@@ -15,17 +15,21 @@
 package crd
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
 	tests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
 )
@@ -361,3 +365,76 @@ func TestHTTPRouteDeleteInfraSetting(t *testing.T) {
 	integrationtest.DeleteSecret(secrets[0], DEFAULT_NAMESPACE)
 	integrationtest.RemoveAnnotateAKONamespaceWithInfraSetting(t, DEFAULT_NAMESPACE)
 }
+
+func TestHTTPRouteWithHostNotClaimed(t *testing.T) {
+	gatewayName := "gateway-06"
+	gatewayClassName := "gateway-class-06"
+	infraSettingName := "infrasetting-06"
+	httpRouteName := "http-route-06"
+	svcName1 := "avisvc-11"
+	svcName2 := "avisvc-12"
+
+	tests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+
+	// foo-8080.com is claimed for another namespace
+	setting := &akov1beta1.AviInfraSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: infraSettingName},
+		Spec: akov1beta1.AviInfraSettingSpec{
+			FQDNClaims: []akov1beta1.AviInfraFQDNClaim{{
+				Domains:    []string{"foo-8080.com"},
+				Namespaces: []string{"red"},
+			}},
+		},
+		Status: akov1beta1.AviInfraSettingStatus{Status: lib.StatusAccepted},
+	}
+	if _, err := lib.AKOControlConfig().V1beta1CRDClientset().AkoV1beta1().AviInfraSettings().Create(context.TODO(), setting, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding AviInfraSetting: %v", err)
+	}
+
+	ports := []int32{8080}
+	listeners := tests.GetListenersV1(ports, false, false)
+	ports = []int32{6443}
+	secrets := []string{"secret-06"}
+	for _, secret := range secrets {
+		integrationtest.AddSecret(secret, DEFAULT_NAMESPACE, "cert", "key")
+	}
+	tlsListeners := tests.GetListenersV1(ports, false, false, secrets...)
+	listeners = append(listeners, tlsListeners...)
+
+	tests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+	g := gomega.NewGomegaWithT(t)
+
+	setupHTTPRoute(t, svcName1, svcName2, gatewayName, httpRouteName)
+
+	routeAccepted := func() string {
+		httpRoute, err := tests.GatewayClient.GatewayV1().HTTPRoutes(DEFAULT_NAMESPACE).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+		if err != nil || len(httpRoute.Status.Parents) == 0 {
+			return ""
+		}
+		condition := apimeta.FindStatusCondition(httpRoute.Status.Parents[0].Conditions, string(gatewayv1.RouteConditionAccepted))
+		if condition == nil {
+			return ""
+		}
+		return condition.Reason
+	}
+	g.Eventually(routeAccepted, 30*time.Second).Should(gomega.Equal(lib.HostNotClaimed))
+
+	// the namespace of the route is added to the claim
+	setting, _ = lib.AKOControlConfig().V1beta1CRDClientset().AkoV1beta1().AviInfraSettings().Get(context.TODO(), infraSettingName, metav1.GetOptions{})
+	setting.Spec.FQDNClaims[0].Namespaces = append(setting.Spec.FQDNClaims[0].Namespaces, DEFAULT_NAMESPACE)
+	setting.ResourceVersion = "2"
+	if _, err := lib.AKOControlConfig().V1beta1CRDClientset().AkoV1beta1().AviInfraSettings().Update(context.TODO(), setting, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating AviInfraSetting: %v", err)
+	}
+	g.Eventually(routeAccepted, 30*time.Second).Should(gomega.Equal(string(gatewayv1.RouteReasonAccepted)))
+
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName1)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName1)
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName2)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName2)
+	tests.TeardownHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE)
+	tests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	tests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DeleteSecret(secrets[0], DEFAULT_NAMESPACE)
+	integrationtest.TeardownAviInfraSetting(t, infraSettingName)
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package graphlayer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+const backendTLSPolicyCACert = `-----BEGIN CERTIFICATE-----
+MIIBszCCAVmgAwIBAgIUNzQ0MzA4NjU3NjMxMjMwMjY1MTQwCgYIKoZIzj0EAwIw
+-----END CERTIFICATE-----`
+
+/* Test cases
+ * - HTTPRoute with a backend targeted by a BackendTLSPolicy
+ */
+func TestHTTPRouteWithBackendTLSPolicy(t *testing.T) {
+
+	gatewayName := "gateway-btls-01"
+	gatewayClassName := "gateway-class-btls-01"
+	httpRouteName := "http-route-btls-01"
+	policyName := "backendtlspolicy-btls-01"
+	caConfigMapName := "ca-btls-01"
+	svcName := "avisvc-btls-01"
+	ports := []int32{8080}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}}, nil)
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].EvhNodes[0].PoolRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	caConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      caConfigMapName,
+			Namespace: DEFAULT_NAMESPACE,
+		},
+		Data: map[string]string{akogatewayapilib.BackendTLSPolicyCACertKey: backendTLSPolicyCACert},
+	}
+	_, err := akogatewayapitests.KubeClient.CoreV1().ConfigMaps(DEFAULT_NAMESPACE).Create(context.TODO(), caConfigMap, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in creating ConfigMap: %v", err)
+	}
+	akogatewayapitests.SetupBackendTLSPolicy(t, policyName, DEFAULT_NAMESPACE, svcName, []string{caConfigMapName}, "backend.foo.com")
+
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return false
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 || len(nodes[0].EvhNodes[0].PoolRefs) != 1 {
+			return false
+		}
+		return nodes[0].EvhNodes[0].PoolRefs[0].PkiProfile != nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	pool := nodes[0].EvhNodes[0].PoolRefs[0]
+	g.Expect(pool.SniEnabled).To(gomega.BeTrue())
+	g.Expect(pool.SslProfileRef).ShouldNot(gomega.BeNil())
+	g.Expect(*pool.ServerName).To(gomega.Equal("backend.foo.com"))
+	g.Expect(pool.DomainName).To(gomega.Equal([]string{"backend.foo.com"}))
+	g.Expect(*pool.HostCheckEnabled).To(gomega.BeTrue())
+	g.Expect(pool.PkiProfile.CACert).To(gomega.Equal(backendTLSPolicyCACert))
+
+	// the rotated CA certificate of the ConfigMap is applied on the pool
+	rotatedCACert := "-----BEGIN CERTIFICATE-----\nrotated\n-----END CERTIFICATE-----"
+	caConfigMap.Data[akogatewayapilib.BackendTLSPolicyCACertKey] = rotatedCACert
+	caConfigMap.ResourceVersion = "2"
+	_, err = akogatewayapitests.KubeClient.CoreV1().ConfigMaps(DEFAULT_NAMESPACE).Update(context.TODO(), caConfigMap, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("error in updating ConfigMap: %v", err)
+	}
+	g.Eventually(func() string {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 || len(nodes[0].EvhNodes[0].PoolRefs) != 1 || nodes[0].EvhNodes[0].PoolRefs[0].PkiProfile == nil {
+			return ""
+		}
+		return nodes[0].EvhNodes[0].PoolRefs[0].PkiProfile.CACert
+	}, 25*time.Second).Should(gomega.Equal(rotatedCACert))
+
+	// the TLS settings are removed once the BackendTLSPolicy is deleted
+	akogatewayapitests.TeardownBackendTLSPolicy(t, policyName, DEFAULT_NAMESPACE)
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return false
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 || len(nodes[0].EvhNodes[0].PoolRefs) != 1 {
+			return false
+		}
+		return nodes[0].EvhNodes[0].PoolRefs[0].PkiProfile == nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	_, aviModel = objects.SharedAviGraphLister().Get(modelName)
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	pool = nodes[0].EvhNodes[0].PoolRefs[0]
+	g.Expect(pool.SniEnabled).To(gomega.BeFalse())
+	g.Expect(pool.SslProfileRef).To(gomega.BeNil())
+	g.Expect(pool.ServerName).To(gomega.BeNil())
+
+	akogatewayapitests.KubeClient.CoreV1().ConfigMaps(DEFAULT_NAMESPACE).Delete(context.TODO(), caConfigMapName, metav1.DeleteOptions{})
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE)
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
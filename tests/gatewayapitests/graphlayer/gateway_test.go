@@ -52,6 +52,7 @@ const (
 func TestMain(m *testing.M) {
 	tests.KubeClient = k8sfake.NewSimpleClientset()
 	tests.GatewayClient = gatewayfake.NewSimpleClientset()
+	tests.RegisterExperimentalResources(tests.GatewayClient)
 	testData := tests.GetL7RuleFakeData()
 	tests.DynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), tests.GvrToKind, &testData)
 	integrationtest.KubeClient = tests.KubeClient
@@ -0,0 +1,278 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package graphlayer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+/* Test cases
+ * - GRPCRoute CRUD
+ * - GRPCRoute with method only and regular expression matches
+ */
+func TestGRPCRouteCRUD(t *testing.T) {
+
+	gatewayName := "gateway-grpc-01"
+	gatewayClassName := "gateway-class-grpc-01"
+	grpcRouteName := "grpc-route-01"
+	svcName := "avisvc-grpc-01"
+	ports := []int32{8080}
+	modelName, parentVSName := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	svcExample := (integrationtest.FakeService{
+		Name:         svcName,
+		Namespace:    DEFAULT_NAMESPACE,
+		Type:         corev1.ServiceTypeClusterIP,
+		ServicePorts: []integrationtest.Serviceport{{PortName: "grpc", Protocol: "TCP", PortNumber: 8080, TargetPort: intstr.FromInt(8080)}},
+	}).Service()
+
+	_, err := akogatewayapitests.KubeClient.CoreV1().Services(DEFAULT_NAMESPACE).Create(context.TODO(), svcExample, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Service: %v", err)
+	}
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetGRPCRouteRuleV1(gatewayv1.GRPCMethodMatchExact, "helloworld.Greeter", "SayHello",
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	rules := []gatewayv1.GRPCRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupGRPCRoute(t, grpcRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+
+	g.Expect(nodes[0].PortProto).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PortProto[0].EnableHTTP2).To(gomega.BeTrue())
+
+	childNode := nodes[0].EvhNodes[0]
+	g.Expect(childNode.VHParentName).To(gomega.Equal(parentVSName))
+	g.Expect(childNode.ServiceMetadata.RouteType).To(gomega.Equal(lib.GRPCRoute))
+	g.Expect(*childNode.VHMatches[0].Host).To(gomega.Equal("foo-8080.com"))
+	g.Expect(childNode.VHMatches[0].Rules[0].Matches.Path.MatchStr).To(gomega.ContainElement("/helloworld.Greeter/SayHello"))
+	g.Expect(*childNode.VHMatches[0].Rules[0].Matches.Path.MatchCriteria).To(gomega.Equal("EQUALS"))
+	g.Expect(childNode.PoolRefs).To(gomega.HaveLen(1))
+	g.Expect(childNode.PoolRefs[0].EnableHttp2).NotTo(gomega.BeNil())
+	g.Expect(*childNode.PoolRefs[0].EnableHttp2).To(gomega.BeTrue())
+
+	// match all the methods of the service
+	rule = akogatewayapitests.GetGRPCRouteRuleV1(gatewayv1.GRPCMethodMatchExact, "helloworld.Greeter", "",
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	rules = []gatewayv1.GRPCRouteRule{rule}
+	akogatewayapitests.UpdateGRPCRoute(t, grpcRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() string {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return ""
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 || len(nodes[0].EvhNodes[0].VHMatches) == 0 {
+			return ""
+		}
+		return *nodes[0].EvhNodes[0].VHMatches[0].Rules[0].Matches.Path.MatchCriteria
+	}, 25*time.Second).Should(gomega.Equal("BEGINS_WITH"))
+
+	_, aviModel = objects.SharedAviGraphLister().Get(modelName)
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	childNode = nodes[0].EvhNodes[0]
+	g.Expect(childNode.VHMatches[0].Rules[0].Matches.Path.MatchStr).To(gomega.ContainElement("/helloworld.Greeter/"))
+
+	// delete grpcroute
+	akogatewayapitests.TeardownGRPCRoute(t, grpcRouteName, DEFAULT_NAMESPACE)
+
+	// verifies the child deletion
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes)
+	}, 25*time.Second).Should(gomega.Equal(0))
+
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestGRPCRouteWithRegexAndMethodOnlyMatch(t *testing.T) {
+
+	gatewayName := "gateway-grpc-02"
+	gatewayClassName := "gateway-class-grpc-02"
+	grpcRouteName := "grpc-route-02"
+	svcName := "avisvc-grpc-02"
+	ports := []int32{8080}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	regexRule := akogatewayapitests.GetGRPCRouteRuleV1(gatewayv1.GRPCMethodMatchRegularExpression, "helloworld\\..*", "Say.*",
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	methodOnlyRule := akogatewayapitests.GetGRPCRouteRuleV1(gatewayv1.GRPCMethodMatchExact, "", "Check",
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	rules := []gatewayv1.GRPCRouteRule{regexRule, methodOnlyRule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupGRPCRoute(t, grpcRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes)
+	}, 25*time.Second).Should(gomega.Equal(2))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+
+	matchStrs := make(map[string]string)
+	for _, childNode := range nodes[0].EvhNodes {
+		path := childNode.VHMatches[0].Rules[0].Matches.Path
+		matchStrs[path.MatchStr[0]] = *path.MatchCriteria
+	}
+	g.Expect(matchStrs).To(gomega.HaveKeyWithValue("^/(helloworld\\..*)/(Say.*)$", "REGEX_MATCH"))
+	g.Expect(matchStrs).To(gomega.HaveKeyWithValue("^/[^/]+/Check$", "REGEX_MATCH"))
+
+	akogatewayapitests.TeardownGRPCRoute(t, grpcRouteName, DEFAULT_NAMESPACE)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes)
+	}, 25*time.Second).Should(gomega.Equal(0))
+
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestGRPCRouteAndHTTPRouteWithSameName(t *testing.T) {
+
+	gatewayName := "gateway-grpc-03"
+	gatewayClassName := "gateway-class-grpc-03"
+	routeName := "route-grpc-http-03"
+	svcName := "avisvc-grpc-03"
+	ports := []int32{8080}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	grpcRule := akogatewayapitests.GetGRPCRouteRuleV1(gatewayv1.GRPCMethodMatchExact, "helloworld.Greeter", "SayHello",
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	akogatewayapitests.SetupGRPCRoute(t, routeName, DEFAULT_NAMESPACE, parentRefs, hostnames, []gatewayv1.GRPCRouteRule{grpcRule})
+	// the HTTPRoute rule has the same match as the GRPCRoute rule
+	httpRule := akogatewayapitests.GetHTTPRouteRuleV1("Exact", []string{"/helloworld.Greeter/SayHello"}, []string{},
+		nil, [][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}}, nil)
+	akogatewayapitests.SetupHTTPRoute(t, routeName, DEFAULT_NAMESPACE, parentRefs, hostnames, []gatewayv1.HTTPRouteRule{httpRule})
+
+	// the child VS, pool and poolgroup of the routes of each kind are distinct
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes)
+	}, 25*time.Second).Should(gomega.Equal(2))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].EvhNodes[0].Name).NotTo(gomega.Equal(nodes[0].EvhNodes[1].Name))
+	g.Expect(nodes[0].EvhNodes[0].PoolRefs).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].EvhNodes[1].PoolRefs).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].EvhNodes[0].PoolRefs[0].Name).NotTo(gomega.Equal(nodes[0].EvhNodes[1].PoolRefs[0].Name))
+	g.Expect(nodes[0].EvhNodes[0].PoolGroupRefs[0].Name).NotTo(gomega.Equal(nodes[0].EvhNodes[1].PoolGroupRefs[0].Name))
+
+	akogatewayapitests.TeardownGRPCRoute(t, routeName, DEFAULT_NAMESPACE)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	akogatewayapitests.TeardownHTTPRoute(t, routeName, DEFAULT_NAMESPACE)
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
@@ -3406,6 +3406,115 @@ func TestHTTPRouteWithSessionPersistence(t *testing.T) {
 	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
 }
 
+func TestHTTPRouteWithTimeoutsAndRetry(t *testing.T) {
+	gatewayClassName := "gateway-class-hr-timeouts-01"
+	gatewayName := "gateway-hr-timeouts-01"
+	httpRouteName := "httproute-timeouts-01"
+	namespace := "default"
+	svcName := "avisvc-hr-timeouts-01"
+	ports := []int32{8080}
+	var requestTimeout gatewayv1.Duration = "10s"
+	var backendRequestTimeout gatewayv1.Duration = "2s"
+	attempts := 3
+
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, "TCP", corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", svcName, false, false, "1.1.1")
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{},
+		nil,
+		[][]string{{svcName, namespace, "8080", "1"}}, nil)
+
+	// Add Timeouts and Retry
+	rule.Timeouts = &gatewayv1.HTTPRouteTimeouts{
+		Request:        &requestTimeout,
+		BackendRequest: &backendRequestTimeout,
+	}
+	rule.Retry = &gatewayv1.HTTPRouteRetry{
+		Codes:    []gatewayv1.HTTPRouteRetryStatusCode{503, 502},
+		Attempts: &attempts,
+	}
+
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, namespace, parentRefs, hostnames, rules)
+
+	modelName := lib.GetModelName(lib.GetTenant(), akogatewayapilib.GetGatewayParentName(DEFAULT_NAMESPACE, gatewayName))
+
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found || aviModel == nil {
+			return false
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes) == 0 || len(nodes[0].EvhNodes) == 0 || len(nodes[0].EvhNodes[0].PoolRefs) == 0 {
+			return false
+		}
+		return nodes[0].EvhNodes[0].PoolRefs[0].ServerReselect != nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].EvhNodes).To(gomega.HaveLen(1))
+
+	poolNode := nodes[0].EvhNodes[0].PoolRefs[0]
+	g.Expect(poolNode.ServerTimeout).NotTo(gomega.BeNil())
+	g.Expect(*poolNode.ServerTimeout).To(gomega.Equal(uint32(2000)))
+	g.Expect(*poolNode.ServerReselect.Enabled).To(gomega.BeTrue())
+	g.Expect(*poolNode.ServerReselect.NumRetries).To(gomega.Equal(uint32(3)))
+	g.Expect(*poolNode.ServerReselect.RetryTimeout).To(gomega.Equal(uint32(2000)))
+	g.Expect(poolNode.ServerReselect.SvrRespCode).NotTo(gomega.BeNil())
+	g.Expect(poolNode.ServerReselect.SvrRespCode.Codes).To(gomega.Equal([]int64{502, 503}))
+
+	// Keep only the request timeout
+	rule.Timeouts = &gatewayv1.HTTPRouteTimeouts{
+		Request: &requestTimeout,
+	}
+	rule.Retry = nil
+	rules = []gatewayv1.HTTPRouteRule{rule}
+	akogatewayapitests.UpdateHTTPRoute(t, httpRouteName, namespace, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		poolNode := nodes[0].EvhNodes[0].PoolRefs[0]
+		return poolNode.ServerReselect == nil && poolNode.ServerTimeout != nil && *poolNode.ServerTimeout == 10000
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	// Remove the timeouts
+	rule.Timeouts = nil
+	rules = []gatewayv1.HTTPRouteRule{rule}
+	akogatewayapitests.UpdateHTTPRoute(t, httpRouteName, namespace, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return nodes[0].EvhNodes[0].PoolRefs[0].ServerTimeout == nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	// Teardown
+	integrationtest.DelSVC(t, namespace, svcName)
+	integrationtest.DelEPS(t, namespace, svcName)
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
 func TestHTTPRouteWithRouteRuleName(t *testing.T) {
 	gatewayClassName := "gateway-class-hr-31"
 	gatewayName := "gateway-hr-31"
@@ -6527,3 +6636,173 @@ func TestHTTPRouteGatewayHostnameandGatewaynameChanges(t *testing.T) {
 	akogatewayapitests.TeardownGateway(t, gateway2Name, DEFAULT_NAMESPACE)
 	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
 }
+
+func TestHTTPRouteWithQueryParamAndMethodMatch(t *testing.T) {
+
+	gatewayName := "gateway-hr-qp-01"
+	gatewayClassName := "gateway-class-hr-qp-01"
+	httpRouteName := "http-route-hr-qp-01"
+	svcName := "avisvc-hr-qp-01"
+	ports := []int32{8080}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}}, nil)
+	method := gatewayv1.HTTPMethodGet
+	rule.Matches[0].Method = &method
+	rule.Matches[0].QueryParams = []gatewayv1.HTTPQueryParamMatch{
+		{Name: "version", Value: "v1"},
+		{Name: "env", Value: "prod.eu"},
+	}
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return false
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes) == 1 && len(nodes[0].EvhNodes[0].VHMatches) == 1
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	matches := nodes[0].EvhNodes[0].VHMatches[0].Rules[0].Matches
+	g.Expect(matches.Path.MatchStr).To(gomega.ContainElement("/foo"))
+	g.Expect(matches.Query).NotTo(gomega.BeNil())
+	g.Expect(*matches.Query.MatchCriteria).To(gomega.Equal("QUERY_MATCH_REGEX_MATCH"))
+	g.Expect(matches.Query.MatchStr).To(gomega.Equal([]string{`^(?=(?:.*&)?version=v1(?:&|$))(?=(?:.*&)?env=prod\.eu(?:&|$))`}))
+	g.Expect(matches.Method).NotTo(gomega.BeNil())
+	g.Expect(*matches.Method.MatchCriteria).To(gomega.Equal("IS_IN"))
+	g.Expect(matches.Method.Methods).To(gomega.Equal([]string{"HTTP_METHOD_GET"}))
+
+	// removing the method and query param matches removes the match criteria
+	rule = akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}}, nil)
+	rules = []gatewayv1.HTTPRouteRule{rule}
+	akogatewayapitests.UpdateHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return false
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 || len(nodes[0].EvhNodes[0].VHMatches) != 1 {
+			return false
+		}
+		matches := nodes[0].EvhNodes[0].VHMatches[0].Rules[0].Matches
+		return matches.Query == nil && matches.Method == nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE)
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestHTTPRouteWithRequestMirrorFilter(t *testing.T) {
+
+	gatewayName := "gateway-hr-mirror-01"
+	gatewayClassName := "gateway-class-hr-mirror-01"
+	httpRouteName := "http-route-hr-mirror-01"
+	svcName := "avisvc-hr-mirror-01"
+	mirrorSvcName := "avisvc-hr-mirror-01-canary"
+	ports := []int32{8080}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, mirrorSvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, mirrorSvcName, false, false, "2.2.2")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}}, nil)
+	mirrorPort := gatewayv1.PortNumber(8080)
+	rule.Filters = append(rule.Filters, gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestMirror,
+		RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+			BackendRef: gatewayv1.BackendObjectReference{Name: gatewayv1.ObjectName(mirrorSvcName), Port: &mirrorPort},
+		},
+	})
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return false
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes) == 1 && nodes[0].EvhNodes[0].TrafficCloneProfile != nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	childNode := nodes[0].EvhNodes[0]
+	trafficClone := childNode.TrafficCloneProfile
+	g.Expect(trafficClone.Name).To(gomega.HavePrefix(lib.GetNamePrefix()))
+	g.Expect(trafficClone.Tenant).To(gomega.Equal(childNode.Tenant))
+	g.Expect(trafficClone.CloneServers).To(gomega.HaveLen(1))
+	g.Expect(*trafficClone.CloneServers[0].Addr).To(gomega.Equal("2.2.2.1"))
+	g.Expect(childNode.TrafficCloneProfileRef).NotTo(gomega.BeNil())
+	g.Expect(*childNode.TrafficCloneProfileRef).To(gomega.Equal("/api/trafficcloneprofile?name=" + trafficClone.Name))
+	// the mirror service is not a backend of the child vs
+	g.Expect(childNode.PoolRefs).To(gomega.HaveLen(1))
+	g.Expect(*childNode.PoolRefs[0].Servers[0].Ip.Addr).To(gomega.Equal("1.1.1.1"))
+
+	// removing the RequestMirror filter removes the traffic clone profile
+	rule = akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}}, nil)
+	rules = []gatewayv1.HTTPRouteRule{rule}
+	akogatewayapitests.UpdateHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return false
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 {
+			return false
+		}
+		return nodes[0].EvhNodes[0].TrafficCloneProfile == nil && nodes[0].EvhNodes[0].TrafficCloneProfileRef == nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE)
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, mirrorSvcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, mirrorSvcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
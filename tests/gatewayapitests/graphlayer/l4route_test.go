@@ -0,0 +1,303 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package graphlayer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+func getL4Listener(port int32, protocol gatewayv1.ProtocolType) gatewayv1.Listener {
+	return gatewayv1.Listener{
+		Name:     gatewayv1.SectionName(fmt.Sprintf("listener-%d", port)),
+		Port:     gatewayv1.PortNumber(port),
+		Protocol: protocol,
+	}
+}
+
+/* Test cases
+ * - TCPRoute CRUD with weighted backends
+ * - UDPRoute CRUD
+ * - TCPRoutes claiming the same listener port
+ */
+func TestTCPRouteCRUD(t *testing.T) {
+
+	gatewayName := "gateway-tcp-01"
+	gatewayClassName := "gateway-class-tcp-01"
+	tcpRouteName := "tcp-route-01"
+	svcName1 := "avisvc-tcp-01a"
+	svcName2 := "avisvc-tcp-01b"
+	ports := []int32{9000}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := []gatewayv1.Listener{getL4Listener(ports[0], gatewayv1.TCPProtocolType)}
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName1, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName1, false, false, "1.1.1")
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName2, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName2, false, false, "1.1.2")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetTCPRouteRuleV1Alpha2([][]string{{svcName1, DEFAULT_NAMESPACE, "8080", "80"}, {svcName2, DEFAULT_NAMESPACE, "8080", "20"}})
+	rules := []gatewayv1alpha2.TCPRouteRule{rule}
+	akogatewayapitests.SetupTCPRoute(t, tcpRouteName, DEFAULT_NAMESPACE, parentRefs, rules)
+
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].L4PolicyRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].EvhNodes).To(gomega.HaveLen(0))
+	g.Expect(nodes[0].PortProto).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PortProto[0].Protocol).To(gomega.Equal("TCP"))
+	g.Expect(nodes[0].HTTPDSrefs).To(gomega.HaveLen(0))
+
+	pgName := akogatewayapilib.GetPoolGroupName(DEFAULT_NAMESPACE, gatewayName, DEFAULT_NAMESPACE, tcpRouteName, lib.TCPRoute)
+	g.Expect(nodes[0].PoolGroupRefs).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PoolGroupRefs[0].Name).To(gomega.Equal(pgName))
+	g.Expect(nodes[0].PoolGroupRefs[0].Members).To(gomega.HaveLen(2))
+	g.Expect(*nodes[0].PoolGroupRefs[0].Members[0].Ratio).To(gomega.Equal(uint32(80)))
+	g.Expect(*nodes[0].PoolGroupRefs[0].Members[1].Ratio).To(gomega.Equal(uint32(20)))
+	g.Expect(nodes[0].PoolRefs).To(gomega.HaveLen(2))
+	g.Expect(nodes[0].PoolRefs[0].Protocol).To(gomega.Equal("TCP"))
+	g.Expect(nodes[0].PoolRefs[0].Servers).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PoolRefs[1].Servers).To(gomega.HaveLen(1))
+
+	l4Policy := nodes[0].L4PolicyRefs[0]
+	g.Expect(l4Policy.Name).To(gomega.Equal(akogatewayapilib.GetL4PolicySetName(DEFAULT_NAMESPACE, gatewayName)))
+	g.Expect(l4Policy.PortPool).To(gomega.HaveLen(1))
+	g.Expect(l4Policy.PortPool[0].Port).To(gomega.Equal(uint32(9000)))
+	g.Expect(l4Policy.PortPool[0].Protocol).To(gomega.Equal("TCP"))
+	g.Expect(l4Policy.PortPool[0].PoolGroup).To(gomega.Equal("/api/poolgroup?name=" + pgName))
+
+	// remove the second backend of the TCPRoute
+	rule = akogatewayapitests.GetTCPRouteRuleV1Alpha2([][]string{{svcName1, DEFAULT_NAMESPACE, "8080", "1"}})
+	rules = []gatewayv1alpha2.TCPRouteRule{rule}
+	akogatewayapitests.UpdateTCPRoute(t, tcpRouteName, DEFAULT_NAMESPACE, parentRefs, rules)
+
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].PoolRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	_, aviModel = objects.SharedAviGraphLister().Get(modelName)
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].PoolGroupRefs).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PoolGroupRefs[0].Members).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].L4PolicyRefs).To(gomega.HaveLen(1))
+
+	akogatewayapitests.TeardownTCPRoute(t, tcpRouteName, DEFAULT_NAMESPACE)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].PoolGroupRefs) + len(nodes[0].PoolRefs) + len(nodes[0].L4PolicyRefs)
+	}, 25*time.Second).Should(gomega.Equal(0))
+
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName1)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName1)
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName2)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName2)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestUDPRouteCRUD(t *testing.T) {
+
+	gatewayName := "gateway-udp-01"
+	gatewayClassName := "gateway-class-udp-01"
+	udpRouteName := "udp-route-01"
+	svcName := "avisvc-udp-01"
+	ports := []int32{5353}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := []gatewayv1.Listener{getL4Listener(ports[0], gatewayv1.UDPProtocolType)}
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolUDP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetUDPRouteRuleV1Alpha2([][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	rules := []gatewayv1alpha2.UDPRouteRule{rule}
+	akogatewayapitests.SetupUDPRoute(t, udpRouteName, DEFAULT_NAMESPACE, parentRefs, rules)
+
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].L4PolicyRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].PortProto).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PortProto[0].Protocol).To(gomega.Equal("UDP"))
+
+	pgName := akogatewayapilib.GetPoolGroupName(DEFAULT_NAMESPACE, gatewayName, DEFAULT_NAMESPACE, udpRouteName, lib.UDPRoute)
+	g.Expect(nodes[0].PoolGroupRefs).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PoolGroupRefs[0].Name).To(gomega.Equal(pgName))
+	g.Expect(nodes[0].PoolRefs).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PoolRefs[0].Protocol).To(gomega.Equal("UDP"))
+
+	l4Policy := nodes[0].L4PolicyRefs[0]
+	g.Expect(l4Policy.PortPool).To(gomega.HaveLen(1))
+	g.Expect(l4Policy.PortPool[0].Port).To(gomega.Equal(uint32(5353)))
+	g.Expect(l4Policy.PortPool[0].Protocol).To(gomega.Equal("UDP"))
+	g.Expect(l4Policy.PortPool[0].PoolGroup).To(gomega.Equal("/api/poolgroup?name=" + pgName))
+
+	akogatewayapitests.TeardownUDPRoute(t, udpRouteName, DEFAULT_NAMESPACE)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].PoolGroupRefs) + len(nodes[0].PoolRefs) + len(nodes[0].L4PolicyRefs)
+	}, 25*time.Second).Should(gomega.Equal(0))
+
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestTCPRoutesWithSameListener(t *testing.T) {
+
+	gatewayName := "gateway-tcp-02"
+	gatewayClassName := "gateway-class-tcp-02"
+	tcpRouteName1 := "tcp-route-02a"
+	tcpRouteName2 := "tcp-route-02b"
+	svcName := "avisvc-tcp-02"
+	ports := []int32{9001}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := []gatewayv1.Listener{getL4Listener(ports[0], gatewayv1.TCPProtocolType)}
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetTCPRouteRuleV1Alpha2([][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	rules := []gatewayv1alpha2.TCPRouteRule{rule}
+	akogatewayapitests.SetupTCPRoute(t, tcpRouteName1, DEFAULT_NAMESPACE, parentRefs, rules)
+
+	pgName1 := akogatewayapilib.GetPoolGroupName(DEFAULT_NAMESPACE, gatewayName, DEFAULT_NAMESPACE, tcpRouteName1, lib.TCPRoute)
+	pgName2 := akogatewayapilib.GetPoolGroupName(DEFAULT_NAMESPACE, gatewayName, DEFAULT_NAMESPACE, tcpRouteName2, lib.TCPRoute)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].PoolGroupRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	akogatewayapitests.SetupTCPRoute(t, tcpRouteName2, DEFAULT_NAMESPACE, parentRefs, rules)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].PoolGroupRefs)
+	}, 25*time.Second).Should(gomega.Equal(2))
+
+	// the port is served by the route attached first
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].L4PolicyRefs).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].L4PolicyRefs[0].PortPool).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].L4PolicyRefs[0].PortPool[0].PoolGroup).To(gomega.Equal("/api/poolgroup?name=" + pgName1))
+
+	// the port is released to the other route, once the route serving it is deleted
+	akogatewayapitests.TeardownTCPRoute(t, tcpRouteName1, DEFAULT_NAMESPACE)
+	g.Eventually(func() string {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return ""
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].L4PolicyRefs) != 1 || len(nodes[0].L4PolicyRefs[0].PortPool) != 1 {
+			return ""
+		}
+		return nodes[0].L4PolicyRefs[0].PortPool[0].PoolGroup
+	}, 25*time.Second).Should(gomega.Equal("/api/poolgroup?name=" + pgName2))
+
+	akogatewayapitests.TeardownTCPRoute(t, tcpRouteName2, DEFAULT_NAMESPACE)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].PoolGroupRefs) + len(nodes[0].L4PolicyRefs)
+	}, 25*time.Second).Should(gomega.Equal(0))
+
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
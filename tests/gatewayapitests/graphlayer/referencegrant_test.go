@@ -0,0 +1,186 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package graphlayer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+/* Test cases
+ * - HTTPRoute with a backendRef in another namespace
+ * - Gateway with a certificateRef in another namespace
+ */
+func TestHTTPRouteCrossNamespaceBackendWithReferenceGrant(t *testing.T) {
+
+	gatewayName := "gateway-rg-01"
+	gatewayClassName := "gateway-class-rg-01"
+	httpRouteName := "http-route-rg-01"
+	grantName := "referencegrant-rg-01"
+	backendNamespace := "backend-rg-01"
+	svcName := "avisvc-rg-01"
+	ports := []int32{8080}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	integrationtest.AddNamespace(t, backendNamespace, map[string]string{})
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, backendNamespace, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, backendNamespace, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, backendNamespace, "8080", "1"}}, nil)
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].EvhNodes)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	// the backend is not used until a ReferenceGrant permits the reference
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].EvhNodes[0].PoolRefs).To(gomega.HaveLen(0))
+
+	akogatewayapitests.SetupReferenceGrant(t, grantName, backendNamespace, lib.HTTPRoute, DEFAULT_NAMESPACE, utils.Service, "")
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 {
+			return -1
+		}
+		return len(nodes[0].EvhNodes[0].PoolRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	_, aviModel = objects.SharedAviGraphLister().Get(modelName)
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].EvhNodes[0].PoolGroupRefs).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].EvhNodes[0].PoolGroupRefs[0].Members).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].EvhNodes[0].PoolRefs[0].Servers).To(gomega.HaveLen(1))
+
+	// the backend is removed once the ReferenceGrant is deleted
+	akogatewayapitests.TeardownReferenceGrant(t, grantName, backendNamespace)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(nodes[0].EvhNodes) != 1 {
+			return -1
+		}
+		return len(nodes[0].EvhNodes[0].PoolRefs)
+	}, 25*time.Second).Should(gomega.Equal(0))
+
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE)
+	integrationtest.DelSVC(t, backendNamespace, svcName)
+	integrationtest.DelEPS(t, backendNamespace, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestGatewayCrossNamespaceCertificateWithReferenceGrant(t *testing.T) {
+
+	gatewayName := "gateway-rg-02"
+	gatewayClassName := "gateway-class-rg-02"
+	grantName := "referencegrant-rg-02"
+	secretNamespace := "secret-rg-02"
+	secrets := []string{"secret-rg-02a", "secret-rg-02b"}
+	ports := []int32{8080, 8081}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	integrationtest.AddNamespace(t, secretNamespace, map[string]string{})
+	integrationtest.AddSecret(secrets[0], DEFAULT_NAMESPACE, "cert", "key")
+	integrationtest.AddSecret(secrets[1], secretNamespace, "cert", "key")
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+
+	// the first listener uses a secret in the namespace of the gateway, the second a secret in another namespace
+	listeners := akogatewayapitests.GetListenersV1(ports[:1], false, false, secrets[0])
+	listeners = append(listeners, akogatewayapitests.GetListenersV1(ports[1:], false, false, secrets[1])...)
+	certNamespace := gatewayv1.Namespace(secretNamespace)
+	listeners[1].TLS.CertificateRefs[0].Namespace = &certNamespace
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].PortProto).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].SSLKeyCertRefs).To(gomega.HaveLen(1))
+
+	akogatewayapitests.SetupReferenceGrant(t, grantName, secretNamespace, lib.Gateway, DEFAULT_NAMESPACE, utils.Secret, secrets[1])
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].SSLKeyCertRefs)
+	}, 25*time.Second).Should(gomega.Equal(2))
+
+	_, aviModel = objects.SharedAviGraphLister().Get(modelName)
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].PortProto).To(gomega.HaveLen(2))
+
+	akogatewayapitests.TeardownReferenceGrant(t, grantName, secretNamespace)
+	g.Eventually(func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return -1
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes[0].SSLKeyCertRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DeleteSecret(secrets[0], DEFAULT_NAMESPACE)
+	integrationtest.DeleteSecret(secrets[1], secretNamespace)
+}
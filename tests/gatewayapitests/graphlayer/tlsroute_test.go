@@ -0,0 +1,283 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package graphlayer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+func getPassthroughListener(port int32) gatewayv1.Listener {
+	listener := gatewayv1.Listener{
+		Name:     gatewayv1.SectionName(fmt.Sprintf("listener-%d", port)),
+		Port:     gatewayv1.PortNumber(port),
+		Protocol: gatewayv1.TLSProtocolType,
+	}
+	tlsMode := gatewayv1.TLSModePassthrough
+	listener.TLS = &gatewayv1.GatewayTLSConfig{Mode: &tlsMode}
+	return listener
+}
+
+// getPassthroughVS returns the passthrough VS of the Gateway parent VS in the model, nil if there is none.
+func getPassthroughVS(modelName string) *avinodes.AviVsNode {
+	found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	if !found || aviModel == nil {
+		return nil
+	}
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	if len(nodes) == 0 || len(nodes[0].PassthroughChildNodes) == 0 {
+		return nil
+	}
+	return nodes[0].PassthroughChildNodes[0]
+}
+
+/* Test cases
+ * - TLSRoute CRUD
+ * - TLSRoutes claiming the same hostname
+ * - Passthrough VS removal with the TLS listener
+ */
+func TestTLSRouteCRUD(t *testing.T) {
+
+	gatewayName := "gateway-tls-01"
+	gatewayClassName := "gateway-class-tls-01"
+	tlsRouteName := "tls-route-01"
+	svcName := "avisvc-tls-01"
+	ports := []int32{8443}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	httpListener := gatewayv1.Listener{Name: "listener-8080", Port: 8080, Protocol: gatewayv1.HTTPProtocolType}
+	listeners := []gatewayv1.Listener{httpListener, getPassthroughListener(ports[0])}
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		return getPassthroughVS(modelName) != nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	// the passthrough port is served by the passthrough VS, which shares the VsVip of the parent VS
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	passVS := nodes[0].PassthroughChildNodes[0]
+	g.Expect(nodes[0].PortProto).To(gomega.HaveLen(1))
+	g.Expect(nodes[0].PortProto[0].Port).To(gomega.Equal(int32(8080)))
+	g.Expect(nodes[0].ServiceMetadata.PassthroughChildRef).To(gomega.Equal(passVS.Name))
+	g.Expect(passVS.Name).To(gomega.Equal(akogatewayapilib.GetGatewayPassthroughName(DEFAULT_NAMESPACE, gatewayName)))
+	g.Expect(passVS.ServiceMetadata.PassthroughParentRef).To(gomega.Equal(nodes[0].Name))
+	g.Expect(passVS.ApplicationProfile).To(gomega.Equal(utils.DEFAULT_L4_APP_PROFILE))
+	g.Expect(passVS.PortProto).To(gomega.HaveLen(1))
+	g.Expect(passVS.PortProto[0].Port).To(gomega.Equal(int32(8443)))
+	g.Expect(passVS.PortProto[0].Passthrough).To(gomega.BeTrue())
+	g.Expect(passVS.VSVIPRefs).To(gomega.HaveLen(1))
+	g.Expect(passVS.VSVIPRefs[0].Name).To(gomega.Equal(nodes[0].VSVIPRefs[0].Name))
+
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetTLSRouteRuleV1Alpha2([][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	rules := []gatewayv1alpha2.TLSRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8443.com"}
+	akogatewayapitests.SetupTLSRoute(t, tlsRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() int {
+		passVS := getPassthroughVS(modelName)
+		if passVS == nil {
+			return 0
+		}
+		return len(passVS.PoolGroupRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	_, aviModel = objects.SharedAviGraphLister().Get(modelName)
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].EvhNodes).To(gomega.HaveLen(0))
+	g.Expect(nodes[0].SSLKeyCertRefs).To(gomega.HaveLen(0))
+	g.Expect(nodes[0].PoolGroupRefs).To(gomega.HaveLen(0))
+	g.Expect(nodes[0].HTTPDSrefs).To(gomega.HaveLen(0))
+
+	passVS = nodes[0].PassthroughChildNodes[0]
+	pgName := akogatewayapilib.GetPoolGroupName(DEFAULT_NAMESPACE, gatewayName, DEFAULT_NAMESPACE, tlsRouteName, "foo-8443.com")
+	g.Expect(passVS.PoolGroupRefs[0].Name).To(gomega.Equal(pgName))
+	g.Expect(passVS.PoolGroupRefs[0].AviMarkers.Host).To(gomega.Equal([]string{"foo-8443.com"}))
+	g.Expect(passVS.PoolGroupRefs[0].Members).To(gomega.HaveLen(1))
+	g.Expect(passVS.PoolRefs).To(gomega.HaveLen(1))
+	g.Expect(passVS.PoolRefs[0].Protocol).To(gomega.Equal("TCP"))
+	g.Expect(passVS.PoolRefs[0].Servers).To(gomega.HaveLen(1))
+
+	g.Expect(passVS.HTTPDSrefs).To(gomega.HaveLen(1))
+	g.Expect(passVS.HTTPDSrefs[0].Name).To(gomega.Equal(akogatewayapilib.GetPassthroughDataScriptName(DEFAULT_NAMESPACE, gatewayName)))
+	g.Expect(passVS.HTTPDSrefs[0].Evt).To(gomega.Equal("VS_DATASCRIPT_EVT_L4_REQUEST"))
+	g.Expect(passVS.HTTPDSrefs[0].PoolGroupRefs).To(gomega.Equal([]string{pgName}))
+	g.Expect(passVS.HTTPDSrefs[0].Script).To(gomega.ContainSubstring(`["foo-8443.com"] = "` + pgName + `"`))
+
+	// update the hostname of the TLSRoute
+	hostnames = []gatewayv1.Hostname{"bar-8443.com"}
+	akogatewayapitests.UpdateTLSRoute(t, tlsRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+	updatedPGName := akogatewayapilib.GetPoolGroupName(DEFAULT_NAMESPACE, gatewayName, DEFAULT_NAMESPACE, tlsRouteName, "bar-8443.com")
+
+	g.Eventually(func() bool {
+		passVS := getPassthroughVS(modelName)
+		if passVS == nil {
+			return false
+		}
+		return len(passVS.PoolGroupRefs) == 1 && passVS.PoolGroupRefs[0].Name == updatedPGName
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	passVS = getPassthroughVS(modelName)
+	g.Expect(passVS.PoolRefs).To(gomega.HaveLen(1))
+	g.Expect(passVS.HTTPDSrefs).To(gomega.HaveLen(1))
+	g.Expect(passVS.HTTPDSrefs[0].Script).To(gomega.ContainSubstring(`["bar-8443.com"] = "` + updatedPGName + `"`))
+	g.Expect(passVS.HTTPDSrefs[0].Script).NotTo(gomega.ContainSubstring("foo-8443.com"))
+
+	akogatewayapitests.TeardownTLSRoute(t, tlsRouteName, DEFAULT_NAMESPACE)
+	g.Eventually(func() int {
+		passVS := getPassthroughVS(modelName)
+		if passVS == nil {
+			return -1
+		}
+		return len(passVS.PoolGroupRefs) + len(passVS.PoolRefs) + len(passVS.HTTPDSrefs)
+	}, 25*time.Second).Should(gomega.Equal(0))
+
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestTLSRouteWithConflictingHostname(t *testing.T) {
+
+	gatewayName := "gateway-tls-02"
+	gatewayClassName := "gateway-class-tls-02"
+	tlsRouteName1 := "tls-route-02a"
+	tlsRouteName2 := "tls-route-02b"
+	svcName := "avisvc-tls-02"
+	ports := []int32{8443}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := []gatewayv1.Listener{getPassthroughListener(ports[0])}
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		return getPassthroughVS(modelName) != nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, DEFAULT_NAMESPACE, svcName, false, false, "1.1.1")
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := akogatewayapitests.GetTLSRouteRuleV1Alpha2([][]string{{svcName, DEFAULT_NAMESPACE, "8080", "1"}})
+	rules := []gatewayv1alpha2.TLSRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8443.com"}
+	akogatewayapitests.SetupTLSRoute(t, tlsRouteName1, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() int {
+		passVS := getPassthroughVS(modelName)
+		if passVS == nil {
+			return 0
+		}
+		return len(passVS.PoolGroupRefs)
+	}, 25*time.Second).Should(gomega.Equal(1))
+
+	akogatewayapitests.SetupTLSRoute(t, tlsRouteName2, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+	g.Eventually(func() int {
+		passVS := getPassthroughVS(modelName)
+		if passVS == nil {
+			return 0
+		}
+		return len(passVS.PoolGroupRefs)
+	}, 25*time.Second).Should(gomega.Equal(2))
+
+	// the hostname is served by the poolgroup of the TLSRoute attached first
+	pgName1 := akogatewayapilib.GetPoolGroupName(DEFAULT_NAMESPACE, gatewayName, DEFAULT_NAMESPACE, tlsRouteName1, "foo-8443.com")
+	pgName2 := akogatewayapilib.GetPoolGroupName(DEFAULT_NAMESPACE, gatewayName, DEFAULT_NAMESPACE, tlsRouteName2, "foo-8443.com")
+	passVS := getPassthroughVS(modelName)
+	g.Expect(passVS.HTTPDSrefs).To(gomega.HaveLen(1))
+	g.Expect(passVS.HTTPDSrefs[0].PoolGroupRefs).To(gomega.Equal([]string{pgName1}))
+	g.Expect(strings.Contains(passVS.HTTPDSrefs[0].Script, pgName2)).To(gomega.BeFalse())
+
+	// the second TLSRoute takes over the hostname once the first one is deleted
+	akogatewayapitests.TeardownTLSRoute(t, tlsRouteName1, DEFAULT_NAMESPACE)
+	g.Eventually(func() []string {
+		passVS := getPassthroughVS(modelName)
+		if passVS == nil || len(passVS.HTTPDSrefs) != 1 {
+			return nil
+		}
+		return passVS.HTTPDSrefs[0].PoolGroupRefs
+	}, 25*time.Second).Should(gomega.Equal([]string{pgName2}))
+
+	akogatewayapitests.TeardownTLSRoute(t, tlsRouteName2, DEFAULT_NAMESPACE)
+	g.Eventually(func() int {
+		passVS := getPassthroughVS(modelName)
+		if passVS == nil {
+			return -1
+		}
+		return len(passVS.PoolGroupRefs) + len(passVS.PoolRefs) + len(passVS.HTTPDSrefs)
+	}, 25*time.Second).Should(gomega.Equal(0))
+
+	integrationtest.DelSVC(t, DEFAULT_NAMESPACE, svcName)
+	integrationtest.DelEPS(t, DEFAULT_NAMESPACE, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestTLSRoutePassthroughVSRemovedWithListener(t *testing.T) {
+
+	gatewayName := "gateway-tls-03"
+	gatewayClassName := "gateway-class-tls-03"
+	ports := []int32{8443}
+	modelName, _ := akogatewayapitests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	httpListener := gatewayv1.Listener{Name: "listener-8080", Port: 8080, Protocol: gatewayv1.HTTPProtocolType}
+	listeners := []gatewayv1.Listener{httpListener, getPassthroughListener(ports[0])}
+	akogatewayapitests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		return getPassthroughVS(modelName) != nil
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	// the passthrough VS is removed along with the TLS listener
+	akogatewayapitests.UpdateGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, []gatewayv1.Listener{httpListener})
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found || aviModel == nil {
+			return false
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		return len(nodes) == 1 && len(nodes[0].PassthroughChildNodes) == 0 && nodes[0].ServiceMetadata.PassthroughChildRef == ""
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
@@ -365,7 +365,7 @@ func TestGatewayInvalidListenerProtocol(t *testing.T) {
 		Status: gatewayv1.GatewayStatus{},
 	}
 	akogatewayapitests.SetGatewayGatewayClass(&gateway, gwClassName)
-	akogatewayapitests.AddGatewayListener(&gateway, "listener-example", 80, gatewayv1.TCPProtocolType, false)
+	akogatewayapitests.AddGatewayListener(&gateway, "listener-example", 80, gatewayv1.ProtocolType("SCTP"), false)
 	akogatewayapitests.SetListenerHostname(&gateway.Spec.Listeners[0], "*.example.com")
 
 	//create
@@ -412,6 +412,7 @@ func TestGatewayInvalidListenerTLS(t *testing.T) {
 	gateway, _ := akogatewayapitests.GatewayClient.GatewayV1().Gateways("default").Get(context.TODO(), gwName, metav1.GetOptions{})
 	tlsModeTerminate := gatewayv1.TLSModeTerminate
 	gateway.Spec.Listeners[0].TLS.Mode = &tlsModeTerminate
+	gateway.ResourceVersion = "2"
 	gw, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways("default").Update(context.TODO(), gateway, metav1.UpdateOptions{})
 	if err != nil {
 		t.Fatalf("Couldn't update, err: %+v", err)
@@ -24,6 +24,7 @@ import (
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
@@ -636,3 +637,55 @@ func TestSvcUpdateAutoAnnotate(t *testing.T) {
 
 	cleanupGatewayForNPL(t, gatewayClassName, gatewayName, httpRouteName)
 }
+
+func TestHTTPRouteWithRequestMirror(t *testing.T) {
+	gatewayName := "gateway-npl-09"
+	gatewayClassName := "gateway-class-npl-09"
+	httpRouteName := "http-route-npl-09"
+	ports := []int32{8080}
+	modelName, _ := tests.GetModelName(DEFAULT_NAMESPACE, gatewayName)
+
+	g := gomega.NewGomegaWithT(t)
+
+	tests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := tests.GetListenersV1(ports, false, false)
+	tests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 25*time.Second).Should(gomega.Equal(true))
+
+	// the traffic is cloned to the endpoint IP addresses, which are not reachable on the node ports with NPL
+	parentRefs := tests.GetParentReferencesV1([]string{gatewayName}, DEFAULT_NAMESPACE, ports)
+	rule := tests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{"avisvc", "default", "8080", "1"}}, nil)
+	mirrorPort := gatewayv1.PortNumber(8080)
+	rule.Filters = append(rule.Filters, gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestMirror,
+		RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+			BackendRef: gatewayv1.BackendObjectReference{Name: "avisvc-canary", Port: &mirrorPort},
+		},
+	})
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	tests.SetupHTTPRoute(t, httpRouteName, DEFAULT_NAMESPACE, parentRefs, hostnames, rules)
+
+	g.Eventually(func() string {
+		httpRoute, err := tests.GatewayClient.GatewayV1().HTTPRoutes(DEFAULT_NAMESPACE).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+		if err != nil || len(httpRoute.Status.Parents) != 1 {
+			return ""
+		}
+		condition := apimeta.FindStatusCondition(httpRoute.Status.Parents[0].Conditions, string(gatewayv1.RouteConditionAccepted))
+		if condition == nil || condition.Status != metav1.ConditionFalse {
+			return ""
+		}
+		return condition.Message
+	}, 30*time.Second).Should(gomega.Equal("RequestMirror filter is not supported with the NodePortLocal service type"))
+
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+	g.Expect(nodes[0].EvhNodes).To(gomega.HaveLen(0))
+
+	cleanupGatewayForNPL(t, gatewayClassName, gatewayName, httpRouteName)
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+func getBackendTLSPolicyAcceptedCondition(t *testing.T, name, namespace string) *metav1.Condition {
+	policy, err := akogatewayapitests.GatewayClient.GatewayV1alpha3().BackendTLSPolicies(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil || policy == nil {
+		t.Logf("Couldn't get the BackendTLSPolicy, err: %+v", err)
+		return nil
+	}
+	if len(policy.Status.Ancestors) != 1 {
+		return nil
+	}
+	return apimeta.FindStatusCondition(policy.Status.Ancestors[0].Conditions, string(gatewayv1alpha2.PolicyConditionAccepted))
+}
+
+/* Test cases
+ * - BackendTLSPolicy with a missing CA certificate ConfigMap, accepted once the ConfigMap is created
+ */
+func TestBackendTLSPolicyWithMissingCACertificate(t *testing.T) {
+	gatewayClassName := "gateway-class-btls-01"
+	gatewayName := "gateway-btls-01"
+	httpRouteName := "httproute-btls-01"
+	policyName := "backendtlspolicy-btls-01"
+	caConfigMapName := "ca-btls-01"
+	svcName := "avisvc-btls-01"
+	namespace := "default"
+	ports := []int32{8080}
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	integrationtest.CreateSVC(t, namespace, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, namespace, svcName, false, false, "1.1.1")
+
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, namespace, "8080", "1"}}, nil)
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, namespace, parentRefs, hostnames, rules)
+	g.Eventually(func() bool {
+		condition := getHTTPRouteResolvedRefsCondition(t, httpRouteName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionTrue
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	// the CA certificate ConfigMap does not exist
+	akogatewayapitests.SetupBackendTLSPolicy(t, policyName, namespace, svcName, []string{caConfigMapName}, "backend.foo.com")
+	g.Eventually(func() bool {
+		condition := getBackendTLSPolicyAcceptedCondition(t, policyName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionFalse &&
+			condition.Reason == string(gatewayv1alpha2.PolicyReasonInvalid)
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	policy, _ := akogatewayapitests.GatewayClient.GatewayV1alpha3().BackendTLSPolicies(namespace).Get(context.TODO(), policyName, metav1.GetOptions{})
+	g.Expect(string(policy.Status.Ancestors[0].AncestorRef.Name)).To(gomega.Equal(gatewayName))
+	g.Expect(string(policy.Status.Ancestors[0].ControllerName)).To(gomega.Equal(akogatewayapilib.GatewayController))
+
+	// the policy is re-evaluated on the creation of the ConfigMap
+	caConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      caConfigMapName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{akogatewayapilib.BackendTLSPolicyCACertKey: "-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----"},
+	}
+	_, err := akogatewayapitests.KubeClient.CoreV1().ConfigMaps(namespace).Create(context.TODO(), caConfigMap, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in creating ConfigMap: %v", err)
+	}
+	g.Eventually(func() bool {
+		condition := getBackendTLSPolicyAcceptedCondition(t, policyName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionTrue
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownBackendTLSPolicy(t, policyName, namespace)
+	akogatewayapitests.KubeClient.CoreV1().ConfigMaps(namespace).Delete(context.TODO(), caConfigMapName, metav1.DeleteOptions{})
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
+	integrationtest.DelSVC(t, namespace, svcName)
+	integrationtest.DelEPS(t, namespace, svcName)
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
@@ -379,6 +379,9 @@ func TestDedicatedGatewayWithHostnameInListeners(t *testing.T) {
 					{
 						Kind: "HTTPRoute",
 					},
+					{
+						Kind: "GRPCRoute",
+					},
 				},
 				AttachedRoutes: 0,
 				Conditions: []metav1.Condition{
@@ -462,6 +465,9 @@ func TestDedicatedGatewayWithAllowedRoutesFromAllNamespaces(t *testing.T) {
 					{
 						Kind: "HTTPRoute",
 					},
+					{
+						Kind: "GRPCRoute",
+					},
 				},
 				AttachedRoutes: 0,
 				Conditions: []metav1.Condition{
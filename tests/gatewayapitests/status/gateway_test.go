@@ -52,6 +52,7 @@ const (
 func TestMain(m *testing.M) {
 	tests.KubeClient = k8sfake.NewSimpleClientset()
 	tests.GatewayClient = gatewayfake.NewSimpleClientset()
+	tests.RegisterExperimentalResources(tests.GatewayClient)
 	testData := tests.GetL7RuleFakeData()
 	tests.DynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), tests.GvrToKind, &testData)
 
@@ -850,6 +851,61 @@ func TestGatewayWithInvalidTLSConfigInListeners(t *testing.T) {
 	}
 }
 
+func TestGatewayWithTLSPassthroughListeners(t *testing.T) {
+
+	gatewayName := "gateway-tls-passthrough-01"
+	gatewayClassName := "gateway-class-tls-passthrough-01"
+	ports := []int32{8443, 8444}
+
+	tests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := tests.GetListenersV1(ports, true, false)
+	terminateMode := gatewayv1.TLSModeTerminate
+	passthroughMode := gatewayv1.TLSModePassthrough
+	listeners[0].Protocol = gatewayv1.TLSProtocolType
+	listeners[0].TLS = &gatewayv1.GatewayTLSConfig{Mode: &terminateMode}
+	listeners[1].Protocol = gatewayv1.TLSProtocolType
+	listeners[1].TLS = &gatewayv1.GatewayTLSConfig{Mode: &passthroughMode}
+	tests.SetupGateway(t, gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := tests.GatewayClient.GatewayV1().Gateways(DEFAULT_NAMESPACE).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	expectedStatus := &gatewayv1.GatewayStatus{
+		Conditions: []metav1.Condition{
+			{
+				Type:               string(gatewayv1.GatewayConditionAccepted),
+				Status:             metav1.ConditionTrue,
+				Message:            "Gateway contains atleast one valid listener",
+				ObservedGeneration: 1,
+				Reason:             string(gatewayv1.GatewayReasonListenersNotValid),
+			},
+		},
+		Listeners: tests.GetListenerStatusV1(ports, []int32{0, 0}, false, false),
+	}
+	expectedStatus.Listeners[0].SupportedKinds = akogatewayapilib.SupportedKinds[gatewayv1.TLSProtocolType]
+	expectedStatus.Listeners[0].Conditions[0].Reason = string(gatewayv1.ListenerReasonInvalid)
+	expectedStatus.Listeners[0].Conditions[0].Status = metav1.ConditionFalse
+	expectedStatus.Listeners[0].Conditions[0].Message = "TLS mode must be Passthrough for TLS protocol"
+
+	expectedStatus.Listeners[1].SupportedKinds = akogatewayapilib.SupportedKinds[gatewayv1.TLSProtocolType]
+
+	gateway, err := tests.GatewayClient.GatewayV1().Gateways(DEFAULT_NAMESPACE).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+	if err != nil || gateway == nil {
+		t.Fatalf("Couldn't get the gateway, err: %+v", err)
+	}
+
+	tests.ValidateGatewayStatus(t, &gateway.Status, expectedStatus)
+	tests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	tests.TeardownGatewayClass(t, gatewayClassName)
+}
+
 func TestGatewayWithInvalidAllowedRoute(t *testing.T) {
 
 	gatewayName := "gateway-neg-06"
@@ -904,7 +960,7 @@ func TestGatewayWithInvalidAllowedRoute(t *testing.T) {
 
 	expectedStatus.Listeners[0].Conditions[1].Reason = string(gatewayv1.ListenerReasonInvalidRouteKinds)
 	expectedStatus.Listeners[0].Conditions[1].Status = metav1.ConditionFalse
-	expectedStatus.Listeners[0].Conditions[1].Message = "AllowedRoute kind is invalid. Only HTTPRoute is supported currently"
+	expectedStatus.Listeners[0].Conditions[1].Message = "AllowedRoute kind is invalid. Only HTTPRoute and GRPCRoute are supported currently"
 	//expectedStatus.Listeners[0].Conditions[1].Type = string(gatewayv1.ListenerConditionResolvedRefs)
 
 	expectedStatus.Listeners[0].Conditions[2].Message = "Virtual service not configured/updated for this listener"
@@ -1464,3 +1520,55 @@ func TestSecretCreateDelete(t *testing.T) {
 	tests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
 	tests.TeardownGatewayClass(t, gatewayClassName)
 }
+
+func TestGatewayCertManagerPendingSecret(t *testing.T) {
+
+	gatewayName := "gateway-13"
+	gatewayClassName := "gateway-class-13"
+	ports := []int32{8080}
+	secrets := []string{"secret-13"}
+
+	tests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := tests.GetListenersV1(ports, false, false, secrets...)
+	gw := &tests.Gateway{}
+	gw.Gateway = gw.GatewayV1(gatewayName, DEFAULT_NAMESPACE, gatewayClassName, nil, listeners)
+	gw.Gateway.Annotations = map[string]string{lib.CertManagerClusterIssuer: "letsencrypt"}
+	gw.Create(t)
+
+	listenerCondition := func(conditionType gatewayv1.ListenerConditionType) *metav1.Condition {
+		gateway, err := tests.GatewayClient.GatewayV1().Gateways(DEFAULT_NAMESPACE).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil || len(gateway.Status.Listeners) != 1 {
+			return nil
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Listeners[0].Conditions, string(conditionType))
+	}
+
+	// the listener is not programmed until cert-manager issues the secret
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() string {
+		if condition := listenerCondition(gatewayv1.ListenerConditionProgrammed); condition != nil {
+			return condition.Reason
+		}
+		return ""
+	}, 30*time.Second).Should(gomega.Equal(string(gatewayv1.ListenerReasonPending)))
+	programmed := listenerCondition(gatewayv1.ListenerConditionProgrammed)
+	g.Expect(programmed.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(programmed.Message).To(gomega.ContainSubstring("pending issuance by cert-manager"))
+	resolvedRefs := listenerCondition(gatewayv1.ListenerConditionResolvedRefs)
+	g.Expect(resolvedRefs.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(resolvedRefs.Reason).To(gomega.Equal(string(gatewayv1.ListenerReasonInvalidCertificateRef)))
+
+	// the listener is programmed once the secret is issued
+	integrationtest.AddSecret(secrets[0], DEFAULT_NAMESPACE, "cert", "key")
+	g.Eventually(func() metav1.ConditionStatus {
+		if condition := listenerCondition(gatewayv1.ListenerConditionProgrammed); condition != nil {
+			return condition.Status
+		}
+		return ""
+	}, 30*time.Second).Should(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(listenerCondition(gatewayv1.ListenerConditionResolvedRefs).Status).To(gomega.Equal(metav1.ConditionTrue))
+
+	tests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	tests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DeleteSecret(secrets[0], DEFAULT_NAMESPACE)
+}
@@ -27,6 +27,8 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
 )
@@ -180,6 +182,75 @@ func TestHTTPRouteWithHostnamesInDedicatedMode(t *testing.T) {
 	integrationtest.DelEPS(t, namespace, svcName)
 }
 
+// TestHTTPRouteWithRequestMirrorInDedicatedMode tests that an HTTPRoute with a RequestMirror filter
+// is rejected by a dedicated Gateway
+func TestHTTPRouteWithRequestMirrorInDedicatedMode(t *testing.T) {
+	gatewayClassName := "gateway-class-dedicated-mirror"
+	gatewayName := "gateway-dedicated-mirror"
+	httpRouteName := "httproute-dedicated-mirror"
+	namespace := DEFAULT_NAMESPACE
+	svcName := "avisvc-dedicated-mirror"
+	mirrorSvcName := "avisvc-dedicated-mirror-canary"
+	ports := []int32{8080}
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	integrationtest.CreateSVC(t, namespace, svcName, "TCP", corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, namespace, svcName, false, false, "1.1.1")
+
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupDedicatedGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/"}, []string{},
+		map[string][]string{},
+		[][]string{{svcName, namespace, "8080", "1"}}, nil)
+	mirrorPort := gatewayv1.PortNumber(8080)
+	rule.Filters = append(rule.Filters, gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestMirror,
+		RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+			BackendRef: gatewayv1.BackendObjectReference{Name: gatewayv1.ObjectName(mirrorSvcName), Port: &mirrorPort},
+		},
+	})
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, namespace, parentRefs, nil, rules)
+
+	g.Eventually(func() bool {
+		httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+		if err != nil || httpRoute == nil {
+			t.Logf("Couldn't get the HTTPRoute, err: %+v", err)
+			return false
+		}
+		if len(httpRoute.Status.Parents) == 0 {
+			return false
+		}
+		condition := apimeta.FindStatusCondition(httpRoute.Status.Parents[0].Conditions, string(gatewayv1.RouteConditionAccepted))
+		return condition != nil && condition.Reason == string(gatewayv1.RouteReasonUnsupportedValue) &&
+			condition.Status == metav1.ConditionFalse
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	condition := apimeta.FindStatusCondition(httpRoute.Status.Parents[0].Conditions, string(gatewayv1.RouteConditionAccepted))
+	g.Expect(condition).ToNot(gomega.BeNil())
+	g.Expect(condition.Message).To(gomega.ContainSubstring("RequestMirror filter is not supported"))
+
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DelSVC(t, namespace, svcName)
+	integrationtest.DelEPS(t, namespace, svcName)
+}
+
 // TestMultipleHTTPRoutesOnDedicatedGateway tests that only one HTTPRoute can be attached
 // to a dedicated Gateway, and subsequent HTTPRoutes are rejected
 func TestMultipleHTTPRoutesOnDedicatedGateway(t *testing.T) {
@@ -623,6 +694,9 @@ func TestHTTPRouteWithCrossNamespaceBackendInDedicatedMode(t *testing.T) {
 	integrationtest.AddNamespace(t, backendNamespace, map[string]string{})
 	integrationtest.CreateSVC(t, backendNamespace, svcName, "TCP", corev1.ServiceTypeClusterIP, false)
 	integrationtest.CreateEPS(t, backendNamespace, svcName, false, false, "1.1.1")
+	// the cross-namespace backend is permitted by a ReferenceGrant in the backend namespace
+	grantName := "grant-dedicated-cross-ns-backend"
+	akogatewayapitests.SetupReferenceGrant(t, grantName, backendNamespace, lib.HTTPRoute, namespace, utils.Service, svcName)
 
 	// Create Gateway with dedicated mode
 	listeners := akogatewayapitests.GetListenersV1(ports, true, false)
@@ -689,6 +763,7 @@ func TestHTTPRouteWithCrossNamespaceBackendInDedicatedMode(t *testing.T) {
 	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
 	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
 	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	akogatewayapitests.TeardownReferenceGrant(t, grantName, backendNamespace)
 	integrationtest.DelSVC(t, backendNamespace, svcName)
 	integrationtest.DelEPS(t, backendNamespace, svcName)
 	integrationtest.DeleteNamespace(backendNamespace)
@@ -934,7 +934,7 @@ func TestMultipleHttpRoutesWithValidAndInvalidGatewayListeners(t *testing.T) {
 	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
 
 	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
-	listeners[1].Protocol = "TCP"
+	listeners[1].Protocol = "SCTP"
 
 	g := gomega.NewGomegaWithT(t)
 	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
@@ -1038,7 +1038,7 @@ func TestHttpRouteWithValidAndInvalidGatewayListeners(t *testing.T) {
 	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
 
 	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
-	listeners[1].Protocol = "TCP"
+	listeners[1].Protocol = "SCTP"
 
 	g := gomega.NewGomegaWithT(t)
 	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
@@ -1387,6 +1387,224 @@ func TestHTTPRouteFilterWithUnsupportedUrlRewritePathType(t *testing.T) {
 	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
 }
 
+func TestHTTPRouteWithUnsupportedQueryParamMatchType(t *testing.T) {
+
+	gatewayName := "gateway-hr-qp-01"
+	gatewayClassName := "gateway-class-hr-qp-01"
+	httpRouteName := "http-route-hr-qp-01"
+	svcName := "avisvc-hr-qp-01"
+	namespace := "default"
+	ports := []int32{8080}
+
+	modelName, _ := akogatewayapitests.GetModelName(namespace, gatewayName)
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 5*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, "default", "8080", "1"}}, nil)
+	queryParamMatchType := gatewayv1.QueryParamMatchRegularExpression
+	rule.Matches[0].QueryParams = []gatewayv1.HTTPQueryParamMatch{{Type: &queryParamMatchType, Name: "version", Value: "v[0-9]+"}}
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, namespace, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+		if err != nil || httpRoute == nil {
+			t.Logf("Couldn't get the HTTPRoute, err: %+v", err)
+			return false
+		}
+		if len(httpRoute.Status.Parents) != 1 {
+			return false
+		}
+		return apimeta.FindStatusCondition(httpRoute.Status.Parents[0].Conditions, string(gatewayv1.RouteConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	conditionMap := make(map[string][]metav1.Condition)
+
+	for _, port := range ports {
+		conditions := make([]metav1.Condition, 0, 1)
+		condition := metav1.Condition{
+			Type:    string(gatewayv1.RouteConditionAccepted),
+			Reason:  string(gatewayv1.RouteReasonUnsupportedValue),
+			Status:  metav1.ConditionFalse,
+			Message: "HTTPRoute QueryParam match type RegularExpression is not supported",
+		}
+		conditions = append(conditions, condition)
+		conditionMap[fmt.Sprintf("%s-%d", gatewayName, port)] = conditions
+	}
+	expectedRouteStatus := akogatewayapitests.GetRouteStatusV1([]string{gatewayName}, namespace, ports, conditionMap)
+
+	httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+	if err != nil || httpRoute == nil {
+		t.Fatalf("Couldn't get the HTTPRoute, err: %+v", err)
+	}
+	akogatewayapitests.ValidateHTTPRouteStatus(t, &httpRoute.Status, &gatewayv1.HTTPRouteStatus{RouteStatus: *expectedRouteStatus})
+
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestHTTPRouteWithPartialRequestMirror(t *testing.T) {
+
+	gatewayName := "gateway-hr-mirror-01"
+	gatewayClassName := "gateway-class-hr-mirror-01"
+	httpRouteName := "http-route-hr-mirror-01"
+	svcName := "avisvc-hr-mirror-01"
+	mirrorSvcName := "avisvc-hr-mirror-01-canary"
+	namespace := "default"
+	ports := []int32{8080}
+
+	modelName, _ := akogatewayapitests.GetModelName(namespace, gatewayName)
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 5*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, "default", "8080", "1"}}, nil)
+	mirrorPort := gatewayv1.PortNumber(8080)
+	mirrorPercent := int32(50)
+	rule.Filters = append(rule.Filters, gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterRequestMirror,
+		RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+			BackendRef: gatewayv1.BackendObjectReference{Name: gatewayv1.ObjectName(mirrorSvcName), Port: &mirrorPort},
+			Percent:    &mirrorPercent,
+		},
+	})
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, namespace, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+		if err != nil || httpRoute == nil {
+			t.Logf("Couldn't get the HTTPRoute, err: %+v", err)
+			return false
+		}
+		if len(httpRoute.Status.Parents) != 1 {
+			return false
+		}
+		return apimeta.FindStatusCondition(httpRoute.Status.Parents[0].Conditions, string(gatewayv1.RouteConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	conditionMap := make(map[string][]metav1.Condition)
+
+	for _, port := range ports {
+		conditions := make([]metav1.Condition, 0, 1)
+		condition := metav1.Condition{
+			Type:    string(gatewayv1.RouteConditionAccepted),
+			Reason:  string(gatewayv1.RouteReasonUnsupportedValue),
+			Status:  metav1.ConditionFalse,
+			Message: "RequestMirror filter with percent or fraction other than 100% is not supported",
+		}
+		conditions = append(conditions, condition)
+		conditionMap[fmt.Sprintf("%s-%d", gatewayName, port)] = conditions
+	}
+	expectedRouteStatus := akogatewayapitests.GetRouteStatusV1([]string{gatewayName}, namespace, ports, conditionMap)
+
+	httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+	if err != nil || httpRoute == nil {
+		t.Fatalf("Couldn't get the HTTPRoute, err: %+v", err)
+	}
+	akogatewayapitests.ValidateHTTPRouteStatus(t, &httpRoute.Status, &gatewayv1.HTTPRouteStatus{RouteStatus: *expectedRouteStatus})
+
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
+func TestHTTPRouteWithInsufficientRequestTimeoutForRetry(t *testing.T) {
+
+	gatewayName := "gateway-hr-timeouts-01"
+	gatewayClassName := "gateway-class-hr-timeouts-01"
+	httpRouteName := "http-route-hr-timeouts-01"
+	svcName := "avisvc-hr-timeouts-01"
+	namespace := "default"
+	ports := []int32{8080}
+
+	modelName, _ := akogatewayapitests.GetModelName(namespace, gatewayName)
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+	g := gomega.NewGomegaWithT(t)
+
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 5*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, "default", "8080", "1"}}, nil)
+	var requestTimeout gatewayv1.Duration = "5s"
+	var backendRequestTimeout gatewayv1.Duration = "2s"
+	attempts := 3
+	rule.Timeouts = &gatewayv1.HTTPRouteTimeouts{
+		Request:        &requestTimeout,
+		BackendRequest: &backendRequestTimeout,
+	}
+	rule.Retry = &gatewayv1.HTTPRouteRetry{
+		Codes:    []gatewayv1.HTTPRouteRetryStatusCode{503},
+		Attempts: &attempts,
+	}
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, namespace, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+		if err != nil || httpRoute == nil {
+			t.Logf("Couldn't get the HTTPRoute, err: %+v", err)
+			return false
+		}
+		if len(httpRoute.Status.Parents) != 1 {
+			return false
+		}
+		return apimeta.FindStatusCondition(httpRoute.Status.Parents[0].Conditions, string(gatewayv1.RouteConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	conditionMap := make(map[string][]metav1.Condition)
+
+	for _, port := range ports {
+		conditions := make([]metav1.Condition, 0, 1)
+		condition := metav1.Condition{
+			Type:    string(gatewayv1.RouteConditionAccepted),
+			Reason:  string(gatewayv1.RouteReasonUnsupportedValue),
+			Status:  metav1.ConditionFalse,
+			Message: "HTTPRoute Request timeout 5s is less than BackendRequest timeout 2s for 3 Retry attempts",
+		}
+		conditions = append(conditions, condition)
+		conditionMap[fmt.Sprintf("%s-%d", gatewayName, port)] = conditions
+	}
+	expectedRouteStatus := akogatewayapitests.GetRouteStatusV1([]string{gatewayName}, namespace, ports, conditionMap)
+
+	httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), httpRouteName, metav1.GetOptions{})
+	if err != nil || httpRoute == nil {
+		t.Fatalf("Couldn't get the HTTPRoute, err: %+v", err)
+	}
+	akogatewayapitests.ValidateHTTPRouteStatus(t, &httpRoute.Status, &gatewayv1.HTTPRouteStatus{RouteStatus: *expectedRouteStatus})
+
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, DEFAULT_NAMESPACE)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+}
+
 func TestHTTPRouteStatusWithHealthMonitorLifecycle(t *testing.T) {
 	gatewayClassName := "gateway-class-hm-lifecycle"
 	gatewayName := "gateway-hm-lifecycle"
@@ -1595,6 +1813,8 @@ func TestHTTPRouteStatusWithCrossNamespaceHealthMonitorDifferentTenant(t *testin
 
 	// Create HealthMonitor CRD in different namespace with different tenant
 	akogatewayapitests.CreateHealthMonitorCRDWithStatus(t, healthMonitorName, healthMonitorNamespace, "thisisaviref-hm-status-04", true, "Accepted", "HealthMonitor has been successfully processed")
+	// the cross-namespace backend is permitted by a ReferenceGrant, so that the HealthMonitor tenant is validated
+	akogatewayapitests.SetupReferenceGrant(t, "grant-hm-status-04", healthMonitorNamespace, lib.HTTPRoute, namespace, utils.Service, svcName)
 
 	// Create HTTPRoute with reference to HealthMonitor in different namespace
 	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
@@ -1635,6 +1855,7 @@ func TestHTTPRouteStatusWithCrossNamespaceHealthMonitorDifferentTenant(t *testin
 
 	// Cleanup
 	akogatewayapitests.DeleteHealthMonitorCRD(t, healthMonitorName, healthMonitorNamespace)
+	akogatewayapitests.TeardownReferenceGrant(t, "grant-hm-status-04", healthMonitorNamespace)
 	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
 	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
 	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
@@ -1999,6 +2220,8 @@ func TestHTTPRouteStatusWithCrossNamespaceRouteBackendExtensionDifferentTenant(t
 	// Create RouteBackendExtension CR in different namespace with different tenant
 	rbe := akogatewayapitests.GetFakeDefaultRBEObj(routeBackendExtensionName, routeBackendExtensionNamespace, "thisisaviref-rbe-status-02")
 	rbe.CreateRouteBackendExtensionCRWithStatus(t)
+	// the cross-namespace backend is permitted by a ReferenceGrant, so that the RouteBackendExtension tenant is validated
+	akogatewayapitests.SetupReferenceGrant(t, "grant-rbe-status-02", routeBackendExtensionNamespace, lib.HTTPRoute, namespace, utils.Service, svcName)
 
 	// Create HTTPRoute with reference to RouteBackendExtension in different namespace
 	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
@@ -2038,6 +2261,7 @@ func TestHTTPRouteStatusWithCrossNamespaceRouteBackendExtensionDifferentTenant(t
 
 	// Cleanup
 	rbe.DeleteRouteBackendExtensionCR(t)
+	akogatewayapitests.TeardownReferenceGrant(t, "grant-rbe-status-02", routeBackendExtensionNamespace)
 	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
 	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
 	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
@@ -0,0 +1,158 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+func getTCPRouteParentCondition(t *testing.T, name, namespace, conditionType string) *metav1.Condition {
+	tcpRoute, err := akogatewayapitests.GatewayClient.GatewayV1alpha2().TCPRoutes(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil || tcpRoute == nil {
+		t.Logf("Couldn't get the TCPRoute, err: %+v", err)
+		return nil
+	}
+	if len(tcpRoute.Status.Parents) != 1 {
+		return nil
+	}
+	return apimeta.FindStatusCondition(tcpRoute.Status.Parents[0].Conditions, conditionType)
+}
+
+func getUDPRouteParentCondition(t *testing.T, name, namespace, conditionType string) *metav1.Condition {
+	udpRoute, err := akogatewayapitests.GatewayClient.GatewayV1alpha2().UDPRoutes(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil || udpRoute == nil {
+		t.Logf("Couldn't get the UDPRoute, err: %+v", err)
+		return nil
+	}
+	if len(udpRoute.Status.Parents) != 1 {
+		return nil
+	}
+	return apimeta.FindStatusCondition(udpRoute.Status.Parents[0].Conditions, conditionType)
+}
+
+func TestTCPRouteWithValidConfig(t *testing.T) {
+	gatewayClassName := "gateway-class-tcpr-01"
+	gatewayName := "gateway-tcpr-01"
+	tcpRouteName := "tcproute-01"
+	namespace := "default"
+	svcName := "avisvc-tcpr-01"
+	ports := []int32{9000}
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, "TCP", corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", svcName, false, false, "1.1.1")
+
+	listeners := akogatewayapitests.GetListenersV1(ports, true, false)
+	listeners[0].Protocol = gatewayv1.TCPProtocolType
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	rule := akogatewayapitests.GetTCPRouteRuleV1Alpha2([][]string{{svcName, namespace, "8080", "1"}})
+	rules := []gatewayv1alpha2.TCPRouteRule{rule}
+	akogatewayapitests.SetupTCPRoute(t, tcpRouteName, namespace, parentRefs, rules)
+
+	g.Eventually(func() bool {
+		condition := getTCPRouteParentCondition(t, tcpRouteName, namespace, string(gatewayv1.RouteConditionResolvedRefs))
+		return condition != nil && condition.Status == metav1.ConditionTrue
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	condition := getTCPRouteParentCondition(t, tcpRouteName, namespace, string(gatewayv1.RouteConditionAccepted))
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(gomega.Equal(string(gatewayv1.RouteReasonAccepted)))
+
+	// the attached route is counted on the TCP listener
+	g.Eventually(func() int32 {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil || len(gateway.Status.Listeners) != 1 {
+			return -1
+		}
+		return gateway.Status.Listeners[0].AttachedRoutes
+	}, 30*time.Second).Should(gomega.Equal(int32(1)))
+
+	akogatewayapitests.TeardownTCPRoute(t, tcpRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DelSVC(t, namespace, svcName)
+	integrationtest.DelEPS(t, namespace, svcName)
+}
+
+func TestUDPRouteWithTCPListener(t *testing.T) {
+	gatewayClassName := "gateway-class-tcpr-02"
+	gatewayName := "gateway-tcpr-02"
+	udpRouteName := "udproute-02"
+	namespace := "default"
+	svcName := "avisvc-tcpr-02"
+	ports := []int32{9001}
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, "TCP", corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", svcName, false, false, "1.1.1")
+
+	listeners := akogatewayapitests.GetListenersV1(ports, true, false)
+	listeners[0].Protocol = gatewayv1.TCPProtocolType
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	rule := akogatewayapitests.GetUDPRouteRuleV1Alpha2([][]string{{svcName, namespace, "8080", "1"}})
+	rules := []gatewayv1alpha2.UDPRouteRule{rule}
+	akogatewayapitests.SetupUDPRoute(t, udpRouteName, namespace, parentRefs, rules)
+
+	// a UDPRoute can not be attached to a TCP listener
+	g.Eventually(func() bool {
+		condition := getUDPRouteParentCondition(t, udpRouteName, namespace, string(gatewayv1.RouteConditionAccepted))
+		return condition != nil && condition.Status == metav1.ConditionFalse &&
+			condition.Reason == string(gatewayv1.RouteReasonNotAllowedByListeners)
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownUDPRoute(t, udpRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DelSVC(t, namespace, svcName)
+	integrationtest.DelEPS(t, namespace, svcName)
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+func getHTTPRouteResolvedRefsCondition(t *testing.T, name, namespace string) *metav1.Condition {
+	httpRoute, err := akogatewayapitests.GatewayClient.GatewayV1().HTTPRoutes(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil || httpRoute == nil {
+		t.Logf("Couldn't get the HTTPRoute, err: %+v", err)
+		return nil
+	}
+	if len(httpRoute.Status.Parents) != 1 {
+		return nil
+	}
+	return apimeta.FindStatusCondition(httpRoute.Status.Parents[0].Conditions, string(gatewayv1.RouteConditionResolvedRefs))
+}
+
+func getListenerResolvedRefsCondition(t *testing.T, name, namespace string) *metav1.Condition {
+	gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil || gateway == nil {
+		t.Logf("Couldn't get the gateway, err: %+v", err)
+		return nil
+	}
+	if len(gateway.Status.Listeners) != 1 {
+		return nil
+	}
+	return apimeta.FindStatusCondition(gateway.Status.Listeners[0].Conditions, string(gatewayv1.ListenerConditionResolvedRefs))
+}
+
+/* Test cases
+ * - HTTPRoute with a backendRef in another namespace, permitted and revoked by a ReferenceGrant
+ * - Gateway with a certificateRef in another namespace, permitted by a ReferenceGrant
+ */
+func TestHTTPRouteCrossNamespaceBackendWithReferenceGrant(t *testing.T) {
+	gatewayClassName := "gateway-class-rg-01"
+	gatewayName := "gateway-rg-01"
+	httpRouteName := "httproute-rg-01"
+	grantName := "referencegrant-rg-01"
+	backendNamespace := "backend-rg-01"
+	svcName := "avisvc-rg-01"
+	namespace := "default"
+	ports := []int32{8080}
+
+	integrationtest.AddNamespace(t, backendNamespace, map[string]string{})
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	integrationtest.CreateSVC(t, backendNamespace, svcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, backendNamespace, svcName, false, false, "1.1.1")
+
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	rule := akogatewayapitests.GetHTTPRouteRuleV1(integrationtest.PATHPREFIX, []string{"/foo"}, []string{}, nil,
+		[][]string{{svcName, backendNamespace, "8080", "1"}}, nil)
+	rules := []gatewayv1.HTTPRouteRule{rule}
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	akogatewayapitests.SetupHTTPRoute(t, httpRouteName, namespace, parentRefs, hostnames, rules)
+
+	// the backend in another namespace is not permitted without a ReferenceGrant
+	g.Eventually(func() bool {
+		condition := getHTTPRouteResolvedRefsCondition(t, httpRouteName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionFalse &&
+			condition.Reason == string(gatewayv1.RouteReasonRefNotPermitted)
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.SetupReferenceGrant(t, grantName, backendNamespace, lib.HTTPRoute, namespace, utils.Service, svcName)
+	g.Eventually(func() bool {
+		condition := getHTTPRouteResolvedRefsCondition(t, httpRouteName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionTrue
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownReferenceGrant(t, grantName, backendNamespace)
+	g.Eventually(func() bool {
+		condition := getHTTPRouteResolvedRefsCondition(t, httpRouteName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionFalse &&
+			condition.Reason == string(gatewayv1.RouteReasonRefNotPermitted)
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownHTTPRoute(t, httpRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DelSVC(t, backendNamespace, svcName)
+	integrationtest.DelEPS(t, backendNamespace, svcName)
+}
+
+func TestGatewayCrossNamespaceCertificateWithReferenceGrant(t *testing.T) {
+	gatewayClassName := "gateway-class-rg-02"
+	gatewayName := "gateway-rg-02"
+	grantName := "referencegrant-rg-02"
+	secretNamespace := "secret-rg-02"
+	secretName := "secret-rg-02"
+	namespace := "default"
+	ports := []int32{8443}
+
+	integrationtest.AddNamespace(t, secretNamespace, map[string]string{})
+	integrationtest.AddSecret(secretName, secretNamespace, "cert", "key")
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false, secretName)
+	certNamespace := gatewayv1.Namespace(secretNamespace)
+	listeners[0].TLS.CertificateRefs[0].Namespace = &certNamespace
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	// the secret in another namespace is not permitted without a ReferenceGrant
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		condition := getListenerResolvedRefsCondition(t, gatewayName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionFalse &&
+			condition.Reason == string(gatewayv1.ListenerReasonRefNotPermitted)
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.SetupReferenceGrant(t, grantName, secretNamespace, lib.Gateway, namespace, utils.Secret, "")
+	g.Eventually(func() bool {
+		condition := getListenerResolvedRefsCondition(t, gatewayName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionTrue
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownReferenceGrant(t, grantName, secretNamespace)
+	g.Eventually(func() bool {
+		condition := getListenerResolvedRefsCondition(t, gatewayName, namespace)
+		return condition != nil && condition.Status == metav1.ConditionFalse &&
+			condition.Reason == string(gatewayv1.ListenerReasonRefNotPermitted)
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DeleteSecret(secretName, secretNamespace)
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
+	akogatewayapitests "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/gatewayapitests"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+func getTLSRouteParentCondition(t *testing.T, name, namespace, conditionType string) *metav1.Condition {
+	tlsRoute, err := akogatewayapitests.GatewayClient.GatewayV1alpha2().TLSRoutes(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil || tlsRoute == nil {
+		t.Logf("Couldn't get the TLSRoute, err: %+v", err)
+		return nil
+	}
+	if len(tlsRoute.Status.Parents) != 1 {
+		return nil
+	}
+	return apimeta.FindStatusCondition(tlsRoute.Status.Parents[0].Conditions, conditionType)
+}
+
+func TestTLSRouteWithValidConfig(t *testing.T) {
+	gatewayClassName := "gateway-class-tlsr-01"
+	gatewayName := "gateway-tlsr-01"
+	tlsRouteName := "tlsroute-01"
+	namespace := "default"
+	svcName := "avisvc-tlsr-01"
+	ports := []int32{8443}
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, "TCP", corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", svcName, false, false, "1.1.1")
+
+	listeners := akogatewayapitests.GetListenersV1(ports, true, false)
+	passthroughMode := gatewayv1.TLSModePassthrough
+	listeners[0].Protocol = gatewayv1.TLSProtocolType
+	listeners[0].TLS = &gatewayv1.GatewayTLSConfig{Mode: &passthroughMode}
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	hostnames := []gatewayv1.Hostname{"foo-8443.com"}
+	rule := akogatewayapitests.GetTLSRouteRuleV1Alpha2([][]string{{svcName, namespace, "8080", "1"}})
+	rules := []gatewayv1alpha2.TLSRouteRule{rule}
+	akogatewayapitests.SetupTLSRoute(t, tlsRouteName, namespace, parentRefs, hostnames, rules)
+
+	g.Eventually(func() bool {
+		condition := getTLSRouteParentCondition(t, tlsRouteName, namespace, string(gatewayv1.RouteConditionResolvedRefs))
+		return condition != nil && condition.Status == metav1.ConditionTrue
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	condition := getTLSRouteParentCondition(t, tlsRouteName, namespace, string(gatewayv1.RouteConditionAccepted))
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(gomega.Equal(string(gatewayv1.RouteReasonAccepted)))
+
+	// the attached route is counted on the passthrough listener
+	g.Eventually(func() int32 {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil || len(gateway.Status.Listeners) != 1 {
+			return -1
+		}
+		return gateway.Status.Listeners[0].AttachedRoutes
+	}, 30*time.Second).Should(gomega.Equal(int32(1)))
+
+	akogatewayapitests.TeardownTLSRoute(t, tlsRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DelSVC(t, namespace, svcName)
+	integrationtest.DelEPS(t, namespace, svcName)
+}
+
+func TestTLSRouteWithHTTPSListener(t *testing.T) {
+	gatewayClassName := "gateway-class-tlsr-02"
+	gatewayName := "gateway-tlsr-02"
+	tlsRouteName := "tlsroute-02"
+	namespace := "default"
+	svcName := "avisvc-tlsr-02"
+	ports := []int32{8080}
+
+	akogatewayapitests.SetupGatewayClass(t, gatewayClassName, akogatewayapilib.GatewayController)
+	integrationtest.CreateSVC(t, DEFAULT_NAMESPACE, svcName, "TCP", corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", svcName, false, false, "1.1.1")
+
+	listeners := akogatewayapitests.GetListenersV1(ports, false, false)
+	akogatewayapitests.SetupGateway(t, gatewayName, namespace, gatewayClassName, nil, listeners)
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() bool {
+		gateway, err := akogatewayapitests.GatewayClient.GatewayV1().Gateways(namespace).Get(context.TODO(), gatewayName, metav1.GetOptions{})
+		if err != nil || gateway == nil {
+			t.Logf("Couldn't get the gateway, err: %+v", err)
+			return false
+		}
+		return apimeta.FindStatusCondition(gateway.Status.Conditions, string(gatewayv1.GatewayConditionAccepted)) != nil
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	parentRefs := akogatewayapitests.GetParentReferencesV1([]string{gatewayName}, namespace, ports)
+	hostnames := []gatewayv1.Hostname{"foo-8080.com"}
+	rule := akogatewayapitests.GetTLSRouteRuleV1Alpha2([][]string{{svcName, namespace, "8080", "1"}})
+	rules := []gatewayv1alpha2.TLSRouteRule{rule}
+	akogatewayapitests.SetupTLSRoute(t, tlsRouteName, namespace, parentRefs, hostnames, rules)
+
+	// a TLSRoute can not be attached to a HTTPS listener
+	g.Eventually(func() bool {
+		condition := getTLSRouteParentCondition(t, tlsRouteName, namespace, string(gatewayv1.RouteConditionAccepted))
+		return condition != nil && condition.Status == metav1.ConditionFalse &&
+			condition.Reason == string(gatewayv1.RouteReasonNotAllowedByListeners)
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	akogatewayapitests.TeardownTLSRoute(t, tlsRouteName, namespace)
+	akogatewayapitests.TeardownGateway(t, gatewayName, namespace)
+	akogatewayapitests.TeardownGatewayClass(t, gatewayClassName)
+	integrationtest.DelSVC(t, namespace, svcName)
+	integrationtest.DelEPS(t, namespace, svcName)
+}
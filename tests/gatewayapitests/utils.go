@@ -30,10 +30,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	gatewayfake "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
 
 	akogatewayapilib "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/ako-gateway-api/lib"
@@ -728,6 +732,405 @@ func TeardownHTTPRoute(t *testing.T, name, namespace string) {
 	hr.Delete(t)
 }
 
+type GRPCRoute struct {
+	*gatewayv1.GRPCRoute
+}
+
+func (gr *GRPCRoute) GRPCRouteV1(name, namespace string, parentRefs []gatewayv1.ParentReference, hostnames []gatewayv1.Hostname, rules []gatewayv1.GRPCRouteRule) *gatewayv1.GRPCRoute {
+	grpcRoute := &gatewayv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: time.Now().Local().String(),
+		},
+		Spec: gatewayv1.GRPCRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: parentRefs,
+			},
+			Hostnames: hostnames,
+			Rules:     rules,
+		},
+	}
+	return grpcRoute
+}
+
+// GetGRPCRouteRuleV1 returns a GRPCRoute rule matching the given gRPC service and method,
+// forwarding to the backends given as {name, namespace, port, weight}.
+func GetGRPCRouteRuleV1(matchType gatewayv1.GRPCMethodMatchType, service, method string, backendRefs [][]string) gatewayv1.GRPCRouteRule {
+	rule := gatewayv1.GRPCRouteRule{}
+	methodMatch := &gatewayv1.GRPCMethodMatch{Type: &matchType}
+	if service != "" {
+		methodMatch.Service = &service
+	}
+	if method != "" {
+		methodMatch.Method = &method
+	}
+	rule.Matches = append(rule.Matches, gatewayv1.GRPCRouteMatch{Method: methodMatch})
+	for _, backendRef := range backendRefs {
+		rule.BackendRefs = append(rule.BackendRefs, gatewayv1.GRPCBackendRef{
+			BackendRef: GetHTTPRouteBackendV1(backendRef).BackendRef,
+		})
+	}
+	return rule
+}
+
+func (gr *GRPCRoute) Create(t *testing.T) {
+	_, err := GatewayClient.GatewayV1().GRPCRoutes(gr.Namespace).Create(context.TODO(), gr.GRPCRoute, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't create the GRPCRoute, err: %+v", err)
+	}
+	t.Logf("Created GRPCRoute %s", gr.Name)
+}
+
+func (gr *GRPCRoute) Update(t *testing.T) {
+	_, err := GatewayClient.GatewayV1().GRPCRoutes(gr.Namespace).Update(context.TODO(), gr.GRPCRoute, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't update the GRPCRoute, err: %+v", err)
+	}
+	t.Logf("Updated GRPCRoute %s", gr.Name)
+}
+
+func (gr *GRPCRoute) Delete(t *testing.T) {
+	err := GatewayClient.GatewayV1().GRPCRoutes(gr.Namespace).Delete(context.TODO(), gr.Name, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't delete the GRPCRoute, err: %+v", err)
+	}
+	t.Logf("Deleted GRPCRoute %s", gr.Name)
+}
+
+func SetupGRPCRoute(t *testing.T, name, namespace string, parentRefs []gatewayv1.ParentReference, hostnames []gatewayv1.Hostname, rules []gatewayv1.GRPCRouteRule) {
+	gr := &GRPCRoute{}
+	gr.GRPCRoute = gr.GRPCRouteV1(name, namespace, parentRefs, hostnames, rules)
+	gr.Create(t)
+}
+
+func UpdateGRPCRoute(t *testing.T, name, namespace string, parentRefs []gatewayv1.ParentReference, hostnames []gatewayv1.Hostname, rules []gatewayv1.GRPCRouteRule) {
+	gr := &GRPCRoute{}
+	gr.GRPCRoute = gr.GRPCRouteV1(name, namespace, parentRefs, hostnames, rules)
+	gr.Update(t)
+}
+
+func TeardownGRPCRoute(t *testing.T, name, namespace string) {
+	gr := &GRPCRoute{}
+	gr.GRPCRoute = gr.GRPCRouteV1(name, namespace, nil, nil, nil)
+	gr.Delete(t)
+}
+
+// RegisterExperimentalResources makes the fake discovery serve the experimental TLSRoute, TCPRoute, UDPRoute
+// and BackendTLSPolicy resources, it must be called before the Gateway API informers are initialised.
+func RegisterExperimentalResources(client *gatewayfake.Clientset) {
+	fakeDiscovery := client.Discovery().(*fakediscovery.FakeDiscovery)
+	fakeDiscovery.Resources = append(fakeDiscovery.Resources, &metav1.APIResourceList{
+		GroupVersion: gatewayv1alpha2.GroupVersion.String(),
+		APIResources: []metav1.APIResource{
+			{Name: akogatewayapilib.TLSRouteResource, Kind: lib.TLSRoute, Namespaced: true},
+			{Name: akogatewayapilib.TCPRouteResource, Kind: lib.TCPRoute, Namespaced: true},
+			{Name: akogatewayapilib.UDPRouteResource, Kind: lib.UDPRoute, Namespaced: true},
+		},
+	}, &metav1.APIResourceList{
+		GroupVersion: gatewayv1alpha3.GroupVersion.String(),
+		APIResources: []metav1.APIResource{
+			{Name: akogatewayapilib.BackendTLSPolicyResource, Kind: lib.BackendTLSPolicy, Namespaced: true},
+		},
+	})
+}
+
+type TLSRoute struct {
+	*gatewayv1alpha2.TLSRoute
+}
+
+func (tr *TLSRoute) TLSRouteV1Alpha2(name, namespace string, parentRefs []gatewayv1.ParentReference, hostnames []gatewayv1.Hostname, rules []gatewayv1alpha2.TLSRouteRule) *gatewayv1alpha2.TLSRoute {
+	tlsRoute := &gatewayv1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: time.Now().Local().String(),
+		},
+		Spec: gatewayv1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: parentRefs,
+			},
+			Hostnames: hostnames,
+			Rules:     rules,
+		},
+	}
+	return tlsRoute
+}
+
+// GetTLSRouteRuleV1Alpha2 returns a TLSRoute rule forwarding to the backends given as {name, namespace, port, weight}.
+func GetTLSRouteRuleV1Alpha2(backendRefs [][]string) gatewayv1alpha2.TLSRouteRule {
+	rule := gatewayv1alpha2.TLSRouteRule{}
+	for _, backendRef := range backendRefs {
+		rule.BackendRefs = append(rule.BackendRefs, GetHTTPRouteBackendV1(backendRef).BackendRef)
+	}
+	return rule
+}
+
+func (tr *TLSRoute) Create(t *testing.T) {
+	_, err := GatewayClient.GatewayV1alpha2().TLSRoutes(tr.Namespace).Create(context.TODO(), tr.TLSRoute, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't create the TLSRoute, err: %+v", err)
+	}
+	t.Logf("Created TLSRoute %s", tr.Name)
+}
+
+func (tr *TLSRoute) Update(t *testing.T) {
+	_, err := GatewayClient.GatewayV1alpha2().TLSRoutes(tr.Namespace).Update(context.TODO(), tr.TLSRoute, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't update the TLSRoute, err: %+v", err)
+	}
+	t.Logf("Updated TLSRoute %s", tr.Name)
+}
+
+func (tr *TLSRoute) Delete(t *testing.T) {
+	err := GatewayClient.GatewayV1alpha2().TLSRoutes(tr.Namespace).Delete(context.TODO(), tr.Name, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't delete the TLSRoute, err: %+v", err)
+	}
+	t.Logf("Deleted TLSRoute %s", tr.Name)
+}
+
+func SetupTLSRoute(t *testing.T, name, namespace string, parentRefs []gatewayv1.ParentReference, hostnames []gatewayv1.Hostname, rules []gatewayv1alpha2.TLSRouteRule) {
+	tr := &TLSRoute{}
+	tr.TLSRoute = tr.TLSRouteV1Alpha2(name, namespace, parentRefs, hostnames, rules)
+	tr.Create(t)
+}
+
+func UpdateTLSRoute(t *testing.T, name, namespace string, parentRefs []gatewayv1.ParentReference, hostnames []gatewayv1.Hostname, rules []gatewayv1alpha2.TLSRouteRule) {
+	tr := &TLSRoute{}
+	tr.TLSRoute = tr.TLSRouteV1Alpha2(name, namespace, parentRefs, hostnames, rules)
+	tr.Update(t)
+}
+
+func TeardownTLSRoute(t *testing.T, name, namespace string) {
+	tr := &TLSRoute{}
+	tr.TLSRoute = tr.TLSRouteV1Alpha2(name, namespace, nil, nil, nil)
+	tr.Delete(t)
+}
+
+type TCPRoute struct {
+	*gatewayv1alpha2.TCPRoute
+}
+
+func (tr *TCPRoute) TCPRouteV1Alpha2(name, namespace string, parentRefs []gatewayv1.ParentReference, rules []gatewayv1alpha2.TCPRouteRule) *gatewayv1alpha2.TCPRoute {
+	tcpRoute := &gatewayv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: time.Now().Local().String(),
+		},
+		Spec: gatewayv1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: parentRefs,
+			},
+			Rules: rules,
+		},
+	}
+	return tcpRoute
+}
+
+// GetTCPRouteRuleV1Alpha2 returns a TCPRoute rule forwarding to the backends given as {name, namespace, port, weight}.
+func GetTCPRouteRuleV1Alpha2(backendRefs [][]string) gatewayv1alpha2.TCPRouteRule {
+	rule := gatewayv1alpha2.TCPRouteRule{}
+	for _, backendRef := range backendRefs {
+		rule.BackendRefs = append(rule.BackendRefs, GetHTTPRouteBackendV1(backendRef).BackendRef)
+	}
+	return rule
+}
+
+func (tr *TCPRoute) Create(t *testing.T) {
+	_, err := GatewayClient.GatewayV1alpha2().TCPRoutes(tr.Namespace).Create(context.TODO(), tr.TCPRoute, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't create the TCPRoute, err: %+v", err)
+	}
+	t.Logf("Created TCPRoute %s", tr.Name)
+}
+
+func (tr *TCPRoute) Update(t *testing.T) {
+	_, err := GatewayClient.GatewayV1alpha2().TCPRoutes(tr.Namespace).Update(context.TODO(), tr.TCPRoute, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't update the TCPRoute, err: %+v", err)
+	}
+	t.Logf("Updated TCPRoute %s", tr.Name)
+}
+
+func (tr *TCPRoute) Delete(t *testing.T) {
+	err := GatewayClient.GatewayV1alpha2().TCPRoutes(tr.Namespace).Delete(context.TODO(), tr.Name, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't delete the TCPRoute, err: %+v", err)
+	}
+	t.Logf("Deleted TCPRoute %s", tr.Name)
+}
+
+func SetupTCPRoute(t *testing.T, name, namespace string, parentRefs []gatewayv1.ParentReference, rules []gatewayv1alpha2.TCPRouteRule) {
+	tr := &TCPRoute{}
+	tr.TCPRoute = tr.TCPRouteV1Alpha2(name, namespace, parentRefs, rules)
+	tr.Create(t)
+}
+
+func UpdateTCPRoute(t *testing.T, name, namespace string, parentRefs []gatewayv1.ParentReference, rules []gatewayv1alpha2.TCPRouteRule) {
+	tr := &TCPRoute{}
+	tr.TCPRoute = tr.TCPRouteV1Alpha2(name, namespace, parentRefs, rules)
+	tr.Update(t)
+}
+
+func TeardownTCPRoute(t *testing.T, name, namespace string) {
+	tr := &TCPRoute{}
+	tr.TCPRoute = tr.TCPRouteV1Alpha2(name, namespace, nil, nil)
+	tr.Delete(t)
+}
+
+type UDPRoute struct {
+	*gatewayv1alpha2.UDPRoute
+}
+
+func (ur *UDPRoute) UDPRouteV1Alpha2(name, namespace string, parentRefs []gatewayv1.ParentReference, rules []gatewayv1alpha2.UDPRouteRule) *gatewayv1alpha2.UDPRoute {
+	udpRoute := &gatewayv1alpha2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: time.Now().Local().String(),
+		},
+		Spec: gatewayv1alpha2.UDPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: parentRefs,
+			},
+			Rules: rules,
+		},
+	}
+	return udpRoute
+}
+
+// GetUDPRouteRuleV1Alpha2 returns a UDPRoute rule forwarding to the backends given as {name, namespace, port, weight}.
+func GetUDPRouteRuleV1Alpha2(backendRefs [][]string) gatewayv1alpha2.UDPRouteRule {
+	rule := gatewayv1alpha2.UDPRouteRule{}
+	for _, backendRef := range backendRefs {
+		rule.BackendRefs = append(rule.BackendRefs, GetHTTPRouteBackendV1(backendRef).BackendRef)
+	}
+	return rule
+}
+
+func (ur *UDPRoute) Create(t *testing.T) {
+	_, err := GatewayClient.GatewayV1alpha2().UDPRoutes(ur.Namespace).Create(context.TODO(), ur.UDPRoute, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't create the UDPRoute, err: %+v", err)
+	}
+	t.Logf("Created UDPRoute %s", ur.Name)
+}
+
+func (ur *UDPRoute) Update(t *testing.T) {
+	_, err := GatewayClient.GatewayV1alpha2().UDPRoutes(ur.Namespace).Update(context.TODO(), ur.UDPRoute, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't update the UDPRoute, err: %+v", err)
+	}
+	t.Logf("Updated UDPRoute %s", ur.Name)
+}
+
+func (ur *UDPRoute) Delete(t *testing.T) {
+	err := GatewayClient.GatewayV1alpha2().UDPRoutes(ur.Namespace).Delete(context.TODO(), ur.Name, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't delete the UDPRoute, err: %+v", err)
+	}
+	t.Logf("Deleted UDPRoute %s", ur.Name)
+}
+
+func SetupUDPRoute(t *testing.T, name, namespace string, parentRefs []gatewayv1.ParentReference, rules []gatewayv1alpha2.UDPRouteRule) {
+	ur := &UDPRoute{}
+	ur.UDPRoute = ur.UDPRouteV1Alpha2(name, namespace, parentRefs, rules)
+	ur.Create(t)
+}
+
+func UpdateUDPRoute(t *testing.T, name, namespace string, parentRefs []gatewayv1.ParentReference, rules []gatewayv1alpha2.UDPRouteRule) {
+	ur := &UDPRoute{}
+	ur.UDPRoute = ur.UDPRouteV1Alpha2(name, namespace, parentRefs, rules)
+	ur.Update(t)
+}
+
+func TeardownUDPRoute(t *testing.T, name, namespace string) {
+	ur := &UDPRoute{}
+	ur.UDPRoute = ur.UDPRouteV1Alpha2(name, namespace, nil, nil)
+	ur.Delete(t)
+}
+
+// SetupReferenceGrant creates a ReferenceGrant in namespace, allowing the objects of fromKind in fromNamespace
+// to refer to the objects of toKind. An empty toName allows references to all the objects of toKind.
+func SetupReferenceGrant(t *testing.T, name, namespace, fromKind, fromNamespace, toKind, toName string) {
+	grant := &gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{
+				Group:     gatewayv1.GroupName,
+				Kind:      gatewayv1.Kind(fromKind),
+				Namespace: gatewayv1.Namespace(fromNamespace),
+			}},
+			To: []gatewayv1beta1.ReferenceGrantTo{{
+				Kind: gatewayv1.Kind(toKind),
+			}},
+		},
+	}
+	if toName != "" {
+		grantToName := gatewayv1.ObjectName(toName)
+		grant.Spec.To[0].Name = &grantToName
+	}
+	_, err := GatewayClient.GatewayV1beta1().ReferenceGrants(namespace).Create(context.TODO(), grant, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't create the ReferenceGrant, err: %+v", err)
+	}
+	t.Logf("Created ReferenceGrant %s", name)
+}
+
+func TeardownReferenceGrant(t *testing.T, name, namespace string) {
+	err := GatewayClient.GatewayV1beta1().ReferenceGrants(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't delete the ReferenceGrant, err: %+v", err)
+	}
+	t.Logf("Deleted ReferenceGrant %s", name)
+}
+
+// SetupBackendTLSPolicy creates a BackendTLSPolicy targeting the Service svcName, validating the backend with
+// the CA certificates of the ConfigMaps caConfigMaps and the hostname.
+func SetupBackendTLSPolicy(t *testing.T, name, namespace, svcName string, caConfigMaps []string, hostname string) {
+	policy := &gatewayv1alpha3.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: gatewayv1alpha3.BackendTLSPolicySpec{
+			TargetRefs: []gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName{{
+				LocalPolicyTargetReference: gatewayv1alpha2.LocalPolicyTargetReference{
+					Kind: utils.Service,
+					Name: gatewayv1.ObjectName(svcName),
+				},
+			}},
+			Validation: gatewayv1alpha3.BackendTLSPolicyValidation{
+				Hostname: gatewayv1.PreciseHostname(hostname),
+			},
+		},
+	}
+	for _, caConfigMap := range caConfigMaps {
+		policy.Spec.Validation.CACertificateRefs = append(policy.Spec.Validation.CACertificateRefs, gatewayv1.LocalObjectReference{
+			Kind: "ConfigMap",
+			Name: gatewayv1.ObjectName(caConfigMap),
+		})
+	}
+	_, err := GatewayClient.GatewayV1alpha3().BackendTLSPolicies(namespace).Create(context.TODO(), policy, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't create the BackendTLSPolicy, err: %+v", err)
+	}
+	t.Logf("Created BackendTLSPolicy %s", name)
+}
+
+func TeardownBackendTLSPolicy(t *testing.T, name, namespace string) {
+	err := GatewayClient.GatewayV1alpha3().BackendTLSPolicies(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't delete the BackendTLSPolicy, err: %+v", err)
+	}
+	t.Logf("Deleted BackendTLSPolicy %s", name)
+}
+
 func ValidateGatewayStatus(t *testing.T, actualStatus, expectedStatus *gatewayv1.GatewayStatus) {
 
 	g := gomega.NewGomegaWithT(t)
@@ -16,6 +16,7 @@ package ingresstests
 
 import (
 	"context"
+	"encoding/json"
 	"sort"
 	"strings"
 	"testing"
@@ -602,6 +603,65 @@ func TestGoodToBadHostRule(t *testing.T) {
 	TearDownIngressForCacheSyncCheck(t, ingName, svcName, "", modelName)
 }
 
+func TestHostRuleCertManagerPendingSecret(t *testing.T) {
+	// the HostRule is pending until cert-manager issues its secret, and accepted once the secret is added
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	hrname := objNameMap.GenerateName("samplehr-foo")
+	secretName := objNameMap.GenerateName("my-secret")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	ingTestObj := IngressTestObject{
+		ingressName: ingName,
+		isTLS:       false,
+		withSecret:  false,
+		serviceName: svcName,
+		modelNames:  []string{modelName},
+	}
+	ingTestObj.FillParams()
+	SetUpIngressForCacheSyncCheck(t, ingTestObj)
+
+	hostrule := integrationtest.FakeHostRule{
+		Name:      hrname,
+		Namespace: "default",
+		Fqdn:      "foo.com",
+	}.HostRule()
+	hostrule.Annotations = map[string]string{lib.CertManagerClusterIssuer: "letsencrypt"}
+	hostrule.Spec.VirtualHost.TLS.SSLKeyCertificate = v1beta1.HostRuleSSLKeyCertificate{
+		Name: secretName,
+		Type: v1beta1.HostRuleSecretTypeSecretReference,
+	}
+	if _, err := v1beta1CRDClient.AkoV1beta1().HostRules("default").Create(context.TODO(), hostrule, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding HostRule: %v", err)
+	}
+	hostruleStatus := func() v1beta1.HostRuleStatus {
+		hostrule, _ := v1beta1CRDClient.AkoV1beta1().HostRules("default").Get(context.TODO(), hrname, metav1.GetOptions{})
+		return hostrule.Status
+	}
+	g.Eventually(func() string {
+		return hostruleStatus().Status
+	}, 20*time.Second).Should(gomega.Equal(lib.StatusPending))
+	g.Expect(hostruleStatus().Error).To(gomega.ContainSubstring("pending issuance by cert-manager"))
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	g.Expect(aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].SniNodes).To(gomega.HaveLen(0))
+
+	// the HostRule is validated again once the secret is issued
+	integrationtest.AddSecret(secretName, "default", "tlsCert", "tlsKey")
+	g.Eventually(func() string {
+		return hostruleStatus().Status
+	}, 20*time.Second).Should(gomega.Equal(lib.StatusAccepted))
+	g.Eventually(func() int {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		return len(aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].SniNodes)
+	}, 20*time.Second).Should(gomega.Equal(1))
+
+	sniVSKey := cache.NamespaceName{Namespace: "admin", Name: "cluster--foo.com"}
+	integrationtest.TeardownHostRule(t, g, sniVSKey, hrname)
+	KubeClient.CoreV1().Secrets("default").Delete(context.TODO(), secretName, metav1.DeleteOptions{})
+	TearDownIngressForCacheSyncCheck(t, ingName, svcName, "", modelName)
+}
+
 func TestInsecureHostAndHostrule(t *testing.T) {
 	// create insecure ingress, insecure hostrule, nothing should be applied
 	g := gomega.NewGomegaWithT(t)
@@ -2757,3 +2817,70 @@ func TestCreateDeleteSharedVSHostRuleWithoutDNS(t *testing.T) {
 	}, 20*time.Second).Should(gomega.Equal("Accepted"))
 }
 */
+
+func TestHostRuleMaintenance(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	hrname := objNameMap.GenerateName("samplehr-foo")
+	secretName := objNameMap.GenerateName("my-secret")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	ingTestObj := IngressTestObject{
+		ingressName: ingName,
+		isTLS:       true,
+		withSecret:  true,
+		secretName:  secretName,
+		serviceName: svcName,
+		modelNames:  []string{modelName},
+	}
+	ingTestObj.FillParams()
+	SetUpIngressForCacheSyncCheck(t, ingTestObj)
+
+	// drain all the pool servers of the host
+	hostrule := integrationtest.FakeHostRule{
+		Name:      hrname,
+		Namespace: "default",
+		Fqdn:      "foo.com",
+	}.HostRule()
+	hostrule.Spec.VirtualHost.Maintenance = &v1beta1.HostRuleMaintenance{Enabled: true}
+	if _, err := v1beta1CRDClient.AkoV1beta1().HostRules("default").Create(context.TODO(), hostrule, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding HostRule: %v", err)
+	}
+	g.Eventually(func() string {
+		hostrule, _ := v1beta1CRDClient.AkoV1beta1().HostRules("default").Get(context.TODO(), hrname, metav1.GetOptions{})
+		return hostrule.Status.Status
+	}, 10*time.Second).Should(gomega.Equal("Accepted"))
+
+	drainedPool := func() bool {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 || len(nodes[0].SniNodes[0].PoolRefs) != 1 {
+			return false
+		}
+		return nodes[0].SniNodes[0].PoolRefs[0].DrainAllServers
+	}
+	maintenance := func() map[string]string {
+		ingress, _ := KubeClient.NetworkingV1().Ingresses("default").Get(context.TODO(), ingName, metav1.GetOptions{})
+		maintenance := make(map[string]string)
+		if value, ok := ingress.Annotations[lib.MaintenanceStatusAnnotation]; ok {
+			json.Unmarshal([]byte(value), &maintenance)
+		}
+		return maintenance
+	}
+	g.Eventually(drainedPool, 10*time.Second).Should(gomega.BeTrue())
+	g.Eventually(maintenance, 15*time.Second).Should(gomega.HaveKeyWithValue(svcName, gomega.ContainSubstring("HostRule default/"+hrname)))
+
+	// the pool servers are enabled again, once the maintenance is disabled
+	hostrule.Spec.VirtualHost.Maintenance.Enabled = false
+	hostrule.ResourceVersion = "2"
+	if _, err := v1beta1CRDClient.AkoV1beta1().HostRules("default").Update(context.TODO(), hostrule, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating HostRule: %v", err)
+	}
+	g.Eventually(drainedPool, 10*time.Second).Should(gomega.BeFalse())
+	g.Eventually(maintenance, 15*time.Second).Should(gomega.BeEmpty())
+
+	sniVSKey := cache.NamespaceName{Namespace: "admin", Name: "cluster--foo.com"}
+	integrationtest.TeardownHostRule(t, g, sniVSKey, hrname)
+	TearDownIngressForCacheSyncCheck(t, ingName, svcName, secretName, modelName)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
 
 	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -662,3 +663,373 @@ func TestIngressAnnotationAddDefaultCert(t *testing.T) {
 	VerifyIngressDeletion(t, g, aviModel, 0)
 	TearDownTestForIngress(t, svcName, modelName)
 }
+
+func TestIngressCanaryBackends(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	canarySvcName := objNameMap.GenerateName("avisvc-canary")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", canarySvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", canarySvcName, false, false, "1.1.2")
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"host": "foo.com", "path": "/foo", "serviceName": "` + canarySvcName + `", "weight": 20}]`,
+	})
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	poolName := "cluster--foo.com_foo-default-" + ingName
+	canaryPoolName := poolName + "-" + canarySvcName
+	var aviModel interface{}
+	g.Eventually(func() int {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		return len(nodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(2))
+
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	ratios := make(map[string]uint32)
+	for _, member := range nodes[0].PoolGroupRefs[0].Members {
+		ratios[*member.PoolRef] = *member.Ratio
+		g.Expect(*member.PriorityLabel).To(gomega.Equal("foo.com/foo"))
+	}
+	g.Expect(ratios).To(gomega.HaveKeyWithValue("/api/pool?name="+poolName, uint32(80)))
+	g.Expect(ratios).To(gomega.HaveKeyWithValue("/api/pool?name="+canaryPoolName, uint32(20)))
+	for _, pool := range nodes[0].PoolRefs {
+		g.Expect(pool.Servers).To(gomega.HaveLen(1))
+		if pool.Name == canaryPoolName {
+			g.Expect(*pool.Servers[0].Ip.Addr).To(gomega.Equal("1.1.2.1"))
+		}
+	}
+
+	// the canary pool is removed with the annotation
+	ingrFake.SetAnnotations(nil)
+	ingrFake.ResourceVersion = "2"
+	_, err = KubeClient.NetworkingV1().Ingresses("default").Update(context.TODO(), ingrFake, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("error in updating Ingress: %v", err)
+	}
+	g.Eventually(func() int {
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		return len(nodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(1))
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	g.Expect(nodes[0].PoolRefs[0].Name).To(gomega.Equal(poolName))
+	g.Expect(nodes[0].PoolGroupRefs[0].Members).To(gomega.HaveLen(1))
+	g.Expect(*nodes[0].PoolGroupRefs[0].Members[0].Ratio).To(gomega.Equal(uint32(100)))
+
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't Delete the Ingress %v", err)
+	}
+	VerifyIngressDeletion(t, g, aviModel, 0)
+	integrationtest.DelSVC(t, "default", canarySvcName)
+	integrationtest.DelEPS(t, "default", canarySvcName)
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
+func TestIngressCanaryBackendsWithHeader(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	recorder, restoreRecorder := integrationtest.SetFakeEventRecorder()
+	defer restoreRecorder()
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	canarySvcName := objNameMap.GenerateName("avisvc-canary")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", canarySvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", canarySvcName, false, false, "1.1.2")
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"host": "foo.com", "path": "/foo", "serviceName": "` + canarySvcName + `", "header": {"name": "x-canary", "value": "always"}}]`,
+	})
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	// the pools of the shared VS are selected by the host and path only, so the canary backend selected by a header
+	// is not added for the host without TLS, and it is reported in the events of the ingress
+	var aviModel interface{}
+	g.Eventually(func() int {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		return len(nodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(1))
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	g.Expect(nodes[0].PoolRefs[0].Name).To(gomega.Equal("cluster--foo.com_foo-default-" + ingName))
+	g.Eventually(func() bool {
+		return integrationtest.EventRecorded(recorder, corev1.EventTypeWarning, lib.CanaryNotSupported, canarySvcName, "header x-canary: always", "foo.com")
+	}, 10*time.Second).Should(gomega.Equal(true))
+
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't Delete the Ingress %v", err)
+	}
+	VerifyIngressDeletion(t, g, aviModel, 0)
+	integrationtest.DelSVC(t, "default", canarySvcName)
+	integrationtest.DelEPS(t, "default", canarySvcName)
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
+func TestSecureIngressCanaryBackends(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	canarySvcName := objNameMap.GenerateName("avisvc-canary")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	secretName := objNameMap.GenerateName("my-secret")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", canarySvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", canarySvcName, false, false, "1.1.2")
+	integrationtest.AddSecret(secretName, "default", "tlsCert", "tlsKey")
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+		TlsSecretDNS: map[string][]string{
+			secretName: {"foo.com"},
+		},
+	}).Ingress()
+	// canary backends without a host apply to the path in all the hosts
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"path": "/foo", "serviceName": "` + canarySvcName + `", "weight": 10}]`,
+	})
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	poolName := "cluster--default-foo.com_foo-" + ingName
+	canaryPoolName := poolName + "-" + canarySvcName
+	var aviModel interface{}
+	g.Eventually(func() int {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].SniNodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(2))
+
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	sniNode := nodes[0].SniNodes[0]
+	g.Expect(sniNode.PoolGroupRefs).To(gomega.HaveLen(1))
+	g.Expect(sniNode.HttpPolicyRefs[0].HppMap).To(gomega.HaveLen(1))
+	ratios := make(map[string]uint32)
+	for _, member := range sniNode.PoolGroupRefs[0].Members {
+		ratios[*member.PoolRef] = *member.Ratio
+	}
+	g.Expect(ratios).To(gomega.HaveKeyWithValue("/api/pool?name="+poolName, uint32(90)))
+	g.Expect(ratios).To(gomega.HaveKeyWithValue("/api/pool?name="+canaryPoolName, uint32(10)))
+
+	// the weights of the canary backends of a path can not add up to more than 100
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"path": "/foo", "serviceName": "` + canarySvcName + `", "weight": 110}]`,
+	})
+	ingrFake.ResourceVersion = "2"
+	_, err = KubeClient.NetworkingV1().Ingresses("default").Update(context.TODO(), ingrFake, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("error in updating Ingress: %v", err)
+	}
+	g.Eventually(func() int {
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].SniNodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(1))
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	g.Expect(nodes[0].SniNodes[0].PoolRefs[0].Name).To(gomega.Equal(poolName))
+	g.Expect(nodes[0].SniNodes[0].PoolGroupRefs[0].Members).To(gomega.HaveLen(1))
+	g.Expect(*nodes[0].SniNodes[0].PoolGroupRefs[0].Members[0].Ratio).To(gomega.Equal(uint32(100)))
+
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't Delete the Ingress %v", err)
+	}
+	KubeClient.CoreV1().Secrets("default").Delete(context.TODO(), secretName, metav1.DeleteOptions{})
+	VerifyIngressDeletion(t, g, aviModel, 0)
+	integrationtest.DelSVC(t, "default", canarySvcName)
+	integrationtest.DelEPS(t, "default", canarySvcName)
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
+func TestSecureIngressCanaryBackendsWithHeaderAndCookie(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	canarySvcName := objNameMap.GenerateName("avisvc-canary")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	secretName := objNameMap.GenerateName("my-secret")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", canarySvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", canarySvcName, false, false, "1.1.2")
+	integrationtest.AddSecret(secretName, "default", "tlsCert", "tlsKey")
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+		TlsSecretDNS: map[string][]string{
+			secretName: {"foo.com"},
+		},
+	}).Ingress()
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"path": "/foo", "serviceName": "` + canarySvcName + `", "header": {"name": "x-canary", "value": "always"}}]`,
+	})
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	poolName := "cluster--default-foo.com_foo-" + ingName
+	canaryPoolName := poolName + "-" + canarySvcName
+	getCanaryRule := func(sniNode *avinodes.AviVsNode) *avinodes.AviHostPathPortPoolPG {
+		for i, rule := range sniNode.HttpPolicyRefs[0].HppMap {
+			if rule.Pool == canaryPoolName {
+				return &sniNode.HttpPolicyRefs[0].HppMap[i]
+			}
+		}
+		return nil
+	}
+	var aviModel interface{}
+	g.Eventually(func() int {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 || len(nodes[0].SniNodes[0].HttpPolicyRefs) != 1 {
+			return 0
+		}
+		return len(nodes[0].SniNodes[0].HttpPolicyRefs[0].HppMap)
+	}, 40*time.Second).Should(gomega.Equal(2))
+
+	// the canary pool is selected by its own rule, and is not a member of the poolgroup of the path
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	sniNode := nodes[0].SniNodes[0]
+	g.Expect(sniNode.PoolRefs).To(gomega.HaveLen(2))
+	g.Expect(sniNode.PoolGroupRefs).To(gomega.HaveLen(1))
+	g.Expect(sniNode.PoolGroupRefs[0].Members).To(gomega.HaveLen(1))
+	g.Expect(*sniNode.PoolGroupRefs[0].Members[0].PoolRef).To(gomega.Equal("/api/pool?name=" + poolName))
+	g.Expect(*sniNode.PoolGroupRefs[0].Members[0].Ratio).To(gomega.Equal(uint32(100)))
+	canaryRule := getCanaryRule(sniNode)
+	g.Expect(canaryRule).ShouldNot(gomega.BeNil())
+	g.Expect(canaryRule.Path).To(gomega.Equal([]string{"/foo"}))
+	g.Expect(canaryRule.PoolGroup).To(gomega.BeEmpty())
+	g.Expect(*canaryRule.CanaryHeader).To(gomega.Equal(avinodes.CanaryMatch{Name: "x-canary", Value: "always"}))
+	g.Expect(canaryRule.CanaryCookie).To(gomega.BeNil())
+
+	// the canary backend is selected by a cookie instead
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"path": "/foo", "serviceName": "` + canarySvcName + `", "cookie": {"name": "canary", "value": "true"}}]`,
+	})
+	ingrFake.ResourceVersion = "2"
+	_, err = KubeClient.NetworkingV1().Ingresses("default").Update(context.TODO(), ingrFake, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("error in updating Ingress: %v", err)
+	}
+	g.Eventually(func() bool {
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 || len(nodes[0].SniNodes[0].HttpPolicyRefs) != 1 {
+			return false
+		}
+		canaryRule := getCanaryRule(nodes[0].SniNodes[0])
+		return canaryRule != nil && canaryRule.CanaryHeader == nil && canaryRule.CanaryCookie != nil
+	}, 40*time.Second).Should(gomega.Equal(true))
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	g.Expect(nodes[0].SniNodes[0].HttpPolicyRefs[0].HppMap).To(gomega.HaveLen(2))
+	g.Expect(*getCanaryRule(nodes[0].SniNodes[0]).CanaryCookie).To(gomega.Equal(avinodes.CanaryMatch{Name: "canary", Value: "true"}))
+
+	// the rule of the canary backend is removed with the weighted canary backend
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"path": "/foo", "serviceName": "` + canarySvcName + `", "weight": 10}]`,
+	})
+	ingrFake.ResourceVersion = "3"
+	_, err = KubeClient.NetworkingV1().Ingresses("default").Update(context.TODO(), ingrFake, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("error in updating Ingress: %v", err)
+	}
+	g.Eventually(func() int {
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 || len(nodes[0].SniNodes[0].HttpPolicyRefs) != 1 {
+			return 0
+		}
+		return len(nodes[0].SniNodes[0].HttpPolicyRefs[0].HppMap)
+	}, 40*time.Second).Should(gomega.Equal(1))
+	nodes = aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	g.Expect(nodes[0].SniNodes[0].PoolRefs).To(gomega.HaveLen(2))
+	g.Expect(nodes[0].SniNodes[0].PoolGroupRefs[0].Members).To(gomega.HaveLen(2))
+
+	// a canary backend can not have both a weight and a header
+	ingrFake.SetAnnotations(map[string]string{
+		lib.CanaryBackendsAnnotation: `[{"path": "/foo", "serviceName": "` + canarySvcName + `", "weight": 10, "header": {"name": "x-canary", "value": "always"}}]`,
+	})
+	ingrFake.ResourceVersion = "4"
+	_, err = KubeClient.NetworkingV1().Ingresses("default").Update(context.TODO(), ingrFake, metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("error in updating Ingress: %v", err)
+	}
+	g.Eventually(func() int {
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].SniNodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(1))
+
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't Delete the Ingress %v", err)
+	}
+	KubeClient.CoreV1().Secrets("default").Delete(context.TODO(), secretName, metav1.DeleteOptions{})
+	VerifyIngressDeletion(t, g, aviModel, 0)
+	integrationtest.DelSVC(t, "default", canarySvcName)
+	integrationtest.DelEPS(t, "default", canarySvcName)
+	TearDownTestForIngress(t, svcName, modelName)
+}
@@ -16,6 +16,13 @@ package ingresstests
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"net/http"
 	"os"
 	"sort"
@@ -29,6 +36,7 @@ import (
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
 	crdfake "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1alpha1/clientset/versioned/fake"
 	v1beta1crdfake "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1beta1/clientset/versioned/fake"
 
@@ -40,6 +48,7 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/vmware/alb-sdk/go/models"
 	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -2366,6 +2375,334 @@ func TestL7ModelSNI(t *testing.T) {
 	TearDownTestForIngress(t, svcName, modelName)
 }
 
+func TestL7ModelSNIDefaultBackend(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	secretName := objNameMap.GenerateName("my-secret")
+	integrationtest.AddSecret(secretName, "default", "tlsCert", "tlsKey")
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	defaultSvcName := objNameMap.GenerateName("avisvc-default")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", defaultSvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", defaultSvcName, false, false, "1.1.3")
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:      ingName,
+		Namespace: "default",
+		DnsNames:  []string{"foo.com"},
+		Ips:       []string{"8.8.8.8"},
+		HostNames: []string{"v1"},
+		TlsSecretDNS: map[string][]string{
+			secretName: {"foo.com"},
+		},
+		ServiceName: svcName,
+	}).Ingress()
+	ingrFake.Spec.DefaultBackend = &networking.IngressBackend{Service: &networking.IngressServiceBackend{
+		Name: defaultSvcName,
+		Port: networking.ServiceBackendPort{Number: 8080},
+	}}
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	var aviModel interface{}
+	g.Eventually(func() int {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return 0
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 {
+			return 0
+		}
+		return len(nodes[0].SniNodes[0].PoolRefs)
+	}, 40*time.Second).Should(gomega.Equal(2))
+
+	// the default backend is the catch-all rule of the host without a path, which is matched after the rules with
+	// a path, including the / paths of the other ingresses of the host
+	sniNode := aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].SniNodes[0]
+	g.Expect(sniNode.HttpPolicyRefs[0].HppMap).To(gomega.HaveLen(2))
+	var defaultPaths int
+	for _, hppMap := range sniNode.HttpPolicyRefs[0].HppMap {
+		if len(hppMap.Path) == 0 {
+			defaultPaths++
+		}
+	}
+	g.Expect(defaultPaths).To(gomega.Equal(1))
+	defaultPoolServers := func() []string {
+		var servers []string
+		for _, pool := range aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].SniNodes[0].PoolRefs {
+			if pool.ServiceMetadata.IngressName == ingName && pool.PriorityLabel == "foo.com" {
+				for _, server := range pool.Servers {
+					servers = append(servers, *server.Ip.Addr)
+				}
+			}
+		}
+		return servers
+	}
+	g.Expect(defaultPoolServers()).To(gomega.Equal([]string{"1.1.3.1"}))
+
+	// the endpoints of the default backend are tracked
+	integrationtest.DelEPS(t, "default", defaultSvcName)
+	g.Eventually(defaultPoolServers, 10*time.Second).Should(gomega.BeEmpty())
+
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+	KubeClient.CoreV1().Secrets("default").Delete(context.TODO(), secretName, metav1.DeleteOptions{})
+	VerifySNIIngressDeletion(t, g, aviModel, 0)
+	integrationtest.DelSVC(t, "default", defaultSvcName)
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
+func TestL7ModelSNIDefaultBackendWithRootPath(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	secretName := objNameMap.GenerateName("my-secret")
+	integrationtest.AddSecret(secretName, "default", "tlsCert", "tlsKey")
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	defaultSvcName := objNameMap.GenerateName("avisvc-default")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", defaultSvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", defaultSvcName, false, false, "1.1.3")
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:      ingName,
+		Namespace: "default",
+		DnsNames:  []string{"foo.com"},
+		Paths:     []string{"/"},
+		Ips:       []string{"8.8.8.8"},
+		HostNames: []string{"v1"},
+		TlsSecretDNS: map[string][]string{
+			secretName: {"foo.com"},
+		},
+		ServiceName: svcName,
+	}).Ingress()
+	ingrFake.Spec.DefaultBackend = &networking.IngressBackend{Service: &networking.IngressServiceBackend{
+		Name: defaultSvcName,
+		Port: networking.ServiceBackendPort{Number: 8080},
+	}}
+	pathType := networking.PathTypePrefix
+	ingrFake.Spec.Rules[0].HTTP.Paths[0].PathType = &pathType
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	var aviModel interface{}
+	sniPoolLabels := func() []string {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found {
+			return nil
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes[0].SniNodes) != 1 {
+			return nil
+		}
+		var labels []string
+		for _, pool := range nodes[0].SniNodes[0].PoolRefs {
+			labels = append(labels, pool.PriorityLabel)
+		}
+		return labels
+	}
+	// the / path of Prefix type matches all the requests of the host, hence the default backend is not used
+	g.Eventually(sniPoolLabels, 40*time.Second).Should(gomega.ConsistOf("foo.com/"))
+
+	// the / path of Exact type matches only the root, hence the default backend is used for the other requests
+	pathType = networking.PathTypeExact
+	ingrFake.ResourceVersion = "2"
+	if _, err = KubeClient.NetworkingV1().Ingresses("default").Update(context.TODO(), ingrFake, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating Ingress: %v", err)
+	}
+	g.Eventually(sniPoolLabels, 10*time.Second).Should(gomega.ConsistOf("foo.com/", "foo.com"))
+
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+	KubeClient.CoreV1().Secrets("default").Delete(context.TODO(), secretName, metav1.DeleteOptions{})
+	VerifySNIIngressDeletion(t, g, aviModel, 0)
+	integrationtest.DelSVC(t, "default", defaultSvcName)
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
+func TestL7ModelDefaultBackendSharedVS(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	defaultSvcName := objNameMap.GenerateName("avisvc-default")
+	rootIngName := objNameMap.GenerateName("foo-with-targets")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	SetUpTestForIngress(t, svcName, modelName)
+	integrationtest.CreateSVC(t, "default", defaultSvcName, corev1.ProtocolTCP, corev1.ServiceTypeClusterIP, false)
+	integrationtest.CreateEPS(t, "default", defaultSvcName, false, false, "1.1.3")
+
+	// two ingresses of the same host without TLS, one with the / path, and the other with the default backend
+	rootIngrFake := (integrationtest.FakeIngress{
+		Name:        rootIngName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Paths:       []string{"/"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	if _, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), rootIngrFake, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Paths:       []string{"/bar"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	ingrFake.Spec.DefaultBackend = &networking.IngressBackend{Service: &networking.IngressServiceBackend{
+		Name: defaultSvcName,
+		Port: networking.ServiceBackendPort{Number: 8080},
+	}}
+	if _, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+
+	var aviModel interface{}
+	poolGroupLabels := func() []string {
+		var found bool
+		found, aviModel = objects.SharedAviGraphLister().Get(modelName)
+		if !found || aviModel == nil {
+			return nil
+		}
+		nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+		if len(nodes) != 1 || len(nodes[0].PoolGroupRefs) != 1 {
+			return nil
+		}
+		var labels []string
+		for _, member := range nodes[0].PoolGroupRefs[0].Members {
+			labels = append(labels, *member.PriorityLabel)
+		}
+		return labels
+	}
+	// the pools of the shared VS are selected by the longest match of the host and path on the priority labels,
+	// hence the default backend, labelled with the host only, is matched after the paths of both the ingresses
+	g.Eventually(poolGroupLabels, 40*time.Second).Should(gomega.ConsistOf("foo.com/", "foo.com/bar", "foo.com"))
+	var defaultPoolServers []string
+	for _, pool := range aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs {
+		if pool.PriorityLabel != "foo.com" {
+			continue
+		}
+		g.Expect(pool.ServiceMetadata.IngressName).To(gomega.Equal(ingName))
+		for _, server := range pool.Servers {
+			defaultPoolServers = append(defaultPoolServers, *server.Ip.Addr)
+		}
+	}
+	g.Expect(defaultPoolServers).To(gomega.Equal([]string{"1.1.3.1"}))
+
+	// the default backend is kept when the other ingress of the host is deleted
+	if err := KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), rootIngName, metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+	g.Eventually(poolGroupLabels, 10*time.Second).Should(gomega.ConsistOf("foo.com/bar", "foo.com"))
+
+	if err := KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+	VerifyIngressDeletion(t, g, aviModel, 0)
+	integrationtest.DelSVC(t, "default", defaultSvcName)
+	integrationtest.DelEPS(t, "default", defaultSvcName)
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
+func TestL7ModelFQDNClaims(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+	settingName := objNameMap.GenerateName("fqdn-claims")
+	SetUpTestForIngress(t, svcName, modelName)
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+	ingressPools := func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found || aviModel == nil {
+			return 0
+		}
+		var pools int
+		for _, pool := range aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs {
+			if pool.ServiceMetadata.IngressName == ingName {
+				pools++
+			}
+		}
+		return pools
+	}
+	ingressStatus := func() string {
+		ingress, err := KubeClient.NetworkingV1().Ingresses("default").Get(context.TODO(), ingName, metav1.GetOptions{})
+		if err != nil {
+			return ""
+		}
+		return ingress.Annotations[lib.IngressStatusAnnotation]
+	}
+	g.Eventually(ingressPools, 40*time.Second).Should(gomega.Equal(1))
+
+	// foo.com is claimed for another namespace, the host is removed from the model
+	setting := &akov1beta1.AviInfraSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: settingName},
+		Spec: akov1beta1.AviInfraSettingSpec{
+			FQDNClaims: []akov1beta1.AviInfraFQDNClaim{{
+				Domains:    []string{"foo.com", "*.foo.com"},
+				Namespaces: []string{"red"},
+			}},
+		},
+		Status: akov1beta1.AviInfraSettingStatus{Status: lib.StatusAccepted},
+	}
+	if _, err := lib.AKOControlConfig().V1beta1CRDClientset().AkoV1beta1().AviInfraSettings().Create(context.TODO(), setting, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding AviInfraSetting: %v", err)
+	}
+	g.Eventually(ingressPools, 40*time.Second).Should(gomega.Equal(0))
+	g.Eventually(ingressStatus, 40*time.Second).Should(gomega.ContainSubstring(
+		`"host/foo.com":"host foo.com is claimed for the namespaces [red] by AviInfraSetting ` + settingName))
+
+	// the namespace of the ingress is added to the claim
+	setting, _ = lib.AKOControlConfig().V1beta1CRDClientset().AkoV1beta1().AviInfraSettings().Get(context.TODO(), settingName, metav1.GetOptions{})
+	setting.Spec.FQDNClaims[0].Namespaces = append(setting.Spec.FQDNClaims[0].Namespaces, "default")
+	setting.ResourceVersion = "2"
+	if _, err := lib.AKOControlConfig().V1beta1CRDClientset().AkoV1beta1().AviInfraSettings().Update(context.TODO(), setting, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating AviInfraSetting: %v", err)
+	}
+	g.Eventually(ingressPools, 40*time.Second).Should(gomega.Equal(1))
+	g.Eventually(ingressStatus, 40*time.Second).Should(gomega.BeEmpty())
+
+	g.Expect(lib.CheckFQDNClaim("bar.foo.com", "red")).To(gomega.Succeed())
+	g.Expect(lib.CheckFQDNClaim("bar.foo.com", "blue")).To(gomega.MatchError(
+		"host bar.foo.com is claimed for the namespaces [default, red] by AviInfraSetting " + settingName + ", and is not allowed in the namespace blue"))
+	g.Expect(lib.CheckFQDNClaim("foo.bar.com", "blue")).To(gomega.Succeed())
+
+	integrationtest.TeardownAviInfraSetting(t, settingName)
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
 func TestL7ModelNoSecretToSecret(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	modelName := MODEL_NAME_PREFIX + "0"
@@ -2431,6 +2768,222 @@ func TestL7ModelNoSecretToSecret(t *testing.T) {
 	TearDownTestForIngress(t, svcName, modelName)
 }
 
+func TestL7ModelCertManagerPendingSecret(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	secretName := objNameMap.GenerateName("my-secret")
+	ingName := objNameMap.GenerateName("foo-cert-manager")
+	SetUpTestForIngress(t, svcName, modelName)
+
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com", "foo.com"},
+		Paths:       []string{"/foo", "/.well-known/acme-challenge/token"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+		TlsSecretDNS: map[string][]string{
+			secretName: {"foo.com"},
+		},
+	}).Ingress()
+	ingrFake.Annotations = map[string]string{lib.CertManagerClusterIssuer: "letsencrypt"}
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+	ingressPools := func() []string {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found || aviModel == nil {
+			return nil
+		}
+		var pools []string
+		for _, pool := range aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs {
+			if pool.IngressName == ingName {
+				pools = append(pools, pool.PriorityLabel)
+			}
+		}
+		return pools
+	}
+
+	ingressStatus := func() string {
+		ingress, err := KubeClient.NetworkingV1().Ingresses("default").Get(context.TODO(), ingName, metav1.GetOptions{})
+		if err != nil {
+			return ""
+		}
+		return ingress.Annotations[lib.IngressStatusAnnotation]
+	}
+
+	// only the HTTP-01 challenge path of the host is served over HTTP, until the secret is issued
+	g.Eventually(ingressPools, 40*time.Second).Should(gomega.Equal([]string{"foo.com/.well-known/acme-challenge/token"}))
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	nodes := aviModel.(*avinodes.AviObjectGraph).GetAviVS()
+	g.Expect(nodes[0].SniNodes).To(gomega.HaveLen(0))
+	g.Eventually(ingressStatus, 40*time.Second).Should(gomega.ContainSubstring("pending issuance by cert-manager"))
+
+	// the solver ingress created by cert-manager for the host is served over HTTP
+	solverName := objNameMap.GenerateName("cm-acme-http-solver")
+	solverFake := (integrationtest.FakeIngress{
+		Name:        solverName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Paths:       []string{"/.well-known/acme-challenge/solver-token"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+	}).Ingress()
+	if _, err = KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), solverFake, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+	g.Eventually(func() bool {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found || aviModel == nil {
+			return false
+		}
+		for _, pool := range aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs {
+			if pool.IngressName == solverName {
+				return true
+			}
+		}
+		return false
+	}, 40*time.Second).Should(gomega.Equal(true))
+	if err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), solverName, metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+
+	// the secret is issued, the host is served over HTTPS
+	integrationtest.AddSecret(secretName, "default", "tlsCert", "tlsKey")
+	g.Eventually(func() int {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		return len(aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].SniNodes)
+	}, 40*time.Second).Should(gomega.Equal(1))
+	g.Eventually(ingressPools, 40*time.Second).Should(gomega.HaveLen(0))
+	g.Eventually(ingressStatus, 40*time.Second).Should(gomega.BeEmpty())
+
+	err = KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't DELETE the Ingress %v", err)
+	}
+	KubeClient.CoreV1().Secrets("default").Delete(context.TODO(), secretName, metav1.DeleteOptions{})
+	_, aviModel = objects.SharedAviGraphLister().Get(modelName)
+	VerifySNIIngressDeletion(t, g, aviModel, 0)
+
+	TearDownTestForIngress(t, svcName, modelName)
+}
+
+// generateCertificate returns a PEM encoded self signed certificate for the host, valid until notAfter, and its key.
+func generateCertificate(t *testing.T, host string, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error in generating the key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error in generating the certificate: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("error in marshalling the key: %v", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestL7ModelExpiredCertificate(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	secretName := objNameMap.GenerateName("my-secret")
+	ingName := objNameMap.GenerateName("foo-expired-cert")
+	SetUpTestForIngress(t, svcName, modelName)
+
+	expiredCert, expiredKey := generateCertificate(t, "foo.com", time.Now().Add(-24*time.Hour))
+	integrationtest.AddSecret(secretName, "default", expiredCert, expiredKey)
+	ingrFake := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		HostNames:   []string{"v1"},
+		ServiceName: svcName,
+		TlsSecretDNS: map[string][]string{
+			secretName: {"foo.com"},
+		},
+	}).Ingress()
+	_, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingrFake, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+	defer func() {
+		err := KubeClient.NetworkingV1().Ingresses("default").Delete(context.TODO(), ingName, metav1.DeleteOptions{})
+		if err != nil {
+			t.Fatalf("Couldn't DELETE the Ingress %v", err)
+		}
+		KubeClient.CoreV1().Secrets("default").Delete(context.TODO(), secretName, metav1.DeleteOptions{})
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		VerifySNIIngressDeletion(t, g, aviModel, 0)
+
+		TearDownTestForIngress(t, svcName, modelName)
+	}()
+	sniNodes := func() int {
+		found, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if !found || aviModel == nil {
+			return 0
+		}
+		return len(aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].SniNodes)
+	}
+
+	ingressStatus := func() string {
+		ingress, err := KubeClient.NetworkingV1().Ingresses("default").Get(context.TODO(), ingName, metav1.GetOptions{})
+		if err != nil {
+			return ""
+		}
+		return ingress.Annotations[lib.IngressStatusAnnotation]
+	}
+
+	// the expired certificate is not programmed, and the TLS configuration of the host is skipped
+	g.Consistently(sniNodes, 10*time.Second).Should(gomega.Equal(0))
+	g.Eventually(ingressStatus, 40*time.Second).Should(gomega.ContainSubstring("secret/" + secretName))
+	g.Expect(ingressStatus()).To(gomega.ContainSubstring("expired on"))
+
+	// the certificate is renewed, the host is served over HTTPS
+	validCert, validKey := generateCertificate(t, "foo.com", time.Now().Add(90*24*time.Hour))
+	integrationtest.UpdateSecret(secretName, "default", validCert, validKey)
+	g.Eventually(sniNodes, 40*time.Second).Should(gomega.Equal(1))
+	g.Eventually(ingressStatus, 40*time.Second).Should(gomega.BeEmpty())
+	g.Eventually(func() bool {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		sslName := aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].SniNodes[0].SSLKeyCertRefs[0].Name
+		_, found := cache.SharedAviObjCache().SSLKeyCache.AviCacheGet(cache.NamespaceName{Namespace: lib.GetTenant(), Name: sslName})
+		return found
+	}, 40*time.Second).Should(gomega.BeTrue())
+
+	// the certificate is replaced with one which does not match the key, the programmed certificate and the
+	// SNI virtualservice are kept
+	otherCert, _ := generateCertificate(t, "foo.com", time.Now().Add(90*24*time.Hour))
+	mismatchSecret := (integrationtest.FakeSecret{
+		Cert:      otherCert,
+		Key:       validKey,
+		Namespace: "default",
+		Name:      secretName,
+	}).Secret()
+	mismatchSecret.ResourceVersion = "3"
+	if _, err = KubeClient.CoreV1().Secrets("default").Update(context.TODO(), mismatchSecret, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating Secret: %v", err)
+	}
+	g.Eventually(ingressStatus, 40*time.Second).Should(gomega.ContainSubstring("does not match the private key"))
+	g.Consistently(sniNodes, 10*time.Second).Should(gomega.Equal(1))
+}
+
 func TestL7ModelOneSecretToMultiIng(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	modelName := MODEL_NAME_PREFIX + "0"
@@ -21,12 +21,16 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/rest"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
 
 	"github.com/onsi/gomega"
@@ -1238,3 +1242,203 @@ func TestProfilesAttachedToVS(t *testing.T) {
 
 	integrationtest.ResetMiddleware()
 }
+
+func TestIngressEventsOnPoolError(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	ingName := objNameMap.GenerateName("foo-with-targets")
+
+	var injectFault atomic.Bool
+	injectFault.Store(true)
+	integrationtest.AddMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && strings.Contains(r.URL.EscapedPath(), "pool") && injectFault.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, `{"error": "pool placement failed"}`)
+			return
+		}
+		integrationtest.NormalControllerServer(w, r)
+	})
+	defer integrationtest.ResetMiddleware()
+	recorder, restoreRecorder := integrationtest.SetFakeEventRecorder()
+	defer restoreRecorder()
+
+	ingTestObj := IngressTestObject{
+		ingressName: ingName,
+		isTLS:       false,
+		withSecret:  false,
+		serviceName: svcName,
+		modelNames:  []string{modelName},
+	}
+	ingTestObj.FillParams()
+	SetUpIngressForCacheSyncCheck(t, ingTestObj)
+
+	// the error of the Avi Controller is reported in the events of the Ingress
+	g.Eventually(func() bool {
+		return integrationtest.EventRecorded(recorder, corev1.EventTypeWarning, lib.Rejected, "cluster--Shared-L7-0", "Pool cluster--foo.com_foo-default-"+ingName)
+	}, 15*time.Second).Should(gomega.Equal(true))
+
+	// the Ingress is programmed on the retry, once the error is resolved
+	injectFault.Store(false)
+	g.Eventually(func() bool {
+		return integrationtest.EventRecorded(recorder, corev1.EventTypeNormal, lib.Programmed, "cluster--Shared-L7-0")
+	}, 30*time.Second).Should(gomega.Equal(true))
+	g.Eventually(func() int {
+		ingress, _ := KubeClient.NetworkingV1().Ingresses("default").Get(context.TODO(), ingName, metav1.GetOptions{})
+		return len(ingress.Status.LoadBalancer.Ingress)
+	}, 15*time.Second).Should(gomega.Equal(1))
+
+	TearDownIngressForCacheSyncCheck(t, ingName, svcName, "", modelName)
+}
+
+// TestPlanWhileSyncingIngress calls the plan API while the rest layer syncs the models of an
+// Ingress. Run with -race: the plans are built on copies of the VS cache objects, and must not
+// race with the updates of the Avi object cache by DequeueNodes.
+func TestPlanWhileSyncingIngress(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	ingName := objNameMap.GenerateName("foo-plan")
+	SetUpTestForIngress(t, svcName, modelName)
+
+	stopCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		for {
+			select {
+			case <-stopCh:
+				return
+			default:
+				rest.GetAllModelPlans()
+				rest.GetModelPlan(modelName)
+			}
+		}
+	}()
+
+	mcache := cache.SharedAviObjCache()
+	vsKey := cache.NamespaceName{Namespace: "admin", Name: "cluster--Shared-L7-0"}
+	for i := 0; i < 5; i++ {
+		ingress := (integrationtest.FakeIngress{
+			Name:        ingName,
+			Namespace:   "default",
+			DnsNames:    []string{"foo.com"},
+			Ips:         []string{"8.8.8.8"},
+			Paths:       []string{fmt.Sprintf("/foo%d", i)},
+			ServiceName: svcName,
+		}).Ingress()
+		ingress.ResourceVersion = fmt.Sprint(i + 1)
+		var err error
+		if i == 0 {
+			_, err = KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingress, metav1.CreateOptions{})
+		} else {
+			_, err = KubeClient.NetworkingV1().Ingresses("default").Update(context.TODO(), ingress, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			t.Fatalf("error in adding Ingress: %v", err)
+		}
+		poolName := fmt.Sprintf("cluster--foo.com_foo%d-default-%s", i, ingName)
+		g.Eventually(func() bool {
+			vsCache, found := mcache.VsCacheMeta.AviCacheGet(vsKey)
+			if !found {
+				return false
+			}
+			vsCacheObj, _ := vsCache.(*cache.AviVsCache)
+			vsCacheCopy, ok := vsCacheObj.GetVSCopy()
+			if !ok {
+				return false
+			}
+			for _, pool := range vsCacheCopy.PoolKeyCollection {
+				if pool.Name == poolName {
+					return true
+				}
+			}
+			return false
+		}, 20*time.Second).Should(gomega.Equal(true))
+	}
+
+	close(stopCh)
+	<-doneCh
+
+	g.Expect(rest.GetModelPlan(modelName).Error).To(gomega.BeEmpty())
+
+	TearDownIngressForCacheSyncCheck(t, ingName, svcName, "", modelName)
+}
+
+// TestPlanWithVsVipNotFound builds the plan of a VS missing in the VS cache, whose VSVIP is not found on the
+// Avi controller. The plan reports the POST of the VSVIP, without removing the VSVIP from the cache, and fetches
+// the VSVIP once through the fetcher of the plan.
+func TestPlanWithVsVipNotFound(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := MODEL_NAME_PREFIX + "0"
+	svcName := objNameMap.GenerateName("avisvc")
+	ingName := objNameMap.GenerateName("foo-plan")
+	SetUpTestForIngress(t, svcName, modelName)
+
+	ingress := (integrationtest.FakeIngress{
+		Name:        ingName,
+		Namespace:   "default",
+		DnsNames:    []string{"foo.com"},
+		Ips:         []string{"8.8.8.8"},
+		Paths:       []string{"/foo"},
+		ServiceName: svcName,
+	}).Ingress()
+	if _, err := KubeClient.NetworkingV1().Ingresses("default").Create(context.TODO(), ingress, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding Ingress: %v", err)
+	}
+	mcache := cache.SharedAviObjCache()
+	vsKey := cache.NamespaceName{Namespace: "admin", Name: "cluster--Shared-L7-0"}
+	poolName := "cluster--foo.com_foo-default-" + ingName
+	g.Eventually(func() bool {
+		vsCache, found := mcache.VsCacheMeta.AviCacheGet(vsKey)
+		if !found {
+			return false
+		}
+		vsCacheObj, _ := vsCache.(*cache.AviVsCache)
+		vsCacheCopy, ok := vsCacheObj.GetVSCopy()
+		if !ok {
+			return false
+		}
+		for _, pool := range vsCacheCopy.PoolKeyCollection {
+			if pool.Name == poolName {
+				return true
+			}
+		}
+		return false
+	}, 20*time.Second).Should(gomega.Equal(true))
+	vsvipKey := cache.NamespaceName{Namespace: "admin", Name: "cluster--Shared-L7-0"}
+	_, found := mcache.VSVIPCache.AviCacheGet(vsvipKey)
+	g.Expect(found).To(gomega.Equal(true))
+
+	var vsvipGets int32
+	integrationtest.AddMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.Contains(r.URL.EscapedPath(), "/api/vsvip/") {
+			atomic.AddInt32(&vsvipGets, 1)
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "VsVip object not found!"}`))
+			return
+		}
+		integrationtest.NormalControllerServer(w, r)
+	})
+	vsCache, _ := mcache.VsCacheMeta.AviCacheGet(vsKey)
+	mcache.VsCacheMeta.AviCacheDelete(vsKey)
+	plan := rest.GetModelPlan(modelName)
+	mcache.VsCacheMeta.AviCacheAdd(vsKey, vsCache)
+	integrationtest.ResetMiddleware()
+
+	var vsvipPosts int
+	for _, op := range plan.Operations {
+		if op.Model == "VsVip" && op.Method == string(utils.RestPost) {
+			vsvipPosts++
+		}
+	}
+	g.Expect(vsvipPosts).To(gomega.Equal(1))
+	g.Expect(atomic.LoadInt32(&vsvipGets)).To(gomega.Equal(int32(1)))
+	_, found = mcache.VSVIPCache.AviCacheGet(vsvipKey)
+	g.Expect(found).To(gomega.Equal(true))
+
+	TearDownIngressForCacheSyncCheck(t, ingName, svcName, "", modelName)
+}
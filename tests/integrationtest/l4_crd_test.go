@@ -27,6 +27,7 @@ import (
 	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -3063,3 +3064,79 @@ func TestL4RuleWithHealthMonitorCrdAndAppPersistenceProfile(t *testing.T) {
 	DeleteHealthMonitorCRD(t, healthMonitorName, NAMESPACE)
 	TearDownTestForSvcLB(t, g, svcName)
 }
+
+func TestL4RuleMaintenance(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	L4RuleName := objNameMap.GenerateName("test-l4rule")
+	svcName := objNameMap.GenerateName(SINGLEPORTSVC)
+	modelName := MODEL_REDNS_PREFIX + svcName
+	ports := []int{8080}
+
+	SetUpTestForSvcLB(t, svcName)
+	g.Eventually(func() bool {
+		found, _ := objects.SharedAviGraphLister().Get(modelName)
+		return found
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	// Create the L4Rule, draining all the pool servers
+	obj := FakeL4Rule{
+		Name:      L4RuleName,
+		Namespace: NAMESPACE,
+		Ports:     ports,
+	}.L4Rule()
+	obj.Spec.Maintenance = &akov1alpha2.Maintenance{Enabled: true, DrainTimeout: proto.Int32(10)}
+	if _, err := lib.AKOControlConfig().V1alpha2CRDClientset().AkoV1alpha2().L4Rules(NAMESPACE).Create(context.TODO(), obj, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding L4Rule: %v", err)
+	}
+	g.Eventually(func() string {
+		l4Rule, _ := lib.AKOControlConfig().V1alpha2CRDClientset().AkoV1alpha2().L4Rules(NAMESPACE).Get(context.TODO(), L4RuleName, metav1.GetOptions{})
+		return l4Rule.Status.Status
+	}, 30*time.Second).Should(gomega.Equal("Accepted"))
+
+	// Apply the  L4Rule to Service
+	svcObj := (FakeService{
+		Name:         svcName,
+		Namespace:    NAMESPACE,
+		Type:         corev1.ServiceTypeLoadBalancer,
+		ServicePorts: []Serviceport{{PortName: "foo1", Protocol: "TCP", PortNumber: 8080, TargetPort: intstr.FromInt(8080)}},
+	}).Service()
+	svcObj.Annotations = map[string]string{lib.L4RuleAnnotation: L4RuleName}
+	svcObj.ResourceVersion = "2"
+	if _, err := KubeClient.CoreV1().Services(NAMESPACE).Update(context.TODO(), svcObj, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating Service: %v", err)
+	}
+
+	drainedPool := func() bool {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		pool := aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs[0]
+		return pool.DrainAllServers
+	}
+	maintenanceCondition := func() *metav1.Condition {
+		svc, _ := KubeClient.CoreV1().Services(NAMESPACE).Get(context.TODO(), svcName, metav1.GetOptions{})
+		return meta.FindStatusCondition(svc.Status.Conditions, lib.MaintenanceCondition)
+	}
+	g.Eventually(drainedPool, 30*time.Second).Should(gomega.BeTrue())
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	pool := aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs[0]
+	g.Expect(*pool.DrainAllServersTimeout).To(gomega.Equal(int32(10)))
+	g.Eventually(func() string {
+		if condition := maintenanceCondition(); condition != nil {
+			return condition.Message
+		}
+		return ""
+	}, 30*time.Second).Should(gomega.ContainSubstring("L4Rule " + NAMESPACE + "/" + L4RuleName))
+
+	// Disable the maintenance in the L4Rule, the pool servers are enabled again
+	obj, _ = lib.AKOControlConfig().V1alpha2CRDClientset().AkoV1alpha2().L4Rules(NAMESPACE).Get(context.TODO(), L4RuleName, metav1.GetOptions{})
+	obj.Spec.Maintenance.Enabled = false
+	obj.ResourceVersion = "2"
+	if _, err := lib.AKOControlConfig().V1alpha2CRDClientset().AkoV1alpha2().L4Rules(NAMESPACE).Update(context.TODO(), obj, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating L4Rule: %v", err)
+	}
+	g.Eventually(drainedPool, 30*time.Second).Should(gomega.BeFalse())
+	g.Eventually(maintenanceCondition, 30*time.Second).Should(gomega.BeNil())
+
+	TearDownTestForSvcLB(t, g, svcName)
+	TeardownL4Rule(t, L4RuleName, NAMESPACE)
+}
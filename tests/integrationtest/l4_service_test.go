@@ -23,6 +23,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -38,7 +39,10 @@ import (
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 
 	"github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -677,6 +681,90 @@ func TestAviSvcUpdateEndpoint(t *testing.T) {
 	TearDownTestForSvcLB(t, g, svcName)
 }
 
+func TestServiceLBMaintenanceMode(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	svcName := objNameMap.GenerateName(SINGLEPORTSVC)
+	modelName := fmt.Sprintf("%s/cluster--%s-%s", AVINAMESPACE, NAMESPACE, svcName)
+
+	SetUpTestForSvcLB(t, svcName)
+	ScaleCreateEPS(t, NAMESPACE, svcName)
+	// the endpoints are ready, so that the pool servers are enabled
+	epSlices, _ := KubeClient.DiscoveryV1().EndpointSlices(NAMESPACE).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: discovery.LabelServiceName + "=" + svcName,
+	})
+	epSlice := epSlices.Items[0]
+	for i := range epSlice.Endpoints {
+		epSlice.Endpoints[i].Conditions = discovery.EndpointConditions{Ready: proto.Bool(true)}
+	}
+	epSlice.ResourceVersion = "3"
+	if _, err := KubeClient.DiscoveryV1().EndpointSlices(NAMESPACE).Update(context.TODO(), &epSlice, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating EndpointSlice: %v", err)
+	}
+	g.Eventually(func() int {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		enabled := 0
+		for _, server := range aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs[0].Servers {
+			if server.Enabled != nil && *server.Enabled {
+				enabled++
+			}
+		}
+		return enabled
+	}, 5*time.Second).Should(gomega.Equal(2))
+
+	updateAnnotations := func(annotations map[string]string) {
+		svc, err := KubeClient.CoreV1().Services(NAMESPACE).Get(context.TODO(), svcName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("error in getting Service: %v", err)
+		}
+		svc.Annotations = annotations
+		svc.ResourceVersion = fmt.Sprintf("%d", time.Now().UnixNano())
+		if _, err = KubeClient.CoreV1().Services(NAMESPACE).Update(context.TODO(), svc, metav1.UpdateOptions{}); err != nil {
+			t.Fatalf("error in updating Service: %v", err)
+		}
+	}
+	disabledServers := func() []string {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		var servers []string
+		for _, server := range aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs[0].Servers {
+			if server.Enabled != nil && !*server.Enabled {
+				servers = append(servers, *server.Ip.Addr)
+			}
+		}
+		return servers
+	}
+	maintenanceCondition := func() *metav1.Condition {
+		svc, _ := KubeClient.CoreV1().Services(NAMESPACE).Get(context.TODO(), svcName, metav1.GetOptions{})
+		return meta.FindStatusCondition(svc.Status.Conditions, lib.MaintenanceCondition)
+	}
+
+	// drain a single pool server with a drain timeout
+	updateAnnotations(map[string]string{lib.DrainServersAnnotation: "1.2.3.5", lib.DrainTimeoutAnnotation: "10"})
+	g.Eventually(disabledServers, 10*time.Second).Should(gomega.Equal([]string{"1.2.3.5"}))
+	_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+	pool := aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs[0]
+	g.Expect(pool.GracefulDisableTimeout).NotTo(gomega.BeNil())
+	g.Expect(*pool.GracefulDisableTimeout).To(gomega.Equal(int32(10)))
+	g.Eventually(func() string {
+		if condition := maintenanceCondition(); condition != nil && condition.Status == metav1.ConditionTrue {
+			return condition.Message
+		}
+		return ""
+	}, 15*time.Second).Should(gomega.ContainSubstring("1.2.3.5"))
+
+	// drain all the pool servers
+	updateAnnotations(map[string]string{lib.MaintenanceModeAnnotation: "true"})
+	g.Eventually(disabledServers, 10*time.Second).Should(gomega.ConsistOf("1.2.3.4", "1.2.3.5"))
+	_, aviModel = objects.SharedAviGraphLister().Get(modelName)
+	g.Expect(aviModel.(*avinodes.AviObjectGraph).GetAviVS()[0].PoolRefs[0].GracefulDisableTimeout).To(gomega.BeNil())
+
+	// the pool servers are enabled again, once the annotations are removed
+	updateAnnotations(nil)
+	g.Eventually(disabledServers, 10*time.Second).Should(gomega.BeEmpty())
+	g.Eventually(maintenanceCondition, 15*time.Second).Should(gomega.BeNil())
+
+	TearDownTestForSvcLB(t, g, svcName)
+}
+
 // Rest Cache sync tests
 
 func TestCreateServiceLBCacheSync(t *testing.T) {
@@ -781,6 +869,46 @@ func TestCreateServiceLBWithFaultCacheSync(t *testing.T) {
 	TearDownTestForSvcLB(t, g, svcName)
 }
 
+func TestServiceLBProgrammedConditionOnVSError(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	svcName := objNameMap.GenerateName(SINGLEPORTSVC)
+
+	var injectFault atomic.Bool
+	injectFault.Store(true)
+	AddMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && strings.Contains(r.URL.EscapedPath(), "virtualservice") && injectFault.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, `{"error": "virtualservice placement failed"}`)
+			return
+		}
+		NormalControllerServer(w, r)
+	})
+	defer ResetMiddleware()
+
+	SetUpTestForSvcLB(t, svcName)
+
+	// the error of the Avi Controller is reported in the Programmed condition of the Service
+	g.Eventually(func() string {
+		svc, _ := KubeClient.CoreV1().Services(NAMESPACE).Get(context.TODO(), svcName, metav1.GetOptions{})
+		if condition := meta.FindStatusCondition(svc.Status.Conditions, lib.ProgrammedCondition); condition != nil &&
+			condition.Status == metav1.ConditionFalse && condition.Reason == lib.Rejected {
+			return condition.Message
+		}
+		return ""
+	}, 15*time.Second).Should(gomega.ContainSubstring("VirtualService cluster--red-ns-" + svcName))
+
+	// the Service is programmed on the retry, once the error is resolved
+	injectFault.Store(false)
+	g.Eventually(func() bool {
+		svc, _ := KubeClient.CoreV1().Services(NAMESPACE).Get(context.TODO(), svcName, metav1.GetOptions{})
+		condition := meta.FindStatusCondition(svc.Status.Conditions, lib.ProgrammedCondition)
+		return len(svc.Status.LoadBalancer.Ingress) > 0 && condition != nil &&
+			condition.Status == metav1.ConditionTrue && condition.Reason == lib.Programmed
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	TearDownTestForSvcLB(t, g, svcName)
+}
+
 func TestCreateMultiportServiceLBCacheSync(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	svcName := objNameMap.GenerateName(MULTIPORTSVC)
@@ -2152,3 +2280,30 @@ func TestAviSvcCreationSinglePortMultiTenantEnabledWithTrafficDisabledAnnotation
 		return found
 	}, 5*time.Second).Should(gomega.Equal(false))
 }
+
+func TestSyncStatusOfDeletedService(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	svcName := "sync-status-svc"
+	objKey := "L4LBService/" + NAMESPACE + "/" + svcName
+	modelName := MODEL_REDNS_PREFIX + svcName
+
+	SetUpTestForSvcLB(t, svcName)
+	g.Eventually(func() bool {
+		found, _ := objects.SharedSyncStatusLister().GetObjectModels(objKey)
+		return found
+	}, 10*time.Second).Should(gomega.Equal(true))
+	g.Eventually(func() bool {
+		found, _ := objects.SharedSyncStatusLister().GetRestResult(modelName)
+		return found
+	}, 10*time.Second).Should(gomega.Equal(true))
+
+	TearDownTestForSvcLB(t, g, svcName)
+	g.Eventually(func() bool {
+		found, _ := objects.SharedSyncStatusLister().GetObjectModels(objKey)
+		return found
+	}, 10*time.Second).Should(gomega.Equal(false))
+	g.Eventually(func() bool {
+		found, _ := objects.SharedSyncStatusLister().GetRestResult(modelName)
+		return found
+	}, 10*time.Second).Should(gomega.Equal(false))
+}
@@ -58,6 +58,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
 )
 
 // constants to be used for creating K8s objs and verifying Avi objs
@@ -1243,6 +1244,39 @@ func AddMiddleware(exec InjectFault) {
 func ResetMiddleware() {
 	FakeServerMiddleware = nil
 }
+
+// SetFakeEventRecorder records the AKO events in a FakeRecorder, and returns it with the function which
+// restores the previous event recorder.
+func SetFakeEventRecorder() (*record.FakeRecorder, func()) {
+	eventRecorder := lib.AKOControlConfig().EventRecorder()
+	prevRecorder, prevFake, prevEnabled := eventRecorder.Recorder, eventRecorder.Fake, eventRecorder.Enabled
+	fakeRecorder := record.NewFakeRecorder(1000)
+	eventRecorder.Recorder, eventRecorder.Fake, eventRecorder.Enabled = fakeRecorder, false, true
+	return fakeRecorder, func() {
+		eventRecorder.Recorder, eventRecorder.Fake, eventRecorder.Enabled = prevRecorder, prevFake, prevEnabled
+	}
+}
+
+// EventRecorded drains the events of the FakeRecorder, and returns true if one of them contains all the substrings.
+func EventRecorded(recorder *record.FakeRecorder, substrings ...string) bool {
+	for {
+		select {
+		case event := <-recorder.Events:
+			matched := true
+			for _, substring := range substrings {
+				if !strings.Contains(event, substring) {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				return true
+			}
+		default:
+			return false
+		}
+	}
+}
 func NewAviFakeClientInstance(kubeclient *k8sfake.Clientset, skipCachePopulation ...bool) {
 	if AviFakeClientInstance == nil {
 		AviFakeClientInstance = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,270 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package mcsserviceimporttests
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/k8s"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	avinodes "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/api"
+	crdfake "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1alpha1/clientset/versioned/fake"
+	v1beta1crdfake "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/client/v1beta1/clientset/versioned/fake"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
+)
+
+var KubeClient *k8sfake.Clientset
+var CRDClient *crdfake.Clientset
+var V1beta1CRDClient *v1beta1crdfake.Clientset
+var DynamicClient *dynamicfake.FakeDynamicClient
+var ctrl *k8s.AviController
+var akoApiServer *api.FakeApiServer
+
+func TestMain(m *testing.M) {
+	os.Setenv("INGRESS_API", "extensionv1")
+	os.Setenv("VIP_NETWORK_LIST", `[{"networkName":"net123"}]`)
+	os.Setenv("CLUSTER_NAME", "cluster")
+	os.Setenv("CLOUD_NAME", "CLOUD_VCENTER")
+	os.Setenv("SEG_NAME", "Default-Group")
+	os.Setenv("NODE_NETWORK_LIST", `[{"networkName":"net123","cidrs":["10.79.168.0/22"]}]`)
+	os.Setenv("POD_NAMESPACE", utils.AKO_DEFAULT_NS)
+	os.Setenv("AUTO_L4_FQDN", "default")
+	os.Setenv("SERVICE_TYPE", "ClusterIP")
+	os.Setenv("ENABLE_EVH", "true")
+	os.Setenv("MCI_ENABLED", "true")
+	os.Setenv("USE_MCS_SERVICE_IMPORT", "true")
+	os.Setenv("POD_NAME", "ako-0")
+
+	akoControlConfig := lib.AKOControlConfig()
+	KubeClient = k8sfake.NewSimpleClientset()
+	CRDClient = crdfake.NewSimpleClientset()
+	V1beta1CRDClient = v1beta1crdfake.NewSimpleClientset()
+	akoControlConfig.SetCRDClientset(CRDClient)
+	akoControlConfig.Setv1beta1CRDClientset(V1beta1CRDClient)
+	akoControlConfig.SetEventRecorder(lib.AKOEventComponent, KubeClient, true)
+	akoControlConfig.SetAKOInstanceFlag(true)
+	data := map[string][]byte{
+		"username": []byte("admin"),
+		"password": []byte("admin"),
+	}
+	object := metav1.ObjectMeta{Name: "avi-secret", Namespace: utils.GetAKONamespace()}
+	secret := &corev1.Secret{Data: data, ObjectMeta: object}
+	KubeClient.CoreV1().Secrets(utils.GetAKONamespace()).Create(context.TODO(), secret, metav1.CreateOptions{})
+
+	gvrToKind := map[schema.GroupVersionResource]string{
+		lib.MCSServiceImportGVR: "ServiceImportList",
+	}
+	DynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToKind)
+	lib.SetDynamicClientSet(DynamicClient)
+
+	registeredInformers := []string{
+		utils.ServiceInformer,
+		utils.EndpointSlicesInformer,
+		utils.IngressInformer,
+		utils.IngressClassInformer,
+		utils.SecretInformer,
+		utils.NSInformer,
+		utils.NodeInformer,
+		utils.ConfigMapInformer,
+		utils.MultiClusterIngressInformer,
+	}
+	args := make(map[string]interface{})
+	args[utils.INFORMERS_AKO_CLIENT] = CRDClient
+	utils.NewInformers(utils.KubeClientIntf{ClientSet: KubeClient}, registeredInformers, args)
+	informers := k8s.K8sinformers{Cs: KubeClient, DynamicClient: DynamicClient}
+	k8s.NewCRDInformers()
+
+	mcache := cache.SharedAviObjCache()
+	cloudObj := &cache.AviCloudPropertyCache{Name: "Default-Cloud", VType: "mock"}
+	cloudObj.NSIpamDNS = []string{"avi.internal", ".com"}
+	mcache.CloudKeyCache.AviCacheAdd("Default-Cloud", cloudObj)
+
+	akoApiServer = integrationtest.InitializeFakeAKOAPIServer()
+
+	integrationtest.NewAviFakeClientInstance(KubeClient)
+	defer integrationtest.AviFakeClientInstance.Close()
+
+	ctrl = k8s.SharedAviController()
+	stopCh := utils.SetupSignalHandler()
+	ctrlCh := make(chan struct{})
+	quickSyncCh := make(chan struct{})
+	waitGroupMap := make(map[string]*sync.WaitGroup)
+	waitGroupMap["ingestion"] = &sync.WaitGroup{}
+	waitGroupMap["fastretry"] = &sync.WaitGroup{}
+	waitGroupMap["slowretry"] = &sync.WaitGroup{}
+	waitGroupMap["graph"] = &sync.WaitGroup{}
+	waitGroupMap["status"] = &sync.WaitGroup{}
+	waitGroupMap["leaderElection"] = &sync.WaitGroup{}
+
+	integrationtest.AddConfigMap(KubeClient)
+	integrationtest.PollForSyncStart(ctrl, 10)
+
+	ctrl.HandleConfigMap(informers, ctrlCh, stopCh, quickSyncCh)
+	integrationtest.KubeClient = KubeClient
+	integrationtest.AddDefaultIngressClass()
+	ctrl.SetSEGroupCloudNameFromNSAnnotations()
+
+	go ctrl.InitController(informers, registeredInformers, ctrlCh, stopCh, quickSyncCh, waitGroupMap)
+	os.Exit(m.Run())
+}
+
+func getModelName(hostname string) string {
+	return "admin/cluster--Shared-L7-EVH-" + strconv.Itoa(int(utils.Bkt(hostname, 8)))
+}
+
+func createMCSServiceImport(t *testing.T, namespace, name string, port int64) {
+	serviceImport := &unstructured.Unstructured{}
+	serviceImport.SetUnstructuredContent(map[string]interface{}{
+		"apiVersion": "multicluster.x-k8s.io/v1alpha1",
+		"kind":       "ServiceImport",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"type": "ClusterSetIP",
+			"ports": []interface{}{
+				map[string]interface{}{"name": "http", "port": port, "protocol": "TCP"},
+			},
+		},
+	})
+	if _, err := DynamicClient.Resource(lib.MCSServiceImportGVR).Namespace(namespace).Create(context.TODO(), serviceImport, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding the upstream service import: %v", err)
+	}
+}
+
+func mcsEndpointSlice(namespace, svcName, cluster string, targetPort int32, addresses ...string) *discovery.EndpointSlice {
+	portName := "http"
+	protocol := corev1.ProtocolTCP
+	ready := true
+	epSlice := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      svcName + "-" + cluster,
+			Namespace: namespace,
+			Labels: map[string]string{
+				lib.MCSServiceNameLabel:   svcName,
+				lib.MCSSourceClusterLabel: cluster,
+			},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+		Ports:       []discovery.EndpointPort{{Name: &portName, Port: &targetPort, Protocol: &protocol}},
+	}
+	for _, address := range addresses {
+		epSlice.Endpoints = append(epSlice.Endpoints, discovery.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discovery.EndpointConditions{Ready: &ready},
+		})
+	}
+	return epSlice
+}
+
+func getPoolServers(modelName string) func() []string {
+	return func() []string {
+		_, aviModel := objects.SharedAviGraphLister().Get(modelName)
+		if aviModel == nil {
+			return nil
+		}
+		vsNodes := aviModel.(*avinodes.AviObjectGraph).GetAviEvhVS()
+		if len(vsNodes) != 1 || len(vsNodes[0].EvhNodes) != 1 || len(vsNodes[0].EvhNodes[0].PoolRefs) != 1 {
+			return nil
+		}
+		var servers []string
+		for _, server := range vsNodes[0].EvhNodes[0].PoolRefs[0].Servers {
+			servers = append(servers, *server.Ip.Addr+":"+strconv.Itoa(int(server.Port)))
+		}
+		sort.Strings(servers)
+		return servers
+	}
+}
+
+func TestMultiClusterIngressWithMCSServiceImport(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	modelName := getModelName("foo.com")
+	objects.SharedAviGraphLister().Delete(modelName)
+	integrationtest.AddSecret("my-secret", utils.GetAKONamespace(), "tlsCert", "tlsKey")
+
+	createMCSServiceImport(t, "default", "svc-foo", 8080)
+	clusterAEpSlice := mcsEndpointSlice("default", "svc-foo", "cluster-a", 9080, "10.10.1.1", "10.10.1.2")
+	if _, err := KubeClient.DiscoveryV1().EndpointSlices("default").Create(context.TODO(), clusterAEpSlice, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding the endpointslice: %v", err)
+	}
+	// The endpoints imported from the other member clusters are not added to the pool of cluster-a.
+	clusterBEpSlice := mcsEndpointSlice("default", "svc-foo", "cluster-b", 9080, "10.20.1.1")
+	if _, err := KubeClient.DiscoveryV1().EndpointSlices("default").Create(context.TODO(), clusterBEpSlice, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding the endpointslice: %v", err)
+	}
+
+	mciObj := integrationtest.FakeMultiClusterIngress{
+		Name:         "mci-foo",
+		HostName:     "foo.com",
+		SecretName:   "my-secret",
+		Clusters:     []string{"cluster-a"},
+		Weights:      []int{10},
+		Paths:        []string{"foo"},
+		ServiceNames: []string{"svc-foo"},
+		Ports:        []int{8080},
+		Namespaces:   []string{"default"},
+	}
+	if _, err := CRDClient.AkoV1alpha1().MultiClusterIngresses(utils.GetAKONamespace()).Create(context.TODO(), mciObj.Create(), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding multi-cluster Ingress: %v", err)
+	}
+
+	g.Eventually(getPoolServers(modelName), 20*time.Second).Should(gomega.Equal([]string{"10.10.1.1:9080", "10.10.1.2:9080"}))
+
+	// A change in the imported endpoints of the cluster updates the servers of the pool.
+	clusterAEpSlice = mcsEndpointSlice("default", "svc-foo", "cluster-a", 9080, "10.10.1.1", "10.10.1.2", "10.10.1.3")
+	clusterAEpSlice.ResourceVersion = "2"
+	if _, err := KubeClient.DiscoveryV1().EndpointSlices("default").Update(context.TODO(), clusterAEpSlice, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error in updating the endpointslice: %v", err)
+	}
+	g.Eventually(getPoolServers(modelName), 20*time.Second).Should(gomega.Equal([]string{"10.10.1.1:9080", "10.10.1.2:9080", "10.10.1.3:9080"}))
+
+	// The servers are removed once the service is no longer imported.
+	if err := DynamicClient.Resource(lib.MCSServiceImportGVR).Namespace("default").Delete(context.TODO(), "svc-foo", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("error in deleting the upstream service import: %v", err)
+	}
+	g.Eventually(func() int {
+		return len(getPoolServers(modelName)())
+	}, 20*time.Second).Should(gomega.Equal(0))
+
+	if err := CRDClient.AkoV1alpha1().MultiClusterIngresses(utils.GetAKONamespace()).Delete(context.TODO(), "mci-foo", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("error in deleting multi-cluster Ingress: %v", err)
+	}
+	KubeClient.DiscoveryV1().EndpointSlices("default").Delete(context.TODO(), clusterAEpSlice.Name, metav1.DeleteOptions{})
+	KubeClient.DiscoveryV1().EndpointSlices("default").Delete(context.TODO(), clusterBEpSlice.Name, metav1.DeleteOptions{})
+	KubeClient.CoreV1().Secrets(utils.GetAKONamespace()).Delete(context.TODO(), "my-secret", metav1.DeleteOptions{})
+	objects.SharedAviGraphLister().Delete(modelName)
+}
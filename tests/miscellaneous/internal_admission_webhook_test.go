@@ -0,0 +1,190 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package miscellaneous
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/k8s"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	akov1alpha2 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1alpha2"
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
+)
+
+// sendAdmissionReview posts an AdmissionReview for the object to the webhook server, the way the
+// kubernetes API server calls the webhook, and returns the response.
+func sendAdmissionReview(t *testing.T, server *httptest.Server, kind string, operation admissionv1.Operation, obj interface{}) *admissionv1.AdmissionResponse {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	review := admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID("uid-" + kind),
+			Kind:      metav1.GroupVersionKind{Group: "ako.vmware.com", Kind: kind},
+			Operation: operation,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+	body, _ := json.Marshal(review)
+	resp, err := server.Client().Post(server.URL+k8s.ValidatingWebhookPath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to call the webhook: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response status %d", resp.StatusCode)
+	}
+	result := &admissionv1.AdmissionReview{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		t.Fatalf("failed to decode the AdmissionReview: %v", err)
+	}
+	if result.Response == nil || result.Response.UID != review.Request.UID {
+		t.Fatalf("unexpected response %+v", result.Response)
+	}
+	return result.Response
+}
+
+func newWebhookServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.Handle(k8s.ValidatingWebhookPath, k8s.NewAdmissionWebhook())
+	return httptest.NewTLSServer(mux)
+}
+
+func TestAdmissionWebhookHostRule(t *testing.T) {
+	server := newWebhookServer()
+	defer server.Close()
+
+	fqdn := "webhook.avi.internal"
+	objects.SharedCRDLister().UpdateFQDNHostruleMapping(fqdn, "default/hr-existing")
+	defer objects.SharedCRDLister().DeleteHostruleFQDNMapping("default/hr-existing")
+
+	hostrule := func(name, fqdn string) *akov1beta1.HostRule {
+		return &akov1beta1.HostRule{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: akov1beta1.HostRuleSpec{
+				VirtualHost: akov1beta1.HostRuleVirtualHost{Fqdn: fqdn},
+			},
+		}
+	}
+
+	// duplicate fqdn in another HostRule
+	resp := sendAdmissionReview(t, server, lib.HostRule, admissionv1.Create, hostrule("hr-new", fqdn))
+	if resp.Allowed || !strings.Contains(resp.Result.Message, "duplicate fqdn "+fqdn+" found in default/hr-existing") {
+		t.Fatalf("expected the HostRule with duplicate fqdn to be denied, got %+v", resp)
+	}
+
+	// update of the HostRule which owns the fqdn
+	resp = sendAdmissionReview(t, server, lib.HostRule, admissionv1.Update, hostrule("hr-existing", fqdn))
+	if !resp.Allowed {
+		t.Fatalf("expected the HostRule to be allowed, got %+v", resp.Result)
+	}
+
+	invalidIP := hostrule("hr-ip", "ip.avi.internal")
+	invalidIP.Spec.VirtualHost.TCPSettings = &akov1beta1.HostRuleTCPSettings{LoadBalancerIP: "10.10.10"}
+	resp = sendAdmissionReview(t, server, lib.HostRule, admissionv1.Create, invalidIP)
+	if resp.Allowed || resp.Result.Message != "loadBalancerIP 10.10.10 is not a valid IP" {
+		t.Fatalf("expected the HostRule with invalid loadBalancerIP to be denied, got %+v", resp)
+	}
+
+	// the objects are not validated on delete
+	resp = sendAdmissionReview(t, server, lib.HostRule, admissionv1.Delete, hostrule("hr-new", fqdn))
+	if !resp.Allowed {
+		t.Fatalf("expected the delete to be allowed, got %+v", resp.Result)
+	}
+}
+
+func TestAdmissionWebhookHTTPRule(t *testing.T) {
+	server := newWebhookServer()
+	defer server.Close()
+
+	httprule := &akov1beta1.HTTPRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "httprule", Namespace: "default"},
+		Spec: akov1beta1.HTTPRuleSpec{
+			Fqdn: "foo.avi.internal",
+			Paths: []akov1beta1.HTTPRulePaths{{
+				Target: "/",
+				TLS:    akov1beta1.HTTPRuleTLS{Type: "reencrypt", PKIProfile: "pki", DestinationCA: "ca"},
+			}},
+		},
+	}
+	resp := sendAdmissionReview(t, server, lib.HTTPRule, admissionv1.Create, httprule)
+	if resp.Allowed || resp.Result.Message != lib.HttpRulePkiAndDestCASetErr {
+		t.Fatalf("expected the HTTPRule to be denied, got %+v", resp)
+	}
+}
+
+func TestAdmissionWebhookAviInfraSetting(t *testing.T) {
+	server := newWebhookServer()
+	defer server.Close()
+
+	infraSetting := &akov1beta1.AviInfraSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "infra"},
+		Spec: akov1beta1.AviInfraSettingSpec{
+			Network: akov1beta1.AviInfraSettingNetwork{BgpPeerLabels: []string{"peer1"}},
+		},
+	}
+	resp := sendAdmissionReview(t, server, lib.AviInfraSetting, admissionv1.Create, infraSetting)
+	if resp.Allowed || resp.Result.Message != "BGPPeerLabels cannot be set if EnableRhi is false." {
+		t.Fatalf("expected the AviInfraSetting to be denied, got %+v", resp)
+	}
+}
+
+func TestAdmissionWebhookL4Rule(t *testing.T) {
+	server := newWebhookServer()
+	defer server.Close()
+
+	invalidIP := "10.10.10.300"
+	l4Rule := &akov1alpha2.L4Rule{
+		ObjectMeta: metav1.ObjectMeta{Name: "l4rule", Namespace: "default"},
+		Spec:       akov1alpha2.L4RuleSpec{LoadBalancerIP: &invalidIP},
+	}
+	resp := sendAdmissionReview(t, server, lib.L4Rule, admissionv1.Create, l4Rule)
+	if resp.Allowed || resp.Result.Message != "loadBalancerIP 10.10.10.300 is not valid" {
+		t.Fatalf("expected the L4Rule to be denied, got %+v", resp)
+	}
+
+	// kinds which are not validated by AKO are allowed
+	resp = sendAdmissionReview(t, server, "HealthMonitor", admissionv1.Create, l4Rule)
+	if !resp.Allowed {
+		t.Fatalf("expected the HealthMonitor to be allowed, got %+v", resp.Result)
+	}
+}
+
+func TestAdmissionWebhookInvalidRequest(t *testing.T) {
+	server := newWebhookServer()
+	defer server.Close()
+
+	resp, err := server.Client().Post(server.URL+k8s.ValidatingWebhookPath, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("failed to call the webhook: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected status %d for a review without request, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+}
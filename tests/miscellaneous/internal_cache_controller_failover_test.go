@@ -0,0 +1,115 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package miscellaneous
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+func setupFailoverControllers(t *testing.T, state string) string {
+	server := setupMockAviServer(t, map[string]interface{}{
+		"/cluster/runtime": map[string]interface{}{
+			"cluster_state": map[string]interface{}{
+				"state": state,
+			},
+		},
+	})
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "https://")
+}
+
+func setupControllerFailover(t *testing.T, endpoints ...string) {
+	utils.SharedCtrlProp().PopulateCtrlProp(map[string]string{
+		utils.ENV_CTRL_USERNAME: "admin",
+		utils.ENV_CTRL_PASSWORD: "admin",
+	})
+	if lib.AKOControlConfig().ControllerVersion() == "" {
+		lib.AKOControlConfig().SetControllerVersion("22.1.2")
+	}
+	lib.SetControllerIP(strings.Join(endpoints, ","))
+	cache.AviClientInstanceMap.Delete(lib.GetTenant())
+	t.Cleanup(func() {
+		cache.AviClientInstanceMap.Delete(lib.GetTenant())
+		lib.SetControllerIP("")
+	})
+}
+
+func TestControllerEndpointsList(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	defer lib.SetControllerIP("")
+
+	lib.SetControllerIP(" 10.10.10.10, 10.10.10.11:8443,,10.10.10.12 ")
+	g.Expect(lib.GetControllerIP()).To(gomega.Equal("10.10.10.10"))
+	g.Expect(lib.GetControllerEndpoints()).To(gomega.Equal([]string{"10.10.10.10", "10.10.10.11:8443", "10.10.10.12"}))
+
+	lib.SetActiveControllerIP("10.10.10.12")
+	g.Expect(lib.GetControllerIP()).To(gomega.Equal("10.10.10.12"))
+	g.Expect(lib.GetControllerEndpoints()).To(gomega.HaveLen(3))
+}
+
+func TestControllerFailoverOnClusterDown(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	downEndpoint := setupFailoverControllers(t, "CLUSTER_DOWN")
+	upEndpoint := setupFailoverControllers(t, "CLUSTER_UP_HA_ACTIVE")
+	setupControllerFailover(t, downEndpoint, upEndpoint)
+
+	// The client pool is created against the first endpoint, since AKO is able to connect to it.
+	oldPool := cache.SharedAVIClients(lib.GetTenant())
+	g.Expect(oldPool).NotTo(gomega.BeNil())
+	g.Expect(lib.GetControllerIP()).To(gomega.Equal(downEndpoint))
+
+	g.Expect(cache.CheckControllerEndpoints()).To(gomega.Equal(upEndpoint))
+	g.Expect(lib.GetControllerIP()).To(gomega.Equal(upEndpoint))
+
+	newPool := cache.SharedAVIClients(lib.GetTenant())
+	g.Expect(newPool).NotTo(gomega.BeIdenticalTo(oldPool))
+	g.Expect(newPool.AviClient).To(gomega.HaveLen(len(oldPool.AviClient)))
+	g.Expect(cache.IsAviClusterActive(newPool.AviClient[0])).To(gomega.BeTrue())
+
+	// The healthy endpoint is retained.
+	g.Expect(cache.CheckControllerEndpoints()).To(gomega.Equal(upEndpoint))
+}
+
+func TestControllerFailoverOnConnectionFailure(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	// The first controller is closed, so that AKO is unable to connect to it.
+	server := setupMockAviServer(t, map[string]interface{}{})
+	unreachable := strings.TrimPrefix(server.URL, "https://")
+	server.Close()
+	upEndpoint := setupFailoverControllers(t, "CLUSTER_UP_HA_ACTIVE")
+	setupControllerFailover(t, unreachable, upEndpoint)
+
+	pool := cache.SharedAVIClients(lib.GetTenant())
+	g.Expect(pool).NotTo(gomega.BeNil())
+	g.Expect(lib.GetControllerIP()).To(gomega.Equal(upEndpoint))
+}
+
+func TestControllerFailoverNoHealthyEndpoint(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	firstEndpoint := setupFailoverControllers(t, "CLUSTER_DOWN")
+	secondEndpoint := setupFailoverControllers(t, "CLUSTER_DOWN")
+	setupControllerFailover(t, firstEndpoint, secondEndpoint)
+
+	g.Expect(cache.SharedAVIClients(lib.GetTenant())).NotTo(gomega.BeNil())
+	g.Expect(cache.CheckControllerEndpoints()).To(gomega.Equal(firstEndpoint))
+	g.Expect(lib.GetControllerIP()).To(gomega.Equal(firstEndpoint))
+}
@@ -0,0 +1,203 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package miscellaneous
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/vmware/alb-sdk/go/session"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/k8s"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+// upgradingController is a fake Avi Controller, whose cluster state and version can be changed by the test.
+type upgradingController struct {
+	lock    sync.Mutex
+	state   string
+	version string
+	leader  string
+}
+
+func (c *upgradingController) set(state, version, leader string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.state, c.version, c.leader = state, version, leader
+}
+
+func (c *upgradingController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case strings.Contains(r.URL.Path, "login"):
+		w.Write([]byte(`{"success": true}`))
+	case c.state == "":
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error": "Service Unavailable"}`))
+	case strings.Contains(r.URL.Path, "initial-data"):
+		w.Write([]byte(fmt.Sprintf(`{"version": {"Version": "%s"}}`, c.version)))
+	case strings.Contains(r.URL.Path, "cluster/runtime"):
+		w.Write([]byte(fmt.Sprintf(`{"cluster_state": {"state": "%s"}, "node_states": [{"role": "CLUSTER_LEADER", "name": "%s", "up_since": "2025-01-01 00:00:00"}]}`,
+			c.state, c.leader)))
+	case strings.HasSuffix(r.URL.Path, "/api/cloud/"):
+		w.Write([]byte(`{"count": 1, "results": [{"name": "CLOUD_VCENTER", "uuid": "cloud-uuid", "vtype": "CLOUD_VCENTER"}]}`))
+	case strings.Contains(r.URL.Path, "/api/cluster"):
+		w.Write([]byte(`{"uuid": "cluster-uuid"}`))
+	default:
+		w.Write([]byte(`{"count": 0, "results": []}`))
+	}
+}
+
+func setupUpgradingController(t *testing.T) *upgradingController {
+	controller := &upgradingController{state: "CLUSTER_UP_HA_ACTIVE", version: "22.1.3", leader: "node1"}
+	server := httptest.NewTLSServer(controller)
+	t.Cleanup(server.Close)
+	setupControllerFailover(t, strings.TrimPrefix(server.URL, "https://"))
+	utils.SharedWorkQueue(
+		&utils.WorkerQueue{NumWorkers: 1, WorkqueueName: utils.GraphLayer},
+		&utils.WorkerQueue{NumWorkers: 1, WorkqueueName: utils.StatusQueue, SlowSyncTime: 60},
+	)
+	t.Cleanup(func() {
+		lib.ResumeControllerSync()
+		cache.SharedAviObjCache().ClusterStatusCache.AviCacheDelete(lib.ClusterStatusCacheKey)
+	})
+	return controller
+}
+
+func graphQueueLen() int {
+	graphQueue := utils.SharedWorkQueue().GetQueueByName(utils.GraphLayer)
+	length := 0
+	for _, queue := range graphQueue.Workqueue {
+		length += queue.Len()
+	}
+	return length
+}
+
+func TestControllerSyncPauseBuffersKeys(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	defer lib.ResumeControllerSync()
+
+	g.Expect(lib.BufferKeyIfControllerSyncPaused("admin/vs1")).To(gomega.BeFalse())
+	g.Expect(lib.PauseControllerSync("upgrade")).To(gomega.BeTrue())
+	g.Expect(lib.PauseControllerSync("upgrade")).To(gomega.BeFalse())
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeTrue())
+
+	g.Expect(lib.BufferKeyIfControllerSyncPaused("admin/vs2")).To(gomega.BeTrue())
+	g.Expect(lib.BufferKeyIfControllerSyncPaused("admin/vs1")).To(gomega.BeTrue())
+	g.Expect(lib.BufferKeyIfControllerSyncPaused("admin/vs2")).To(gomega.BeTrue())
+	paused, reason, _, numKeys := lib.GetControllerSyncPauseState()
+	g.Expect(paused).To(gomega.BeTrue())
+	g.Expect(reason).To(gomega.Equal("upgrade"))
+	g.Expect(numKeys).To(gomega.Equal(2))
+
+	g.Expect(lib.ResumeControllerSync()).To(gomega.Equal([]string{"admin/vs2", "admin/vs1"}))
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeFalse())
+	g.Expect(lib.ResumeControllerSync()).To(gomega.BeEmpty())
+}
+
+func TestIsControllerUnavailableError(t *testing.T) {
+	upgradeMsg := "Configuration is disallowed during upgrade"
+	otherMsg := "Permission denied"
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "503", err: session.AviError{HttpStatusCode: 503}, want: true},
+		{name: "403 during upgrade", err: session.AviError{HttpStatusCode: 403, AviResult: session.AviResult{Message: &upgradeMsg}}, want: true},
+		{name: "wrapped 503", err: &utils.WebSyncError{Err: session.AviError{HttpStatusCode: 503}, Operation: "POST"}, want: true},
+		{name: "403", err: session.AviError{HttpStatusCode: 403, AviResult: session.AviResult{Message: &otherMsg}}, want: false},
+		{name: "500", err: session.AviError{HttpStatusCode: 500}, want: false},
+		{name: "not an AviError", err: fmt.Errorf("connection refused"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lib.IsControllerUnavailableError(tt.err); got != tt.want {
+				t.Errorf("IsControllerUnavailableError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObserveControllerUnavailableError(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// A lone 503 is retried, the sync is paused only if the controller keeps failing the requests.
+	g.Expect(lib.ObserveControllerUnavailableError()).To(gomega.BeFalse())
+	g.Expect(lib.ObserveControllerUnavailableError()).To(gomega.BeFalse())
+	g.Expect(lib.ObserveControllerUnavailableError()).To(gomega.BeTrue())
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeFalse())
+}
+
+func TestSyncFromNodesLayerBuffersKeysDuringPause(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	defer lib.ResumeControllerSync()
+
+	lib.PauseControllerSync("upgrade")
+	g.Expect(k8s.SyncFromNodesLayer("admin/paused-vs", nil)).To(gomega.Succeed())
+	_, _, _, numKeys := lib.GetControllerSyncPauseState()
+	g.Expect(numKeys).To(gomega.Equal(1))
+	g.Expect(lib.ResumeControllerSync()).To(gomega.Equal([]string{"admin/paused-vs"}))
+}
+
+func TestCheckControllerSyncPausesAndResumes(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	controller := setupUpgradingController(t)
+
+	version := k8s.CheckControllerSync("")
+	g.Expect(version).To(gomega.Equal("22.1.3"))
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeFalse())
+
+	// The controller is rebooted during the upgrade.
+	controller.set("", "", "")
+	g.Expect(k8s.CheckControllerSync(version)).To(gomega.Equal(version))
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeTrue())
+
+	controller.set("CLUSTER_STARTING", "22.1.3", "node2")
+	g.Expect(k8s.CheckControllerSync(version)).To(gomega.Equal(version))
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeTrue())
+	lib.BufferKeyIfControllerSyncPaused("admin/upgrade-vs1")
+	lib.BufferKeyIfControllerSyncPaused("admin/upgrade-vs2")
+
+	// The sync is resumed after the upgrade, and the buffered keys are published to the rest layer.
+	queueLen := graphQueueLen()
+	controller.set("CLUSTER_UP_HA_ACTIVE", "30.1.1", "node2")
+	g.Expect(k8s.CheckControllerSync(version)).To(gomega.Equal("30.1.1"))
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeFalse())
+	g.Eventually(graphQueueLen, 5*time.Second).Should(gomega.Equal(queueLen + 2))
+}
+
+func TestCheckControllerSyncOnVersionChange(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	controller := setupUpgradingController(t)
+
+	version := k8s.CheckControllerSync("")
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeFalse())
+
+	// An upgrade which completed between two checks, re-populates the cache and the sync is not left paused.
+	controller.set("CLUSTER_UP_HA_ACTIVE", "30.1.1", "node2")
+	g.Expect(k8s.CheckControllerSync(version)).To(gomega.Equal("30.1.1"))
+	g.Expect(lib.IsControllerSyncPaused()).To(gomega.BeFalse())
+}
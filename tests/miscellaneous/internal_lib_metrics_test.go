@@ -0,0 +1,192 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package miscellaneous
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/alb-sdk/go/models"
+	"github.com/vmware/alb-sdk/go/session"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+func TestSyncPipelineMetrics(t *testing.T) {
+	lib.SetPrometheusRegistry()
+	reg := lib.RegisterPromMetrics()
+	lib.AKOControlConfig().SetAKOPrometheusFlag(true)
+	defer lib.AKOControlConfig().SetAKOPrometheusFlag(false)
+	lib.AKOControlConfig().SetIsLeaderFlag(true)
+
+	cache := avicache.NewAviObjCache()
+	cache.PoolCache.AviCacheAdd(avicache.NamespaceName{Namespace: "admin", Name: "metrics-pool"}, &avicache.AviPoolCache{Name: "metrics-pool"})
+	lib.SetCacheSizeFunc(cache.CacheSizes)
+	defer lib.SetCacheSizeFunc(nil)
+
+	lib.ObserveSyncStageDuration(lib.RestStage, time.Now().Add(-time.Second))
+
+	// the key added again while it is in the queue keeps the time of the first event
+	ingestionQueue := utils.NewWorkQueue(1, utils.ObjectIngestionLayer)
+	ingestionQueue.EnableEnqueueTimeTracking()
+	ingestionQueue.Workqueue[0].AddRateLimited("Ingress/default/metrics-ing")
+	firstEvent := time.Now()
+	ingestionQueue.Workqueue[0].AddRateLimited("Ingress/default/metrics-ing")
+	enqueueTime, ok := ingestionQueue.PopEnqueueTime("Ingress/default/metrics-ing")
+	if !ok || enqueueTime.After(firstEvent) {
+		t.Errorf("expected the enqueue time of the first event, got %v %v", enqueueTime, ok)
+	}
+	if _, ok := ingestionQueue.PopEnqueueTime("Ingress/default/metrics-ing"); ok {
+		t.Errorf("enqueue time found after it is popped")
+	}
+	lib.ObserveSyncStageDuration(lib.IngestionStage, enqueueTime)
+	lib.ObserveFullSyncDuration(time.Now())
+	lib.IncrementRestOpErrorCounter("Pool", session.AviError{HttpStatusCode: 409})
+	lib.PauseControllerSync("metrics test")
+	lib.BufferKeyIfControllerSyncPaused("admin/metrics-vs")
+	lib.ResumeControllerSync()
+
+	metricFamilies, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics %v", err)
+	}
+	values := make(map[string]float64)
+	for _, mf := range metricFamilies {
+		for _, m := range mf.GetMetric() {
+			labels := []string{mf.GetName()}
+			for _, label := range m.GetLabel() {
+				labels = append(labels, label.GetValue())
+			}
+			key := strings.Join(labels, "/")
+			switch {
+			case m.GetHistogram() != nil:
+				values[key] = float64(m.GetHistogram().GetSampleCount())
+			case m.GetCounter() != nil:
+				values[key] = m.GetCounter().GetValue()
+			case m.GetGauge() != nil:
+				values[key] = m.GetGauge().GetValue()
+			}
+		}
+	}
+	expected := map[string]float64{
+		"sync_stage_duration_seconds/ingestion":       1,
+		"sync_stage_duration_seconds/rest":            1,
+		"full_sync_duration_seconds":                  1,
+		"rest_api_errors/Pool/409":                    1,
+		"cached_avi_objects/Pool":                     1,
+		"cached_avi_objects/VirtualService":           0,
+		"retry_queue_depth/fast":                      0,
+		"retry_queue_depth/slow":                      0,
+		"is_leader":                                   1,
+		"avi_controller_connection_status/INITIATING": 1,
+		"controller_sync_pause_duration_seconds":      1,
+		"controller_sync_paused":                      0,
+		"controller_sync_paused_models":               0,
+	}
+	// metric names are prefixed with the namespace and subsystem, ako_<pod name>_<pod namespace>_
+	for key, value := range expected {
+		found := false
+		for name, got := range values {
+			if strings.HasSuffix(name, "_"+key) {
+				found = true
+				if got != value {
+					t.Errorf("expected metric %s to be %v, got %v", key, value, got)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("metric %s not found", key)
+		}
+	}
+}
+
+func TestCertificateExpiryMetric(t *testing.T) {
+	lib.SetPrometheusRegistry()
+	reg := lib.RegisterPromMetrics()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error in generating the key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "foo.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(10 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error in generating the certificate: %v", err)
+	}
+	cert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	// the certificate is read while populating the cache, and its secret is set from the model
+	hostKey := "Host"
+	markers := []*models.RoleFilterMatchLabel{{Key: &hostKey, Values: []string{"foo.com"}}}
+	lib.TrackCertificateExpiry("admin", "metrics-cert", markers, cert)
+	lib.SetCertificateSecret("admin", "metrics-cert", "default", "foo-secret")
+	defer lib.UntrackCertificateExpiry("admin", "metrics-cert")
+
+	expiryDays := func() (float64, bool) {
+		metricFamilies, err := reg.Gather()
+		if err != nil {
+			t.Fatalf("failed to gather metrics %v", err)
+		}
+		for _, mf := range metricFamilies {
+			if !strings.HasSuffix(mf.GetName(), "_certificate_expiry_days") {
+				continue
+			}
+			for _, m := range mf.GetMetric() {
+				labels := make(map[string]string)
+				for _, label := range m.GetLabel() {
+					labels[label.GetName()] = label.GetValue()
+				}
+				if labels["certificate"] != "metrics-cert" {
+					continue
+				}
+				if labels["tenant"] != "admin" || labels["host"] != "foo.com" ||
+					labels["secret_namespace"] != "default" || labels["secret_name"] != "foo-secret" {
+					t.Errorf("unexpected labels of the certificate expiry metric %v", labels)
+				}
+				return m.GetGauge().GetValue(), true
+			}
+		}
+		return 0, false
+	}
+
+	days, found := expiryDays()
+	if !found {
+		t.Fatalf("certificate expiry metric not found")
+	}
+	if math.Round(days) != 10 {
+		t.Errorf("expected the certificate to expire in 10 days, got %v", days)
+	}
+
+	lib.UntrackCertificateExpiry("admin", "metrics-cert")
+	if _, found := expiryDays(); found {
+		t.Errorf("certificate expiry metric found after the certificate is deleted")
+	}
+}
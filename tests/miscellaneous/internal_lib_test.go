@@ -15,16 +15,22 @@
 package miscellaneous
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
 	avimodels "github.com/vmware/alb-sdk/go/models"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
 )
 
 // ========== Tests from finalizer_utils_test.go ==========
@@ -660,3 +666,165 @@ func TestVSVIPNotFoundError(t *testing.T) {
 		t.Error("VSVIPNotFoundError should contain 'VsVip'")
 	}
 }
+
+// ========== Tests from lib.go shard moves ==========
+
+func TestGetShardMoves(t *testing.T) {
+	hosts := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		hosts = append(hosts, fmt.Sprintf("host%d.avi.internal", i))
+	}
+
+	// on a resize from 8 to 4 shards only the hosts of the removed shards move.
+	moves := lib.GetShardMoves(hosts, nil, 8, 4)
+	if len(moves) == 0 || len(moves) >= len(hosts) {
+		t.Errorf("unexpected number of moves %d for %d hosts", len(moves), len(hosts))
+	}
+	for _, move := range moves {
+		from, _ := strconv.Atoi(move.From)
+		to, _ := strconv.Atoi(move.To)
+		if from < 4 || to != from-4 {
+			t.Errorf("host %s moved from shard %s to shard %s", move.Hostname, move.From, move.To)
+		}
+		if uint32(from) != utils.Bkt(move.Hostname, 8) {
+			t.Errorf("host %s is on shard %d, reported on shard %s", move.Hostname, utils.Bkt(move.Hostname, 8), move.From)
+		}
+	}
+	for i := 1; i < len(moves); i++ {
+		if moves[i-1].Hostname > moves[i].Hostname {
+			t.Errorf("moves are not sorted by hostname")
+			break
+		}
+	}
+
+	// on a resize from 4 to 8 shards hosts only move to the added shards.
+	for _, move := range lib.GetShardMoves(hosts, nil, 4, 8) {
+		if to, _ := strconv.Atoi(move.To); to < 4 {
+			t.Errorf("host %s moved to shard %s which is not added", move.Hostname, move.To)
+		}
+	}
+
+	if moves := lib.GetShardMoves(hosts, nil, 8, 8); len(moves) != 0 {
+		t.Errorf("expected no moves without a change, got %d", len(moves))
+	}
+
+	// on a change to or from dedicated VSs all the hosts move.
+	moves = lib.GetShardMoves(hosts, nil, 0, 8)
+	if len(moves) != len(hosts) {
+		t.Errorf("expected %d moves from dedicated VSs, got %d", len(hosts), len(moves))
+	}
+	for _, move := range moves {
+		if move.From != "DEDICATED" || move.To != strconv.Itoa(int(utils.Bkt(move.Hostname, 8))) {
+			t.Errorf("unexpected move %v from dedicated VSs", move)
+		}
+	}
+	moves = lib.GetShardMoves(hosts, nil, 4, 0)
+	if len(moves) != len(hosts) {
+		t.Errorf("expected %d moves to dedicated VSs, got %d", len(hosts), len(moves))
+	}
+	for _, move := range moves {
+		if move.From != strconv.Itoa(int(utils.Bkt(move.Hostname, 4))) || move.To != "DEDICATED" {
+			t.Errorf("unexpected move %v to dedicated VSs", move)
+		}
+	}
+	if moves := lib.GetShardMoves(hosts, nil, 0, 0); len(moves) != 0 {
+		t.Errorf("expected no moves between dedicated VSs, got %d", len(moves))
+	}
+}
+
+func TestStickyShardPlacement(t *testing.T) {
+	t.Setenv("STICKY_SHARD_PLACEMENT", "true")
+	hosts := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		hosts = append(hosts, fmt.Sprintf("sticky%d.avi.internal", i))
+	}
+	kubeClient := k8sfake.NewSimpleClientset()
+	if err := lib.LoadShardPlacements(kubeClient); err != nil {
+		t.Fatalf("failed to load the shard placements: %v", err)
+	}
+	defer lib.LoadShardPlacements(k8sfake.NewSimpleClientset())
+
+	// the hosts are hashed when they are placed first, and are not moved when the shard size is increased.
+	placed := make(map[string]uint32)
+	for _, host := range hosts {
+		if placed[host] = lib.GetShardVSNum(host, "", 4); placed[host] != utils.Bkt(host, 4) {
+			t.Errorf("host %s placed on shard %d, expected %d", host, placed[host], utils.Bkt(host, 4))
+		}
+	}
+	if moves := lib.GetShardMoves(hosts, nil, 4, 8); len(moves) != 0 {
+		t.Errorf("expected no moves on a resize from 4 to 8 shards, got %v", moves)
+	}
+	for _, host := range hosts {
+		if vsNum := lib.GetShardVSNum(host, "", 8); vsNum != placed[host] {
+			t.Errorf("host %s moved from shard %d to shard %d", host, placed[host], vsNum)
+		}
+	}
+	// the placements are kept per AviInfraSetting.
+	if vsNum := lib.GetShardVSNum(hosts[0], "infra-setting", 8); vsNum != utils.Bkt(hosts[0], 8) {
+		t.Errorf("host %s placed on shard %d of the AviInfraSetting, expected %d", hosts[0], vsNum, utils.Bkt(hosts[0], 8))
+	}
+
+	// the placements are persisted, and loaded during bootup.
+	if err := lib.SaveShardPlacements(kubeClient, hosts); err != nil {
+		t.Fatalf("failed to save the shard placements: %v", err)
+	}
+	lib.LoadShardPlacements(k8sfake.NewSimpleClientset())
+	var rehashed bool
+	for _, host := range hosts {
+		if lib.GetShardVSNum(host, "", 8) != placed[host] {
+			rehashed = true
+		}
+	}
+	if !rehashed {
+		t.Errorf("expected the hosts to be hashed over 8 shards without the persisted placements")
+	}
+	if err := lib.LoadShardPlacements(kubeClient); err != nil {
+		t.Fatalf("failed to load the shard placements: %v", err)
+	}
+	for _, host := range hosts {
+		if vsNum := lib.GetShardVSNum(host, "", 8); vsNum != placed[host] {
+			t.Errorf("host %s is on shard %d after bootup, expected %d", host, vsNum, placed[host])
+		}
+	}
+
+	// on a resize from 4 to 2 shards only the hosts of the removed shards move.
+	moves := lib.GetShardMoves(hosts, nil, 4, 2)
+	for _, move := range moves {
+		if move.From != strconv.Itoa(int(placed[move.Hostname])) || placed[move.Hostname] < 2 || move.To != strconv.Itoa(int(utils.Bkt(move.Hostname, 2))) {
+			t.Errorf("unexpected move %v on a resize from 4 to 2 shards", move)
+		}
+	}
+	for _, host := range hosts {
+		expected := placed[host]
+		if expected >= 2 {
+			expected = utils.Bkt(host, 2)
+		}
+		if vsNum := lib.GetShardVSNum(host, "", 2); vsNum != expected {
+			t.Errorf("host %s is on shard %d after the resize, expected %d", host, vsNum, expected)
+		}
+	}
+
+	// the placements of the removed hosts are dropped.
+	if err := lib.SaveShardPlacements(kubeClient, hosts[:1]); err != nil {
+		t.Fatalf("failed to save the shard placements: %v", err)
+	}
+	cm, err := kubeClient.CoreV1().ConfigMaps(utils.GetAKONamespace()).Get(context.TODO(), lib.ShardPlacementConfigMap, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get configmap %s: %v", lib.ShardPlacementConfigMap, err)
+	}
+	placements := make(map[string]uint32)
+	if err := json.Unmarshal([]byte(cm.Data["placements"]), &placements); err != nil {
+		t.Fatalf("failed to decode the shard placements: %v", err)
+	}
+	if len(placements) != 2 {
+		t.Errorf("expected the placements of %s only, got %v", hosts[0], placements)
+	}
+
+	// without the sticky shard placement, the hosts are hashed.
+	t.Setenv("STICKY_SHARD_PLACEMENT", "false")
+	for _, host := range hosts {
+		if vsNum := lib.GetShardVSNum(host, "", 8); vsNum != utils.Bkt(host, 8) {
+			t.Errorf("host %s placed on shard %d, expected %d", host, vsNum, utils.Bkt(host, 8))
+		}
+	}
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package miscellaneous
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	akov1beta1 "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/apis/ako/v1beta1"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+func TestShardPlanEndpoint(t *testing.T) {
+	t.Setenv("SHARD_VS_SIZE", "LARGE")
+	hosts := []string{"foo.com", "bar.com", "baz.com", "qux.com", "shard-plan.avi.internal"}
+	for _, host := range hosts {
+		nodes.SharedHostNameLister().SaveHostPathStore(host, "/", "default/ingress-shard-plan")
+		defer nodes.SharedHostNameLister().RemoveHostPathStore(host, "/", "default/ingress-shard-plan")
+	}
+
+	model := &nodes.ShardPlanModel{}
+	model.InitModel()
+	ops := model.ApiOperationMap(false, nil)
+	if len(ops) != 1 || ops[0].Route != "/api/shards/plan" {
+		t.Fatalf("unexpected operations %v", ops)
+	}
+
+	req := httptest.NewRequest("GET", "/api/shards/plan?shardSize=MEDIUM", nil)
+	w := httptest.NewRecorder()
+	ops[0].Handler(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("shard plan returned %d, want %d", w.Code, http.StatusOK)
+	}
+	var plan nodes.ShardPlan
+	if err := json.NewDecoder(w.Body).Decode(&plan); err != nil {
+		t.Fatalf("failed to decode the shard plan: %v", err)
+	}
+	if plan.CurrentShardSize != 8 || plan.ShardSize != 4 || plan.TotalHosts < len(hosts) {
+		t.Errorf("unexpected shard plan %v", plan)
+	}
+	expectedMoves := map[string]bool{}
+	for _, host := range hosts {
+		if utils.Bkt(host, 8) != utils.Bkt(host, 4) {
+			expectedMoves[host] = true
+		}
+	}
+	for _, move := range plan.Moves {
+		delete(expectedMoves, move.Hostname)
+		if move.From != strconv.Itoa(int(utils.Bkt(move.Hostname, 8))) || move.To != strconv.Itoa(int(utils.Bkt(move.Hostname, 4))) {
+			t.Errorf("unexpected move %v", move)
+		}
+	}
+	if len(expectedMoves) != 0 {
+		t.Errorf("moves missing from the shard plan: %v", expectedMoves)
+	}
+
+	req = httptest.NewRequest("GET", "/api/shards/plan?shardSize=DEDICATED", nil)
+	w = httptest.NewRecorder()
+	ops[0].Handler(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("shard plan for DEDICATED returned %d, want %d", w.Code, http.StatusOK)
+	}
+	plan = nodes.ShardPlan{}
+	if err := json.NewDecoder(w.Body).Decode(&plan); err != nil {
+		t.Fatalf("failed to decode the shard plan: %v", err)
+	}
+	if plan.ShardSize != 0 || len(plan.Moves) != plan.TotalHosts {
+		t.Errorf("expected all the hosts to move to dedicated VSs, got %v", plan)
+	}
+
+	req = httptest.NewRequest("GET", "/api/shards/plan?shardSize=HUGE", nil)
+	w = httptest.NewRecorder()
+	ops[0].Handler(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("shard plan for HUGE returned %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	req = httptest.NewRequest("GET", "/api/shards/plan?aviInfraSetting=unknown-infrasetting", nil)
+	w = httptest.NewRecorder()
+	ops[0].Handler(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("shard plan for an unknown AviInfraSetting returned %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestShardPlanForAviInfraSetting(t *testing.T) {
+	t.Setenv("SHARD_VS_SIZE", "LARGE")
+	infraSettingName := "shard-plan-infrasetting"
+	ingClassName := "shard-plan-ingress-class"
+
+	// the informers are not started, the objects are added to their stores.
+	informers := utils.GetInformers()
+	ingressInformer, ingressClassInformer := informers.IngressInformer, informers.IngressClassInformer
+	factory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	informers.IngressInformer = factory.Networking().V1().Ingresses()
+	informers.IngressClassInformer = factory.Networking().V1().IngressClasses()
+	defer func() {
+		informers.IngressInformer, informers.IngressClassInformer = ingressInformer, ingressClassInformer
+	}()
+
+	infraSetting := &akov1beta1.AviInfraSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: infraSettingName},
+		Spec: akov1beta1.AviInfraSettingSpec{
+			L7Settings: akov1beta1.AviInfraL7Settings{ShardSize: "SMALL"},
+		},
+		Status: akov1beta1.AviInfraSettingStatus{Status: lib.StatusAccepted},
+	}
+	infraSettingStore := lib.AKOControlConfig().CRDInformers().AviInfraSettingInformer.Informer().GetStore()
+	infraSettingStore.Add(infraSetting)
+	defer infraSettingStore.Delete(infraSetting)
+	apiGroup := lib.AkoGroup
+	informers.IngressClassInformer.Informer().GetStore().Add(&networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{Name: ingClassName},
+		Spec: networkingv1.IngressClassSpec{
+			Controller: lib.AviIngressController,
+			Parameters: &networkingv1.IngressClassParametersReference{
+				APIGroup: &apiGroup,
+				Kind:     lib.AviInfraSetting,
+				Name:     infraSettingName,
+			},
+		},
+	})
+	informers.IngressInformer.Informer().GetStore().Add(&networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress-shard-plan-infra", Namespace: "default"},
+		Spec:       networkingv1.IngressSpec{IngressClassName: &ingClassName},
+	})
+
+	infraHosts := []string{"foo.infra.com", "bar.infra.com", "baz.infra.com", "qux.infra.com"}
+	for _, host := range infraHosts {
+		nodes.SharedHostNameLister().SaveHostPathStore(host, "/", "default/ingress-shard-plan-infra")
+		defer nodes.SharedHostNameLister().RemoveHostPathStore(host, "/", "default/ingress-shard-plan-infra")
+	}
+	globalHosts := []string{"foo.global.com", "bar.global.com"}
+	for _, host := range globalHosts {
+		nodes.SharedHostNameLister().SaveHostPathStore(host, "/", "default/ingress-shard-plan-global")
+		defer nodes.SharedHostNameLister().RemoveHostPathStore(host, "/", "default/ingress-shard-plan-global")
+	}
+	allHosts := append(append([]string{}, infraHosts...), globalHosts...)
+
+	// the hosts sharded by the AviInfraSetting are left out of the plan of the global shard size.
+	plan := nodes.GetShardPlan(allHosts, nil, 0)
+	if plan.TotalHosts != len(globalHosts) || len(plan.Moves) != len(globalHosts) {
+		t.Errorf("unexpected plan for the global shard size %v", plan)
+	}
+	for _, move := range plan.Moves {
+		if !utils.HasElem(globalHosts, move.Hostname) || move.To != "DEDICATED" {
+			t.Errorf("unexpected move %v for the global shard size", move)
+		}
+	}
+
+	model := &nodes.ShardPlanModel{}
+	ops := model.ApiOperationMap(false, nil)
+	req := httptest.NewRequest("GET", "/api/shards/plan?aviInfraSetting="+infraSettingName+"&shardSize=MEDIUM", nil)
+	w := httptest.NewRecorder()
+	ops[0].Handler(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("shard plan returned %d, want %d", w.Code, http.StatusOK)
+	}
+	plan = nodes.ShardPlan{}
+	if err := json.NewDecoder(w.Body).Decode(&plan); err != nil {
+		t.Fatalf("failed to decode the shard plan: %v", err)
+	}
+	if plan.AviInfraSetting != infraSettingName || plan.CurrentShardSize != 1 || plan.ShardSize != 4 || plan.TotalHosts != len(infraHosts) {
+		t.Errorf("unexpected shard plan for the AviInfraSetting %v", plan)
+	}
+	for _, move := range plan.Moves {
+		if !utils.HasElem(infraHosts, move.Hostname) || move.From != "0" || move.To != strconv.Itoa(int(utils.Bkt(move.Hostname, 4))) {
+			t.Errorf("unexpected move %v for the AviInfraSetting", move)
+		}
+	}
+}
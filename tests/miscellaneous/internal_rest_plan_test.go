@@ -0,0 +1,147 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package miscellaneous
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/nodes"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/rest"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/pkg/utils"
+)
+
+func TestBuildRestOpsForModelWithCache(t *testing.T) {
+	cache := avicache.NewAviObjCache()
+	restOps := rest.NewRestOperations(cache)
+
+	poolNode := &nodes.AviPoolNode{Name: "plan-pool-a", Tenant: "admin"}
+	vsNode := &nodes.AviVsNode{Name: "plan-vs", Tenant: "admin", PoolRefs: []*nodes.AviPoolNode{poolNode}}
+	avimodel := nodes.NewAviObjectGraph()
+	avimodel.AddModelNode(vsNode)
+
+	poolA := avicache.NamespaceName{Namespace: "admin", Name: "plan-pool-a"}
+	poolB := avicache.NamespaceName{Namespace: "admin", Name: "plan-pool-b"}
+	cache.PoolCache.AviCacheAdd(poolA, &avicache.AviPoolCache{Name: poolA.Name, Tenant: "admin", Uuid: "pool-a-uuid", CloudConfigCksum: strconv.Itoa(int(poolNode.GetCheckSum()))})
+	cache.PoolCache.AviCacheAdd(poolB, &avicache.AviPoolCache{Name: poolB.Name, Tenant: "admin", Uuid: "pool-b-uuid"})
+	vsKey := avicache.NamespaceName{Namespace: "admin", Name: "plan-vs"}
+	cache.VsCacheMeta.AviCacheAdd(vsKey, &avicache.AviVsCache{
+		Name:              vsKey.Name,
+		Tenant:            "admin",
+		Uuid:              "vs-uuid",
+		CloudConfigCksum:  "1",
+		PoolKeyCollection: []avicache.NamespaceName{poolA, poolB},
+	})
+
+	ops, err := restOps.BuildRestOpsForModel("admin/plan-vs", avimodel)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var got []string
+	for _, op := range ops {
+		got = append(got, string(op.Method)+" "+op.Model+" "+op.Path)
+	}
+	expected := []string{"PUT VirtualService /api/virtualservice/vs-uuid", "DELETE Pool /api/pool/pool-b-uuid"}
+	if strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected rest operations %v, got %v", expected, got)
+	}
+	if _, found := cache.PoolCache.AviCacheGet(poolB); !found {
+		t.Fatalf("cache must not be updated by the plan")
+	}
+
+	// nil model deletes the virtualservice and its objects.
+	ops, err = restOps.BuildRestOpsForModel("admin/plan-vs", nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	got = nil
+	for _, op := range ops {
+		got = append(got, string(op.Method)+" "+op.Model+" "+op.ObjName)
+	}
+	expected = []string{"DELETE VirtualService plan-vs", "DELETE Pool plan-pool-a", "DELETE Pool plan-pool-b"}
+	if strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected rest operations %v, got %v", expected, got)
+	}
+}
+
+func TestDiffAviObject(t *testing.T) {
+	current := map[string]interface{}{
+		"name":            "pool",
+		"uuid":            "pool-uuid",
+		"cloud_ref":       "https://10.10.10.10/api/cloud/cloud-uuid#Default-Cloud",
+		"default_port":    float64(80),
+		"lb_algorithm":    "LB_ALGORITHM_LEAST_CONNECTIONS",
+		"servers":         []interface{}{map[string]interface{}{"ip": map[string]interface{}{"addr": "10.1.1.1"}}, map[string]interface{}{"ip": map[string]interface{}{"addr": "10.1.1.2"}}},
+		"controller_only": true,
+	}
+	desired := map[string]interface{}{
+		"name":         "pool",
+		"cloud_ref":    "/api/cloud?name=Default-Cloud",
+		"default_port": float64(8080),
+		"servers":      []interface{}{map[string]interface{}{"ip": map[string]interface{}{"addr": "10.1.1.3"}}},
+		"markers":      []interface{}{map[string]interface{}{"key": "clustername"}},
+	}
+	changes := rest.DiffAviObject(current, desired)
+	expected := []rest.FieldChange{
+		{Field: "default_port", Old: float64(80), New: float64(8080)},
+		{Field: "markers[0].key", New: "clustername"},
+		{Field: "servers[0].ip.addr", Old: "10.1.1.1", New: "10.1.1.3"},
+		{Field: "servers[1]", Old: map[string]interface{}{"ip": map[string]interface{}{"addr": "10.1.1.2"}}},
+	}
+	if utils.Stringify(changes) != utils.Stringify(expected) {
+		t.Fatalf("expected changes %s, got %s", utils.Stringify(expected), utils.Stringify(changes))
+	}
+
+	text := rest.FormatPlans([]rest.ModelPlan{{Model: "admin/vs", Operations: []rest.PlannedOperation{{Method: "PUT", Model: "Pool", Name: "pool", Tenant: "admin", Path: "/api/pool/pool-uuid", Changes: changes}}}})
+	for _, line := range []string{"PUT Pool pool", "~ default_port: 80 -> 8080", "+ markers[0].key: \"clustername\"", "- servers[1]:"} {
+		if !strings.Contains(text, line) {
+			t.Fatalf("expected %q in the plan:\n%s", line, text)
+		}
+	}
+}
+
+func TestDiffAviObjectRedactsSecrets(t *testing.T) {
+	current := map[string]interface{}{
+		"name":        "sslkeycert",
+		"certificate": map[string]interface{}{"certificate": "old-cert"},
+		"key":         "<sensitive>",
+	}
+	desired := map[string]interface{}{
+		"name":           "sslkeycert",
+		"certificate":    map[string]interface{}{"certificate": "new-cert"},
+		"key":            "private-key",
+		"key_passphrase": "key-passphrase",
+		"markers":        []interface{}{map[string]interface{}{"key": "clustername"}},
+		"auth":           map[string]interface{}{"password": "auth-password"},
+	}
+	changes := rest.DiffAviObject(current, desired)
+	expected := []rest.FieldChange{
+		{Field: "auth.password", New: "<redacted>"},
+		{Field: "certificate.certificate", Old: "old-cert", New: "new-cert"},
+		{Field: "key", Old: "<redacted>", New: "<redacted>"},
+		{Field: "key_passphrase", New: "<redacted>"},
+		{Field: "markers[0].key", New: "clustername"},
+	}
+	if utils.Stringify(changes) != utils.Stringify(expected) {
+		t.Fatalf("expected changes %s, got %s", utils.Stringify(expected), utils.Stringify(changes))
+	}
+	for _, secret := range []string{"private-key", "key-passphrase", "auth-password"} {
+		if strings.Contains(utils.Stringify(changes), secret) {
+			t.Fatalf("secret %s is reported in the changes %s", secret, utils.Stringify(changes))
+		}
+	}
+}
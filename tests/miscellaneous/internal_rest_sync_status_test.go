@@ -0,0 +1,118 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package miscellaneous
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	avicache "github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/cache"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/rest"
+)
+
+func TestObjectSyncStatus(t *testing.T) {
+	modelName := "admin/sync-status-vs"
+	syncStatus := objects.SharedSyncStatusLister()
+	syncStatus.ResetObjectModels("Ingress/default/sync-status-ing")
+	syncStatus.AddObjectModel("Ingress/default/sync-status-ing", modelName)
+	syncStatus.AddObjectModel("Ingress/default/sync-status-ing", modelName)
+	syncStatus.AddObjectModel("L4LBService/default/sync-status-svc", modelName)
+	syncStatus.SaveRestResult(modelName, objects.RestResult{Timestamp: time.Now(), Success: false, Operations: []string{"POST Pool sync-status-pool"}, Errors: []string{"pool error"}})
+	syncStatus.SaveRetry(modelName, objects.SlowRetryQueue)
+	defer syncStatus.DeleteRetry(modelName)
+
+	cache := avicache.SharedAviObjCache()
+	poolKey := avicache.NamespaceName{Namespace: "admin", Name: "sync-status-pool"}
+	vsKey := avicache.NamespaceName{Namespace: "admin", Name: "sync-status-vs"}
+	cache.PoolCache.AviCacheAdd(poolKey, &avicache.AviPoolCache{Name: poolKey.Name, Tenant: "admin", Uuid: "sync-status-pool-uuid"})
+	cache.VsCacheMeta.AviCacheAdd(vsKey, &avicache.AviVsCache{Name: vsKey.Name, Tenant: "admin", Uuid: "sync-status-vs-uuid", PoolKeyCollection: []avicache.NamespaceName{poolKey}})
+	defer cache.PoolCache.AviCacheDelete(poolKey)
+	defer cache.VsCacheMeta.AviCacheDelete(vsKey)
+
+	statuses := rest.GetObjectSyncStatus("ingress", "default", "sync-status-ing")
+	if len(statuses) != 1 || len(statuses[0].Models) != 1 {
+		t.Fatalf("expected one object with one model, got %+v", statuses)
+	}
+	status := statuses[0].Models[0]
+	if status.Model != modelName {
+		t.Fatalf("expected model %s, got %s", modelName, status.Model)
+	}
+	if len(status.Objects) != 2 || status.Objects[0] != "Ingress/default/sync-status-ing" || status.Objects[1] != "L4LBService/default/sync-status-svc" {
+		t.Fatalf("unexpected objects of the model %v", status.Objects)
+	}
+	if status.LastRestResult == nil || status.LastRestResult.Success || status.LastRestResult.Errors[0] != "pool error" {
+		t.Fatalf("unexpected rest result %+v", status.LastRestResult)
+	}
+	if status.Retry == nil || status.Retry.Queue != objects.SlowRetryQueue {
+		t.Fatalf("expected model in slow retry queue, got %+v", status.Retry)
+	}
+	if len(status.CachedObjects) != 2 || status.CachedObjects[0].Uuid != "sync-status-vs-uuid" || status.CachedObjects[1].Uuid != "sync-status-pool-uuid" {
+		t.Fatalf("unexpected cached objects %+v", status.CachedObjects)
+	}
+
+	// kind Service matches the LoadBalancer services
+	if statuses := rest.GetObjectSyncStatus("Service", "default", "sync-status-svc"); len(statuses) != 1 {
+		t.Fatalf("expected LoadBalancer service to be found, got %+v", statuses)
+	}
+
+	syncStatus.DeleteRetry(modelName)
+	if status := rest.GetModelSyncStatus(modelName); status.Retry != nil {
+		t.Fatalf("expected model to be removed from retry queue, got %+v", status.Retry)
+	}
+}
+
+func TestObjectSyncStatusApi(t *testing.T) {
+	objects.SharedSyncStatusLister().AddObjectModel("Ingress/default/sync-status-api-ing", "admin/sync-status-api-vs")
+	router := mux.NewRouter()
+	for _, o := range (&rest.SyncStatusModel{}).ApiOperationMap(false, nil) {
+		router.HandleFunc(o.Route, o.Handler).Methods(o.Method)
+	}
+
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/objects/Ingress/default/sync-status-api-ing", nil))
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.Code)
+	}
+	var statuses []rest.ObjectSyncStatus
+	if err := json.Unmarshal(resp.Body.Bytes(), &statuses); err != nil {
+		t.Fatalf("failed to unmarshal response %v", err)
+	}
+	if len(statuses) != 1 || len(statuses[0].Models) != 1 || statuses[0].Models[0].Model != "admin/sync-status-api-vs" {
+		t.Fatalf("unexpected response %s", resp.Body.String())
+	}
+
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/models/admin/sync-status-api-vs", nil))
+	var modelStatus rest.ModelSyncStatus
+	if err := json.Unmarshal(resp.Body.Bytes(), &modelStatus); err != nil {
+		t.Fatalf("failed to unmarshal response %v", err)
+	}
+	if len(modelStatus.Objects) != 1 || modelStatus.Objects[0] != "Ingress/default/sync-status-api-ing" {
+		t.Fatalf("unexpected response %s", resp.Body.String())
+	}
+
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/objects/Ingress/default/unknown", nil))
+	statuses = nil
+	if err := json.Unmarshal(resp.Body.Bytes(), &statuses); err != nil || len(statuses) != 0 {
+		t.Fatalf("expected no objects, got %s", resp.Body.String())
+	}
+}
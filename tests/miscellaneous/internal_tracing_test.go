@@ -0,0 +1,198 @@
+/*
+ * Copyright © 2025 Broadcom Inc. and/or its subsidiaries. All Rights Reserved.
+ * All Rights Reserved.
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*   http://www.apache.org/licenses/LICENSE-2.0
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*/
+
+package miscellaneous
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/tracing"
+)
+
+func setupInMemoryTracing() *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	tracing.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	return exporter
+}
+
+func TestTracingDisabled(t *testing.T) {
+	tracing.SetTracerProvider(nil)
+	span := tracing.StartSpan(lib.GraphStage, "Ingress/default/foo")
+	if span != nil {
+		t.Fatalf("expected no span when tracing is disabled")
+	}
+	// no-op on a nil span
+	span.SetAttribute("key", "value")
+	span.SetError(errors.New("error"))
+	span.End()
+	tracing.Publish(lib.GraphStage, "Ingress/default/foo", lib.RestStage, "admin/vs")
+	if tracing.ActiveSpanContext(lib.GraphStage, "Ingress/default/foo").IsValid() {
+		t.Fatalf("expected no active span when tracing is disabled")
+	}
+}
+
+func TestTracePropagationAcrossStages(t *testing.T) {
+	exporter := setupInMemoryTracing()
+	defer tracing.SetTracerProvider(nil)
+
+	ingressKey, modelName := "Ingress/default/foo", "admin/cluster--Shared-L7-0"
+	graphSpan := tracing.StartSpan(lib.GraphStage, ingressKey)
+	tracing.Publish(lib.GraphStage, ingressKey, lib.RestStage, modelName)
+	if tracing.PendingParentsCount() != 1 {
+		t.Fatalf("expected a pending parent for the published key, got %d", tracing.PendingParentsCount())
+	}
+	graphSpan.End()
+
+	restSpan := tracing.StartSpan(lib.RestStage, modelName)
+	if tracing.PendingParentsCount() != 0 {
+		t.Fatalf("expected the pending parent to be consumed by the next stage")
+	}
+	callSpan := tracing.StartChildSpan(lib.RestStage, modelName, "PUT Pool")
+	callSpan.SetAttribute("http.status_code", 409)
+	callSpan.SetError(errors.New("conflict"))
+	callSpan.End()
+	tracing.Publish(lib.RestStage, modelName, lib.RetryStage, modelName)
+	statusParent := tracing.ActiveSpanContext(lib.RestStage, modelName)
+	restSpan.End()
+
+	retrySpan := tracing.StartSpan(lib.RetryStage, modelName)
+	retrySpan.End()
+	statusSpan := tracing.StartSpanWithParent(statusParent, lib.StatusStage, ingressKey)
+	statusSpan.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 5 {
+		t.Fatalf("expected 5 spans, got %d", len(spans))
+	}
+	traceID := graphSpan.SpanContext().TraceID
+	stubs := make(map[*tracing.Span]tracetest.SpanStub)
+	for _, stub := range spans {
+		if stub.SpanContext.TraceID() != traceID {
+			t.Fatalf("expected span %s in trace %s, got %s", stub.Name, traceID, stub.SpanContext.TraceID())
+		}
+		for _, span := range []*tracing.Span{graphSpan, restSpan, callSpan, retrySpan, statusSpan} {
+			if span.SpanContext().SpanID == stub.SpanContext.SpanID() {
+				stubs[span] = stub
+			}
+		}
+	}
+	parents := map[*tracing.Span]*tracing.Span{
+		restSpan:   graphSpan,
+		callSpan:   restSpan,
+		retrySpan:  restSpan,
+		statusSpan: restSpan,
+	}
+	for span, parent := range parents {
+		if stubs[span].Parent.SpanID() != parent.SpanContext().SpanID {
+			t.Fatalf("expected span %s to be a child of %s", stubs[span].Name, stubs[parent].Name)
+		}
+	}
+	callStub := stubs[callSpan]
+	statusCode := false
+	for _, attr := range callStub.Attributes {
+		if attr == attribute.Int("http.status_code", 409) {
+			statusCode = true
+		}
+	}
+	if callStub.Status.Code != codes.Error || callStub.Status.Description != "conflict" || !statusCode {
+		t.Fatalf("unexpected rest call span %+v", callStub)
+	}
+	if tracing.ActiveSpanContext(lib.RestStage, modelName).IsValid() {
+		t.Fatalf("expected no active span after the span is ended")
+	}
+
+	// a key which is not published by a traced stage starts a new trace
+	exporter.Reset()
+	span := tracing.StartSpan(lib.GraphStage, "Service/default/bar")
+	span.End()
+	if span.SpanContext().TraceID == traceID || exporter.GetSpans()[0].Parent.IsValid() {
+		t.Fatalf("expected a new trace for the key")
+	}
+}
+
+func TestOTLPHTTPExporter(t *testing.T) {
+	request := &coltracepb.ExportTraceServiceRequest{}
+	var path, contentType, header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType, header = r.URL.Path, r.Header.Get("Content-Type"), r.Header.Get("X-Scope-OrgID")
+		body, _ := io.ReadAll(r.Body)
+		proto.Unmarshal(body, request)
+	}))
+	defer server.Close()
+
+	os.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", server.URL+"/v1/traces")
+	os.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "X-Scope-OrgID=tenant")
+	os.Setenv("OTEL_SERVICE_NAME", "ako-test")
+	defer func() {
+		os.Unsetenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+		os.Unsetenv("OTEL_EXPORTER_OTLP_HEADERS")
+		os.Unsetenv("OTEL_SERVICE_NAME")
+	}()
+	tracing.InitFromEnv()
+	if !tracing.Enabled() {
+		t.Fatalf("expected tracing to be enabled")
+	}
+	span := tracing.StartSpan(lib.RestStage, "admin/vs")
+	span.SetAttribute("http.status_code", 500)
+	span.SetError(errors.New("internal error"))
+	span.End()
+	// flushes the batched spans
+	tracing.Shutdown()
+
+	if path != "/v1/traces" || contentType != "application/x-protobuf" || header != "tenant" {
+		t.Fatalf("unexpected request path %s, content type %s, header %s", path, contentType, header)
+	}
+	if len(request.ResourceSpans) != 1 {
+		t.Fatalf("expected the spans of a resource, got %d", len(request.ResourceSpans))
+	}
+	serviceName := ""
+	for _, attr := range request.ResourceSpans[0].Resource.Attributes {
+		if attr.Key == "service.name" {
+			serviceName = attr.Value.GetStringValue()
+		}
+	}
+	if serviceName != "ako-test" {
+		t.Fatalf("unexpected service name %s", serviceName)
+	}
+	otlpSpan := request.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	traceID, spanID := span.SpanContext().TraceID, span.SpanContext().SpanID
+	if string(otlpSpan.TraceId) != string(traceID[:]) || string(otlpSpan.SpanId) != string(spanID[:]) || otlpSpan.Name != lib.RestStage {
+		t.Fatalf("unexpected span %v", otlpSpan)
+	}
+	if otlpSpan.Status.Code.String() != "STATUS_CODE_ERROR" || otlpSpan.Status.Message != "internal error" {
+		t.Fatalf("unexpected span status %v", otlpSpan.Status)
+	}
+	statusCode := int64(0)
+	for _, attr := range otlpSpan.Attributes {
+		if attr.Key == "http.status_code" {
+			statusCode = attr.Value.GetIntValue()
+		}
+	}
+	if statusCode != 500 {
+		t.Fatalf("unexpected span attributes %v", otlpSpan.Attributes)
+	}
+}
@@ -231,6 +231,18 @@ func TestStatusModelUpdateAviApiRestStatusErrorLimit(t *testing.T) {
 	}
 }
 
+func TestStatusModelUpdateAviApiControllerEndpoint(t *testing.T) {
+	model := &models.StatusModel{}
+	model.UpdateAviApiControllerEndpoint("10.10.10.11")
+	if model.AviApi.ControllerEndpoint != "10.10.10.11" {
+		t.Errorf("UpdateAviApiControllerEndpoint() ControllerEndpoint = %v, want 10.10.10.11", model.AviApi.ControllerEndpoint)
+	}
+
+	// This should not panic
+	var nilModel *models.StatusModel
+	nilModel.UpdateAviApiControllerEndpoint("10.10.10.11")
+}
+
 func TestStatusModelUpdateAviApiRestStatusNilModel(t *testing.T) {
 	// Test that updating a nil model doesn't panic
 	var model *models.StatusModel
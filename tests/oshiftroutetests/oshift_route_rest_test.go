@@ -2,9 +2,14 @@ package oshiftroutetests
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/lib"
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/internal/objects"
 
 	"github.com/vmware/load-balancer-and-ingress-services-for-kubernetes/tests/integrationtest"
@@ -260,3 +265,47 @@ func TestPassthroughRouteWrongAlternateBackend(t *testing.T) {
 	TearDownRouteForRestCheck(t, DefaultPassthroughModel)
 	objects.SharedAviGraphLister().Delete(DefaultPassthroughModel)
 }
+
+func TestRouteEventsOnPoolError(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	var injectFault atomic.Bool
+	injectFault.Store(true)
+	integrationtest.AddMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && strings.Contains(r.URL.EscapedPath(), "pool") && injectFault.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, `{"error": "pool placement failed"}`)
+			return
+		}
+		integrationtest.NormalControllerServer(w, r)
+	})
+	defer integrationtest.ResetMiddleware()
+	recorder, restoreRecorder := integrationtest.SetFakeEventRecorder()
+	defer restoreRecorder()
+
+	SetUpTestForRoute(t, defaultModelName)
+	routeExample := FakeRoute{}.Route()
+	if _, err := OshiftClient.RouteV1().Routes(defaultNamespace).Create(context.TODO(), routeExample, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error in adding route: %v", err)
+	}
+
+	// the error of the Avi Controller is reported in the events of the Route
+	g.Eventually(func() bool {
+		return integrationtest.EventRecorded(recorder, corev1.EventTypeWarning, lib.Rejected, "cluster--Shared-L7-0", "Pool cluster--foo.com-default-foo-avisvc")
+	}, 30*time.Second).Should(gomega.Equal(true))
+
+	// the Route is programmed on the retry, once the error is resolved
+	injectFault.Store(false)
+	g.Eventually(func() bool {
+		return integrationtest.EventRecorded(recorder, corev1.EventTypeNormal, lib.Programmed, "cluster--Shared-L7-0")
+	}, 30*time.Second).Should(gomega.Equal(true))
+	g.Eventually(func() string {
+		route, _ := OshiftClient.RouteV1().Routes(defaultNamespace).Get(context.TODO(), defaultRouteName, metav1.GetOptions{})
+		if len(route.Status.Ingress) != 1 {
+			return ""
+		}
+		return route.Status.Ingress[0].Conditions[0].Message
+	}, 30*time.Second).Should(gomega.Equal("10.250.250.10"))
+
+	TearDownRouteForRestCheck(t, defaultModelName)
+}